@@ -7,6 +7,8 @@ package blobserve
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"html"
@@ -205,8 +207,6 @@ func (reg *Server) serve(w http.ResponseWriter, req *http.Request) {
 		req.URL.Path += "/"
 	}
 
-	w.Header().Set("ETag", hash)
-
 	inlineVarsValue := req.Header.Get("X-BlobServe-InlineVars")
 	if inlineVarsValue == "" {
 		w.Header().Set("Cache-Control", "public, max-age=31536000")
@@ -226,6 +226,17 @@ func (reg *Server) serve(w http.ResponseWriter, req *http.Request) {
 	if resourcePath == "/" {
 		resourcePath = "/index.html"
 	}
+	// ETags are strong validators, i.e. they must change whenever the content does. We prefer the hash of
+	// the file's content over the blob digest, so that clients can keep files which didn't change between blobs.
+	etag := fmt.Sprintf("%q", hash)
+	files, hasVariants := blobFS.(blobFiles)
+	if hasVariants {
+		if h := files.ContentHash(filepath.Join(workdir, resourcePath)); h != "" {
+			etag = fmt.Sprintf("%q", h)
+		}
+	}
+	w.Header().Set("ETag", etag)
+
 	if strings.HasSuffix(resourcePath, "/index.html") {
 		fc, err := fs.Open(resourcePath)
 		if err != nil {
@@ -246,11 +257,32 @@ func (reg *Server) serve(w http.ResponseWriter, req *http.Request) {
 		if err != nil {
 			log.WithError(err).Error()
 		}
+		if content != io.ReadSeeker(fc) {
+			// the inlined vars are part of the content
+			h := sha256.New()
+			_, err = io.Copy(h, content)
+			if err == nil {
+				_, err = content.Seek(0, io.SeekStart)
+			}
+			if err != nil {
+				log.WithError(err).WithField("fn", resourcePath).Error("cannot hash resource")
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("ETag", fmt.Sprintf("%q", hex.EncodeToString(h.Sum(nil))))
+		}
 
 		http.ServeContent(w, req, stat.Name(), stat.ModTime(), content)
 		return
 	}
 
+	if hasVariants {
+		w.Header().Set("Vary", "Accept-Encoding")
+		if servePrecompressed(w, req, files, filepath.Join(workdir, resourcePath)) {
+			return
+		}
+	}
+
 	http.StripPrefix(pathPrefix, http.FileServer(fs)).ServeHTTP(w, req)
 }
 
@@ -16,6 +16,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/containerd/containerd/errdefs"
@@ -86,7 +87,7 @@ func (b *diskBlobspace) collectGarbage(interval time.Duration) {
 		}
 
 		for _, f := range files {
-			if !f.IsDir() {
+			if !f.IsDir() || strings.HasSuffix(f.Name(), variantsSuffix) {
 				continue
 			}
 
@@ -98,6 +99,7 @@ func (b *diskBlobspace) collectGarbage(interval time.Duration) {
 				// TODO: also remove this blob if we're not aware of it being initialized at the moment
 				log.WithField("location", blob.F).Info("removing too old unready blob")
 
+				_ = os.RemoveAll(blob.F + variantsSuffix)
 				err = os.RemoveAll(blob.F)
 				if err != nil {
 					log.WithError(err).WithField("location", blob.F).Error("cannot remove blob")
@@ -123,6 +125,7 @@ func (b *diskBlobspace) collectGarbage(interval time.Duration) {
 				os.Remove(fmt.Sprintf("%s.ready", blob.F))
 				os.Remove(fmt.Sprintf("%s.size", blob.F))
 				os.Remove(fmt.Sprintf("%s.used", blob.F))
+				os.RemoveAll(blob.F + variantsSuffix)
 				err = os.RemoveAll(blob.F)
 				if err != nil {
 					log.WithError(err).WithField("location", blob.F).Error("cannot remove blob")
@@ -180,7 +183,7 @@ func (b *diskBlobspace) Get(name string) (fs http.FileSystem, state blobstate) {
 	}
 
 	_ = os.WriteFile(fmt.Sprintf("%s.used", fn), nil, 0644)
-	return http.Dir(fn), blobReady
+	return blobFiles{Dir: http.Dir(fn), Variants: fn + variantsSuffix}, blobReady
 }
 
 // AddFromTar adds content to this store under the given name.
@@ -220,10 +223,27 @@ func (b *diskBlobspace) AddFromTar(ctx context.Context, name string, in io.Reade
 		}
 	}
 
+	variants := fn + variantsSuffix
+	err = hashFiles(fn, variants)
+	if err != nil {
+		// without content hashes we fall back to the blob digest as ETag
+		log.WithError(err).WithField("blob", name).Warn("cannot hash blob content")
+	}
+
 	_ = os.WriteFile(fmt.Sprintf("%s.size", fn), []byte(fmt.Sprintf("%d", cw.C)), 0644)
 	_ = os.WriteFile(fmt.Sprintf("%s.used", fn), nil, 0644)
 	_ = os.WriteFile(fmt.Sprintf("%s.ready", fn), nil, 0644)
 
+	// Compressing takes a while. We don't want to delay serving the blob for that and serve the uncompressed
+	// files until their variants are in place.
+	go func() {
+		size, err := precompress(fn, variants)
+		if err != nil {
+			log.WithError(err).WithField("blob", name).Warn("cannot precompress blob content")
+		}
+		_ = os.WriteFile(fmt.Sprintf("%s.size", fn), []byte(fmt.Sprintf("%d", cw.C+size)), 0644)
+	}()
+
 	return nil
 }
 
@@ -0,0 +1,313 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package blobserve
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// variantsSuffix names the directory next to a blob which holds the content hashes and compressed variants of its files
+	variantsSuffix = ".variants"
+	// hashSuffix names the file which holds the hex-encoded SHA-256 hash of a file's content
+	hashSuffix = ".sha256"
+
+	// minPrecompressSize is the size below which compressing a file isn't worth it
+	minPrecompressSize = 1024
+)
+
+// precompressedExtensions lists the extensions of the files we precompress. Images, fonts like woff2 and archives are compressed already.
+var precompressedExtensions = map[string]struct{}{
+	".css":  {},
+	".htm":  {},
+	".html": {},
+	".js":   {},
+	".json": {},
+	".map":  {},
+	".md":   {},
+	".mjs":  {},
+	".svg":  {},
+	".ttf":  {},
+	".txt":  {},
+	".wasm": {},
+	".xml":  {},
+}
+
+type contentEncoding struct {
+	Name      string
+	Ext       string
+	NewWriter func(w io.Writer) (io.WriteCloser, error)
+}
+
+// contentEncodings lists the encodings we precompress files in, in order of preference
+var contentEncodings = []contentEncoding{
+	{
+		Name: "br",
+		Ext:  ".br",
+		NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return brotli.NewWriterLevel(w, brotli.BestCompression), nil
+		},
+	},
+	{
+		Name: "gzip",
+		Ext:  ".gz",
+		NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, gzip.BestCompression)
+		},
+	},
+}
+
+// hashFiles writes the content hash of every regular file in dir to variantsDir, which mirrors dir's structure
+func hashFiles(dir, variantsDir string) error {
+	err := os.RemoveAll(variantsDir)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(dir, func(fn string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, fn)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(fn)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		hash := sha256.New()
+		_, err = io.Copy(hash, f)
+		if err != nil {
+			return xerrors.Errorf("cannot hash %s: %w", rel, err)
+		}
+
+		dst := filepath.Join(variantsDir, rel+hashSuffix)
+		err = os.MkdirAll(filepath.Dir(dst), 0755)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dst, []byte(hex.EncodeToString(hash.Sum(nil))), 0644)
+	})
+}
+
+// precompress writes the compressed variants of the files in dir worth compressing to variantsDir.
+// Variants which aren't smaller than the original are dropped. Returns the total size of the variants.
+func precompress(dir, variantsDir string) (size int64, err error) {
+	err = filepath.WalkDir(dir, func(fn string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if _, ok := precompressedExtensions[strings.ToLower(filepath.Ext(fn))]; !ok {
+			return nil
+		}
+		stat, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if stat.Size() < minPrecompressSize {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, fn)
+		if err != nil {
+			return err
+		}
+
+		for _, enc := range contentEncodings {
+			n, err := compressFile(fn, filepath.Join(variantsDir, rel+enc.Ext), enc)
+			if err != nil {
+				return xerrors.Errorf("cannot compress %s: %w", rel, err)
+			}
+			if n >= stat.Size() {
+				_ = os.Remove(filepath.Join(variantsDir, rel+enc.Ext))
+				continue
+			}
+			size += n
+		}
+		return nil
+	})
+	return size, err
+}
+
+// compressFile compresses src to dst. We write to a temporary file first, so that we never serve a partial variant.
+func compressFile(src, dst string, enc contentEncoding) (size int64, err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	err = os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return 0, err
+	}
+	out, err := os.CreateTemp(filepath.Dir(dst), ".precompress-*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		out.Close()
+		if err != nil {
+			os.Remove(out.Name())
+		}
+	}()
+
+	var cw countingWriter
+	w, err := enc.NewWriter(io.MultiWriter(out, &cw))
+	if err != nil {
+		return 0, err
+	}
+	_, err = io.Copy(w, in)
+	if err != nil {
+		return 0, err
+	}
+	err = w.Close()
+	if err != nil {
+		return 0, err
+	}
+	err = out.Close()
+	if err != nil {
+		return 0, err
+	}
+	err = os.Rename(out.Name(), dst)
+	if err != nil {
+		return 0, err
+	}
+	return cw.C, nil
+}
+
+// blobFiles serves the files of a blob, and knows their content hashes and precompressed variants
+type blobFiles struct {
+	http.Dir
+	Variants string
+}
+
+// ContentHash returns the hex-encoded SHA-256 hash of a file's content, or an empty string if we don't know it
+func (b blobFiles) ContentHash(name string) string {
+	hash, err := os.ReadFile(b.variantPath(name) + hashSuffix)
+	if err != nil {
+		return ""
+	}
+	return string(hash)
+}
+
+// OpenVariant opens the variant of a file in the given content encoding
+func (b blobFiles) OpenVariant(name string, enc contentEncoding) (*os.File, error) {
+	return os.Open(b.variantPath(name) + enc.Ext)
+}
+
+func (b blobFiles) variantPath(name string) string {
+	return filepath.Join(b.Variants, filepath.FromSlash(path.Clean("/"+name)))
+}
+
+// acceptedEncodings lists the encodings we precompress in which the client accepts, most preferred first
+func acceptedEncodings(acceptEncoding string) []contentEncoding {
+	var (
+		quality  = make(map[string]float64)
+		wildcard = -1.0
+	)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		if k, v, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(k) == "q" {
+			var err error
+			q, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				continue
+			}
+		}
+		if name == "*" {
+			wildcard = q
+			continue
+		}
+		quality[name] = q
+	}
+
+	var res []contentEncoding
+	for _, enc := range contentEncodings {
+		q, ok := quality[enc.Name]
+		if !ok {
+			q = wildcard
+		}
+		if q <= 0 {
+			continue
+		}
+		res = append(res, enc)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		qi, ok := quality[res[i].Name]
+		if !ok {
+			qi = wildcard
+		}
+		qj, ok := quality[res[j].Name]
+		if !ok {
+			qj = wildcard
+		}
+		return qi > qj
+	})
+	return res
+}
+
+// servePrecompressed serves the precompressed variant of a file if the client accepts one. Returns false if it didn't serve anything.
+// Range requests get the original file, as clients expect ranges to refer to the uncompressed content.
+func servePrecompressed(w http.ResponseWriter, req *http.Request, files blobFiles, name string) bool {
+	if req.Header.Get("Range") != "" {
+		return false
+	}
+	ctype := mime.TypeByExtension(filepath.Ext(name))
+	if ctype == "" {
+		return false
+	}
+
+	for _, enc := range acceptedEncodings(req.Header.Get("Accept-Encoding")) {
+		f, err := files.OpenVariant(name, enc)
+		if err != nil {
+			continue
+		}
+		defer f.Close()
+		stat, err := f.Stat()
+		if err != nil {
+			return false
+		}
+
+		h := w.Header()
+		h.Set("Content-Type", ctype)
+		h.Set("Content-Encoding", enc.Name)
+		if etag := h.Get("ETag"); strings.HasSuffix(etag, `"`) {
+			// strong ETags must differ between the encodings of a file
+			h.Set("ETag", strings.TrimSuffix(etag, `"`)+"-"+enc.Name+`"`)
+		}
+		http.ServeContent(w, req, name, stat.ModTime(), f)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package blobserve
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_acceptedEncodings(t *testing.T) {
+	tests := []struct {
+		Name           string
+		AcceptEncoding string
+		Expected       []string
+	}{
+		{Name: "none", AcceptEncoding: "", Expected: nil},
+		{Name: "identity only", AcceptEncoding: "identity", Expected: nil},
+		{Name: "browser", AcceptEncoding: "gzip, deflate, br, zstd", Expected: []string{"br", "gzip"}},
+		{Name: "gzip only", AcceptEncoding: "gzip", Expected: []string{"gzip"}},
+		{Name: "quality", AcceptEncoding: "br;q=0.5, gzip;q=0.8", Expected: []string{"gzip", "br"}},
+		{Name: "rejected", AcceptEncoding: "br;q=0, gzip", Expected: []string{"gzip"}},
+		{Name: "wildcard", AcceptEncoding: "*", Expected: []string{"br", "gzip"}},
+		{Name: "wildcard with exception", AcceptEncoding: "gzip;q=0, *;q=0.1", Expected: []string{"br"}},
+		{Name: "case insensitive", AcceptEncoding: "GZIP", Expected: []string{"gzip"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var act []string
+			for _, enc := range acceptedEncodings(tt.AcceptEncoding) {
+				act = append(act, enc.Name)
+			}
+			if diff := cmp.Diff(tt.Expected, act); diff != "" {
+				t.Errorf("acceptedEncodings() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_precompress(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		variants = dir + variantsSuffix
+		large    = []byte(strings.Repeat("console.log('hello world');\n", 100))
+	)
+	for fn, content := range map[string][]byte{
+		"main.js":      large,
+		"small.css":    []byte("body{}"),
+		"img/logo.png": large,
+	} {
+		err := os.MkdirAll(filepath.Dir(filepath.Join(dir, fn)), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, fn), content, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := hashFiles(dir, variants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, err := precompress(dir, variants)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var act []string
+	err = filepath.Walk(variants, func(fn string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(variants, fn)
+		act = append(act, rel)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"img/logo.png.sha256", "main.js.br", "main.js.gz", "main.js.sha256", "small.css.sha256"}
+	if diff := cmp.Diff(expected, act); diff != "" {
+		t.Errorf("precompress() produced unexpected files (-want +got):\n%s", diff)
+	}
+	if size <= 0 || size >= 2*int64(len(large)) {
+		t.Errorf("precompress() returned unexpected size %d", size)
+	}
+
+	files := blobFiles{Dir: http.Dir(dir), Variants: variants}
+	hash := sha256.Sum256(large)
+	if diff := cmp.Diff(hex.EncodeToString(hash[:]), files.ContentHash("/main.js")); diff != "" {
+		t.Errorf("ContentHash() mismatch (-want +got):\n%s", diff)
+	}
+	if h := files.ContentHash("/../../main.js"); h != hex.EncodeToString(hash[:]) {
+		t.Errorf("ContentHash() did not stay within the blob: %s", h)
+	}
+
+	for _, enc := range contentEncodings {
+		f, err := files.OpenVariant("/main.js", enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r io.Reader
+		switch enc.Name {
+		case "br":
+			r = brotli.NewReader(f)
+		case "gzip":
+			r, err = gzip.NewReader(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		content, err := io.ReadAll(r)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(large, content) {
+			t.Errorf("%s variant does not decompress to the original content", enc.Name)
+		}
+	}
+}
+
+func Test_servePrecompressed(t *testing.T) {
+	dir := t.TempDir()
+	variants := dir + variantsSuffix
+	err := os.WriteFile(filepath.Join(dir, "main.js"), []byte(strings.Repeat("console.log('hello world');\n", 100)), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = precompress(dir, variants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := blobFiles{Dir: http.Dir(dir), Variants: variants}
+
+	type Expectation struct {
+		Served          bool
+		Status          int
+		ContentEncoding string
+		ETag            string
+	}
+	tests := []struct {
+		Name        string
+		Header      map[string]string
+		Expectation Expectation
+	}{
+		{
+			Name:        "brotli",
+			Header:      map[string]string{"Accept-Encoding": "gzip, br"},
+			Expectation: Expectation{Served: true, Status: http.StatusOK, ContentEncoding: "br", ETag: `"hash-br"`},
+		},
+		{
+			Name:        "gzip",
+			Header:      map[string]string{"Accept-Encoding": "gzip"},
+			Expectation: Expectation{Served: true, Status: http.StatusOK, ContentEncoding: "gzip", ETag: `"hash-gzip"`},
+		},
+		{
+			Name:        "not modified",
+			Header:      map[string]string{"Accept-Encoding": "br", "If-None-Match": `"hash-br"`},
+			Expectation: Expectation{Served: true, Status: http.StatusNotModified, ETag: `"hash-br"`},
+		},
+		{
+			Name:        "identity",
+			Header:      map[string]string{"Accept-Encoding": "identity"},
+			Expectation: Expectation{ETag: `"hash"`},
+		},
+		{
+			Name:        "range",
+			Header:      map[string]string{"Accept-Encoding": "br", "Range": "bytes=0-10"},
+			Expectation: Expectation{ETag: `"hash"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/main.js", nil)
+			for k, v := range tt.Header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			rec.Header().Set("ETag", `"hash"`)
+
+			var act Expectation
+			act.Served = servePrecompressed(rec, req, files, "/main.js")
+			if act.Served {
+				act.Status = rec.Code
+			}
+			act.ContentEncoding = rec.Header().Get("Content-Encoding")
+			act.ETag = rec.Header().Get("ETag")
+
+			if diff := cmp.Diff(tt.Expectation, act); diff != "" {
+				t.Errorf("servePrecompressed() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
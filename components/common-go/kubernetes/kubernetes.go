@@ -81,6 +81,15 @@ const (
 
 	// ImageNameAnnotation indicates the original format of the main image of the pod
 	ImageNameAnnotation = "gitpod.io/image_name"
+
+	// WorkspaceSSHRecordingAnnotation is set to "true" on workspaces whose organization asked for terminal sessions at the SSH gateway to be recorded
+	WorkspaceSSHRecordingAnnotation = "gitpod.io/sshRecording"
+
+	// WorkspaceRevokedPortSharesAnnotation contains the IDs of revoked port share links as a JSON object mapping each ID to the time it can be forgotten
+	WorkspaceRevokedPortSharesAnnotation = "gitpod.io/revokedPortShares"
+
+	// NodeDrainAnnotation marks a node whose workspaces should be moved off it. Its value is the time the drain was requested.
+	NodeDrainAnnotation = "gitpod.io/drainWorkspaces"
 )
 
 // GetOWIFromObject finds the owner, workspace and instance information on a Kubernetes object using labels
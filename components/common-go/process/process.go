@@ -23,6 +23,10 @@ func IsNotChildProcess(err error) bool {
 	return (err.Error() == "wait: no child processes" || err.Error() == "waitid: no child processes")
 }
 
+// TaskIDEnvVar is set on the terminals supervisor starts for workspace tasks, and hence inherited by
+// all task processes. ws-daemon uses it to tell task processes apart from the IDE and supervisor.
+const TaskIDEnvVar = "GITPOD_TASK_ID"
+
 var ErrForceKilled = errors.New("Process didn't terminate, so we sent SIGKILL")
 
 // TerminateSync sends a SIGTERM to the given process and returns when the process has terminated or when the context was cancelled.
@@ -0,0 +1,160 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	// TraceParentAnnotation carries the W3C traceparent of the trace a workspace startup is recorded in
+	TraceParentAnnotation = "gitpod.io/traceparent"
+	// TraceStateAnnotation carries the W3C tracestate of the trace a workspace startup is recorded in
+	TraceStateAnnotation = "gitpod.io/tracestate"
+
+	// TraceParentEnvVar passes the W3C traceparent to processes running in a workspace
+	TraceParentEnvVar = "TRACEPARENT"
+	// TraceStateEnvVar passes the W3C tracestate to processes running in a workspace
+	TraceStateEnvVar = "TRACESTATE"
+
+	tracerName = "github.com/gitpod-io/gitpod"
+)
+
+var propagator = propagation.TraceContext{}
+
+// initOTLP sets up an OpenTelemetry tracer provider which exports spans using OTLP/gRPC.
+// The exporter is configured using the standard OTEL_EXPORTER_OTLP_* environment variables.
+// If no OTLP endpoint is configured, OpenTelemetry tracing remains disabled and initOTLP returns nil.
+func initOTLP(serviceName string) io.Closer {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return nil
+	}
+
+	exporter, err := otlptracegrpc.New(context.Background())
+	if err != nil {
+		log.WithError(err).Debug("cannot initialize OTLP trace exporter")
+		return nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		attribute.String("service.build.commit", os.Getenv("GITPOD_BUILD_GIT_COMMIT")),
+		attribute.String("service.build.version", os.Getenv("GITPOD_BUILD_VERSION")),
+	))
+	if err != nil {
+		log.WithError(err).Debug("cannot create OpenTelemetry resource")
+		res = resource.Default()
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+
+	return closerFunc(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	})
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+type multiCloser []io.Closer
+
+func (m multiCloser) Close() error {
+	var res error
+	for _, c := range m {
+		if err := c.Close(); err != nil && res == nil {
+			res = err
+		}
+	}
+	return res
+}
+
+// Tracer returns the OpenTelemetry tracer used across Gitpod components
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// InjectAnnotations stores the trace context of ctx in a Kubernetes object's annotations,
+// so that other components can add their spans to the same trace.
+func InjectAnnotations(ctx context.Context, annotations map[string]string) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	if v := carrier.Get("traceparent"); v != "" {
+		annotations[TraceParentAnnotation] = v
+	}
+	if v := carrier.Get("tracestate"); v != "" {
+		annotations[TraceStateAnnotation] = v
+	}
+}
+
+// ExtractAnnotations returns a context carrying the trace context stored in a Kubernetes
+// object's annotations by InjectAnnotations. If there is none, ctx is returned unchanged.
+func ExtractAnnotations(ctx context.Context, annotations map[string]string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier{
+		"traceparent": annotations[TraceParentAnnotation],
+		"tracestate":  annotations[TraceStateAnnotation],
+	})
+}
+
+// ExtractEnv returns a context carrying the trace context passed to this process using
+// the TRACEPARENT and TRACESTATE environment variables.
+func ExtractEnv(ctx context.Context) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier{
+		"traceparent": os.Getenv(TraceParentEnvVar),
+		"tracestate":  os.Getenv(TraceStateEnvVar),
+	})
+}
+
+// StartSpan starts an OpenTelemetry span. Callers must end the returned span.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// OWIAttributes turns owner/workspace/instance log fields (see log.OWI) into span attributes
+func OWIAttributes(owi map[string]interface{}) []attribute.KeyValue {
+	res := make([]attribute.KeyValue, 0, len(owi))
+	for k, v := range owi {
+		if v == nil || v == "" {
+			continue
+		}
+		res = append(res, attribute.String(k, fmt.Sprint(v)))
+	}
+	return res
+}
+
+// RecordSpan records a span for something that has already happened, e.g. a workspace phase
+// we only learn about after the fact. If err is not nil, the span is marked as failed.
+func RecordSpan(ctx context.Context, name string, start, end time.Time, err error, attrs ...attribute.KeyValue) {
+	_, span := Tracer().Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	if err != nil {
+		span.RecordError(err, trace.WithTimestamp(end))
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAnnotationsRoundTrip(t *testing.T) {
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	annotations := make(map[string]string)
+	InjectAnnotations(ctx, annotations)
+	if annotations[TraceParentAnnotation] == "" {
+		t.Fatalf("expected %s annotation to be set, got %v", TraceParentAnnotation, annotations)
+	}
+
+	extracted := trace.SpanContextFromContext(ExtractAnnotations(context.Background(), annotations))
+	if extracted.TraceID() != span.SpanContext().TraceID() {
+		t.Errorf("unexpected trace ID: %s, expected %s", extracted.TraceID(), span.SpanContext().TraceID())
+	}
+	if extracted.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("unexpected span ID: %s, expected %s", extracted.SpanID(), span.SpanContext().SpanID())
+	}
+}
+
+func TestExtractAnnotationsWithoutTraceContext(t *testing.T) {
+	ctx := ExtractAnnotations(context.Background(), map[string]string{"foo": "bar"})
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		t.Errorf("expected no valid span context")
+	}
+}
@@ -38,8 +38,23 @@ func WithPrometheusReporter(p *PromReporter) Option {
 	}
 }
 
-// Init initializes tracing for this application
+// Init initializes tracing for this application. Besides the OpenTracing/Jaeger tracer, this sets up
+// OpenTelemetry tracing using OTLP if an OTLP endpoint is configured (see initOTLP).
 func Init(serviceName string, opts ...Option) io.Closer {
+	var closer multiCloser
+	if c := initOTLP(serviceName); c != nil {
+		closer = append(closer, c)
+	}
+	if c := initJaeger(serviceName, opts...); c != nil {
+		closer = append(closer, c)
+	}
+	if len(closer) == 0 {
+		return nil
+	}
+	return closer
+}
+
+func initJaeger(serviceName string, opts ...Option) io.Closer {
 	cfg, err := jaegercfg.FromEnv()
 	if err != nil {
 		log.WithError(err).Debug("cannot initialize Jaeger tracer from env")
@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+var duCmdOpts struct {
+	Depth int
+	Limit int
+	Json  bool
+}
+
+type dirUsage struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// duCmd represents the du command
+var duCmd = &cobra.Command{
+	Use:   "du [directory]",
+	Short: "Display the largest directories of the workspace",
+	Long: `Display the largest directories of the workspace. Only the content below /workspace
+counts towards the storage quota of a workspace, hence it's the default directory. For example:
+    gp du --depth 3
+lists the largest directories up to three levels below /workspace.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root := "/workspace"
+		if len(args) > 0 {
+			root = args[0]
+		}
+		if duCmdOpts.Depth < 1 {
+			return GpError{Err: xerrors.Errorf("depth must be at least 1"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		usage, err := largestDirectories(root, duCmdOpts.Depth)
+		if err != nil {
+			return xerrors.Errorf("cannot compute disk usage: %w", err)
+		}
+		if duCmdOpts.Limit > 0 && len(usage) > duCmdOpts.Limit {
+			usage = usage[:duCmdOpts.Limit]
+		}
+
+		if duCmdOpts.Json {
+			content, _ := json.Marshal(usage)
+			fmt.Println(string(content))
+			return nil
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Size", "Directory"})
+		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		table.SetCenterSeparator("|")
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		for _, u := range usage {
+			table.Append([]string{formatBytes(u.Size), u.Path})
+		}
+		table.Render()
+		return nil
+	},
+}
+
+// largestDirectories returns the directories up to depth levels below root, sorted by
+// the accumulated size of the files they contain. Symlinks are not followed.
+func largestDirectories(root string, depth int) ([]dirUsage, error) {
+	root = filepath.Clean(root)
+	sizes := make(map[string]int64)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			// we can't read everything (e.g. files owned by root), but want to report on the rest
+			return nil
+		}
+		if d.IsDir() {
+			if _, exists := sizes[path]; !exists && dirDepth(root, path) <= depth {
+				sizes[path] = 0
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+
+		for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+			if dirDepth(root, dir) <= depth {
+				sizes[dir] += info.Size()
+			}
+			if dir == root || dir == filepath.Dir(dir) {
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]dirUsage, 0, len(sizes))
+	for path, size := range sizes {
+		res = append(res, dirUsage{Path: path, Size: size})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Size == res[j].Size {
+			return res[i].Path < res[j].Path
+		}
+		return res[i].Size > res[j].Size
+	})
+	return res, nil
+}
+
+func dirDepth(root, dir string) int {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == "." {
+		return 0
+	}
+	return len(strings.Split(rel, string(filepath.Separator)))
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ci", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func init() {
+	duCmd.Flags().IntVarP(&duCmdOpts.Depth, "depth", "d", 2, "Number of directory levels to report on")
+	duCmd.Flags().IntVarP(&duCmdOpts.Limit, "limit", "n", 10, "Number of directories to list, 0 lists all")
+	duCmd.Flags().BoolVarP(&duCmdOpts.Json, "json", "j", false, "Output in JSON format")
+	rootCmd.AddCommand(duCmd)
+}
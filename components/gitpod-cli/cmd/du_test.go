@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLargestDirectories(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]int{
+		"a.txt":                    10,
+		"repo/main.go":             100,
+		"repo/node_modules/x.js":   1000,
+		"repo/node_modules/y/z.js": 500,
+		"other/b.bin":              50,
+	}
+	for name, size := range files {
+		fn := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fn, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		Depth       int
+		Expectation []dirUsage
+	}{
+		{
+			Depth: 1,
+			Expectation: []dirUsage{
+				{Path: root, Size: 1660},
+				{Path: filepath.Join(root, "repo"), Size: 1600},
+				{Path: filepath.Join(root, "other"), Size: 50},
+			},
+		},
+		{
+			Depth: 2,
+			Expectation: []dirUsage{
+				{Path: root, Size: 1660},
+				{Path: filepath.Join(root, "repo"), Size: 1600},
+				{Path: filepath.Join(root, "repo/node_modules"), Size: 1500},
+				{Path: filepath.Join(root, "other"), Size: 50},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		act, err := largestDirectories(root, test.Depth)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(test.Expectation, act); diff != "" {
+			t.Errorf("unexpected largestDirectories(depth=%d) (-want +got):\n%s", test.Depth, diff)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		Input       int64
+		Expectation string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1024, "1.0Ki"},
+		{1536 * 1024, "1.5Mi"},
+		{30 * 1024 * 1024 * 1024, "30.0Gi"},
+	}
+	for _, test := range tests {
+		if act := formatBytes(test.Input); act != test.Expectation {
+			t.Errorf("unexpected formatBytes(%d): %s, expected %s", test.Input, act, test.Expectation)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+// shortIDLength is the length of the request IDs we print in the list of requests
+const shortIDLength = 8
+
+var portsInspectOpts struct {
+	Replay  bool
+	Enable  bool
+	Disable bool
+}
+
+// portsInspectCmd shows the recent HTTP requests to a port
+var portsInspectCmd = &cobra.Command{
+	Use:   "inspect <port> [request-id]",
+	Short: "Show the recent HTTP requests to a port and their responses",
+	Long: `Show the recent HTTP requests to a port and their responses.
+
+Requests are only recorded for ports you enabled the inspection for using --enable, and only if request inspection is enabled in your Gitpod installation.
+It can take a few seconds until requests are recorded after enabling the inspection. Use --disable to stop recording and drop the recorded requests.
+
+Without a request ID, the most recent requests are listed. With a request ID, the request and its response are shown in detail.
+Sensitive values like tokens and cookies are redacted, and large bodies are truncated.
+Use --replay to send a request to the port again, e.g. while debugging a webhook. Redacted values are replayed redacted.`,
+	Example: `  gp ports inspect 3000 --enable
+  gp ports inspect 3000
+  gp ports inspect 3000 1b4e28ba
+  gp ports inspect 3000 1b4e28ba --replay
+  gp ports inspect 3000 --disable`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		port, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return GpError{Err: xerrors.Errorf("port should be integer"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if portsInspectOpts.Replay && len(args) < 2 {
+			return GpError{Err: xerrors.Errorf("--replay requires a request ID"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if portsInspectOpts.Enable && portsInspectOpts.Disable {
+			return GpError{Err: xerrors.Errorf("--enable and --disable cannot be used together"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		toggle := portsInspectOpts.Enable || portsInspectOpts.Disable
+		if toggle && (len(args) > 1 || portsInspectOpts.Replay) {
+			return GpError{Err: xerrors.Errorf("--enable and --disable cannot be used with a request ID"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 40*time.Second)
+		defer cancel()
+
+		client, err := supervisor.New(ctx)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if toggle {
+			_, err = client.Port.SetPortInspection(ctx, &api.SetPortInspectionRequest{Port: uint32(port), Enabled: portsInspectOpts.Enable})
+			if err != nil {
+				return xerrors.Errorf("cannot change the inspection of port %d: %w", port, err)
+			}
+			if portsInspectOpts.Enable {
+				fmt.Printf("Recording the requests to port %d.\n", port)
+			} else {
+				fmt.Printf("Stopped recording the requests to port %d.\n", port)
+			}
+			return nil
+		}
+
+		resp, err := client.Port.ListInspectedRequests(ctx, &api.ListInspectedRequestsRequest{Port: uint32(port)})
+		if err != nil {
+			return xerrors.Errorf("cannot list requests of port %d: %w", port, err)
+		}
+		if len(args) < 2 {
+			if len(resp.Requests) == 0 {
+				fmt.Printf("No requests to port %d recorded. Use --enable to record them.\n", port)
+				return nil
+			}
+			printInspectedRequests(os.Stdout, resp.Requests)
+			return nil
+		}
+
+		req, err := findInspectedRequest(resp.Requests, args[1])
+		if err != nil {
+			return GpError{Err: err, OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if portsInspectOpts.Replay {
+			replay, err := client.Port.ReplayInspectedRequest(ctx, &api.ReplayInspectedRequestRequest{Port: uint32(port), Id: req.Id})
+			if err != nil {
+				return xerrors.Errorf("cannot replay request %s: %w", req.Id, err)
+			}
+			req = replay.Request
+		}
+		printInspectedRequest(os.Stdout, req)
+		return nil
+	},
+}
+
+// findInspectedRequest finds a request by its ID or an unambiguous prefix of it
+func findInspectedRequest(requests []*api.InspectedRequest, id string) (*api.InspectedRequest, error) {
+	var res *api.InspectedRequest
+	for _, req := range requests {
+		if req.Id == id {
+			return req, nil
+		}
+		if !strings.HasPrefix(req.Id, id) {
+			continue
+		}
+		if res != nil {
+			return nil, xerrors.Errorf("request ID %s is ambiguous", id)
+		}
+		res = req
+	}
+	if res == nil {
+		return nil, xerrors.Errorf("request %s not found", id)
+	}
+	return res, nil
+}
+
+func printInspectedRequests(out io.Writer, requests []*api.InspectedRequest) {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"ID", "Time", "Method", "URI", "Status", "Duration"})
+	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	table.SetCenterSeparator("|")
+	table.SetAutoWrapText(false)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+
+	for _, req := range requests {
+		id := req.Id
+		if len(id) > shortIDLength {
+			id = id[:shortIDLength]
+		}
+		status := strconv.Itoa(int(req.StatusCode))
+		if req.Error != "" {
+			status = "failed"
+		}
+		if req.ReplayOf != "" {
+			status += " (replay)"
+		}
+		table.Append([]string{
+			id,
+			time.UnixMilli(req.StartTime).Local().Format(time.TimeOnly),
+			req.Method,
+			req.Uri,
+			status,
+			(time.Duration(req.DurationMs) * time.Millisecond).String(),
+		})
+	}
+	table.Render()
+}
+
+func printInspectedRequest(out io.Writer, req *api.InspectedRequest) {
+	fmt.Fprintf(out, "ID:       %s\n", req.Id)
+	if req.ReplayOf != "" {
+		fmt.Fprintf(out, "Replay:   %s\n", req.ReplayOf)
+	}
+	fmt.Fprintf(out, "Time:     %s\n", time.UnixMilli(req.StartTime).Local().Format(time.RFC1123))
+	fmt.Fprintf(out, "Duration: %s\n\n", time.Duration(req.DurationMs)*time.Millisecond)
+
+	fmt.Fprintf(out, "> %s %s\n", req.Method, req.Uri)
+	printInspectedHeaders(out, "> ", req.RequestHeaders)
+	printInspectedBody(out, req.RequestBody, req.RequestBodyTruncated)
+	fmt.Fprintln(out)
+
+	if req.Error != "" {
+		fmt.Fprintf(out, "< request failed: %s\n", req.Error)
+		return
+	}
+	fmt.Fprintf(out, "< %d %s\n", req.StatusCode, http.StatusText(int(req.StatusCode)))
+	printInspectedHeaders(out, "< ", req.ResponseHeaders)
+	printInspectedBody(out, req.ResponseBody, req.ResponseBodyTruncated)
+}
+
+func printInspectedHeaders(out io.Writer, prefix string, headers []*api.HTTPHeader) {
+	sorted := append([]*api.HTTPHeader(nil), headers...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	for _, h := range sorted {
+		for _, v := range h.Values {
+			fmt.Fprintf(out, "%s%s: %s\n", prefix, h.Name, v)
+		}
+	}
+}
+
+func printInspectedBody(out io.Writer, body []byte, truncated bool) {
+	if len(body) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+	if utf8.Valid(body) {
+		fmt.Fprintln(out, strings.TrimRight(string(body), "\n"))
+	} else {
+		fmt.Fprintf(out, "[%d bytes of binary data]\n", len(body))
+	}
+	if truncated {
+		fmt.Fprintln(out, "[truncated]")
+	}
+}
+
+func init() {
+	portsInspectCmd.Flags().BoolVar(&portsInspectOpts.Replay, "replay", false, "send the request to the port again and show the result")
+	portsInspectCmd.Flags().BoolVar(&portsInspectOpts.Enable, "enable", false, "start recording the requests to the port")
+	portsInspectCmd.Flags().BoolVar(&portsInspectOpts.Disable, "disable", false, "stop recording the requests to the port and drop the recorded ones")
+	portsCmd.AddCommand(portsInspectCmd)
+}
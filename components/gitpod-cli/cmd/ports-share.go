@@ -0,0 +1,227 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/gitpod"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+var portsShareOpts struct {
+	TTL      time.Duration
+	ReadOnly bool
+	MaxUses  int
+}
+
+// portsShareCmd creates an expiring share link for a port
+var portsShareCmd = &cobra.Command{
+	Use:   "share <port>",
+	Short: "Create a link which grants access to a private port until it expires",
+	Long: `Create a link which grants access to a private port until it expires.
+
+Everyone who has the link can access the port, without making the port public.
+Links stop working when the workspace stops.`,
+	Example: "  gp ports share 3000 --ttl 2h --read-only",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		port, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return GpError{Err: xerrors.Errorf("port should be integer"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if portsShareOpts.TTL <= 0 {
+			return GpError{Err: xerrors.Errorf("ttl must be positive"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if portsShareOpts.MaxUses < 0 {
+			return GpError{Err: xerrors.Errorf("max-uses must not be negative"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+		defer cancel()
+
+		supervisorClient, err := supervisor.New(ctx)
+		if err != nil {
+			return err
+		}
+		defer supervisorClient.Close()
+
+		ports, err := supervisorClient.GetPortsList(ctx)
+		if err != nil {
+			return err
+		}
+		var portURL string
+		for _, p := range ports {
+			if p.LocalPort == uint32(port) && p.Exposed != nil {
+				portURL = p.Exposed.Url
+				break
+			}
+		}
+		if portURL == "" {
+			return GpError{Err: xerrors.Errorf("port %d is not exposed", port), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		workspaceURL, ownerToken, err := getWorkspaceOwnerToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		share, err := requestPortShare(ctx, workspaceURL, ownerToken, &portShareRequest{
+			Port:     uint32(port),
+			TTL:      portsShareOpts.TTL.String(),
+			ReadOnly: portsShareOpts.ReadOnly,
+			MaxUses:  portsShareOpts.MaxUses,
+		})
+		if err != nil {
+			return err
+		}
+		link, err := url.Parse(portURL)
+		if err != nil {
+			return xerrors.Errorf("cannot parse port URL %s: %w", portURL, err)
+		}
+		q := link.Query()
+		q.Set(share.TokenParam, share.Token)
+		link.RawQuery = q.Encode()
+
+		fmt.Println(link.String())
+		fmt.Printf("The link expires at %s. Revoke it earlier with `gp ports unshare %s`.\n", share.ExpiresAt.Local().Format(time.RFC1123), share.ID)
+		return nil
+	},
+}
+
+// portsUnshareCmd revokes a share link
+var portsUnshareCmd = &cobra.Command{
+	Use:     "unshare <share-id>",
+	Short:   "Revoke a link created with gp ports share before it expires",
+	Example: "  gp ports unshare 2f1c7a6e-4b1d-4c8e-9a55-0c3b8f0e2d71",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+		defer cancel()
+
+		workspaceURL, ownerToken, err := getWorkspaceOwnerToken(ctx)
+		if err != nil {
+			return err
+		}
+		err = revokePortShare(ctx, workspaceURL, ownerToken, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Revoked share link %s.\n", args[0])
+		return nil
+	},
+}
+
+// getWorkspaceOwnerToken returns the URL of this workspace and its owner token
+func getWorkspaceOwnerToken(ctx context.Context) (workspaceURL string, ownerToken string, err error) {
+	wsInfo, err := gitpod.GetWSInfo(ctx)
+	if err != nil {
+		return "", "", xerrors.Errorf("cannot get workspace info, %w", err)
+	}
+	client, err := gitpod.ConnectToServer(ctx, wsInfo, []string{
+		"function:getOwnerToken",
+		"resource:workspace::" + wsInfo.WorkspaceId + "::get",
+	})
+	if err != nil {
+		return "", "", xerrors.Errorf("cannot connect to server, %w", err)
+	}
+	defer client.Close()
+	ownerToken, err = client.GetOwnerToken(ctx, wsInfo.WorkspaceId)
+	if err != nil {
+		return "", "", xerrors.Errorf("cannot get owner token, %w", err)
+	}
+	return wsInfo.WorkspaceUrl, ownerToken, nil
+}
+
+type portShareRequest struct {
+	Port     uint32 `json:"port"`
+	TTL      string `json:"ttl,omitempty"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+	MaxUses  int    `json:"maxUses,omitempty"`
+}
+
+type portShareResponse struct {
+	ID         string    `json:"id"`
+	Token      string    `json:"token"`
+	TokenParam string    `json:"tokenParam"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// requestPortShare asks ws-proxy to issue a share link for a port
+func requestPortShare(ctx context.Context, workspaceURL, ownerToken string, req *portShareRequest) (*portShareResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(workspaceURL, "/")+"/_ports/share", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-gitpod-owner-token", ownerToken)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot request share link: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, xerrors.Errorf("cannot create share link: %s", strings.TrimSpace(string(msg)))
+	}
+	// without port sharing, ws-proxy passes the request on to the IDE
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return nil, xerrors.Errorf("sharing ports is not enabled in this Gitpod installation")
+	}
+
+	var res portShareResponse
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse share link response: %w", err)
+	}
+	return &res, nil
+}
+
+// revokePortShare asks ws-proxy to revoke a share link
+func revokePortShare(ctx context.Context, workspaceURL, ownerToken, shareID string) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, strings.TrimSuffix(workspaceURL, "/")+"/_ports/share/"+url.PathEscape(shareID), nil)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("x-gitpod-owner-token", ownerToken)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return xerrors.Errorf("cannot revoke share link: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return xerrors.Errorf("cannot revoke share link: %s", strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func init() {
+	portsShareCmd.Flags().DurationVar(&portsShareOpts.TTL, "ttl", 1*time.Hour, "how long the link remains valid")
+	portsShareCmd.Flags().BoolVar(&portsShareOpts.ReadOnly, "read-only", false, "only allow read-only HTTP requests (GET, HEAD, OPTIONS)")
+	portsShareCmd.Flags().IntVar(&portsShareOpts.MaxUses, "max-uses", 0, "how often the link can be opened, 0 means no limit")
+	portsCmd.AddCommand(portsShareCmd)
+	portsCmd.AddCommand(portsUnshareCmd)
+}
@@ -33,7 +33,7 @@ type topData struct {
 
 var topCmd = &cobra.Command{
 	Use:   "top",
-	Short: "Display usage of workspace resources (CPU and memory)",
+	Short: "Display usage of workspace resources (CPU, memory and disk)",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 		defer cancel()
@@ -108,6 +108,17 @@ func outputTable(workspaceResources *api.ResourcesStatusResponse, workspaceClass
 	table.Rich([]string{"CPU (millicores)", cpu}, cpuColors)
 	table.Rich([]string{"Memory (bytes)", memory}, memoryColors)
 
+	if disk := workspaceResources.Disk; disk != nil && disk.Limit > 0 {
+		diskFraction := int64((float64(disk.Used) / float64(disk.Limit)) * 100)
+		diskUsage := fmt.Sprintf("%dMi/%dMi (%d%%)", disk.Used/(1024*1024), disk.Limit/(1024*1024), diskFraction)
+
+		var diskColors []tablewriter.Colors
+		if !noColor && utils.ColorsEnabled() {
+			diskColors = []tablewriter.Colors{nil, {getColor(disk.Severity)}}
+		}
+		table.Rich([]string{"Disk (bytes)", diskUsage}, diskColors)
+	}
+
 	table.Render()
 }
 
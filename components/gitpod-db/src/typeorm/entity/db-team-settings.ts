@@ -4,7 +4,7 @@
  * See License.AGPL.txt in the project root for license information.
  */
 
-import { OrgMemberRole, OrganizationSettings } from "@gitpod/gitpod-protocol";
+import { OrgMachineActivitySettings, OrgMemberRole, OrganizationSettings } from "@gitpod/gitpod-protocol";
 import { Entity, Column, PrimaryColumn } from "typeorm";
 import { TypeORM } from "../typeorm";
 
@@ -33,6 +33,14 @@ export class DBOrgSettings implements OrganizationSettings {
     @Column("varchar", { nullable: true })
     defaultRole?: OrgMemberRole | undefined;
 
+    @Column("json", { nullable: true })
+    machineActivity?: OrgMachineActivitySettings | null;
+
+    @Column({
+        default: false,
+    })
+    recordSSHSessions?: boolean;
+
     @Column()
     deleted: boolean;
 }
@@ -368,6 +368,8 @@ export class TeamDBImpl extends TransactionalDBImpl<TeamDB> implements TeamDB {
                 "pinnedEditorVersions",
                 "restrictedEditorNames",
                 "defaultRole",
+                "machineActivity",
+                "recordSSHSessions",
             ],
         });
     }
@@ -1023,6 +1023,17 @@ export class TypeORMWorkspaceDBImpl extends TransactionalDBImpl<WorkspaceDB> imp
         return qb.getMany();
     }
 
+    async findInstancesStoppedSince(since: string): Promise<WorkspaceInstance[]> {
+        const repo = await this.getWorkspaceInstanceRepo();
+        // uses index: ind_phasePersisted_stoppedTime
+        const qb = repo
+            .createQueryBuilder("wsi")
+            .where("wsi.deleted != TRUE")
+            .andWhere("wsi.phasePersisted = 'stopped'")
+            .andWhere("wsi.stoppedTime >= :since", { since });
+        return qb.getMany();
+    }
+
     /**
      * Finds prebuilt workspaces by organization with optional filtering and pagination.
      * @param organizationId The ID of the organization.
@@ -224,6 +224,20 @@ class WorkspaceDBSpec {
         fail("Rollback failed");
     }
 
+    @test(timeout(10000))
+    public async testFindInstancesStoppedSince() {
+        const stopped = <WorkspaceInstance>{
+            ...this.wsi1,
+            stoppingTime: this.timeAfter,
+            stoppedTime: this.timeAfter,
+            status: { ...this.wsi1.status, phase: "stopped" },
+        };
+        await Promise.all([this.db.store(this.ws), this.db.storeInstance(stopped), this.db.storeInstance(this.wsi2)]);
+
+        expect((await this.db.findInstancesStoppedSince(this.timeBefore)).map((i) => i.id)).to.deep.eq([stopped.id]);
+        expect(await this.db.findInstancesStoppedSince(new Date(2020, 0, 1).toISOString())).to.be.empty;
+    }
+
     @test(timeout(10000))
     public async testFindByInstanceId() {
         await this.db.transaction(async (db) => {
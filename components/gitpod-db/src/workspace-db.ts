@@ -132,6 +132,7 @@ export interface WorkspaceDB {
     ): Promise<{ total: number; rows: WorkspaceAndInstance[] }>;
     findWorkspaceAndInstance(id: string): Promise<WorkspaceAndInstance | undefined>;
     findInstancesByPhase(phases: string[]): Promise<WorkspaceInstance[]>;
+    findInstancesStoppedSince(since: string): Promise<WorkspaceInstance[]>;
 
     getWorkspaceCount(type?: String): Promise<Number>;
     getInstanceCount(type?: string): Promise<number>;
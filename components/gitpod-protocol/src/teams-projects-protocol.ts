@@ -223,6 +223,21 @@ export interface OrganizationSettings {
 
     // what role new members will get, default is "member"
     defaultRole?: OrgMemberRole;
+
+    // which workload in a workspace prevents it from timing out, null to use the default of the workspace class
+    machineActivity?: OrgMachineActivitySettings | null;
+
+    // whether terminal sessions at the SSH gateway are recorded for the organization's workspaces
+    recordSSHSessions?: boolean;
+}
+
+export interface OrgMachineActivitySettings {
+    // average CPU usage in millicores above which a workspace is active, 0 to ignore the CPU usage
+    cpuMillicores?: number;
+    // whether processes running in task terminals keep a workspace active
+    taskProcesses?: boolean;
+    // whether established network connections of task processes keep a workspace active
+    networkConnections?: boolean;
 }
 
 export type TeamMemberRole = OrgMemberRole;
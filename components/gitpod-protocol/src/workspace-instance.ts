@@ -177,6 +177,10 @@ export interface WorkspaceInstanceConditions {
 
     // stopped_by_request is true if the workspace was stopped using a StopWorkspace call
     stoppedByRequest?: boolean;
+
+    // nodeDrain is true if the workspace was stopped because its node was drained. The server restarts such
+    // workspaces from their backup on another node.
+    nodeDrain?: boolean;
 }
 
 // AdmissionLevel describes who can access a workspace instance and its ports.
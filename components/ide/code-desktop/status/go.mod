@@ -2,9 +2,13 @@ module github.com/gitpod-io/gitpod/code-desktop/status
 
 go 1.22
 
-require google.golang.org/grpc v1.52.3
+require google.golang.org/grpc v1.59.0
 
-require github.com/grpc-ecosystem/grpc-gateway/v2 v2.11.3 // indirect
+require (
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.16.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20230822172742-b8732ec3820d // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20230822172742-b8732ec3820d // indirect
+)
 
 require (
 	github.com/gitpod-io/gitpod/common-go v0.0.0-00010101000000-000000000000
@@ -14,7 +18,7 @@ require (
 	golang.org/x/sys v0.15.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
-	google.golang.org/genproto v0.0.0-20221118155620-16455021b5e6 // indirect
+	google.golang.org/genproto v0.0.0-20230822172742-b8732ec3820d // indirect
 	google.golang.org/protobuf v1.33.0 // indirect
 )
 
@@ -0,0 +1,9 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package api
+
+// BuildCacheStatsMarker prefixes the log line bob prints the build cache stats on.
+// image-builder-mk3 looks for it in the build log to report the stats to its clients.
+const BuildCacheStatsMarker = "bob: build cache stats: "
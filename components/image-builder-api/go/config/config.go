@@ -32,6 +32,14 @@ type Configuration struct {
 	// PullSecretFile points to a mount of the .dockerconfigjson file of the PullSecret.
 	PullSecretFile string `json:"pullSecretFile,omitempty"`
 
+	// BuildSecretsKeySecret names a Kubernetes secret which contains a `key` entry of exactly 32 bytes.
+	// We encrypt build secrets and SSH keys with this key, and pass the key on to build workspaces by reference
+	// so that it never shows up in their spec. If not set, we reject builds which use secrets or SSH keys.
+	BuildSecretsKeySecret string `json:"buildSecretsKeySecret,omitempty"`
+
+	// BuildSecretsKeyFile points to a mount of the key of the BuildSecretsKeySecret.
+	BuildSecretsKeyFile string `json:"buildSecretsKeyFile,omitempty"`
+
 	// BaseImageRepository configures repository where we'll push base images to.
 	BaseImageRepository string `json:"baseImageRepository"`
 
@@ -51,6 +59,107 @@ type Configuration struct {
 	SubassemblyBucketName string `json:"subassemblyBucketName,omitempty"`
 	// SubassemblyBucketPrefix configures an optional key prefix used for locating subassemblies in the bucket
 	SubassemblyBucketPrefix string `json:"subassemblyBucketPrefix,omitempty"`
+
+	// BuildCache configures the BuildKit cache image builds import and export. If not set, every build starts cold.
+	BuildCache *BuildCacheConfig `json:"buildCache,omitempty"`
+
+	// SBOM configures the software bill of materials we attach to workspace images. If not set, we don't produce any.
+	SBOM *SBOMConfig `json:"sbom,omitempty"`
+
+	// BuildQueue limits how many image builds run at the same time. Builds beyond the limits wait in a queue.
+	// If not set, builds start right away.
+	BuildQueue *BuildQueueConfig `json:"buildQueue,omitempty"`
+}
+
+// BuildQueueConfig limits how many image builds run at the same time
+type BuildQueueConfig struct {
+	// MaxConcurrentBuilds limits how many builds run at the same time. Zero means no limit.
+	MaxConcurrentBuilds int `json:"maxConcurrentBuilds,omitempty"`
+	// MaxConcurrentBuildsPerOrganization limits how many builds of a single organization run at the same time. Zero means no limit.
+	MaxConcurrentBuildsPerOrganization int `json:"maxConcurrentBuildsPerOrganization,omitempty"`
+}
+
+// BuildCacheMode determines where image builds keep their BuildKit cache
+type BuildCacheMode string
+
+const (
+	// BuildCacheModeRegistry pushes the cache to a registry, next to the base images
+	BuildCacheModeRegistry BuildCacheMode = "registry"
+	// BuildCacheModeLocal keeps the cache in a local directory of the build workspace
+	BuildCacheModeLocal BuildCacheMode = "local"
+)
+
+// BuildCacheConfig configures the BuildKit cache of image builds
+type BuildCacheConfig struct {
+	Mode BuildCacheMode `json:"mode"`
+
+	// Repository is where we push the registry cache to. Defaults to the base image repository.
+	// Builds of the same Dockerfile share their cache, no matter the Dockerfile's content.
+	Repository string `json:"repository,omitempty"`
+
+	// Dir is the cache directory for the local cache mode. It must persist across builds, i.e. be a host path
+	// mounted into the image build workspaces, and be below /workspace, because that's the only part of the
+	// workspace's file system bob sees. The installer mounts buildCacheHostPath there.
+	Dir string `json:"dir,omitempty"`
+
+	// ExportAll exports the cache of all build stages, not just the ones of the final image.
+	// This makes the cache larger, but helps multi-stage builds.
+	ExportAll bool `json:"exportAll,omitempty"`
+
+	// MaxSizeMB limits the size of BuildKit's own cache. BuildKit prunes the least recently used records
+	// once the limit is exceeded. Zero means BuildKit's default.
+	// bob configures the garbage collection of the buildkitd it starts accordingly. If it uses a node-local
+	// buildkitd instead, bob prunes that one's cache after every build.
+	MaxSizeMB int64 `json:"maxSizeMB,omitempty"`
+
+	// KeepDuration makes BuildKit prune cache records which haven't been used in this long, e.g. 48h.
+	// It applies like MaxSizeMB.
+	KeepDuration string `json:"keepDuration,omitempty"`
+}
+
+// SBOMFormat is a format of software bill of materials
+type SBOMFormat string
+
+const (
+	SBOMFormatSPDX      SBOMFormat = "spdx"
+	SBOMFormatCycloneDX SBOMFormat = "cyclonedx"
+)
+
+// SBOMConfig configures the software bill of materials of workspace images
+type SBOMConfig struct {
+	// Formats lists the formats we produce. Each SBOM is pushed as an OCI referrer of the workspace image.
+	// Defaults to SPDX only.
+	Formats []SBOMFormat `json:"formats,omitempty"`
+
+	// Vulnerabilities configures the vulnerability check of the packages listed in the SBOM
+	Vulnerabilities *VulnerabilityPolicy `json:"vulnerabilities,omitempty"`
+}
+
+// VulnerabilityAction determines what happens if a workspace image has vulnerabilities above the policy's threshold
+type VulnerabilityAction string
+
+const (
+	// VulnerabilityActionWarn lists the vulnerabilities in the build log
+	VulnerabilityActionWarn VulnerabilityAction = "warn"
+	// VulnerabilityActionFail fails the build, and workspaces which use the image. Existing images without an SBOM
+	// are scanned in the background and let through until the scan is done. If we cannot check an existing image,
+	// e.g. because the registry is unavailable, we let it through as well.
+	VulnerabilityActionFail VulnerabilityAction = "fail"
+)
+
+// VulnerabilityPolicy configures the vulnerability check of workspace images
+type VulnerabilityPolicy struct {
+	// Database is a directory of OSV vulnerability records (https://ossf.github.io/osv-schema/), either as JSON files
+	// or as zip archives like the ones osv.dev publishes per ecosystem. We read it on startup.
+	Database string `json:"database"`
+
+	// Threshold is the lowest severity we act on: low, medium, high or critical
+	Threshold string `json:"threshold"`
+
+	Action VulnerabilityAction `json:"action"`
+
+	// Ignore lists vulnerability IDs or aliases which we never act on, e.g. CVE-2023-1234
+	Ignore []string `json:"ignore,omitempty"`
 }
 
 type TLS struct {
@@ -61,6 +61,26 @@ func (mr *MockImageBuilderClientMockRecorder) Build(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockImageBuilderClient)(nil).Build), varargs...)
 }
 
+// GetBuildSBOM mocks base method.
+func (m *MockImageBuilderClient) GetBuildSBOM(arg0 context.Context, arg1 *api.GetBuildSBOMRequest, arg2 ...grpc.CallOption) (*api.GetBuildSBOMResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBuildSBOM", varargs...)
+	ret0, _ := ret[0].(*api.GetBuildSBOMResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildSBOM indicates an expected call of GetBuildSBOM.
+func (mr *MockImageBuilderClientMockRecorder) GetBuildSBOM(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildSBOM", reflect.TypeOf((*MockImageBuilderClient)(nil).GetBuildSBOM), varargs...)
+}
+
 // ListBuilds mocks base method.
 func (m *MockImageBuilderClient) ListBuilds(arg0 context.Context, arg1 *api.ListBuildsRequest, arg2 ...grpc.CallOption) (*api.ListBuildsResponse, error) {
 	m.ctrl.T.Helper()
@@ -424,6 +444,21 @@ func (mr *MockImageBuilderServerMockRecorder) Build(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockImageBuilderServer)(nil).Build), arg0, arg1)
 }
 
+// GetBuildSBOM mocks base method.
+func (m *MockImageBuilderServer) GetBuildSBOM(arg0 context.Context, arg1 *api.GetBuildSBOMRequest) (*api.GetBuildSBOMResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildSBOM", arg0, arg1)
+	ret0, _ := ret[0].(*api.GetBuildSBOMResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildSBOM indicates an expected call of GetBuildSBOM.
+func (mr *MockImageBuilderServerMockRecorder) GetBuildSBOM(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildSBOM", reflect.TypeOf((*MockImageBuilderServer)(nil).GetBuildSBOM), arg0, arg1)
+}
+
 // ListBuilds mocks base method.
 func (m *MockImageBuilderServer) ListBuilds(arg0 context.Context, arg1 *api.ListBuildsRequest) (*api.ListBuildsResponse, error) {
 	m.ctrl.T.Helper()
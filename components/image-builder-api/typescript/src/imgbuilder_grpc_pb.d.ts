@@ -20,6 +20,7 @@ interface IImageBuilderService extends grpc.ServiceDefinition<grpc.UntypedServic
     build: IImageBuilderService_IBuild;
     logs: IImageBuilderService_ILogs;
     listBuilds: IImageBuilderService_IListBuilds;
+    getBuildSBOM: IImageBuilderService_IGetBuildSBOM;
 }
 
 interface IImageBuilderService_IResolveBaseImage extends grpc.MethodDefinition<imgbuilder_pb.ResolveBaseImageRequest, imgbuilder_pb.ResolveBaseImageResponse> {
@@ -67,6 +68,15 @@ interface IImageBuilderService_IListBuilds extends grpc.MethodDefinition<imgbuil
     responseSerialize: grpc.serialize<imgbuilder_pb.ListBuildsResponse>;
     responseDeserialize: grpc.deserialize<imgbuilder_pb.ListBuildsResponse>;
 }
+interface IImageBuilderService_IGetBuildSBOM extends grpc.MethodDefinition<imgbuilder_pb.GetBuildSBOMRequest, imgbuilder_pb.GetBuildSBOMResponse> {
+    path: "/builder.ImageBuilder/GetBuildSBOM";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<imgbuilder_pb.GetBuildSBOMRequest>;
+    requestDeserialize: grpc.deserialize<imgbuilder_pb.GetBuildSBOMRequest>;
+    responseSerialize: grpc.serialize<imgbuilder_pb.GetBuildSBOMResponse>;
+    responseDeserialize: grpc.deserialize<imgbuilder_pb.GetBuildSBOMResponse>;
+}
 
 export const ImageBuilderService: IImageBuilderService;
 
@@ -76,6 +86,7 @@ export interface IImageBuilderServer extends grpc.UntypedServiceImplementation {
     build: grpc.handleServerStreamingCall<imgbuilder_pb.BuildRequest, imgbuilder_pb.BuildResponse>;
     logs: grpc.handleServerStreamingCall<imgbuilder_pb.LogsRequest, imgbuilder_pb.LogsResponse>;
     listBuilds: grpc.handleUnaryCall<imgbuilder_pb.ListBuildsRequest, imgbuilder_pb.ListBuildsResponse>;
+    getBuildSBOM: grpc.handleUnaryCall<imgbuilder_pb.GetBuildSBOMRequest, imgbuilder_pb.GetBuildSBOMResponse>;
 }
 
 export interface IImageBuilderClient {
@@ -92,6 +103,9 @@ export interface IImageBuilderClient {
     listBuilds(request: imgbuilder_pb.ListBuildsRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
+    getBuildSBOM(request: imgbuilder_pb.GetBuildSBOMRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.GetBuildSBOMResponse) => void): grpc.ClientUnaryCall;
+    getBuildSBOM(request: imgbuilder_pb.GetBuildSBOMRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.GetBuildSBOMResponse) => void): grpc.ClientUnaryCall;
+    getBuildSBOM(request: imgbuilder_pb.GetBuildSBOMRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.GetBuildSBOMResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class ImageBuilderClient extends grpc.Client implements IImageBuilderClient {
@@ -109,4 +123,7 @@ export class ImageBuilderClient extends grpc.Client implements IImageBuilderClie
     public listBuilds(request: imgbuilder_pb.ListBuildsRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     public listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     public listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
+    public getBuildSBOM(request: imgbuilder_pb.GetBuildSBOMRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.GetBuildSBOMResponse) => void): grpc.ClientUnaryCall;
+    public getBuildSBOM(request: imgbuilder_pb.GetBuildSBOMRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.GetBuildSBOMResponse) => void): grpc.ClientUnaryCall;
+    public getBuildSBOM(request: imgbuilder_pb.GetBuildSBOMRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.GetBuildSBOMResponse) => void): grpc.ClientUnaryCall;
 }
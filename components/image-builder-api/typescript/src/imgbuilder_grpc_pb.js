@@ -33,6 +33,28 @@ function deserialize_builder_BuildResponse(buffer_arg) {
   return imgbuilder_pb.BuildResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_builder_GetBuildSBOMRequest(arg) {
+  if (!(arg instanceof imgbuilder_pb.GetBuildSBOMRequest)) {
+    throw new Error('Expected argument of type builder.GetBuildSBOMRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_builder_GetBuildSBOMRequest(buffer_arg) {
+  return imgbuilder_pb.GetBuildSBOMRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_builder_GetBuildSBOMResponse(arg) {
+  if (!(arg instanceof imgbuilder_pb.GetBuildSBOMResponse)) {
+    throw new Error('Expected argument of type builder.GetBuildSBOMResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_builder_GetBuildSBOMResponse(buffer_arg) {
+  return imgbuilder_pb.GetBuildSBOMResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_builder_ListBuildsRequest(arg) {
   if (!(arg instanceof imgbuilder_pb.ListBuildsRequest)) {
     throw new Error('Expected argument of type builder.ListBuildsRequest');
@@ -184,6 +206,18 @@ listBuilds: {
     responseSerialize: serialize_builder_ListBuildsResponse,
     responseDeserialize: deserialize_builder_ListBuildsResponse,
   },
+  // GetBuildSBOM returns the software bill of materials of a workspace image, and the vulnerabilities found in it
+getBuildSBOM: {
+    path: '/builder.ImageBuilder/GetBuildSBOM',
+    requestStream: false,
+    responseStream: false,
+    requestType: imgbuilder_pb.GetBuildSBOMRequest,
+    responseType: imgbuilder_pb.GetBuildSBOMResponse,
+    requestSerialize: serialize_builder_GetBuildSBOMRequest,
+    requestDeserialize: deserialize_builder_GetBuildSBOMRequest,
+    responseSerialize: serialize_builder_GetBuildSBOMResponse,
+    responseDeserialize: deserialize_builder_GetBuildSBOMResponse,
+  },
 };
 
 exports.ImageBuilderClient = grpc.makeGenericClientConstructor(ImageBuilderService);
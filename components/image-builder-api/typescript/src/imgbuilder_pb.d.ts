@@ -223,6 +223,16 @@ export class BuildRequest extends jspb.Message {
     setSupervisorRef(value: string): BuildRequest;
     getBaseImageNameResolved(): string;
     setBaseImageNameResolved(value: string): BuildRequest;
+    clearSecretsList(): void;
+    getSecretsList(): Array<BuildSecret>;
+    setSecretsList(value: Array<BuildSecret>): BuildRequest;
+    addSecrets(value?: BuildSecret, index?: number): BuildSecret;
+    clearSshKeysList(): void;
+    getSshKeysList(): Array<BuildSSHKey>;
+    setSshKeysList(value: Array<BuildSSHKey>): BuildRequest;
+    addSshKeys(value?: BuildSSHKey, index?: number): BuildSSHKey;
+    getOrganizationId(): string;
+    setOrganizationId(value: string): BuildRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): BuildRequest.AsObject;
@@ -242,6 +252,55 @@ export namespace BuildRequest {
         triggeredBy: string,
         supervisorRef: string,
         baseImageNameResolved: string,
+        secretsList: Array<BuildSecret.AsObject>,
+        sshKeysList: Array<BuildSSHKey.AsObject>,
+        organizationId: string,
+    }
+}
+
+export class BuildSecret extends jspb.Message {
+    getId(): string;
+    setId(value: string): BuildSecret;
+    getSecretValue(): string;
+    setSecretValue(value: string): BuildSecret;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BuildSecret.AsObject;
+    static toObject(includeInstance: boolean, msg: BuildSecret): BuildSecret.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BuildSecret, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BuildSecret;
+    static deserializeBinaryFromReader(message: BuildSecret, reader: jspb.BinaryReader): BuildSecret;
+}
+
+export namespace BuildSecret {
+    export type AsObject = {
+        id: string,
+        secretValue: string,
+    }
+}
+
+export class BuildSSHKey extends jspb.Message {
+    getId(): string;
+    setId(value: string): BuildSSHKey;
+    getPrivateKey(): string;
+    setPrivateKey(value: string): BuildSSHKey;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BuildSSHKey.AsObject;
+    static toObject(includeInstance: boolean, msg: BuildSSHKey): BuildSSHKey.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BuildSSHKey, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BuildSSHKey;
+    static deserializeBinaryFromReader(message: BuildSSHKey, reader: jspb.BinaryReader): BuildSSHKey;
+}
+
+export namespace BuildSSHKey {
+    export type AsObject = {
+        id: string,
+        privateKey: string,
     }
 }
 
@@ -351,6 +410,11 @@ export class BuildResponse extends jspb.Message {
     getInfo(): BuildInfo | undefined;
     setInfo(value?: BuildInfo): BuildResponse;
 
+    hasCacheStats(): boolean;
+    clearCacheStats(): void;
+    getCacheStats(): BuildCacheStats | undefined;
+    setCacheStats(value?: BuildCacheStats): BuildResponse;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): BuildResponse.AsObject;
     static toObject(includeInstance: boolean, msg: BuildResponse): BuildResponse.AsObject;
@@ -368,6 +432,30 @@ export namespace BuildResponse {
         status: BuildStatus,
         message: string,
         info?: BuildInfo.AsObject,
+        cacheStats?: BuildCacheStats.AsObject,
+    }
+}
+
+export class BuildCacheStats extends jspb.Message {
+    getSteps(): number;
+    setSteps(value: number): BuildCacheStats;
+    getCachedSteps(): number;
+    setCachedSteps(value: number): BuildCacheStats;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BuildCacheStats.AsObject;
+    static toObject(includeInstance: boolean, msg: BuildCacheStats): BuildCacheStats.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BuildCacheStats, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BuildCacheStats;
+    static deserializeBinaryFromReader(message: BuildCacheStats, reader: jspb.BinaryReader): BuildCacheStats;
+}
+
+export namespace BuildCacheStats {
+    export type AsObject = {
+        steps: number,
+        cachedSteps: number,
     }
 }
 
@@ -474,6 +562,8 @@ export class BuildInfo extends jspb.Message {
     clearLogInfo(): void;
     getLogInfo(): LogInfo | undefined;
     setLogInfo(value?: LogInfo): BuildInfo;
+    getQueuePosition(): number;
+    setQueuePosition(value: number): BuildInfo;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): BuildInfo.AsObject;
@@ -493,6 +583,7 @@ export namespace BuildInfo {
         startedAt: number,
         buildId: string,
         logInfo?: LogInfo.AsObject,
+        queuePosition: number,
     }
 }
 
@@ -521,9 +612,111 @@ export namespace LogInfo {
     }
 }
 
+export class GetBuildSBOMRequest extends jspb.Message {
+    getRef(): string;
+    setRef(value: string): GetBuildSBOMRequest;
+    getFormat(): SBOMFormat;
+    setFormat(value: SBOMFormat): GetBuildSBOMRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetBuildSBOMRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetBuildSBOMRequest): GetBuildSBOMRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetBuildSBOMRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetBuildSBOMRequest;
+    static deserializeBinaryFromReader(message: GetBuildSBOMRequest, reader: jspb.BinaryReader): GetBuildSBOMRequest;
+}
+
+export namespace GetBuildSBOMRequest {
+    export type AsObject = {
+        ref: string,
+        format: SBOMFormat,
+    }
+}
+
+export class GetBuildSBOMResponse extends jspb.Message {
+    getMediaType(): string;
+    setMediaType(value: string): GetBuildSBOMResponse;
+    getContent(): Uint8Array | string;
+    getContent_asU8(): Uint8Array;
+    getContent_asB64(): string;
+    setContent(value: Uint8Array | string): GetBuildSBOMResponse;
+    clearVulnerabilitiesList(): void;
+    getVulnerabilitiesList(): Array<Vulnerability>;
+    setVulnerabilitiesList(value: Array<Vulnerability>): GetBuildSBOMResponse;
+    addVulnerabilities(value?: Vulnerability, index?: number): Vulnerability;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetBuildSBOMResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetBuildSBOMResponse): GetBuildSBOMResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetBuildSBOMResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetBuildSBOMResponse;
+    static deserializeBinaryFromReader(message: GetBuildSBOMResponse, reader: jspb.BinaryReader): GetBuildSBOMResponse;
+}
+
+export namespace GetBuildSBOMResponse {
+    export type AsObject = {
+        mediaType: string,
+        content: Uint8Array | string,
+        vulnerabilitiesList: Array<Vulnerability.AsObject>,
+    }
+}
+
+export class Vulnerability extends jspb.Message {
+    getId(): string;
+    setId(value: string): Vulnerability;
+    getPackage(): string;
+    setPackage(value: string): Vulnerability;
+    getVersion(): string;
+    setVersion(value: string): Vulnerability;
+    getSeverity(): VulnerabilitySeverity;
+    setSeverity(value: VulnerabilitySeverity): Vulnerability;
+    getSummary(): string;
+    setSummary(value: string): Vulnerability;
+    getFixedVersion(): string;
+    setFixedVersion(value: string): Vulnerability;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Vulnerability.AsObject;
+    static toObject(includeInstance: boolean, msg: Vulnerability): Vulnerability.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Vulnerability, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Vulnerability;
+    static deserializeBinaryFromReader(message: Vulnerability, reader: jspb.BinaryReader): Vulnerability;
+}
+
+export namespace Vulnerability {
+    export type AsObject = {
+        id: string,
+        pb_package: string,
+        version: string,
+        severity: VulnerabilitySeverity,
+        summary: string,
+        fixedVersion: string,
+    }
+}
+
 export enum BuildStatus {
     UNKNOWN = 0,
     RUNNING = 1,
     DONE_SUCCESS = 2,
     DONE_FAILURE = 3,
+    QUEUED = 4,
+}
+
+export enum SBOMFormat {
+    SPDX = 0,
+    CYCLONEDX = 1,
+}
+
+export enum VulnerabilitySeverity {
+    SEVERITY_UNKNOWN = 0,
+    SEVERITY_LOW = 1,
+    SEVERITY_MEDIUM = 2,
+    SEVERITY_HIGH = 3,
+    SEVERITY_CRITICAL = 4,
 }
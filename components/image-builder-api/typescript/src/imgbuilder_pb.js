@@ -23,6 +23,7 @@ var global = (function() { return this || window || global || self || Function('
 
 var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
 goog.object.extend(proto, content$service$api_initializer_pb);
+goog.exportSymbol('proto.builder.BuildCacheStats', null, global);
 goog.exportSymbol('proto.builder.BuildInfo', null, global);
 goog.exportSymbol('proto.builder.BuildRegistryAuth', null, global);
 goog.exportSymbol('proto.builder.BuildRegistryAuth.ModeCase', null, global);
@@ -30,11 +31,15 @@ goog.exportSymbol('proto.builder.BuildRegistryAuthSelective', null, global);
 goog.exportSymbol('proto.builder.BuildRegistryAuthTotal', null, global);
 goog.exportSymbol('proto.builder.BuildRequest', null, global);
 goog.exportSymbol('proto.builder.BuildResponse', null, global);
+goog.exportSymbol('proto.builder.BuildSSHKey', null, global);
+goog.exportSymbol('proto.builder.BuildSecret', null, global);
 goog.exportSymbol('proto.builder.BuildSource', null, global);
 goog.exportSymbol('proto.builder.BuildSource.FromCase', null, global);
 goog.exportSymbol('proto.builder.BuildSourceDockerfile', null, global);
 goog.exportSymbol('proto.builder.BuildSourceReference', null, global);
 goog.exportSymbol('proto.builder.BuildStatus', null, global);
+goog.exportSymbol('proto.builder.GetBuildSBOMRequest', null, global);
+goog.exportSymbol('proto.builder.GetBuildSBOMResponse', null, global);
 goog.exportSymbol('proto.builder.ListBuildsRequest', null, global);
 goog.exportSymbol('proto.builder.ListBuildsResponse', null, global);
 goog.exportSymbol('proto.builder.LogInfo', null, global);
@@ -44,6 +49,9 @@ goog.exportSymbol('proto.builder.ResolveBaseImageRequest', null, global);
 goog.exportSymbol('proto.builder.ResolveBaseImageResponse', null, global);
 goog.exportSymbol('proto.builder.ResolveWorkspaceImageRequest', null, global);
 goog.exportSymbol('proto.builder.ResolveWorkspaceImageResponse', null, global);
+goog.exportSymbol('proto.builder.SBOMFormat', null, global);
+goog.exportSymbol('proto.builder.Vulnerability', null, global);
+goog.exportSymbol('proto.builder.VulnerabilitySeverity', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -202,7 +210,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.builder.BuildRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.BuildRequest.repeatedFields_, null);
 };
 goog.inherits(proto.builder.BuildRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -212,6 +220,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.builder.BuildRequest.displayName = 'proto.builder.BuildRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.BuildSecret = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.BuildSecret, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.BuildSecret.displayName = 'proto.builder.BuildSecret';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.BuildSSHKey = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.BuildSSHKey, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.BuildSSHKey.displayName = 'proto.builder.BuildSSHKey';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -296,6 +346,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.builder.BuildResponse.displayName = 'proto.builder.BuildResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.BuildCacheStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.BuildCacheStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.BuildCacheStats.displayName = 'proto.builder.BuildCacheStats';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -422,6 +493,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.builder.LogInfo.displayName = 'proto.builder.LogInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.GetBuildSBOMRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.GetBuildSBOMRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.GetBuildSBOMRequest.displayName = 'proto.builder.GetBuildSBOMRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.GetBuildSBOMResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.GetBuildSBOMResponse.repeatedFields_, null);
+};
+goog.inherits(proto.builder.GetBuildSBOMResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.GetBuildSBOMResponse.displayName = 'proto.builder.GetBuildSBOMResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.Vulnerability = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.Vulnerability, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.Vulnerability.displayName = 'proto.builder.Vulnerability';
+}
 
 /**
  * Oneof group definitions for this message. Each group defines the field
@@ -1725,6 +1859,13 @@ proto.builder.ResolveWorkspaceImageResponse.prototype.setStatus = function(value
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.BuildRequest.repeatedFields_ = [7,8];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1761,7 +1902,12 @@ proto.builder.BuildRequest.toObject = function(includeInstance, msg) {
     forceRebuild: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
     triggeredBy: jspb.Message.getFieldWithDefault(msg, 4, ""),
     supervisorRef: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    baseImageNameResolved: jspb.Message.getFieldWithDefault(msg, 6, "")
+    baseImageNameResolved: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    secretsList: jspb.Message.toObjectList(msg.getSecretsList(),
+    proto.builder.BuildSecret.toObject, includeInstance),
+    sshKeysList: jspb.Message.toObjectList(msg.getSshKeysList(),
+    proto.builder.BuildSSHKey.toObject, includeInstance),
+    organizationId: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -1824,6 +1970,20 @@ proto.builder.BuildRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setBaseImageNameResolved(value);
       break;
+    case 7:
+      var value = new proto.builder.BuildSecret;
+      reader.readMessage(value,proto.builder.BuildSecret.deserializeBinaryFromReader);
+      msg.addSecrets(value);
+      break;
+    case 8:
+      var value = new proto.builder.BuildSSHKey;
+      reader.readMessage(value,proto.builder.BuildSSHKey.deserializeBinaryFromReader);
+      msg.addSshKeys(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganizationId(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1897,6 +2057,29 @@ proto.builder.BuildRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getSecretsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.builder.BuildSecret.serializeBinaryToWriter
+    );
+  }
+  f = message.getSshKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.builder.BuildSSHKey.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrganizationId();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -2046,35 +2229,103 @@ proto.builder.BuildRequest.prototype.setBaseImageNameResolved = function(value)
 };
 
 
+/**
+ * repeated BuildSecret secrets = 7;
+ * @return {!Array<!proto.builder.BuildSecret>}
+ */
+proto.builder.BuildRequest.prototype.getSecretsList = function() {
+  return /** @type{!Array<!proto.builder.BuildSecret>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildSecret, 7));
+};
+
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * @param {!Array<!proto.builder.BuildSecret>} value
+ * @return {!proto.builder.BuildRequest} returns this
+*/
+proto.builder.BuildRequest.prototype.setSecretsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.builder.BuildSecret=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildSecret}
  */
-proto.builder.BuildRegistryAuth.oneofGroups_ = [[1,2]];
+proto.builder.BuildRequest.prototype.addSecrets = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.builder.BuildSecret, opt_index);
+};
+
 
 /**
- * @enum {number}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.BuildRequest} returns this
  */
-proto.builder.BuildRegistryAuth.ModeCase = {
-  MODE_NOT_SET: 0,
-  TOTAL: 1,
-  SELECTIVE: 2
+proto.builder.BuildRequest.prototype.clearSecretsList = function() {
+  return this.setSecretsList([]);
 };
 
+
 /**
- * @return {proto.builder.BuildRegistryAuth.ModeCase}
+ * repeated BuildSSHKey ssh_keys = 8;
+ * @return {!Array<!proto.builder.BuildSSHKey>}
  */
-proto.builder.BuildRegistryAuth.prototype.getModeCase = function() {
-  return /** @type {proto.builder.BuildRegistryAuth.ModeCase} */(jspb.Message.computeOneofCase(this, proto.builder.BuildRegistryAuth.oneofGroups_[0]));
+proto.builder.BuildRequest.prototype.getSshKeysList = function() {
+  return /** @type{!Array<!proto.builder.BuildSSHKey>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildSSHKey, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.builder.BuildSSHKey>} value
+ * @return {!proto.builder.BuildRequest} returns this
+*/
+proto.builder.BuildRequest.prototype.setSshKeysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.builder.BuildSSHKey=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildSSHKey}
+ */
+proto.builder.BuildRequest.prototype.addSshKeys = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.builder.BuildSSHKey, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.BuildRequest} returns this
+ */
+proto.builder.BuildRequest.prototype.clearSshKeysList = function() {
+  return this.setSshKeysList([]);
+};
+
+
+/**
+ * optional string organization_id = 9;
+ * @return {string}
+ */
+proto.builder.BuildRequest.prototype.getOrganizationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildRequest} returns this
+ */
+proto.builder.BuildRequest.prototype.setOrganizationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2088,8 +2339,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.BuildRegistryAuth.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.BuildRegistryAuth.toObject(opt_includeInstance, this);
+proto.builder.BuildSecret.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildSecret.toObject(opt_includeInstance, this);
 };
 
 
@@ -2098,15 +2349,14 @@ proto.builder.BuildRegistryAuth.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.BuildRegistryAuth} msg The msg instance to transform.
+ * @param {!proto.builder.BuildSecret} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildRegistryAuth.toObject = function(includeInstance, msg) {
+proto.builder.BuildSecret.toObject = function(includeInstance, msg) {
   var f, obj = {
-    total: (f = msg.getTotal()) && proto.builder.BuildRegistryAuthTotal.toObject(includeInstance, f),
-    selective: (f = msg.getSelective()) && proto.builder.BuildRegistryAuthSelective.toObject(includeInstance, f),
-    additionalMap: (f = msg.getAdditionalMap()) ? f.toObject(includeInstance, undefined) : []
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    secretValue: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2120,23 +2370,23 @@ proto.builder.BuildRegistryAuth.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.BuildRegistryAuth}
+ * @return {!proto.builder.BuildSecret}
  */
-proto.builder.BuildRegistryAuth.deserializeBinary = function(bytes) {
+proto.builder.BuildSecret.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.BuildRegistryAuth;
-  return proto.builder.BuildRegistryAuth.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildSecret;
+  return proto.builder.BuildSecret.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.BuildRegistryAuth} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildSecret} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.BuildRegistryAuth}
+ * @return {!proto.builder.BuildSecret}
  */
-proto.builder.BuildRegistryAuth.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildSecret.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2144,20 +2394,12 @@ proto.builder.BuildRegistryAuth.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.builder.BuildRegistryAuthTotal;
-      reader.readMessage(value,proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader);
-      msg.setTotal(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = new proto.builder.BuildRegistryAuthSelective;
-      reader.readMessage(value,proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader);
-      msg.setSelective(value);
-      break;
-    case 3:
-      var value = msg.getAdditionalMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecretValue(value);
       break;
     default:
       reader.skipField();
@@ -2172,9 +2414,9 @@ proto.builder.BuildRegistryAuth.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.BuildRegistryAuth.prototype.serializeBinary = function() {
+proto.builder.BuildSecret.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.BuildRegistryAuth.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildSecret.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2182,131 +2424,65 @@ proto.builder.BuildRegistryAuth.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.BuildRegistryAuth} message
+ * @param {!proto.builder.BuildSecret} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildRegistryAuth.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildSecret.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTotal();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.builder.BuildRegistryAuthTotal.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSelective();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSecretValue();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.builder.BuildRegistryAuthSelective.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getAdditionalMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
 };
 
 
 /**
- * optional BuildRegistryAuthTotal total = 1;
- * @return {?proto.builder.BuildRegistryAuthTotal}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.builder.BuildRegistryAuth.prototype.getTotal = function() {
-  return /** @type{?proto.builder.BuildRegistryAuthTotal} */ (
-    jspb.Message.getWrapperField(this, proto.builder.BuildRegistryAuthTotal, 1));
-};
-
-
-/**
- * @param {?proto.builder.BuildRegistryAuthTotal|undefined} value
- * @return {!proto.builder.BuildRegistryAuth} returns this
-*/
-proto.builder.BuildRegistryAuth.prototype.setTotal = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.builder.BuildRegistryAuth.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.builder.BuildRegistryAuth} returns this
- */
-proto.builder.BuildRegistryAuth.prototype.clearTotal = function() {
-  return this.setTotal(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.builder.BuildRegistryAuth.prototype.hasTotal = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional BuildRegistryAuthSelective selective = 2;
- * @return {?proto.builder.BuildRegistryAuthSelective}
- */
-proto.builder.BuildRegistryAuth.prototype.getSelective = function() {
-  return /** @type{?proto.builder.BuildRegistryAuthSelective} */ (
-    jspb.Message.getWrapperField(this, proto.builder.BuildRegistryAuthSelective, 2));
-};
-
-
-/**
- * @param {?proto.builder.BuildRegistryAuthSelective|undefined} value
- * @return {!proto.builder.BuildRegistryAuth} returns this
-*/
-proto.builder.BuildRegistryAuth.prototype.setSelective = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.builder.BuildRegistryAuth.oneofGroups_[0], value);
+proto.builder.BuildSecret.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.builder.BuildRegistryAuth} returns this
+ * @param {string} value
+ * @return {!proto.builder.BuildSecret} returns this
  */
-proto.builder.BuildRegistryAuth.prototype.clearSelective = function() {
-  return this.setSelective(undefined);
+proto.builder.BuildSecret.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string secret_value = 2;
+ * @return {string}
  */
-proto.builder.BuildRegistryAuth.prototype.hasSelective = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.builder.BuildSecret.prototype.getSecretValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * map<string, string> additional = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * @param {string} value
+ * @return {!proto.builder.BuildSecret} returns this
  */
-proto.builder.BuildRegistryAuth.prototype.getAdditionalMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
+proto.builder.BuildSecret.prototype.setSecretValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.builder.BuildRegistryAuth} returns this
- */
-proto.builder.BuildRegistryAuth.prototype.clearAdditionalMap = function() {
-  this.getAdditionalMap().clear();
-  return this;};
-
-
 
 
 
@@ -2323,8 +2499,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.BuildRegistryAuthTotal.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.BuildRegistryAuthTotal.toObject(opt_includeInstance, this);
+proto.builder.BuildSSHKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildSSHKey.toObject(opt_includeInstance, this);
 };
 
 
@@ -2333,13 +2509,14 @@ proto.builder.BuildRegistryAuthTotal.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.BuildRegistryAuthTotal} msg The msg instance to transform.
+ * @param {!proto.builder.BuildSSHKey} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildRegistryAuthTotal.toObject = function(includeInstance, msg) {
+proto.builder.BuildSSHKey.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allowAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    privateKey: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2353,23 +2530,23 @@ proto.builder.BuildRegistryAuthTotal.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.BuildRegistryAuthTotal}
+ * @return {!proto.builder.BuildSSHKey}
  */
-proto.builder.BuildRegistryAuthTotal.deserializeBinary = function(bytes) {
+proto.builder.BuildSSHKey.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.BuildRegistryAuthTotal;
-  return proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildSSHKey;
+  return proto.builder.BuildSSHKey.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.BuildRegistryAuthTotal} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildSSHKey} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.BuildRegistryAuthTotal}
+ * @return {!proto.builder.BuildSSHKey}
  */
-proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildSSHKey.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2377,8 +2554,12 @@ proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAllowAll(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateKey(value);
       break;
     default:
       reader.skipField();
@@ -2393,9 +2574,9 @@ proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.BuildRegistryAuthTotal.prototype.serializeBinary = function() {
+proto.builder.BuildSSHKey.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.BuildRegistryAuthTotal.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildSSHKey.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2403,47 +2584,90 @@ proto.builder.BuildRegistryAuthTotal.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.BuildRegistryAuthTotal} message
+ * @param {!proto.builder.BuildSSHKey} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildRegistryAuthTotal.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildSSHKey.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllowAll();
-  if (f) {
-    writer.writeBool(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getPrivateKey();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool allow_all = 1;
- * @return {boolean}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.builder.BuildRegistryAuthTotal.prototype.getAllowAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.builder.BuildSSHKey.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.builder.BuildRegistryAuthTotal} returns this
+ * @param {string} value
+ * @return {!proto.builder.BuildSSHKey} returns this
  */
-proto.builder.BuildRegistryAuthTotal.prototype.setAllowAll = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.builder.BuildSSHKey.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string private_key = 2;
+ * @return {string}
+ */
+proto.builder.BuildSSHKey.prototype.getPrivateKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildSSHKey} returns this
+ */
+proto.builder.BuildSSHKey.prototype.setPrivateKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
  * @const
  */
-proto.builder.BuildRegistryAuthSelective.repeatedFields_ = [3];
+proto.builder.BuildRegistryAuth.oneofGroups_ = [[1,2]];
+
+/**
+ * @enum {number}
+ */
+proto.builder.BuildRegistryAuth.ModeCase = {
+  MODE_NOT_SET: 0,
+  TOTAL: 1,
+  SELECTIVE: 2
+};
+
+/**
+ * @return {proto.builder.BuildRegistryAuth.ModeCase}
+ */
+proto.builder.BuildRegistryAuth.prototype.getModeCase = function() {
+  return /** @type {proto.builder.BuildRegistryAuth.ModeCase} */(jspb.Message.computeOneofCase(this, proto.builder.BuildRegistryAuth.oneofGroups_[0]));
+};
 
 
 
@@ -2460,8 +2684,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.BuildRegistryAuthSelective.toObject(opt_includeInstance, this);
+proto.builder.BuildRegistryAuth.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildRegistryAuth.toObject(opt_includeInstance, this);
 };
 
 
@@ -2470,15 +2694,15 @@ proto.builder.BuildRegistryAuthSelective.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.BuildRegistryAuthSelective} msg The msg instance to transform.
+ * @param {!proto.builder.BuildRegistryAuth} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildRegistryAuthSelective.toObject = function(includeInstance, msg) {
+proto.builder.BuildRegistryAuth.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allowBaserep: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    allowWorkspacerep: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    anyOfList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+    total: (f = msg.getTotal()) && proto.builder.BuildRegistryAuthTotal.toObject(includeInstance, f),
+    selective: (f = msg.getSelective()) && proto.builder.BuildRegistryAuthSelective.toObject(includeInstance, f),
+    additionalMap: (f = msg.getAdditionalMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2492,23 +2716,23 @@ proto.builder.BuildRegistryAuthSelective.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.BuildRegistryAuthSelective}
+ * @return {!proto.builder.BuildRegistryAuth}
  */
-proto.builder.BuildRegistryAuthSelective.deserializeBinary = function(bytes) {
+proto.builder.BuildRegistryAuth.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.BuildRegistryAuthSelective;
-  return proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildRegistryAuth;
+  return proto.builder.BuildRegistryAuth.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.BuildRegistryAuthSelective} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildRegistryAuth} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.BuildRegistryAuthSelective}
+ * @return {!proto.builder.BuildRegistryAuth}
  */
-proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildRegistryAuth.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2516,16 +2740,20 @@ proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAllowBaserep(value);
+      var value = new proto.builder.BuildRegistryAuthTotal;
+      reader.readMessage(value,proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader);
+      msg.setTotal(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAllowWorkspacerep(value);
+      var value = new proto.builder.BuildRegistryAuthSelective;
+      reader.readMessage(value,proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader);
+      msg.setSelective(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addAnyOf(value);
+      var value = msg.getAdditionalMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -2540,9 +2768,9 @@ proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.serializeBinary = function() {
+proto.builder.BuildRegistryAuth.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.BuildRegistryAuthSelective.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildRegistryAuth.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2550,109 +2778,131 @@ proto.builder.BuildRegistryAuthSelective.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.BuildRegistryAuthSelective} message
+ * @param {!proto.builder.BuildRegistryAuth} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildRegistryAuthSelective.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildRegistryAuth.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllowBaserep();
-  if (f) {
-    writer.writeBool(
+  f = message.getTotal();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.builder.BuildRegistryAuthTotal.serializeBinaryToWriter
     );
   }
-  f = message.getAllowWorkspacerep();
-  if (f) {
-    writer.writeBool(
+  f = message.getSelective();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.builder.BuildRegistryAuthSelective.serializeBinaryToWriter
     );
   }
-  f = message.getAnyOfList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      3,
-      f
-    );
+  f = message.getAdditionalMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
 /**
- * optional bool allow_baserep = 1;
- * @return {boolean}
+ * optional BuildRegistryAuthTotal total = 1;
+ * @return {?proto.builder.BuildRegistryAuthTotal}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.getAllowBaserep = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.builder.BuildRegistryAuth.prototype.getTotal = function() {
+  return /** @type{?proto.builder.BuildRegistryAuthTotal} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildRegistryAuthTotal, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.builder.BuildRegistryAuthSelective} returns this
- */
-proto.builder.BuildRegistryAuthSelective.prototype.setAllowBaserep = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
+ * @param {?proto.builder.BuildRegistryAuthTotal|undefined} value
+ * @return {!proto.builder.BuildRegistryAuth} returns this
+*/
+proto.builder.BuildRegistryAuth.prototype.setTotal = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.builder.BuildRegistryAuth.oneofGroups_[0], value);
+};
+
 
 /**
- * optional bool allow_workspacerep = 2;
- * @return {boolean}
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.BuildRegistryAuth} returns this
  */
-proto.builder.BuildRegistryAuthSelective.prototype.getAllowWorkspacerep = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.builder.BuildRegistryAuth.prototype.clearTotal = function() {
+  return this.setTotal(undefined);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.setAllowWorkspacerep = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.builder.BuildRegistryAuth.prototype.hasTotal = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * repeated string any_of = 3;
- * @return {!Array<string>}
+ * optional BuildRegistryAuthSelective selective = 2;
+ * @return {?proto.builder.BuildRegistryAuthSelective}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.getAnyOfList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.builder.BuildRegistryAuth.prototype.getSelective = function() {
+  return /** @type{?proto.builder.BuildRegistryAuthSelective} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildRegistryAuthSelective, 2));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ * @param {?proto.builder.BuildRegistryAuthSelective|undefined} value
+ * @return {!proto.builder.BuildRegistryAuth} returns this
+*/
+proto.builder.BuildRegistryAuth.prototype.setSelective = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.builder.BuildRegistryAuth.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.BuildRegistryAuth} returns this
  */
-proto.builder.BuildRegistryAuthSelective.prototype.setAnyOfList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.builder.BuildRegistryAuth.prototype.clearSelective = function() {
+  return this.setSelective(undefined);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.addAnyOf = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.builder.BuildRegistryAuth.prototype.hasSelective = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ * map<string, string> additional = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.builder.BuildRegistryAuthSelective.prototype.clearAnyOfList = function() {
-  return this.setAnyOfList([]);
+proto.builder.BuildRegistryAuth.prototype.getAdditionalMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
 };
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.builder.BuildRegistryAuth} returns this
+ */
+proto.builder.BuildRegistryAuth.prototype.clearAdditionalMap = function() {
+  this.getAdditionalMap().clear();
+  return this;};
+
+
 
 
 
@@ -2669,8 +2919,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.BuildResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.BuildResponse.toObject(opt_includeInstance, this);
+proto.builder.BuildRegistryAuthTotal.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildRegistryAuthTotal.toObject(opt_includeInstance, this);
 };
 
 
@@ -2679,17 +2929,13 @@ proto.builder.BuildResponse.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.BuildResponse} msg The msg instance to transform.
+ * @param {!proto.builder.BuildRegistryAuthTotal} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildResponse.toObject = function(includeInstance, msg) {
+proto.builder.BuildRegistryAuthTotal.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    baseRef: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    status: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    message: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    info: (f = msg.getInfo()) && proto.builder.BuildInfo.toObject(includeInstance, f)
+    allowAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -2703,23 +2949,23 @@ proto.builder.BuildResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.BuildResponse}
+ * @return {!proto.builder.BuildRegistryAuthTotal}
  */
-proto.builder.BuildResponse.deserializeBinary = function(bytes) {
+proto.builder.BuildRegistryAuthTotal.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.BuildResponse;
-  return proto.builder.BuildResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildRegistryAuthTotal;
+  return proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.BuildResponse} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildRegistryAuthTotal} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.BuildResponse}
+ * @return {!proto.builder.BuildRegistryAuthTotal}
  */
-proto.builder.BuildResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildRegistryAuthTotal.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2727,25 +2973,8 @@ proto.builder.BuildResponse.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRef(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBaseRef(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
-      break;
-    case 5:
-      var value = new proto.builder.BuildInfo;
-      reader.readMessage(value,proto.builder.BuildInfo.deserializeBinaryFromReader);
-      msg.setInfo(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowAll(value);
       break;
     default:
       reader.skipField();
@@ -2760,9 +2989,9 @@ proto.builder.BuildResponse.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.BuildResponse.prototype.serializeBinary = function() {
+proto.builder.BuildRegistryAuthTotal.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.BuildResponse.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildRegistryAuthTotal.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2770,157 +2999,1340 @@ proto.builder.BuildResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.BuildResponse} message
+ * @param {!proto.builder.BuildRegistryAuthTotal} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildResponse.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildRegistryAuthTotal.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRef();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAllowAll();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = message.getBaseRef();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getInfo();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.builder.BuildInfo.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string ref = 1;
- * @return {string}
+ * optional bool allow_all = 1;
+ * @return {boolean}
  */
-proto.builder.BuildResponse.prototype.getRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.builder.BuildRegistryAuthTotal.prototype.getAllowAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.builder.BuildResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.builder.BuildRegistryAuthTotal} returns this
  */
-proto.builder.BuildResponse.prototype.setRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.builder.BuildRegistryAuthTotal.prototype.setAllowAll = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
-/**
- * optional string base_ref = 4;
- * @return {string}
- */
-proto.builder.BuildResponse.prototype.getBaseRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.builder.BuildResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.builder.BuildResponse.prototype.setBaseRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
+proto.builder.BuildRegistryAuthSelective.repeatedFields_ = [3];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional BuildStatus status = 2;
- * @return {!proto.builder.BuildStatus}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.builder.BuildResponse.prototype.getStatus = function() {
-  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.builder.BuildRegistryAuthSelective.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildRegistryAuthSelective.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.builder.BuildStatus} value
- * @return {!proto.builder.BuildResponse} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.BuildRegistryAuthSelective} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.builder.BuildRegistryAuthSelective.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allowBaserep: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    allowWorkspacerep: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    anyOfList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string message = 3;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.BuildRegistryAuthSelective}
  */
-proto.builder.BuildResponse.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.builder.BuildRegistryAuthSelective.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.BuildRegistryAuthSelective;
+  return proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.BuildRegistryAuthSelective} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.BuildRegistryAuthSelective}
+ */
+proto.builder.BuildRegistryAuthSelective.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowBaserep(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowWorkspacerep(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAnyOf(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.BuildRegistryAuthSelective.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.BuildRegistryAuthSelective} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildRegistryAuthSelective.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllowBaserep();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getAllowWorkspacerep();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getAnyOfList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool allow_baserep = 1;
+ * @return {boolean}
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.getAllowBaserep = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.setAllowBaserep = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional bool allow_workspacerep = 2;
+ * @return {boolean}
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.getAllowWorkspacerep = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.setAllowWorkspacerep = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * repeated string any_of = 3;
+ * @return {!Array<string>}
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.getAnyOfList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.setAnyOfList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.addAnyOf = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.BuildRegistryAuthSelective} returns this
+ */
+proto.builder.BuildRegistryAuthSelective.prototype.clearAnyOfList = function() {
+  return this.setAnyOfList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.BuildResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.BuildResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    baseRef: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    info: (f = msg.getInfo()) && proto.builder.BuildInfo.toObject(includeInstance, f),
+    cacheStats: (f = msg.getCacheStats()) && proto.builder.BuildCacheStats.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.BuildResponse}
+ */
+proto.builder.BuildResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.BuildResponse;
+  return proto.builder.BuildResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.BuildResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.BuildResponse}
+ */
+proto.builder.BuildResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBaseRef(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 5:
+      var value = new proto.builder.BuildInfo;
+      reader.readMessage(value,proto.builder.BuildInfo.deserializeBinaryFromReader);
+      msg.setInfo(value);
+      break;
+    case 6:
+      var value = new proto.builder.BuildCacheStats;
+      reader.readMessage(value,proto.builder.BuildCacheStats.deserializeBinaryFromReader);
+      msg.setCacheStats(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.BuildResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.BuildResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.BuildResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getBaseRef();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getInfo();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.builder.BuildInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getCacheStats();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.builder.BuildCacheStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string ref = 1;
+ * @return {string}
+ */
+proto.builder.BuildResponse.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildResponse} returns this
+ */
+proto.builder.BuildResponse.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string base_ref = 4;
+ * @return {string}
+ */
+proto.builder.BuildResponse.prototype.getBaseRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildResponse} returns this
+ */
+proto.builder.BuildResponse.prototype.setBaseRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional BuildStatus status = 2;
+ * @return {!proto.builder.BuildStatus}
+ */
+proto.builder.BuildResponse.prototype.getStatus = function() {
+  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.builder.BuildStatus} value
+ * @return {!proto.builder.BuildResponse} returns this
+ */
+proto.builder.BuildResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string message = 3;
+ * @return {string}
+ */
+proto.builder.BuildResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildResponse} returns this
+ */
+proto.builder.BuildResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional BuildInfo info = 5;
+ * @return {?proto.builder.BuildInfo}
+ */
+proto.builder.BuildResponse.prototype.getInfo = function() {
+  return /** @type{?proto.builder.BuildInfo} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildInfo, 5));
+};
+
+
+/**
+ * @param {?proto.builder.BuildInfo|undefined} value
+ * @return {!proto.builder.BuildResponse} returns this
+*/
+proto.builder.BuildResponse.prototype.setInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.BuildResponse} returns this
+ */
+proto.builder.BuildResponse.prototype.clearInfo = function() {
+  return this.setInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.builder.BuildResponse.prototype.hasInfo = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional BuildCacheStats cache_stats = 6;
+ * @return {?proto.builder.BuildCacheStats}
+ */
+proto.builder.BuildResponse.prototype.getCacheStats = function() {
+  return /** @type{?proto.builder.BuildCacheStats} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildCacheStats, 6));
+};
+
+
+/**
+ * @param {?proto.builder.BuildCacheStats|undefined} value
+ * @return {!proto.builder.BuildResponse} returns this
+*/
+proto.builder.BuildResponse.prototype.setCacheStats = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
  * @return {!proto.builder.BuildResponse} returns this
  */
-proto.builder.BuildResponse.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.builder.BuildResponse.prototype.clearCacheStats = function() {
+  return this.setCacheStats(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.builder.BuildResponse.prototype.hasCacheStats = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.BuildCacheStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildCacheStats.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.BuildCacheStats} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildCacheStats.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    steps: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    cachedSteps: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.BuildCacheStats}
+ */
+proto.builder.BuildCacheStats.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.BuildCacheStats;
+  return proto.builder.BuildCacheStats.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.BuildCacheStats} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.BuildCacheStats}
+ */
+proto.builder.BuildCacheStats.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSteps(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCachedSteps(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.BuildCacheStats.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.BuildCacheStats.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.BuildCacheStats} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildCacheStats.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSteps();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getCachedSteps();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 steps = 1;
+ * @return {number}
+ */
+proto.builder.BuildCacheStats.prototype.getSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.builder.BuildCacheStats} returns this
+ */
+proto.builder.BuildCacheStats.prototype.setSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 cached_steps = 2;
+ * @return {number}
+ */
+proto.builder.BuildCacheStats.prototype.getCachedSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.builder.BuildCacheStats} returns this
+ */
+proto.builder.BuildCacheStats.prototype.setCachedSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.LogsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.LogsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.LogsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.LogsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    buildRef: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    censored: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    buildId: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.LogsRequest}
+ */
+proto.builder.LogsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.LogsRequest;
+  return proto.builder.LogsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.LogsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.LogsRequest}
+ */
+proto.builder.LogsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildRef(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCensored(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.LogsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.LogsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.LogsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.LogsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBuildRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCensored();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getBuildId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string build_ref = 1;
+ * @return {string}
+ */
+proto.builder.LogsRequest.prototype.getBuildRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.LogsRequest} returns this
+ */
+proto.builder.LogsRequest.prototype.setBuildRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool censored = 2;
+ * @return {boolean}
+ */
+proto.builder.LogsRequest.prototype.getCensored = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.builder.LogsRequest} returns this
+ */
+proto.builder.LogsRequest.prototype.setCensored = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string build_id = 3;
+ * @return {string}
+ */
+proto.builder.LogsRequest.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.LogsRequest} returns this
+ */
+proto.builder.LogsRequest.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.LogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.LogsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.LogsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.LogsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    content: msg.getContent_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.LogsResponse}
+ */
+proto.builder.LogsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.LogsResponse;
+  return proto.builder.LogsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.LogsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.LogsResponse}
+ */
+proto.builder.LogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.LogsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.LogsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.LogsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.LogsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes content = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.builder.LogsResponse.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
+ */
+proto.builder.LogsResponse.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
+ */
+proto.builder.LogsResponse.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.builder.LogsResponse} returns this
+ */
+proto.builder.LogsResponse.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.ListBuildsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ListBuildsRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional BuildInfo info = 5;
- * @return {?proto.builder.BuildInfo}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.ListBuildsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildResponse.prototype.getInfo = function() {
-  return /** @type{?proto.builder.BuildInfo} */ (
-    jspb.Message.getWrapperField(this, proto.builder.BuildInfo, 5));
+proto.builder.ListBuildsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {?proto.builder.BuildInfo|undefined} value
- * @return {!proto.builder.BuildResponse} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.ListBuildsRequest}
+ */
+proto.builder.ListBuildsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.ListBuildsRequest;
+  return proto.builder.ListBuildsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.ListBuildsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.ListBuildsRequest}
+ */
+proto.builder.ListBuildsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.ListBuildsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.ListBuildsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.ListBuildsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.ListBuildsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.ListBuildsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ListBuildsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.ListBuildsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    buildsList: jspb.Message.toObjectList(msg.getBuildsList(),
+    proto.builder.BuildInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.ListBuildsResponse}
+ */
+proto.builder.ListBuildsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.ListBuildsResponse;
+  return proto.builder.ListBuildsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.ListBuildsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.ListBuildsResponse}
+ */
+proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.builder.BuildInfo;
+      reader.readMessage(value,proto.builder.BuildInfo.deserializeBinaryFromReader);
+      msg.addBuilds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.ListBuildsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.ListBuildsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.ListBuildsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBuildsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.builder.BuildInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated BuildInfo builds = 1;
+ * @return {!Array<!proto.builder.BuildInfo>}
+ */
+proto.builder.ListBuildsResponse.prototype.getBuildsList = function() {
+  return /** @type{!Array<!proto.builder.BuildInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.builder.BuildInfo>} value
+ * @return {!proto.builder.ListBuildsResponse} returns this
 */
-proto.builder.BuildResponse.prototype.setInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+proto.builder.ListBuildsResponse.prototype.setBuildsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.builder.BuildResponse} returns this
+ * @param {!proto.builder.BuildInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildInfo}
  */
-proto.builder.BuildResponse.prototype.clearInfo = function() {
-  return this.setInfo(undefined);
+proto.builder.ListBuildsResponse.prototype.addBuilds = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.builder.BuildInfo, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.ListBuildsResponse} returns this
  */
-proto.builder.BuildResponse.prototype.hasInfo = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.builder.ListBuildsResponse.prototype.clearBuildsList = function() {
+  return this.setBuildsList([]);
 };
 
 
@@ -2940,8 +4352,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.LogsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.LogsRequest.toObject(opt_includeInstance, this);
+proto.builder.BuildInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -2950,15 +4362,19 @@ proto.builder.LogsRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.LogsRequest} msg The msg instance to transform.
+ * @param {!proto.builder.BuildInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.LogsRequest.toObject = function(includeInstance, msg) {
+proto.builder.BuildInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    buildRef: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    censored: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    buildId: jspb.Message.getFieldWithDefault(msg, 3, "")
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    baseRef: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    startedAt: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    buildId: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    logInfo: (f = msg.getLogInfo()) && proto.builder.LogInfo.toObject(includeInstance, f),
+    queuePosition: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -2972,23 +4388,23 @@ proto.builder.LogsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.LogsRequest}
+ * @return {!proto.builder.BuildInfo}
  */
-proto.builder.LogsRequest.deserializeBinary = function(bytes) {
+proto.builder.BuildInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.LogsRequest;
-  return proto.builder.LogsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildInfo;
+  return proto.builder.BuildInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.LogsRequest} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.LogsRequest}
+ * @return {!proto.builder.BuildInfo}
  */
-proto.builder.LogsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2997,16 +4413,33 @@ proto.builder.LogsRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBuildRef(value);
+      msg.setRef(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBaseRef(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setCensored(value);
+      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
+      msg.setStatus(value);
       break;
     case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartedAt(value);
+      break;
+    case 5:
       var value = /** @type {string} */ (reader.readString());
       msg.setBuildId(value);
       break;
+    case 6:
+      var value = new proto.builder.LogInfo;
+      reader.readMessage(value,proto.builder.LogInfo.deserializeBinaryFromReader);
+      msg.setLogInfo(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setQueuePosition(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3020,9 +4453,9 @@ proto.builder.LogsRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.LogsRequest.prototype.serializeBinary = function() {
+proto.builder.BuildInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.LogsRequest.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3030,30 +4463,59 @@ proto.builder.LogsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.LogsRequest} message
+ * @param {!proto.builder.BuildInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.LogsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBuildRef();
+  f = message.getRef();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getCensored();
-  if (f) {
-    writer.writeBool(
+  f = message.getBaseRef();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
+  f = message.getStartedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
   f = message.getBuildId();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      5,
+      f
+    );
+  }
+  f = message.getLogInfo();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.builder.LogInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getQueuePosition();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
       f
     );
   }
@@ -3061,210 +4523,147 @@ proto.builder.LogsRequest.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string build_ref = 1;
+ * optional string ref = 1;
  * @return {string}
  */
-proto.builder.LogsRequest.prototype.getBuildRef = function() {
+proto.builder.BuildInfo.prototype.getRef = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.builder.LogsRequest} returns this
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsRequest.prototype.setBuildRef = function(value) {
+proto.builder.BuildInfo.prototype.setRef = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool censored = 2;
- * @return {boolean}
+ * optional string base_ref = 4;
+ * @return {string}
  */
-proto.builder.LogsRequest.prototype.getCensored = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.builder.BuildInfo.prototype.getBaseRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.builder.LogsRequest} returns this
+ * @param {string} value
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsRequest.prototype.setCensored = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.builder.BuildInfo.prototype.setBaseRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string build_id = 3;
- * @return {string}
+ * optional BuildStatus status = 2;
+ * @return {!proto.builder.BuildStatus}
  */
-proto.builder.LogsRequest.prototype.getBuildId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.builder.BuildInfo.prototype.getStatus = function() {
+  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.builder.LogsRequest} returns this
+ * @param {!proto.builder.BuildStatus} value
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsRequest.prototype.setBuildId = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.builder.BuildInfo.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional int64 started_at = 3;
+ * @return {number}
  */
-proto.builder.LogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.LogsResponse.toObject(opt_includeInstance, this);
+proto.builder.BuildInfo.prototype.getStartedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.builder.LogsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    content: msg.getContent_asB64()
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.builder.BuildInfo.prototype.setStartedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.LogsResponse}
+ * optional string build_id = 5;
+ * @return {string}
  */
-proto.builder.LogsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.LogsResponse;
-  return proto.builder.LogsResponse.deserializeBinaryFromReader(msg, reader);
+proto.builder.BuildInfo.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.builder.LogsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.LogsResponse}
+ * @param {string} value
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setContent(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.builder.BuildInfo.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional LogInfo log_info = 6;
+ * @return {?proto.builder.LogInfo}
  */
-proto.builder.LogsResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.builder.LogsResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.builder.BuildInfo.prototype.getLogInfo = function() {
+  return /** @type{?proto.builder.LogInfo} */ (
+    jspb.Message.getWrapperField(this, proto.builder.LogInfo, 6));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.builder.LogsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.builder.LogsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getContent_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
-      1,
-      f
-    );
-  }
+ * @param {?proto.builder.LogInfo|undefined} value
+ * @return {!proto.builder.BuildInfo} returns this
+*/
+proto.builder.BuildInfo.prototype.setLogInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
- * optional bytes content = 1;
- * @return {!(string|Uint8Array)}
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsResponse.prototype.getContent = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.builder.BuildInfo.prototype.clearLogInfo = function() {
+  return this.setLogInfo(undefined);
 };
 
 
 /**
- * optional bytes content = 1;
- * This is a type-conversion wrapper around `getContent()`
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.builder.LogsResponse.prototype.getContent_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getContent()));
+proto.builder.BuildInfo.prototype.hasLogInfo = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional bytes content = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getContent()`
- * @return {!Uint8Array}
+ * optional int32 queue_position = 7;
+ * @return {number}
  */
-proto.builder.LogsResponse.prototype.getContent_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getContent()));
+proto.builder.BuildInfo.prototype.getQueuePosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.builder.LogsResponse} returns this
+ * @param {number} value
+ * @return {!proto.builder.BuildInfo} returns this
  */
-proto.builder.LogsResponse.prototype.setContent = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.builder.BuildInfo.prototype.setQueuePosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
@@ -3284,8 +4683,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ListBuildsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ListBuildsRequest.toObject(opt_includeInstance, this);
+proto.builder.LogInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.LogInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -3294,13 +4693,14 @@ proto.builder.ListBuildsRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ListBuildsRequest} msg The msg instance to transform.
+ * @param {!proto.builder.LogInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsRequest.toObject = function(includeInstance, msg) {
+proto.builder.LogInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    headersMap: (f = msg.getHeadersMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -3314,29 +4714,39 @@ proto.builder.ListBuildsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ListBuildsRequest}
+ * @return {!proto.builder.LogInfo}
  */
-proto.builder.ListBuildsRequest.deserializeBinary = function(bytes) {
+proto.builder.LogInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ListBuildsRequest;
-  return proto.builder.ListBuildsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.LogInfo;
+  return proto.builder.LogInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ListBuildsRequest} msg The message object to deserialize into.
+ * @param {!proto.builder.LogInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ListBuildsRequest}
+ * @return {!proto.builder.LogInfo}
  */
-proto.builder.ListBuildsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = msg.getHeadersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
     default:
       reader.skipField();
       break;
@@ -3350,9 +4760,9 @@ proto.builder.ListBuildsRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ListBuildsRequest.prototype.serializeBinary = function() {
+proto.builder.LogInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ListBuildsRequest.serializeBinaryToWriter(this, writer);
+  proto.builder.LogInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3360,22 +4770,66 @@ proto.builder.ListBuildsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ListBuildsRequest} message
+ * @param {!proto.builder.LogInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.builder.LogInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getHeadersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.builder.LogInfo.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.builder.LogInfo} returns this
  */
-proto.builder.ListBuildsResponse.repeatedFields_ = [1];
+proto.builder.LogInfo.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, string> headers = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.builder.LogInfo.prototype.getHeadersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.builder.LogInfo} returns this
+ */
+proto.builder.LogInfo.prototype.clearHeadersMap = function() {
+  this.getHeadersMap().clear();
+  return this;};
+
+
 
 
 
@@ -3392,8 +4846,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ListBuildsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ListBuildsResponse.toObject(opt_includeInstance, this);
+proto.builder.GetBuildSBOMRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.GetBuildSBOMRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3402,14 +4856,14 @@ proto.builder.ListBuildsResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ListBuildsResponse} msg The msg instance to transform.
+ * @param {!proto.builder.GetBuildSBOMRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsResponse.toObject = function(includeInstance, msg) {
+proto.builder.GetBuildSBOMRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    buildsList: jspb.Message.toObjectList(msg.getBuildsList(),
-    proto.builder.BuildInfo.toObject, includeInstance)
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    format: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3423,23 +4877,23 @@ proto.builder.ListBuildsResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ListBuildsResponse}
+ * @return {!proto.builder.GetBuildSBOMRequest}
  */
-proto.builder.ListBuildsResponse.deserializeBinary = function(bytes) {
+proto.builder.GetBuildSBOMRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ListBuildsResponse;
-  return proto.builder.ListBuildsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.GetBuildSBOMRequest;
+  return proto.builder.GetBuildSBOMRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ListBuildsResponse} msg The message object to deserialize into.
+ * @param {!proto.builder.GetBuildSBOMRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ListBuildsResponse}
+ * @return {!proto.builder.GetBuildSBOMRequest}
  */
-proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.GetBuildSBOMRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3447,9 +4901,12 @@ proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.builder.BuildInfo;
-      reader.readMessage(value,proto.builder.BuildInfo.deserializeBinaryFromReader);
-      msg.addBuilds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.builder.SBOMFormat} */ (reader.readEnum());
+      msg.setFormat(value);
       break;
     default:
       reader.skipField();
@@ -3464,9 +4921,9 @@ proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ListBuildsResponse.prototype.serializeBinary = function() {
+proto.builder.GetBuildSBOMRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ListBuildsResponse.serializeBinaryToWriter(this, writer);
+  proto.builder.GetBuildSBOMRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3474,62 +4931,73 @@ proto.builder.ListBuildsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ListBuildsResponse} message
+ * @param {!proto.builder.GetBuildSBOMRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.builder.GetBuildSBOMRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBuildsList();
+  f = message.getRef();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.builder.BuildInfo.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getFormat();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated BuildInfo builds = 1;
- * @return {!Array<!proto.builder.BuildInfo>}
+ * optional string ref = 1;
+ * @return {string}
  */
-proto.builder.ListBuildsResponse.prototype.getBuildsList = function() {
-  return /** @type{!Array<!proto.builder.BuildInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildInfo, 1));
+proto.builder.GetBuildSBOMRequest.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.builder.BuildInfo>} value
- * @return {!proto.builder.ListBuildsResponse} returns this
-*/
-proto.builder.ListBuildsResponse.prototype.setBuildsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.builder.GetBuildSBOMRequest} returns this
+ */
+proto.builder.GetBuildSBOMRequest.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.builder.BuildInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.builder.BuildInfo}
+ * optional SBOMFormat format = 2;
+ * @return {!proto.builder.SBOMFormat}
  */
-proto.builder.ListBuildsResponse.prototype.addBuilds = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.builder.BuildInfo, opt_index);
+proto.builder.GetBuildSBOMRequest.prototype.getFormat = function() {
+  return /** @type {!proto.builder.SBOMFormat} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.builder.ListBuildsResponse} returns this
+ * @param {!proto.builder.SBOMFormat} value
+ * @return {!proto.builder.GetBuildSBOMRequest} returns this
  */
-proto.builder.ListBuildsResponse.prototype.clearBuildsList = function() {
-  return this.setBuildsList([]);
+proto.builder.GetBuildSBOMRequest.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.GetBuildSBOMResponse.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3545,8 +5013,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.BuildInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.BuildInfo.toObject(opt_includeInstance, this);
+proto.builder.GetBuildSBOMResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.GetBuildSBOMResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3555,18 +5023,16 @@ proto.builder.BuildInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.BuildInfo} msg The msg instance to transform.
+ * @param {!proto.builder.GetBuildSBOMResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildInfo.toObject = function(includeInstance, msg) {
+proto.builder.GetBuildSBOMResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    baseRef: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    status: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    startedAt: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    buildId: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    logInfo: (f = msg.getLogInfo()) && proto.builder.LogInfo.toObject(includeInstance, f)
+    mediaType: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    content: msg.getContent_asB64(),
+    vulnerabilitiesList: jspb.Message.toObjectList(msg.getVulnerabilitiesList(),
+    proto.builder.Vulnerability.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3580,23 +5046,23 @@ proto.builder.BuildInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.BuildInfo}
+ * @return {!proto.builder.GetBuildSBOMResponse}
  */
-proto.builder.BuildInfo.deserializeBinary = function(bytes) {
+proto.builder.GetBuildSBOMResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.BuildInfo;
-  return proto.builder.BuildInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.GetBuildSBOMResponse;
+  return proto.builder.GetBuildSBOMResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.BuildInfo} msg The message object to deserialize into.
+ * @param {!proto.builder.GetBuildSBOMResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.BuildInfo}
+ * @return {!proto.builder.GetBuildSBOMResponse}
  */
-proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.GetBuildSBOMResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3605,28 +5071,16 @@ proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRef(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBaseRef(value);
+      msg.setMediaType(value);
       break;
     case 2:
-      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setStartedAt(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBuildId(value);
-      break;
-    case 6:
-      var value = new proto.builder.LogInfo;
-      reader.readMessage(value,proto.builder.LogInfo.deserializeBinaryFromReader);
-      msg.setLogInfo(value);
+      var value = new proto.builder.Vulnerability;
+      reader.readMessage(value,proto.builder.Vulnerability.deserializeBinaryFromReader);
+      msg.addVulnerabilities(value);
       break;
     default:
       reader.skipField();
@@ -3641,9 +5095,9 @@ proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.BuildInfo.prototype.serializeBinary = function() {
+proto.builder.GetBuildSBOMResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.BuildInfo.serializeBinaryToWriter(this, writer);
+  proto.builder.GetBuildSBOMResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3651,182 +5105,132 @@ proto.builder.BuildInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.BuildInfo} message
+ * @param {!proto.builder.GetBuildSBOMResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildInfo.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getRef();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getBaseRef();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-  f = message.getStartedAt();
-  if (f !== 0) {
-    writer.writeInt64(
-      3,
+proto.builder.GetBuildSBOMResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMediaType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
       f
     );
   }
-  f = message.getBuildId();
+  f = message.getContent_asU8();
   if (f.length > 0) {
-    writer.writeString(
-      5,
+    writer.writeBytes(
+      2,
       f
     );
   }
-  f = message.getLogInfo();
-  if (f != null) {
-    writer.writeMessage(
-      6,
+  f = message.getVulnerabilitiesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
       f,
-      proto.builder.LogInfo.serializeBinaryToWriter
+      proto.builder.Vulnerability.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string ref = 1;
+ * optional string media_type = 1;
  * @return {string}
  */
-proto.builder.BuildInfo.prototype.getRef = function() {
+proto.builder.GetBuildSBOMResponse.prototype.getMediaType = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.builder.BuildInfo} returns this
+ * @return {!proto.builder.GetBuildSBOMResponse} returns this
  */
-proto.builder.BuildInfo.prototype.setRef = function(value) {
+proto.builder.GetBuildSBOMResponse.prototype.setMediaType = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string base_ref = 4;
- * @return {string}
- */
-proto.builder.BuildInfo.prototype.getBaseRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.builder.BuildInfo} returns this
- */
-proto.builder.BuildInfo.prototype.setBaseRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional BuildStatus status = 2;
- * @return {!proto.builder.BuildStatus}
- */
-proto.builder.BuildInfo.prototype.getStatus = function() {
-  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {!proto.builder.BuildStatus} value
- * @return {!proto.builder.BuildInfo} returns this
- */
-proto.builder.BuildInfo.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
-};
-
-
-/**
- * optional int64 started_at = 3;
- * @return {number}
+ * optional bytes content = 2;
+ * @return {!(string|Uint8Array)}
  */
-proto.builder.BuildInfo.prototype.getStartedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.builder.GetBuildSBOMResponse.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.builder.BuildInfo} returns this
+ * optional bytes content = 2;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
  */
-proto.builder.BuildInfo.prototype.setStartedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.builder.GetBuildSBOMResponse.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
 };
 
 
 /**
- * optional string build_id = 5;
- * @return {string}
+ * optional bytes content = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
  */
-proto.builder.BuildInfo.prototype.getBuildId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.builder.GetBuildSBOMResponse.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.builder.BuildInfo} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.builder.GetBuildSBOMResponse} returns this
  */
-proto.builder.BuildInfo.prototype.setBuildId = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.builder.GetBuildSBOMResponse.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
 /**
- * optional LogInfo log_info = 6;
- * @return {?proto.builder.LogInfo}
+ * repeated Vulnerability vulnerabilities = 3;
+ * @return {!Array<!proto.builder.Vulnerability>}
  */
-proto.builder.BuildInfo.prototype.getLogInfo = function() {
-  return /** @type{?proto.builder.LogInfo} */ (
-    jspb.Message.getWrapperField(this, proto.builder.LogInfo, 6));
+proto.builder.GetBuildSBOMResponse.prototype.getVulnerabilitiesList = function() {
+  return /** @type{!Array<!proto.builder.Vulnerability>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.Vulnerability, 3));
 };
 
 
 /**
- * @param {?proto.builder.LogInfo|undefined} value
- * @return {!proto.builder.BuildInfo} returns this
+ * @param {!Array<!proto.builder.Vulnerability>} value
+ * @return {!proto.builder.GetBuildSBOMResponse} returns this
 */
-proto.builder.BuildInfo.prototype.setLogInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+proto.builder.GetBuildSBOMResponse.prototype.setVulnerabilitiesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.builder.BuildInfo} returns this
+ * @param {!proto.builder.Vulnerability=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.Vulnerability}
  */
-proto.builder.BuildInfo.prototype.clearLogInfo = function() {
-  return this.setLogInfo(undefined);
+proto.builder.GetBuildSBOMResponse.prototype.addVulnerabilities = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.builder.Vulnerability, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.GetBuildSBOMResponse} returns this
  */
-proto.builder.BuildInfo.prototype.hasLogInfo = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.builder.GetBuildSBOMResponse.prototype.clearVulnerabilitiesList = function() {
+  return this.setVulnerabilitiesList([]);
 };
 
 
@@ -3846,8 +5250,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.LogInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.LogInfo.toObject(opt_includeInstance, this);
+proto.builder.Vulnerability.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.Vulnerability.toObject(opt_includeInstance, this);
 };
 
 
@@ -3856,14 +5260,18 @@ proto.builder.LogInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.LogInfo} msg The msg instance to transform.
+ * @param {!proto.builder.Vulnerability} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.LogInfo.toObject = function(includeInstance, msg) {
+proto.builder.Vulnerability.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    headersMap: (f = msg.getHeadersMap()) ? f.toObject(includeInstance, undefined) : []
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pb_package: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    severity: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    summary: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    fixedVersion: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -3877,23 +5285,23 @@ proto.builder.LogInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.LogInfo}
+ * @return {!proto.builder.Vulnerability}
  */
-proto.builder.LogInfo.deserializeBinary = function(bytes) {
+proto.builder.Vulnerability.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.LogInfo;
-  return proto.builder.LogInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.Vulnerability;
+  return proto.builder.Vulnerability.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.LogInfo} msg The message object to deserialize into.
+ * @param {!proto.builder.Vulnerability} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.LogInfo}
+ * @return {!proto.builder.Vulnerability}
  */
-proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.Vulnerability.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3902,13 +5310,27 @@ proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = msg.getHeadersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackage(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.builder.VulnerabilitySeverity} */ (reader.readEnum());
+      msg.setSeverity(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSummary(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFixedVersion(value);
       break;
     default:
       reader.skipField();
@@ -3923,9 +5345,9 @@ proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.LogInfo.prototype.serializeBinary = function() {
+proto.builder.Vulnerability.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.LogInfo.serializeBinaryToWriter(this, writer);
+  proto.builder.Vulnerability.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3933,64 +5355,163 @@ proto.builder.LogInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.LogInfo} message
+ * @param {!proto.builder.Vulnerability} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.LogInfo.serializeBinaryToWriter = function(message, writer) {
+proto.builder.Vulnerability.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getHeadersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getPackage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSeverity();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getSummary();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getFixedVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
   }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.builder.LogInfo.prototype.getUrl = function() {
+proto.builder.Vulnerability.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.builder.LogInfo} returns this
+ * @return {!proto.builder.Vulnerability} returns this
  */
-proto.builder.LogInfo.prototype.setUrl = function(value) {
+proto.builder.Vulnerability.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * map<string, string> headers = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string package = 2;
+ * @return {string}
  */
-proto.builder.LogInfo.prototype.getHeadersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.builder.Vulnerability.prototype.getPackage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.builder.LogInfo} returns this
+ * @param {string} value
+ * @return {!proto.builder.Vulnerability} returns this
  */
-proto.builder.LogInfo.prototype.clearHeadersMap = function() {
-  this.getHeadersMap().clear();
-  return this;};
+proto.builder.Vulnerability.prototype.setPackage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string version = 3;
+ * @return {string}
+ */
+proto.builder.Vulnerability.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.Vulnerability} returns this
+ */
+proto.builder.Vulnerability.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional VulnerabilitySeverity severity = 4;
+ * @return {!proto.builder.VulnerabilitySeverity}
+ */
+proto.builder.Vulnerability.prototype.getSeverity = function() {
+  return /** @type {!proto.builder.VulnerabilitySeverity} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.builder.VulnerabilitySeverity} value
+ * @return {!proto.builder.Vulnerability} returns this
+ */
+proto.builder.Vulnerability.prototype.setSeverity = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional string summary = 5;
+ * @return {string}
+ */
+proto.builder.Vulnerability.prototype.getSummary = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.Vulnerability} returns this
+ */
+proto.builder.Vulnerability.prototype.setSummary = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string fixed_version = 6;
+ * @return {string}
+ */
+proto.builder.Vulnerability.prototype.getFixedVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.Vulnerability} returns this
+ */
+proto.builder.Vulnerability.prototype.setFixedVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
 
 
 /**
@@ -4000,7 +5521,27 @@ proto.builder.BuildStatus = {
   UNKNOWN: 0,
   RUNNING: 1,
   DONE_SUCCESS: 2,
-  DONE_FAILURE: 3
+  DONE_FAILURE: 3,
+  QUEUED: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.builder.SBOMFormat = {
+  SPDX: 0,
+  CYCLONEDX: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.builder.VulnerabilitySeverity = {
+  SEVERITY_UNKNOWN: 0,
+  SEVERITY_LOW: 1,
+  SEVERITY_MEDIUM: 2,
+  SEVERITY_HIGH: 3,
+  SEVERITY_CRITICAL: 4
 };
 
 goog.object.extend(exports, proto.builder);
@@ -243,7 +243,8 @@ export class PromisifiedImageBuilderClient {
                     forceRebuild: request.getForceRebuild(),
                     triggeredBy: request.getTriggeredBy(),
                 });
-                if (resp.getStatus() == BuildStatus.RUNNING) {
+                if (resp.getStatus() == BuildStatus.RUNNING || resp.getStatus() == BuildStatus.QUEUED) {
+                    // a queued build is a build which waits for a free slot in image-builder
                     resultResp.actuallyNeedsBuild = true;
                     result.resolve(resultResp);
                 } else if (
@@ -13,6 +13,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
 	"syscall"
 	"time"
 
@@ -44,18 +46,20 @@ func (b *Builder) Build() error {
 	var (
 		cl       *client.Client
 		teardown func() error = func() error { return nil }
+		external bool
 		err      error
 	)
 	if b.Config.ExternalBuildkitd != "" {
 		log.WithField("socketPath", b.Config.ExternalBuildkitd).Info("using external buildkit daemon")
 		cl, err = connectToBuildkitd(b.Config.ExternalBuildkitd)
+		external = err == nil
 
 		if err != nil {
 			log.Warn("cannot connect to node-local buildkitd - falling back to pod-local one")
-			cl, teardown, err = StartBuildkit(buildkitdSocketPath)
+			cl, teardown, err = StartBuildkit(buildkitdSocketPath, b.Config.Cache)
 		}
 	} else {
-		cl, teardown, err = StartBuildkit(buildkitdSocketPath)
+		cl, teardown, err = StartBuildkit(buildkitdSocketPath, b.Config.Cache)
 	}
 	if err != nil {
 		return err
@@ -69,6 +73,10 @@ func (b *Builder) Build() error {
 	if err != nil {
 		return err
 	}
+	if external {
+		// we cannot configure the garbage collection of a buildkitd we haven't started ourselves
+		pruneBuildkitCache(ctx, cl, b.Config.Cache)
+	}
 	err = b.buildWorkspaceImage(ctx)
 	if err != nil {
 		return err
@@ -83,7 +91,7 @@ func (b *Builder) buildBaseLayer(ctx context.Context, cl *client.Client) error {
 	}
 
 	log.Info("building base image")
-	return buildImage(ctx, b.Config.ContextDir, b.Config.Dockerfile, b.Config.WorkspaceLayerAuth, b.Config.BaseRef)
+	return buildImage(ctx, b.Config.ContextDir, b.Config.Dockerfile, b.Config.WorkspaceLayerAuth, b.Config.BaseRef, b.Config.Cache, b.Config.Secrets, b.Config.SSHKeys)
 }
 
 func (b *Builder) buildWorkspaceImage(ctx context.Context) (err error) {
@@ -94,7 +102,7 @@ func (b *Builder) buildWorkspaceImage(ctx context.Context) (err error) {
 	return crane.Copy(b.Config.BaseRef, b.Config.TargetRef, crane.Insecure, crane.WithJobs(runtime.GOMAXPROCS(0)))
 }
 
-func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target string) (err error) {
+func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target string, cache CacheConfig, secrets, sshKeys map[string]string) (err error) {
 	log.Info("waiting for build context")
 	waitctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
@@ -136,18 +144,32 @@ func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target s
 		"build",
 		"--progress=plain",
 		"--output=type=image,name=" + target + ",push=true,oci-mediatypes=true",
-		//"--export-cache=type=inline",
 		"--local=context=" + contextdir,
-		//"--export-cache=type=registry,ref=" + target + "-cache",
-		//"--import-cache=type=registry,ref=" + target + "-cache",
 		"--frontend=dockerfile.v0",
 		"--local=dockerfile=" + filepath.Dir(dockerfile),
 		"--opt=filename=" + filepath.Base(dockerfile),
 	}
+	buildctlArgs = append(buildctlArgs, cacheArgs(cache)...)
+
+	if len(secrets) > 0 || len(sshKeys) > 0 {
+		secretsDir, err := os.MkdirTemp("", "bob-secrets")
+		if err != nil {
+			return xerrors.Errorf("cannot create build secrets directory: %w", err)
+		}
+		defer os.RemoveAll(secretsDir)
+
+		args, err := secretArgs(secretsDir, secrets, sshKeys)
+		if err != nil {
+			return err
+		}
+		buildctlArgs = append(buildctlArgs, args...)
+	}
 
+	// buildctl reports the progress of the build on stderr
+	stats := newCacheStatsWriter(os.Stderr)
 	buildctlCmd := exec.Command("buildctl", buildctlArgs...)
 
-	buildctlCmd.Stderr = os.Stderr
+	buildctlCmd.Stderr = stats
 	buildctlCmd.Stdout = os.Stdout
 
 	env := os.Environ()
@@ -165,9 +187,72 @@ func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target s
 		return err
 	}
 
+	// image-builder-mk3 reads the stats from the build log and reports them to its clients
+	fmt.Println(stats.Stats().String())
+
 	return nil
 }
 
+// cacheArgs produces the buildctl arguments which import and export the build cache
+func cacheArgs(cache CacheConfig) []string {
+	mode := "min"
+	if cache.ExportAll {
+		mode = "max"
+	}
+
+	switch cache.Mode {
+	case CacheModeRegistry:
+		return []string{
+			"--export-cache=type=registry,ref=" + cache.Ref + ",mode=" + mode + ",oci-mediatypes=true,ignore-error=true",
+			"--import-cache=type=registry,ref=" + cache.Ref,
+		}
+	case CacheModeLocal:
+		res := []string{
+			"--export-cache=type=local,dest=" + cache.Dir + ",mode=" + mode + ",ignore-error=true",
+		}
+		// buildctl fails if there's no cache to import, which is the case for the first build
+		if _, err := os.Stat(filepath.Join(cache.Dir, "index.json")); err == nil {
+			res = append(res, "--import-cache=type=local,src="+cache.Dir)
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+// secretArgs writes the build secrets and SSH keys to dir and produces the buildctl arguments which make them available to the build.
+// BuildKit never stores them in the image or the build cache.
+func secretArgs(dir string, secrets, sshKeys map[string]string) ([]string, error) {
+	var res []string
+	for _, s := range []struct {
+		Flag   string
+		Kind   string
+		Values map[string]string
+	}{
+		{"--secret=id=%s,src=%s", "secret", secrets},
+		{"--ssh=%s=%s", "ssh-key", sshKeys},
+	} {
+		ids := make([]string, 0, len(s.Values))
+		for id := range s.Values {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			if id == "" || strings.ContainsAny(id, ",=/") {
+				return nil, xerrors.Errorf("invalid %s id %q", s.Kind, id)
+			}
+			fn := filepath.Join(dir, s.Kind+"-"+id)
+			err := os.WriteFile(fn, []byte(s.Values[id]), 0600)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot write %s %s: %w", s.Kind, id, err)
+			}
+			res = append(res, fmt.Sprintf(s.Flag, id, fn))
+		}
+	}
+	return res, nil
+}
+
 func waitForBuildContext(ctx context.Context) error {
 	done := make(chan struct{})
 
@@ -194,8 +279,8 @@ func waitForBuildContext(ctx context.Context) error {
 	}
 }
 
-// StartBuildkit starts a local buildkit daemon
-func StartBuildkit(socketPath string) (cl *client.Client, teardown func() error, err error) {
+// StartBuildkit starts a local buildkit daemon. Its cache is garbage collected according to the limits of the cache config.
+func StartBuildkit(socketPath string, cache CacheConfig) (cl *client.Client, teardown func() error, err error) {
 	stderr, err := ioutil.TempFile(os.TempDir(), "buildkitd_stderr")
 	if err != nil {
 		return nil, nil, xerrors.Errorf("cannot create buildkitd log file: %w", err)
@@ -205,12 +290,22 @@ func StartBuildkit(socketPath string) (cl *client.Client, teardown func() error,
 		return nil, nil, xerrors.Errorf("cannot create buildkitd log file: %w", err)
 	}
 
-	cmd := exec.Command("buildkitd",
+	args := []string{
 		"--debug",
-		"--addr="+socketPath,
+		"--addr=" + socketPath,
 		"--oci-worker-net=host",
 		"--root=/workspace/buildkit",
-	)
+	}
+	if cfg := buildkitdGCConfig(cache); cfg != "" {
+		fn := filepath.Join(os.TempDir(), "buildkitd.toml")
+		err = os.WriteFile(fn, []byte(cfg), 0644)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("cannot write buildkitd config: %w", err)
+		}
+		args = append(args, "--config="+fn)
+	}
+
+	cmd := exec.Command("buildkitd", args...)
 	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: 0, Gid: 0}}
 	cmd.Stderr = stderr
 	cmd.Stdout = stdout
@@ -249,6 +344,45 @@ func StartBuildkit(socketPath string) (cl *client.Client, teardown func() error,
 	return
 }
 
+// buildkitdGCConfig produces a buildkitd config which limits the size and age of its cache.
+// Returns an empty string if the cache config sets no limits.
+func buildkitdGCConfig(cache CacheConfig) string {
+	if cache.MaxSizeMB <= 0 && cache.KeepDuration <= 0 {
+		return ""
+	}
+
+	var (
+		keepBytes = cache.MaxSizeMB * 1e6
+		res       bytes.Buffer
+	)
+	fmt.Fprintln(&res, "[worker.oci]")
+	fmt.Fprintln(&res, "  gc = true")
+	if keepBytes > 0 {
+		fmt.Fprintf(&res, "  gckeepstorage = %d\n", keepBytes)
+	}
+	fmt.Fprintln(&res, "  [[worker.oci.gcpolicy]]")
+	fmt.Fprintln(&res, "    all = true")
+	if keepBytes > 0 {
+		fmt.Fprintf(&res, "    keepBytes = %d\n", keepBytes)
+	}
+	if cache.KeepDuration > 0 {
+		fmt.Fprintf(&res, "    keepDuration = %q\n", cache.KeepDuration.String())
+	}
+	return res.String()
+}
+
+// pruneBuildkitCache removes the cache records of a buildkitd which exceed the limits of the cache config
+func pruneBuildkitCache(ctx context.Context, cl *client.Client, cache CacheConfig) {
+	if cache.MaxSizeMB <= 0 && cache.KeepDuration <= 0 {
+		return
+	}
+
+	err := cl.Prune(ctx, nil, client.PruneAll, client.WithKeepOpt(cache.KeepDuration, cache.MaxSizeMB*1e6))
+	if err != nil {
+		log.WithError(err).Warn("cannot prune build cache")
+	}
+}
+
 func connectToBuildkitd(socketPath string) (cl *client.Client, err error) {
 	backoff := 1 * time.Second
 	for i := 0; i < maxConnectionAttempts; i++ {
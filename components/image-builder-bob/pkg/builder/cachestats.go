@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/gitpod-io/gitpod/image-builder/api"
+)
+
+// CacheStats describes how many of the build steps were served from the build cache
+type CacheStats struct {
+	Steps       int `json:"steps"`
+	CachedSteps int `json:"cachedSteps"`
+}
+
+// String produces the log line image-builder-mk3 reads the stats from
+func (s CacheStats) String() string {
+	b, _ := json.Marshal(s)
+	return api.BuildCacheStatsMarker + string(b)
+}
+
+// cacheStatsWriter passes buildctl's plain progress output through and counts the build steps
+// and which of them were cached along the way. The output looks like:
+//
+//	#5 [2/3] RUN apk add git
+//	#5 CACHED
+type cacheStatsWriter struct {
+	W io.Writer
+
+	mu     sync.Mutex
+	buf    []byte
+	steps  map[string]struct{}
+	cached map[string]struct{}
+}
+
+func newCacheStatsWriter(w io.Writer) *cacheStatsWriter {
+	return &cacheStatsWriter{
+		W:      w,
+		steps:  make(map[string]struct{}),
+		cached: make(map[string]struct{}),
+	}
+}
+
+func (w *cacheStatsWriter) Write(p []byte) (n int, err error) {
+	w.mu.Lock()
+	w.buf = append(w.buf, p...)
+	for {
+		idx := bytes.IndexByte(w.buf, '\n')
+		if idx < 0 {
+			break
+		}
+		w.parseLine(string(w.buf[:idx]))
+		w.buf = w.buf[idx+1:]
+	}
+	w.mu.Unlock()
+
+	return w.W.Write(p)
+}
+
+func (w *cacheStatsWriter) parseLine(line string) {
+	id, msg, ok := strings.Cut(strings.TrimSpace(line), " ")
+	if !ok || !strings.HasPrefix(id, "#") {
+		return
+	}
+
+	switch {
+	case msg == "CACHED":
+		w.cached[id] = struct{}{}
+	case strings.HasPrefix(msg, "[internal]"), strings.HasPrefix(msg, "[auth]"):
+		// loading the build context and authenticating against registries are no build steps
+	case strings.HasPrefix(msg, "["):
+		w.steps[id] = struct{}{}
+	}
+}
+
+// Stats returns the stats of the output written so far
+func (w *cacheStatsWriter) Stats() CacheStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	res := CacheStats{Steps: len(w.steps)}
+	for id := range w.cached {
+		if _, ok := w.steps[id]; ok {
+			res.CachedSteps++
+		}
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/image-builder/api"
+)
+
+const buildctlOutput = `#1 [internal] load build definition from Dockerfile
+#1 transferring dockerfile: 98B done
+#1 DONE 0.0s
+
+#2 [auth] gitpod/workspace-full:pull token for localhost:8080
+#2 DONE 0.0s
+
+#3 [1/3] FROM localhost:8080/base:latest@sha256:1234
+#3 CACHED
+
+#4 [2/3] RUN apt-get update
+#4 CACHED
+
+#5 [3/3] RUN echo hello
+#5 0.213 hello
+#5 DONE 0.3s
+
+#6 exporting to image
+#6 DONE 1.2s
+`
+
+func TestCacheStatsWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := newCacheStatsWriter(&out)
+
+	// write in small chunks to make sure we handle lines spanning several writes
+	r := bytes.NewReader([]byte(buildctlOutput))
+	_, err := io.CopyBuffer(struct{ io.Writer }{w}, r, make([]byte, 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out.String() != buildctlOutput {
+		t.Errorf("output was not passed through")
+	}
+	act := w.Stats()
+	if exp := (CacheStats{Steps: 3, CachedSteps: 2}); act != exp {
+		t.Errorf("unexpected stats: %+v, expected %+v", act, exp)
+	}
+	if exp := api.BuildCacheStatsMarker + `{"steps":3,"cachedSteps":2}`; act.String() != exp {
+		t.Errorf("unexpected marker line: %s", act.String())
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/base64"
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/xerrors"
 )
@@ -26,7 +29,38 @@ type Config struct {
 	Dockerfile         string
 	ContextDir         string
 	ExternalBuildkitd  string
-	localCacheImport   string
+	Cache              CacheConfig
+	// Secrets maps secret IDs to the values RUN --mount=type=secret instructions can access
+	Secrets map[string]string
+	// SSHKeys maps SSH key IDs to the private keys RUN --mount=type=ssh instructions can use
+	SSHKeys map[string]string
+}
+
+// CacheMode determines where we import the BuildKit cache from and export it to
+type CacheMode string
+
+const (
+	// CacheModeNone builds without importing or exporting a cache
+	CacheModeNone CacheMode = ""
+	// CacheModeRegistry keeps the cache in a registry, which we reach through the proxy
+	CacheModeRegistry CacheMode = "registry"
+	// CacheModeLocal keeps the cache in a local directory
+	CacheModeLocal CacheMode = "local"
+)
+
+// CacheConfig configures the BuildKit cache of the build
+type CacheConfig struct {
+	Mode CacheMode
+	// Ref is the registry cache ref. The proxy maps it to the actual cache repository.
+	Ref string
+	// Dir is the directory of the local cache
+	Dir string
+	// ExportAll exports the cache of all build stages, not just the ones of the final image
+	ExportAll bool
+	// MaxSizeMB limits the size of the cache of the buildkitd we start ourselves
+	MaxSizeMB int64
+	// KeepDuration makes the buildkitd we start prune cache records which haven't been used in this long
+	KeepDuration time.Duration
 }
 
 // GetConfigFromEnv extracts configuration from environment variables
@@ -41,7 +75,12 @@ func GetConfigFromEnv() (*Config, error) {
 		Dockerfile:         os.Getenv("BOB_DOCKERFILE_PATH"),
 		ContextDir:         os.Getenv("BOB_CONTEXT_DIR"),
 		ExternalBuildkitd:  os.Getenv("BOB_EXTERNAL_BUILDKITD"),
-		localCacheImport:   os.Getenv("BOB_LOCAL_CACHE_IMPORT"),
+		Cache: CacheConfig{
+			Mode:      CacheMode(os.Getenv("BOB_CACHE_MODE")),
+			Ref:       os.Getenv("BOB_CACHE_REF"),
+			Dir:       os.Getenv("BOB_CACHE_DIR"),
+			ExportAll: os.Getenv("BOB_CACHE_EXPORT_ALL") == "true",
+		},
 	}
 
 	if cfg.BaseRef == "" {
@@ -67,7 +106,39 @@ func GetConfigFromEnv() (*Config, error) {
 		}
 	}
 
-	var authKey = os.Getenv("BOB_AUTH_KEY")
+	switch cfg.Cache.Mode {
+	case CacheModeNone:
+	case CacheModeRegistry:
+		if cfg.Cache.Ref == "" {
+			cfg.Cache.Ref = "localhost:8080/cache:latest"
+		}
+	case CacheModeLocal:
+		if cfg.Cache.Dir == "" {
+			return nil, xerrors.Errorf("BOB_CACHE_DIR is mandatory for the local build cache")
+		}
+	default:
+		return nil, xerrors.Errorf("unsupported BOB_CACHE_MODE: %s", cfg.Cache.Mode)
+	}
+	if v := os.Getenv("BOB_CACHE_MAX_SIZE_MB"); v != "" {
+		var err error
+		cfg.Cache.MaxSizeMB, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid BOB_CACHE_MAX_SIZE_MB: %w", err)
+		}
+	}
+	if v := os.Getenv("BOB_CACHE_KEEP_DURATION"); v != "" {
+		var err error
+		cfg.Cache.KeepDuration, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid BOB_CACHE_KEEP_DURATION: %w", err)
+		}
+	}
+
+	var (
+		secrets = os.Getenv("BOB_SECRETS")
+		sshKeys = os.Getenv("BOB_SSH_KEYS")
+		authKey = os.Getenv("BOB_AUTH_KEY")
+	)
 	if authKey != "" {
 		if len(authKey) != 32 {
 			return nil, xerrors.Errorf("BOB_AUTH_KEY must be exactly 32 bytes long")
@@ -94,6 +165,39 @@ func GetConfigFromEnv() (*Config, error) {
 				return nil, xerrors.Errorf("cannot decrypt BOB_WSLAYER_AUTH: %w", err)
 			}
 		}
+		if secrets != "" {
+			dec, err := base64.RawStdEncoding.DecodeString(secrets)
+			if err != nil {
+				return nil, xerrors.Errorf("BOB_SECRETS is not base64 encoded but BOB_AUTH_KEY is present")
+			}
+			secrets, err = decrypt(dec, authKey)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot decrypt BOB_SECRETS: %w", err)
+			}
+		}
+		if sshKeys != "" {
+			dec, err := base64.RawStdEncoding.DecodeString(sshKeys)
+			if err != nil {
+				return nil, xerrors.Errorf("BOB_SSH_KEYS is not base64 encoded but BOB_AUTH_KEY is present")
+			}
+			sshKeys, err = decrypt(dec, authKey)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot decrypt BOB_SSH_KEYS: %w", err)
+			}
+		}
+	}
+
+	if secrets != "" {
+		err := json.Unmarshal([]byte(secrets), &cfg.Secrets)
+		if err != nil {
+			return nil, xerrors.Errorf("BOB_SECRETS is not a JSON object of secret IDs and values: %w", err)
+		}
+	}
+	if sshKeys != "" {
+		err := json.Unmarshal([]byte(sshKeys), &cfg.SSHKeys)
+		if err != nil {
+			return nil, xerrors.Errorf("BOB_SSH_KEYS is not a JSON object of key IDs and private keys: %w", err)
+		}
 	}
 
 	return cfg, nil
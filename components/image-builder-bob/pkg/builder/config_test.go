@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetConfigFromEnvSecrets(t *testing.T) {
+	const key = "0123456789abcdef0123456789abcdef"
+	encrypt := func(plaintext string) string {
+		c, err := aes.NewCipher([]byte(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+		gcm, err := cipher.NewGCM(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nonce := make([]byte, gcm.NonceSize())
+		_, err = rand.Read(nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawStdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(plaintext), nil))
+	}
+
+	t.Setenv("BOB_AUTH_KEY", key)
+	t.Setenv("BOB_SECRETS", encrypt(`{"npmrc":"token"}`))
+	t.Setenv("BOB_SSH_KEYS", encrypt(`{"default":"private key"}`))
+	cfg, err := GetConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"npmrc": "token"}, cfg.Secrets); diff != "" {
+		t.Errorf("unexpected secrets (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"default": "private key"}, cfg.SSHKeys); diff != "" {
+		t.Errorf("unexpected SSH keys (-want +got):\n%s", diff)
+	}
+
+	t.Setenv("BOB_SECRETS", `{"npmrc":"token"}`)
+	_, err = GetConfigFromEnv()
+	if err == nil {
+		t.Error("expected unencrypted secrets to fail when BOB_AUTH_KEY is present")
+	}
+}
+
+func TestSecretArgs(t *testing.T) {
+	dir := t.TempDir()
+	act, err := secretArgs(dir, map[string]string{"npmrc": "token", "aws": "credentials"}, map[string]string{"default": "private key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectation := []string{
+		"--secret=id=aws,src=" + filepath.Join(dir, "secret-aws"),
+		"--secret=id=npmrc,src=" + filepath.Join(dir, "secret-npmrc"),
+		"--ssh=default=" + filepath.Join(dir, "ssh-key-default"),
+	}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected buildctl args (-want +got):\n%s", diff)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "secret-npmrc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "token" {
+		t.Errorf("unexpected secret file content: %s", content)
+	}
+
+	_, err = secretArgs(dir, map[string]string{"id,src=/etc/passwd": ""}, nil)
+	if err == nil {
+		t.Error("expected an invalid secret id to fail")
+	}
+}
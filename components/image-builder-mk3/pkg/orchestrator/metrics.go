@@ -8,6 +8,8 @@ import (
 	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gitpod-io/gitpod/image-builder/api"
 )
 
 // RegisterMetrics registers the metrics of this builder
@@ -20,6 +22,18 @@ func (o *Orchestrator) RegisterMetrics(reg prometheus.Registerer) error {
 	if err != nil {
 		return err
 	}
+	err = reg.Register(o.metrics.imageBuildCacheStepsTotal)
+	if err != nil {
+		return err
+	}
+	err = reg.Register(o.metrics.imageBuildsQueued)
+	if err != nil {
+		return err
+	}
+	err = reg.Register(o.metrics.imageBuildsCoalescedTotal)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -31,6 +45,12 @@ const (
 type metrics struct {
 	imageBuildsDoneTotal    *prometheus.CounterVec
 	imageBuildsStartedTotal prometheus.Counter
+	// imageBuildCacheStepsTotal counts the build steps of image builds, and whether they were served from the build cache
+	imageBuildCacheStepsTotal *prometheus.CounterVec
+	// imageBuildsQueued is the number of builds waiting for other builds to finish
+	imageBuildsQueued prometheus.Gauge
+	// imageBuildsCoalescedTotal counts the build requests we served with a build which was already queued or running
+	imageBuildsCoalescedTotal prometheus.Counter
 }
 
 func newMetrics() *metrics {
@@ -45,6 +65,21 @@ func newMetrics() *metrics {
 			Subsystem: metricsSubsystem,
 			Name:      "builds_started_total",
 		}),
+		imageBuildCacheStepsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "build_cache_steps_total",
+		}, []string{"cached"}),
+		imageBuildsQueued: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "builds_queued",
+		}),
+		imageBuildsCoalescedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "builds_coalesced_total",
+		}),
 	}
 }
 
@@ -55,3 +90,19 @@ func (m *metrics) BuildDone(success bool) {
 func (m *metrics) BuildStarted() {
 	m.imageBuildsStartedTotal.Inc()
 }
+
+func (m *metrics) BuildCacheStats(stats *api.BuildCacheStats) {
+	if stats == nil {
+		return
+	}
+	m.imageBuildCacheStepsTotal.WithLabelValues("true").Add(float64(stats.CachedSteps))
+	m.imageBuildCacheStepsTotal.WithLabelValues("false").Add(float64(stats.Steps - stats.CachedSteps))
+}
+
+func (m *metrics) BuildsQueued(n int) {
+	m.imageBuildsQueued.Set(float64(n))
+}
+
+func (m *metrics) BuildCoalesced() {
+	m.imageBuildsCoalescedTotal.Inc()
+}
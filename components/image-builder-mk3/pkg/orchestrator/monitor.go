@@ -5,6 +5,7 @@
 package orchestrator
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -29,6 +30,9 @@ const (
 	annotationRef       = "ref"
 	annotationBaseRef   = "baseref"
 	annotationManagedBy = "managed-by"
+
+	// maxPartialLogLine limits how much of an incomplete log line we keep while looking for the cache stats
+	maxPartialLogLine = 4096
 )
 
 type orchestrator interface {
@@ -42,6 +46,7 @@ func newBuildMonitor(o orchestrator, wsman wsmanapi.WorkspaceManagerClient) *bui
 		wsman:         wsman,
 		runningBuilds: make(map[string]*runningBuild),
 		logs:          map[string]context.CancelFunc{},
+		cacheStats:    make(map[string]*buildCacheStats),
 	}
 }
 
@@ -53,6 +58,15 @@ type buildMonitor struct {
 	runningBuildsMu sync.RWMutex
 
 	logs map[string]context.CancelFunc
+
+	cacheStats   map[string]*buildCacheStats
+	cacheStatsMu sync.Mutex
+}
+
+// buildCacheStats collects the build cache stats bob reports in the build log
+type buildCacheStats struct {
+	partialLine []byte
+	stats       *api.BuildCacheStats
 }
 
 type runningBuild struct {
@@ -133,6 +147,15 @@ func (m *buildMonitor) handleStatusUpdate(status *wsmanapi.WorkspaceStatus) {
 	}
 	m.runningBuildsMu.Unlock()
 
+	if resp.Status != api.BuildStatus_running {
+		m.cacheStatsMu.Lock()
+		if s, ok := m.cacheStats[status.Id]; ok {
+			resp.CacheStats = s.stats
+			delete(m.cacheStats, status.Id)
+		}
+		m.cacheStatsMu.Unlock()
+	}
+
 	m.O.PublishStatus(status.Id, resp)
 
 	// handleStatusUpdate is called from a single go-routine, hence there's no need to synchronize
@@ -162,11 +185,62 @@ func (m *buildMonitor) handleHeadlessLogs(buildID string) listenToHeadlessLogsCa
 		}
 
 		if len(content) > 0 {
+			m.collectCacheStats(buildID, content)
 			m.O.PublishLog(buildID, string(content))
 		}
 	}
 }
 
+// collectCacheStats looks for the build cache stats in the log output of a build
+func (m *buildMonitor) collectCacheStats(buildID string, content []byte) {
+	m.cacheStatsMu.Lock()
+	defer m.cacheStatsMu.Unlock()
+
+	s, ok := m.cacheStats[buildID]
+	if !ok {
+		s = &buildCacheStats{}
+		m.cacheStats[buildID] = s
+	}
+
+	buf := append(s.partialLine, content...)
+	for {
+		idx := bytes.IndexByte(buf, '\n')
+		if idx < 0 {
+			break
+		}
+		if stats := parseCacheStats(string(buf[:idx])); stats != nil {
+			s.stats = stats
+		}
+		buf = buf[idx+1:]
+	}
+	if len(buf) > maxPartialLogLine {
+		buf = buf[len(buf)-maxPartialLogLine:]
+	}
+	s.partialLine = append([]byte(nil), buf...)
+}
+
+// parseCacheStats parses the build cache stats from a log line. Returns nil if the line does not contain them.
+func parseCacheStats(line string) *api.BuildCacheStats {
+	idx := strings.Index(line, api.BuildCacheStatsMarker)
+	if idx < 0 {
+		return nil
+	}
+
+	var stats struct {
+		Steps       int32 `json:"steps"`
+		CachedSteps int32 `json:"cachedSteps"`
+	}
+	err := json.Unmarshal([]byte(strings.TrimSpace(line[idx+len(api.BuildCacheStatsMarker):])), &stats)
+	if err != nil {
+		log.WithError(err).WithField("line", line).Debug("cannot parse build cache stats")
+		return nil
+	}
+	return &api.BuildCacheStats{
+		Steps:       stats.Steps,
+		CachedSteps: stats.CachedSteps,
+	}
+}
+
 var errOutOfRetries = xerrors.Errorf("out of retries")
 
 // retry makes multiple attempts to execute op if op returns an UNAVAILABLE gRPC status code
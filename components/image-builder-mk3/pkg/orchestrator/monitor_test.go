@@ -131,3 +131,47 @@ func TestExtractBuildResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectCacheStats(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Chunks      []string
+		Expectation *api.BuildCacheStats
+	}{
+		{
+			Name:   "no stats",
+			Chunks: []string{"#5 [2/3] RUN echo hello\r\n", "#5 DONE 0.3s\r\n"},
+		},
+		{
+			Name:        "stats",
+			Chunks:      []string{"#6 DONE 1.2s\r\n", "bob: build cache stats: {\"steps\":3,\"cachedSteps\":2}\r\n"},
+			Expectation: &api.BuildCacheStats{Steps: 3, CachedSteps: 2},
+		},
+		{
+			Name:        "stats split across chunks",
+			Chunks:      []string{"bob: build cache ", "stats: {\"steps\":3,", "\"cachedSteps\":3}\r\ndone\r\n"},
+			Expectation: &api.BuildCacheStats{Steps: 3, CachedSteps: 3},
+		},
+		{
+			Name:   "incomplete line",
+			Chunks: []string{"bob: build cache stats: {\"steps\":3,\"cachedSteps\":2}"},
+		},
+		{
+			Name:   "invalid stats",
+			Chunks: []string{"bob: build cache stats: {\"steps\":\r\n"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			m := newBuildMonitor(nil, nil)
+			for _, c := range test.Chunks {
+				m.collectCacheStats("build-id", []byte(c))
+			}
+
+			act := m.cacheStats["build-id"].stats
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.BuildCacheStats{})); diff != "" {
+				t.Errorf("collectCacheStats() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -14,13 +14,14 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/distribution/reference"
-	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/opentracing/opentracing-go"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/xerrors"
@@ -40,6 +41,7 @@ import (
 	"github.com/gitpod-io/gitpod/image-builder/api/config"
 	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
 	"github.com/gitpod-io/gitpod/image-builder/pkg/resolve"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/sbom"
 	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
 )
 
@@ -119,9 +121,22 @@ func NewOrchestratingBuilder(cfg config.Configuration) (res *Orchestrator, err e
 		buildListener: make(map[string]map[buildListener]struct{}),
 		logListener:   make(map[string]map[logListener]struct{}),
 		censorship:    make(map[string][]string),
+		vulnScans:     make(map[string]struct{}),
 		metrics:       newMetrics(),
 	}
 	o.monitor = newBuildMonitor(o, o.wsman)
+	o.scheduler = newBuildScheduler(cfg.BuildQueue, o.PublishStatus, o.metrics)
+
+	if cfg.SBOM != nil && cfg.SBOM.Vulnerabilities != nil {
+		o.vulnDB, err = loadVulnerabilityDatabase(cfg.SBOM.Vulnerabilities)
+		if err != nil {
+			return nil, err
+		}
+		o.vulnVerdicts, err = lru.New(vulnerabilityVerdictCacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return o, nil
 }
@@ -140,10 +155,15 @@ type Orchestrator struct {
 	censorship    map[string][]string
 	mu            sync.RWMutex
 
-	monitor *buildMonitor
+	monitor   *buildMonitor
+	scheduler *buildScheduler
 
 	metrics *metrics
 
+	vulnDB       *sbom.Database
+	vulnVerdicts *lru.Cache
+	vulnScans    map[string]struct{}
+
 	protocol.UnimplementedImageBuilderServer
 }
 
@@ -254,11 +274,16 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 			return status.Errorf(codes.Internal, "cannot check if image is already built: %q", err)
 		}
 		if exists {
-			err = resp.Send(&protocol.BuildResponse{
+			update := &protocol.BuildResponse{
 				Status:  protocol.BuildStatus_done_success,
 				Ref:     wsrefstr,
 				BaseRef: req.BaseImageNameResolved,
-			})
+			}
+			if msg := o.enforceVulnerabilityPolicy(ctx, wsrefstr); msg != "" {
+				update.Status = protocol.BuildStatus_done_failure
+				update.Message = msg
+			}
+			err = resp.Send(update)
 			if err != nil {
 				return err
 			}
@@ -301,32 +326,23 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	}
 	if exists && !req.GetForceRebuild() {
 		// image has already been built - no need for us to start building
-		err = resp.Send(&protocol.BuildResponse{
+		update := &protocol.BuildResponse{
 			Status:  protocol.BuildStatus_done_success,
 			Ref:     wsrefstr,
 			BaseRef: baseref,
-		})
+		}
+		if msg := o.enforceVulnerabilityPolicy(ctx, wsrefstr); msg != "" {
+			update.Status = protocol.BuildStatus_done_failure
+			update.Message = msg
+		}
+		err = resp.Send(update)
 		if err != nil {
 			return err
 		}
 		return nil
 	}
 
-	o.metrics.BuildStarted()
-
-	// Once a build is running we don't want it cancelled becuase the server disconnected i.e. during deployment.
-	// Instead we want to impose our own timeout/lifecycle on the build. Using context.WithTimeout does not shadow its parent's
-	// cancelation (see https://play.golang.org/p/N3QBIGlp8Iw for an example/experiment).
-	ctx, cancel := context.WithTimeout(&parentCantCancelContext{Delegate: ctx}, maxBuildRuntime)
-	defer cancel()
-
-	randomUUID, err := uuid.NewRandom()
-	if err != nil {
-		return
-	}
-
 	var (
-		buildID        = randomUUID.String()
 		buildBase      = "false"
 		contextPath    = "."
 		dockerfilePath = "Dockerfile"
@@ -336,11 +352,33 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 			Empty: &csapi.EmptyInitializer{},
 		},
 	}
+	var (
+		cacheEnv   []*wsmanapi.EnvironmentVariable
+		secretsEnv []*wsmanapi.EnvironmentVariable
+		censored   []string
+	)
 	if fsrc := req.Source.GetFile(); fsrc != nil {
 		buildBase = "true"
 		initializer = fsrc.Source
 		contextPath = fsrc.ContextPath
 		dockerfilePath = fsrc.DockerfilePath
+
+		cacheEnv, err = o.buildCacheEnv(fsrc)
+		if err != nil {
+			return status.Errorf(codes.Internal, "cannot configure build cache: %q", err)
+		}
+		var key string
+		if o.Config.BuildSecretsKeyFile != "" && (len(req.Secrets) > 0 || len(req.SshKeys) > 0) {
+			// we read the key for every build so that we pick up key rotations
+			key, err = loadBuildSecretsKey(o.Config.BuildSecretsKeyFile)
+			if err != nil {
+				return status.Errorf(codes.Internal, "cannot configure build secrets: %q", err)
+			}
+		}
+		secretsEnv, censored, err = buildSecretsEnv(req, o.Config.BuildSecretsKeySecret, key)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "cannot configure build secrets: %q", err)
+		}
 	}
 	dockerfilePath = filepath.Join("/workspace", dockerfilePath)
 
@@ -349,23 +387,6 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	}
 	contextPath = filepath.Join("/workspace", strings.TrimPrefix(contextPath, "/workspace"))
 
-	o.censor(buildID, []string{
-		wsrefstr,
-		baseref,
-		strings.Split(wsrefstr, ":")[0],
-		strings.Split(baseref, ":")[0],
-	})
-
-	// push some log to the client before starting the job, just in case the build workspace takes a while to start up
-	o.PublishLog(buildID, "starting image build")
-
-	retryIfUnavailable1 := func(err error) bool {
-		if st, ok := status.FromError(err); ok && st.Code() == codes.Unavailable {
-			return true
-		}
-		return false
-	}
-
 	pbaseref, err := reference.ParseNormalizedNamed(baseref)
 	if err != nil {
 		return xerrors.Errorf("cannot parse baseref: %v", err)
@@ -388,75 +409,181 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		}
 	}
 
-	var swr *wsmanapi.StartWorkspaceResponse
-	err = retry(ctx, func(ctx context.Context) (err error) {
-		swr, err = o.wsman.StartWorkspace(ctx, &wsmanapi.StartWorkspaceRequest{
-			Id:            buildID,
-			ServicePrefix: buildID,
-			Metadata: &wsmanapi.WorkspaceMetadata{
-				MetaId: buildID,
-				Annotations: map[string]string{
-					annotationRef:       wsrefstr,
-					annotationBaseRef:   baseref,
-					annotationManagedBy: buildWorkspaceManagerID,
-				},
-				Owner: req.GetTriggeredBy(),
+	// runBuild fills in the build ID
+	spec := &wsmanapi.StartWorkspaceRequest{
+		Metadata: &wsmanapi.WorkspaceMetadata{
+			Annotations: map[string]string{
+				annotationRef:       wsrefstr,
+				annotationBaseRef:   baseref,
+				annotationManagedBy: buildWorkspaceManagerID,
 			},
-			Spec: &wsmanapi.StartWorkspaceSpec{
-				Initializer:    initializer,
-				Timeout:        maxBuildRuntime.String(),
-				WorkspaceImage: o.Config.BuilderImage,
-				IdeImage: &wsmanapi.IDEImage{
-					WebRef:        o.Config.BuilderImage,
-					SupervisorRef: req.SupervisorRef,
-				},
-				WorkspaceLocation: contextPath,
-				Envvars: []*wsmanapi.EnvironmentVariable{
-					{Name: "BOB_TARGET_REF", Value: "localhost:8080/target:latest"},
-					{Name: "BOB_BASE_REF", Value: bobBaseref},
-					{Name: "BOB_BUILD_BASE", Value: buildBase},
-					{Name: "BOB_DOCKERFILE_PATH", Value: dockerfilePath},
-					{Name: "BOB_CONTEXT_DIR", Value: contextPath},
-					{Name: "GITPOD_TASKS", Value: `[{"name": "build", "init": "sudo -E /app/bob build"}]`},
-					{Name: "WORKSPACEKIT_RING2_ENCLAVE", Value: "/app/bob proxy"},
-					{Name: "WORKSPACEKIT_BOBPROXY_BASEREF", Value: baseref},
-					{Name: "WORKSPACEKIT_BOBPROXY_TARGETREF", Value: wsrefstr},
-					{
-						Name: "WORKSPACEKIT_BOBPROXY_AUTH",
-						Secret: &wsmanapi.EnvironmentVariable_SecretKeyRef{
-							SecretName: o.Config.PullSecret,
-							Key:        ".dockerconfigjson",
-						},
-					},
-					{
-						Name:  "WORKSPACEKIT_BOBPROXY_ADDITIONALAUTH",
-						Value: string(additionalAuth),
+			Owner: req.GetTriggeredBy(),
+		},
+		Spec: &wsmanapi.StartWorkspaceSpec{
+			Initializer:    initializer,
+			Timeout:        maxBuildRuntime.String(),
+			WorkspaceImage: o.Config.BuilderImage,
+			IdeImage: &wsmanapi.IDEImage{
+				WebRef:        o.Config.BuilderImage,
+				SupervisorRef: req.SupervisorRef,
+			},
+			WorkspaceLocation: contextPath,
+			Envvars: append([]*wsmanapi.EnvironmentVariable{
+				{Name: "BOB_TARGET_REF", Value: "localhost:8080/target:latest"},
+				{Name: "BOB_BASE_REF", Value: bobBaseref},
+				{Name: "BOB_BUILD_BASE", Value: buildBase},
+				{Name: "BOB_DOCKERFILE_PATH", Value: dockerfilePath},
+				{Name: "BOB_CONTEXT_DIR", Value: contextPath},
+				{Name: "GITPOD_TASKS", Value: `[{"name": "build", "init": "sudo -E /app/bob build"}]`},
+				{Name: "WORKSPACEKIT_RING2_ENCLAVE", Value: "/app/bob proxy"},
+				{Name: "WORKSPACEKIT_BOBPROXY_BASEREF", Value: baseref},
+				{Name: "WORKSPACEKIT_BOBPROXY_TARGETREF", Value: wsrefstr},
+				{
+					Name: "WORKSPACEKIT_BOBPROXY_AUTH",
+					Secret: &wsmanapi.EnvironmentVariable_SecretKeyRef{
+						SecretName: o.Config.PullSecret,
+						Key:        ".dockerconfigjson",
 					},
-					{Name: "SUPERVISOR_DEBUG_ENABLE", Value: fmt.Sprintf("%v", log.Log.Logger.IsLevelEnabled(logrus.DebugLevel))},
 				},
-			},
-			Type: wsmanapi.WorkspaceType_IMAGEBUILD,
-		})
+				{
+					Name:  "WORKSPACEKIT_BOBPROXY_ADDITIONALAUTH",
+					Value: string(additionalAuth),
+				},
+				{Name: "SUPERVISOR_DEBUG_ENABLE", Value: fmt.Sprintf("%v", log.Log.Logger.IsLevelEnabled(logrus.DebugLevel))},
+			}, append(cacheEnv, secretsEnv...)...),
+		},
+		Type: wsmanapi.WorkspaceType_IMAGEBUILD,
+	}
+
+	// Equivalent requests for the same workspace image share a single build.
+	bld, created, err := o.scheduler.Schedule(coalescingKey(wsrefstr, req), wsrefstr, baseref, req.GetOrganizationId())
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot schedule build: %q", err)
+	}
+	updates, cancel := o.registerBuildListener(bld.ID)
+	defer cancel()
+	if created {
+		o.censor(bld.ID, append([]string{
+			wsrefstr,
+			baseref,
+			strings.Split(wsrefstr, ":")[0],
+			strings.Split(baseref, ":")[0],
+		}, censored...))
+
+		// Once a build is running we don't want it cancelled becuase the server disconnected i.e. during deployment.
+		// Instead we want to impose our own timeout/lifecycle on the build.
+		go o.runBuild(&parentCantCancelContext{Delegate: ctx}, bld, spec, wsrefAuth)
+	} else {
+		log.WithField("buildID", bld.ID).WithField("ref", wsrefstr).Info("joining build of the same workspace image")
+	}
+
+	if pos := o.scheduler.QueuePosition(bld); pos > 0 {
+		err = resp.Send(queuedBuildResponse(bld, pos))
+		if err != nil {
+			return status.Errorf(codes.Unknown, "cannot send update: %v", err)
+		}
+	}
+	for {
+		select {
+		case update := <-updates:
+			if update == nil {
+				// channel was closed unexpectatly
+				return status.Error(codes.Aborted, "subscription canceled - please try again")
+			}
+			if update.Status == protocol.BuildStatus_done_failure || update.Status == protocol.BuildStatus_done_success {
+				// runBuild checks the result before we forward it
+				continue
+			}
+
+			err := resp.Send(update)
+			if err != nil {
+				log.WithError(err).Error("cannot forward build update - dropping listener")
+				return status.Errorf(codes.Unknown, "cannot send update: %v", err)
+			}
+		case <-bld.done:
+			if bld.err != nil {
+				return bld.err
+			}
+			if bld.result.Status != protocol.BuildStatus_done_success {
+				log.WithField("UserID", req.GetTriggeredBy()).Error("image build done failed for user")
+			}
+
+			err := resp.Send(bld.result)
+			if err != nil {
+				log.WithError(err).Error("cannot forward build update - dropping listener")
+				return status.Errorf(codes.Unknown, "cannot send update: %v", err)
+			}
+			return nil
+		case <-ctx.Done():
+			// the build carries on for everyone else who waits for it
+			return status.Error(codes.Canceled, "build request canceled")
+		}
+	}
+}
+
+// runBuild starts the build workspace of a scheduled build once the scheduler lets it start, and waits for the build to finish.
+// It hands the checked result to the scheduler, which forwards it to all requests waiting for the build.
+func (o *Orchestrator) runBuild(ctx context.Context, bld *scheduledBuild, spec *wsmanapi.StartWorkspaceRequest, wsrefAuth *auth.Authentication) {
+	var (
+		result *protocol.BuildResponse
+		err    error
+	)
+	defer func() {
+		o.scheduler.Finish(bld, result, err)
+	}()
+
+	<-bld.started
+	o.metrics.BuildStarted()
+
+	// Using context.WithTimeout does not shadow its parent's cancelation (see https://play.golang.org/p/N3QBIGlp8Iw for an example/experiment).
+	ctx, cancel := context.WithTimeout(ctx, maxBuildRuntime)
+	defer cancel()
+
+	buildID := bld.ID
+	spec.Id = buildID
+	spec.ServicePrefix = buildID
+	spec.Metadata.MetaId = buildID
+
+	// push some log to the client before starting the job, just in case the build workspace takes a while to start up
+	o.PublishLog(buildID, "starting image build")
+
+	retryIfUnavailable1 := func(err error) bool {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.Unavailable {
+			return true
+		}
+		return false
+	}
+
+	var swr *wsmanapi.StartWorkspaceResponse
+	err = retry(ctx, func(ctx context.Context) (err error) {
+		swr, err = o.wsman.StartWorkspace(ctx, spec)
 		return
 	}, retryIfUnavailable1, 1*time.Second, 10)
 	if status.Code(err) == codes.AlreadyExists {
 		// build is already running - do not add it to the list of builds
+		err = nil
 	} else if errors.Is(err, errOutOfRetries) {
-		return status.Error(codes.Unavailable, "workspace services are currently unavailable")
+		err = status.Error(codes.Unavailable, "workspace services are currently unavailable")
+		return
 	} else if err != nil {
-		return status.Errorf(codes.Internal, "cannot start build: %q", err)
+		err = status.Errorf(codes.Internal, "cannot start build: %q", err)
+		return
 	} else {
-		o.monitor.RegisterNewBuild(buildID, wsrefstr, baseref, swr.Url, swr.OwnerToken)
+		o.monitor.RegisterNewBuild(buildID, bld.Ref, bld.BaseRef, swr.Url, swr.OwnerToken)
 		o.PublishLog(buildID, "starting image build ...\n")
 	}
 
-	updates, cancel := o.registerBuildListener(buildID)
-	defer cancel()
+	updates, cancelListener := o.registerBuildListener(buildID)
+	defer cancelListener()
 	for {
 		update := <-updates
 		if update == nil {
 			// channel was closed unexpectatly
-			return status.Error(codes.Aborted, "subscription canceled - please try again")
+			err = status.Error(codes.Aborted, "subscription canceled - please try again")
+			return
+		}
+		if update.Status != protocol.BuildStatus_done_failure && update.Status != protocol.BuildStatus_done_success {
+			continue
 		}
 
 		// The failed condition of ws-manager is not stable, hence we might wrongly report that the
@@ -464,34 +591,62 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		// "cannot pull from reg.gitpod.io" error message. Instead the image-build should fail properly.
 		// To do this, we resolve the built image afterwards to ensure it was actually built.
 		if update.Status == protocol.BuildStatus_done_success {
-			exists, err := o.checkImageExists(ctx, wsrefstr, wsrefAuth)
+			exists, err := o.checkImageExists(ctx, bld.Ref, wsrefAuth)
 			if err != nil {
 				update.Status = protocol.BuildStatus_done_failure
 				update.Message = fmt.Sprintf("cannot check if workspace image exists after the build: %v", err)
 			} else if !exists {
 				update.Status = protocol.BuildStatus_done_failure
 				update.Message = "image build did not produce a workspace image"
+			} else if o.Config.SBOM != nil {
+				if msg := o.processBuildSBOM(ctx, buildID, bld.Ref); msg != "" {
+					update.Status = protocol.BuildStatus_done_failure
+					update.Message = msg
+				}
 			}
 		}
 
-		err := resp.Send(update)
-		if err != nil {
-			log.WithError(err).Error("cannot forward build update - dropping listener")
-			return status.Errorf(codes.Unknown, "cannot send update: %v", err)
-		}
+		// build is done
+		o.clearListener(buildID)
+		o.metrics.BuildDone(update.Status == protocol.BuildStatus_done_success)
+		o.metrics.BuildCacheStats(update.CacheStats)
+		result = update
+		return
+	}
+}
 
-		if update.Status == protocol.BuildStatus_done_failure || update.Status == protocol.BuildStatus_done_success {
-			// build is done
-			o.clearListener(buildID)
-			o.metrics.BuildDone(update.Status == protocol.BuildStatus_done_success)
-			if update.Status != protocol.BuildStatus_done_success {
-				log.WithField("UserID", req.GetTriggeredBy()).Error("image build done failed for user")
-			}
-			break
-		}
+// buildCacheEnv configures bob to import and export the BuildKit cache of a Dockerfile build
+func (o *Orchestrator) buildCacheEnv(src *protocol.BuildSourceDockerfile) ([]*wsmanapi.EnvironmentVariable, error) {
+	cfg := o.Config.BuildCache
+	if cfg == nil {
+		return nil, nil
 	}
 
-	return nil
+	res := []*wsmanapi.EnvironmentVariable{
+		{Name: "BOB_CACHE_MODE", Value: string(cfg.Mode)},
+		{Name: "BOB_CACHE_EXPORT_ALL", Value: strconv.FormatBool(cfg.ExportAll)},
+	}
+	if cfg.MaxSizeMB > 0 {
+		res = append(res, &wsmanapi.EnvironmentVariable{Name: "BOB_CACHE_MAX_SIZE_MB", Value: strconv.FormatInt(cfg.MaxSizeMB, 10)})
+	}
+	if cfg.KeepDuration != "" {
+		res = append(res, &wsmanapi.EnvironmentVariable{Name: "BOB_CACHE_KEEP_DURATION", Value: cfg.KeepDuration})
+	}
+
+	switch cfg.Mode {
+	case config.BuildCacheModeRegistry:
+		// bob reaches the cache through its proxy, which authenticates against the registry
+		ref, err := o.getBuildCacheRef(src)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &wsmanapi.EnvironmentVariable{Name: "WORKSPACEKIT_BOBPROXY_CACHEREF", Value: ref})
+	case config.BuildCacheModeLocal:
+		res = append(res, &wsmanapi.EnvironmentVariable{Name: "BOB_CACHE_DIR", Value: cfg.Dir})
+	default:
+		return nil, xerrors.Errorf("unsupported build cache mode: %s", cfg.Mode)
+	}
+	return res, nil
 }
 
 // publishStatus broadcasts a build status update to all listeners
@@ -577,6 +732,7 @@ func (o *Orchestrator) ListBuilds(ctx context.Context, req *protocol.ListBuildsR
 	for _, ws := range builds {
 		res = append(res, &ws.Info)
 	}
+	res = append(res, o.scheduler.Queued()...)
 
 	return &protocol.ListBuildsResponse{Builds: res}, nil
 }
@@ -639,31 +795,11 @@ func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSo
 		return o.getAbsoluteImageRef(ctx, src.Ref.Ref, allowedAuth)
 
 	case *protocol.BuildSource_File:
-		manifest := map[string]string{
-			"DockerfilePath":    src.File.DockerfilePath,
-			"DockerfileVersion": src.File.DockerfileVersion,
-			"ContextPath":       src.File.ContextPath,
-		}
-		// workspace starter will only ever send us Git sources. Should that ever change, we'll need to add
-		// manifest support for the other initializer types.
-		if src.File.Source.GetGit() != nil {
-			fsrc := src.File.Source.GetGit()
-			manifest["Source"] = "git"
-			manifest["CloneTarget"] = fsrc.CloneTaget
-			manifest["RemoteURI"] = fsrc.RemoteUri
-		} else {
-			return "", xerrors.Errorf("unsupported context initializer")
-		}
-		// Go maps do NOT maintain their order - we must sort the keys to maintain a stable order
-		var keys []string
-		for k := range manifest {
-			keys = append(keys, k)
-		}
-		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-		var dfl string
-		for _, k := range keys {
-			dfl += fmt.Sprintf("%s: %s\n", k, manifest[k])
+		manifest, err := dockerfileManifest(src.File)
+		if err != nil {
+			return "", err
 		}
+		dfl := manifestString(manifest)
 		span.LogKV("manifest", dfl)
 
 		hash := sha256.New()
@@ -691,6 +827,57 @@ func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSo
 	}
 }
 
+// getBuildCacheRef produces the ref of the registry build cache for a Dockerfile build.
+// Unlike the base image ref, the cache ref does not depend on the Dockerfile's content, so that
+// a rebuild after editing the Dockerfile finds the cache of the previous build.
+func (o *Orchestrator) getBuildCacheRef(src *protocol.BuildSourceDockerfile) (string, error) {
+	manifest, err := dockerfileManifest(src)
+	if err != nil {
+		return "", err
+	}
+	delete(manifest, "DockerfileVersion")
+
+	repo := o.Config.BuildCache.Repository
+	if repo == "" {
+		repo = o.Config.BaseImageRepository
+	}
+	return fmt.Sprintf("%s:cache-%x", repo, sha256.Sum256([]byte(manifestString(manifest)))), nil
+}
+
+// dockerfileManifest describes the Dockerfile and context a base image is built from
+func dockerfileManifest(src *protocol.BuildSourceDockerfile) (map[string]string, error) {
+	manifest := map[string]string{
+		"DockerfilePath":    src.DockerfilePath,
+		"DockerfileVersion": src.DockerfileVersion,
+		"ContextPath":       src.ContextPath,
+	}
+	// workspace starter will only ever send us Git sources. Should that ever change, we'll need to add
+	// manifest support for the other initializer types.
+	if src.Source.GetGit() != nil {
+		fsrc := src.Source.GetGit()
+		manifest["Source"] = "git"
+		manifest["CloneTarget"] = fsrc.CloneTaget
+		manifest["RemoteURI"] = fsrc.RemoteUri
+	} else {
+		return nil, xerrors.Errorf("unsupported context initializer")
+	}
+	return manifest, nil
+}
+
+func manifestString(manifest map[string]string) string {
+	// Go maps do NOT maintain their order - we must sort the keys to maintain a stable order
+	var keys []string
+	for k := range manifest {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	var dfl string
+	for _, k := range keys {
+		dfl += fmt.Sprintf("%s: %s\n", k, manifest[k])
+	}
+	return dfl
+}
+
 func (o *Orchestrator) getWorkspaceImageRef(ctx context.Context, baseref string) (ref string, err error) {
 	cnt := []byte(fmt.Sprintf("%s\n%d\n", baseref, workspaceBuildProcessVersion))
 	hash := sha256.New()
@@ -0,0 +1,427 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/remotes"
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	protocol "github.com/gitpod-io/gitpod/image-builder/api"
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/sbom"
+)
+
+const (
+	// maxReportedVulnerabilities limits how many vulnerabilities we list in build failure messages
+	maxReportedVulnerabilities = 10
+
+	// vulnerabilityVerdictCacheSize is the number of workspace images whose vulnerability verdict we remember
+	vulnerabilityVerdictCacheSize = 10000
+
+	// sbomLoadTimeout limits how long we wait for the SBOM of an existing workspace image before we let the image through
+	sbomLoadTimeout = 5 * time.Second
+
+	// sbomScanTimeout limits how long a background scan of an existing workspace image may take
+	sbomScanTimeout = 10 * time.Minute
+)
+
+// loadVulnerabilityDatabase validates the vulnerability policy and loads its database
+func loadVulnerabilityDatabase(policy *config.VulnerabilityPolicy) (*sbom.Database, error) {
+	if sbom.ParseSeverity(policy.Threshold) == sbom.SeverityUnknown {
+		return nil, xerrors.Errorf("invalid vulnerability threshold %q: must be low, medium, high or critical", policy.Threshold)
+	}
+	switch policy.Action {
+	case config.VulnerabilityActionWarn, config.VulnerabilityActionFail:
+	default:
+		return nil, xerrors.Errorf("invalid vulnerability action %q: must be warn or fail", policy.Action)
+	}
+
+	db, err := sbom.LoadDatabase(policy.Database)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("records", db.Len()).WithField("database", policy.Database).Info("loaded vulnerability database")
+	return db, nil
+}
+
+// GetBuildSBOM returns the software bill of materials of a workspace image, and the vulnerabilities found in it
+func (o *Orchestrator) GetBuildSBOM(ctx context.Context, req *protocol.GetBuildSBOMRequest) (resp *protocol.GetBuildSBOMResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetBuildSBOM")
+	defer tracing.FinishSpan(span, &err)
+	tracing.LogRequestSafe(span, req)
+
+	if o.Config.SBOM == nil {
+		return nil, status.Error(codes.FailedPrecondition, "SBOMs are not enabled")
+	}
+	if req.Ref == "" {
+		return nil, status.Error(codes.InvalidArgument, "ref is required")
+	}
+	repo, err := sbom.Repository(req.Ref)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ref: %v", err)
+	}
+	wsrepo, err := sbom.Repository(o.Config.WorkspaceImageRepository)
+	if err != nil || repo != wsrepo {
+		// we only have SBOMs for workspace images, and don't want to hand out credentials for other repositories
+		return nil, status.Error(codes.InvalidArgument, "ref is not a workspace image")
+	}
+
+	var format config.SBOMFormat
+	switch req.Format {
+	case protocol.SBOMFormat_spdx:
+		format = config.SBOMFormatSPDX
+	case protocol.SBOMFormat_cyclonedx:
+		format = config.SBOMFormatCycloneDX
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported format %v", req.Format)
+	}
+
+	mediaType, data, err := o.loadSBOM(ctx, req.Ref, format)
+	if errors.Is(err, sbom.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "workspace image has no %s SBOM", format)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot load SBOM: %v", err)
+	}
+
+	resp = &protocol.GetBuildSBOMResponse{
+		MediaType: mediaType,
+		Content:   data,
+	}
+	if o.vulnDB != nil {
+		inv, err := sbom.Decode(mediaType, data)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot decode SBOM: %v", err)
+		}
+		for _, v := range o.vulnDB.Match(inv.Packages) {
+			resp.Vulnerabilities = append(resp.Vulnerabilities, &protocol.Vulnerability{
+				Id:           v.ID,
+				Package:      v.Package.Name,
+				Version:      v.Package.Version,
+				Severity:     protocol.VulnerabilitySeverity(v.Severity),
+				Summary:      v.Summary,
+				FixedVersion: v.FixedVersion,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// processBuildSBOM produces the SBOM of a freshly built workspace image and checks it against the vulnerability policy.
+// Returns a non-empty message if the build must fail.
+func (o *Orchestrator) processBuildSBOM(ctx context.Context, buildID, ref string) string {
+	inv, err := o.produceSBOM(ctx, ref)
+	if err != nil {
+		// a registry hiccup must not fail an otherwise successful build
+		log.WithError(err).WithField("buildID", buildID).Warn("cannot produce SBOM")
+		o.PublishLog(buildID, fmt.Sprintf("\nWARNING: cannot check the workspace image for vulnerabilities: %v\n", err))
+		return ""
+	}
+
+	if o.vulnerabilityAction() == config.VulnerabilityActionFail {
+		return o.cacheVulnerabilityVerdict(ref, inv)
+	}
+	vulns := o.reportedVulnerabilities(inv)
+	if len(vulns) == 0 {
+		return ""
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "\nWARNING: the workspace image has %d vulnerabilities of severity %s or above:\n", len(vulns), o.Config.SBOM.Vulnerabilities.Threshold)
+	for _, v := range vulns {
+		fmt.Fprintf(&msg, "  %s\n", formatVulnerability(v))
+	}
+	o.PublishLog(buildID, msg.String())
+	return ""
+}
+
+// enforceVulnerabilityPolicy checks an existing workspace image against the vulnerability policy if the policy fails builds.
+// Returns a non-empty message if the image must not be used. This runs on every workspace start, hence it remembers its
+// verdict per image, never scans synchronously and lets the image through if it cannot check it.
+func (o *Orchestrator) enforceVulnerabilityPolicy(ctx context.Context, ref string) string {
+	if o.vulnerabilityAction() != config.VulnerabilityActionFail {
+		return ""
+	}
+	if msg, ok := o.vulnVerdicts.Get(ref); ok {
+		return msg.(string)
+	}
+
+	lctx, cancel := context.WithTimeout(ctx, sbomLoadTimeout)
+	defer cancel()
+	mediaType, data, err := o.loadSBOM(lctx, ref, o.sbomFormats()[0])
+	if errors.Is(err, sbom.ErrNotFound) {
+		// the image was built before we produced SBOMs
+		o.scanInBackground(ref)
+		return ""
+	}
+	var inv *sbom.Inventory
+	if err == nil {
+		inv, err = sbom.Decode(mediaType, data)
+	}
+	if err != nil {
+		log.WithError(err).WithField("ref", ref).Warn("cannot check workspace image for vulnerabilities - letting it through")
+		return ""
+	}
+	return o.cacheVulnerabilityVerdict(ref, inv)
+}
+
+// scanInBackground produces the SBOM of an existing workspace image so that the next workspace start can enforce the policy
+func (o *Orchestrator) scanInBackground(ref string) {
+	o.mu.Lock()
+	if _, running := o.vulnScans[ref]; running {
+		o.mu.Unlock()
+		return
+	}
+	o.vulnScans[ref] = struct{}{}
+	o.mu.Unlock()
+
+	go func() {
+		defer func() {
+			o.mu.Lock()
+			delete(o.vulnScans, ref)
+			o.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), sbomScanTimeout)
+		defer cancel()
+		inv, err := o.produceSBOM(ctx, ref)
+		if err != nil {
+			log.WithError(err).WithField("ref", ref).Warn("cannot produce SBOM of existing workspace image")
+			return
+		}
+		o.cacheVulnerabilityVerdict(ref, inv)
+	}()
+}
+
+// cacheVulnerabilityVerdict checks an image's inventory against the vulnerability policy and remembers the verdict.
+// Workspace image refs are derived from their content, hence the verdict stays valid for as long as the database does.
+func (o *Orchestrator) cacheVulnerabilityVerdict(ref string, inv *sbom.Inventory) string {
+	var msg string
+	if vulns := o.reportedVulnerabilities(inv); len(vulns) > 0 {
+		msg = vulnerabilityMessage(o.Config.SBOM.Vulnerabilities.Threshold, vulns)
+	}
+	if o.vulnVerdicts != nil {
+		o.vulnVerdicts.Add(ref, msg)
+	}
+	return msg
+}
+
+func (o *Orchestrator) vulnerabilityAction() config.VulnerabilityAction {
+	if o.Config.SBOM == nil || o.Config.SBOM.Vulnerabilities == nil || o.vulnDB == nil {
+		return ""
+	}
+	return o.Config.SBOM.Vulnerabilities.Action
+}
+
+// reportedVulnerabilities lists the vulnerabilities at or above the policy's threshold which the policy doesn't ignore
+func (o *Orchestrator) reportedVulnerabilities(inv *sbom.Inventory) []sbom.Vulnerability {
+	if o.vulnDB == nil {
+		return nil
+	}
+	policy := o.Config.SBOM.Vulnerabilities
+	ignored := make(map[string]struct{}, len(policy.Ignore))
+	for _, id := range policy.Ignore {
+		ignored[id] = struct{}{}
+	}
+	threshold := sbom.ParseSeverity(policy.Threshold)
+
+	var res []sbom.Vulnerability
+	for _, v := range o.vulnDB.Match(inv.Packages) {
+		if v.Severity < threshold {
+			continue
+		}
+		if _, ok := ignored[v.ID]; ok {
+			continue
+		}
+		var aliasIgnored bool
+		for _, a := range v.Aliases {
+			if _, ok := ignored[a]; ok {
+				aliasIgnored = true
+				break
+			}
+		}
+		if aliasIgnored {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
+}
+
+func vulnerabilityMessage(threshold string, vulns []sbom.Vulnerability) string {
+	var ids []string
+	for i, v := range vulns {
+		if i == maxReportedVulnerabilities {
+			ids = append(ids, fmt.Sprintf("and %d more", len(vulns)-i))
+			break
+		}
+		ids = append(ids, formatVulnerability(v))
+	}
+	return fmt.Sprintf("workspace image has %d vulnerabilities of severity %s or above: %s", len(vulns), threshold, strings.Join(ids, ", "))
+}
+
+func formatVulnerability(v sbom.Vulnerability) string {
+	res := fmt.Sprintf("%s (%s %s, %s", v.ID, v.Package.Name, v.Package.Version, v.Severity)
+	if v.FixedVersion != "" {
+		res += ", fixed in " + v.FixedVersion
+	}
+	return res + ")"
+}
+
+// produceSBOM scans a workspace image for installed packages and pushes its SBOMs as referrers of the image
+func (o *Orchestrator) produceSBOM(ctx context.Context, ref string) (inv *sbom.Inventory, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "produceSBOM")
+	defer tracing.FinishSpan(span, &err)
+	span.SetTag("ref", ref)
+
+	repo, err := sbom.Repository(ref)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := o.workspaceImageResolver(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot resolve workspace image: %w", err)
+	}
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create fetcher: %w", err)
+	}
+	manifest, err := fetchManifest(ctx, fetcher, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err = sbom.Scan(ctx, fetcher, manifest.Layers)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot scan workspace image: %w", err)
+	}
+	doc := &sbom.Document{
+		Image:     repo + "@" + desc.Digest.String(),
+		Created:   time.Now(),
+		Inventory: inv,
+	}
+	for _, format := range o.sbomFormats() {
+		mediaType, encode := sbomEncoding(format)
+		if encode == nil {
+			return nil, xerrors.Errorf("unsupported SBOM format %s", format)
+		}
+		data, err := encode(doc)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot encode %s SBOM: %w", format, err)
+		}
+		err = sbom.PushReferrer(ctx, resolver, repo, desc, mediaType, data)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot push %s SBOM: %w", format, err)
+		}
+	}
+	if len(inv.SkippedLayers) > 0 {
+		log.WithField("ref", ref).WithField("layers", inv.SkippedLayers).Warn("skipped layers with unsupported media type - SBOM may be incomplete")
+	}
+	log.WithField("ref", ref).WithField("packages", len(inv.Packages)).Debug("produced SBOM")
+
+	return inv, nil
+}
+
+// loadSBOM fetches the SBOM of a workspace image from the registry
+func (o *Orchestrator) loadSBOM(ctx context.Context, ref string, format config.SBOMFormat) (mediaType string, data []byte, err error) {
+	mediaType, encode := sbomEncoding(format)
+	if encode == nil {
+		return "", nil, xerrors.Errorf("unsupported SBOM format %s", format)
+	}
+	repo, err := sbom.Repository(ref)
+	if err != nil {
+		return "", nil, err
+	}
+	resolver, err := o.workspaceImageResolver(ctx, ref)
+	if err != nil {
+		return "", nil, err
+	}
+	_, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", nil, xerrors.Errorf("cannot resolve workspace image: %w", err)
+	}
+	data, err = sbom.FetchReferrer(ctx, resolver, repo, desc.Digest, mediaType)
+	if err != nil {
+		return "", nil, err
+	}
+	return mediaType, data, nil
+}
+
+func (o *Orchestrator) sbomFormats() []config.SBOMFormat {
+	if len(o.Config.SBOM.Formats) == 0 {
+		return []config.SBOMFormat{config.SBOMFormatSPDX}
+	}
+	return o.Config.SBOM.Formats
+}
+
+func sbomEncoding(format config.SBOMFormat) (mediaType string, encode func(*sbom.Document) ([]byte, error)) {
+	switch format {
+	case config.SBOMFormatSPDX:
+		return sbom.MediaTypeSPDX, sbom.EncodeSPDX
+	case config.SBOMFormatCycloneDX:
+		return sbom.MediaTypeCycloneDX, sbom.EncodeCycloneDX
+	default:
+		return "", nil
+	}
+}
+
+// workspaceImageResolver produces a resolver which can pull and push workspace images
+func (o *Orchestrator) workspaceImageResolver(ctx context.Context, ref string) (remotes.Resolver, error) {
+	// the ref does not come from the user, hence we can safely use auth.AllowedAuthForAll here
+	authentication, err := auth.AllowedAuthForAll().GetAuthFor(ctx, o.Auth, ref)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get workspace image authentication: %w", err)
+	}
+	return dockerremote.NewResolver(dockerremote.ResolverOptions{
+		Authorizer: dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(func(host string) (username, password string, err error) {
+			if authentication == nil {
+				return
+			}
+			return authentication.Username, authentication.Password, nil
+		})),
+	}), nil
+}
+
+func fetchManifest(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) (*ociv1.Manifest, error) {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch manifest: %w", err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch manifest: %w", err)
+	}
+
+	var manifest ociv1.Manifest
+	err = json.Unmarshal(data, &manifest)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal manifest: %w", err)
+	}
+	if manifest.Config.Size == 0 {
+		return nil, xerrors.Errorf("workspace image is not a single-platform image")
+	}
+	return &manifest, nil
+}
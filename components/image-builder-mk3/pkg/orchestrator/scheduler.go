@@ -0,0 +1,242 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/gitpod-io/gitpod/image-builder/api"
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+)
+
+// buildScheduler coalesces equivalent requests for the same workspace image onto a single build, and limits how many
+// builds run at the same time. Builds beyond the limits wait in a queue until a running build finishes.
+type buildScheduler struct {
+	cfg     config.BuildQueueConfig
+	publish func(buildID string, resp *api.BuildResponse)
+	metrics *metrics
+
+	mu sync.Mutex
+	// builds are the queued and running builds by their coalescing key
+	builds       map[string]*scheduledBuild
+	queue        []*scheduledBuild
+	running      int
+	runningByOrg map[string]int
+}
+
+type scheduledBuild struct {
+	ID             string
+	Key            string
+	Ref            string
+	BaseRef        string
+	OrganizationID string
+
+	// position is the position in the queue we last published
+	position int
+	// started is closed once the build may start
+	started chan struct{}
+	// done is closed once the build has finished and result or err are set
+	done   chan struct{}
+	result *api.BuildResponse
+	err    error
+}
+
+func newBuildScheduler(cfg *config.BuildQueueConfig, publish func(buildID string, resp *api.BuildResponse), metrics *metrics) *buildScheduler {
+	s := &buildScheduler{
+		publish:      publish,
+		metrics:      metrics,
+		builds:       make(map[string]*scheduledBuild),
+		runningByOrg: make(map[string]int),
+	}
+	if cfg != nil {
+		s.cfg = *cfg
+	}
+	return s
+}
+
+// Schedule returns the build of a workspace image for a coalescing key (see coalescingKey). If there's none yet, Schedule
+// creates one and returns created == true. The caller must then run the build once it's started, and call Finish when it's done.
+func (s *buildScheduler) Schedule(key, ref, baseRef, organizationID string) (bld *scheduledBuild, created bool, err error) {
+	s.mu.Lock()
+	if bld, ok := s.builds[key]; ok {
+		s.mu.Unlock()
+		s.metrics.BuildCoalesced()
+		return bld, false, nil
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		s.mu.Unlock()
+		return nil, false, err
+	}
+	bld = &scheduledBuild{
+		ID:             id.String(),
+		Key:            key,
+		Ref:            ref,
+		BaseRef:        baseRef,
+		OrganizationID: organizationID,
+		started:        make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+	s.builds[key] = bld
+	s.queue = append(s.queue, bld)
+	updates := s.dispatch()
+	s.mu.Unlock()
+
+	s.publishQueuePositions(updates)
+	return bld, true, nil
+}
+
+// Finish frees the slot of a build, starts the queued builds which fit into the limits now, and hands the result
+// to everyone who's waiting for the build.
+func (s *buildScheduler) Finish(bld *scheduledBuild, result *api.BuildResponse, err error) {
+	s.mu.Lock()
+	if s.builds[bld.Key] == bld {
+		delete(s.builds, bld.Key)
+	}
+	select {
+	case <-bld.started:
+		s.running--
+		s.runningByOrg[bld.OrganizationID]--
+		if s.runningByOrg[bld.OrganizationID] <= 0 {
+			delete(s.runningByOrg, bld.OrganizationID)
+		}
+	default:
+		// the build never started, e.g. because it failed while it was queued
+		for i, b := range s.queue {
+			if b == bld {
+				s.queue = append(s.queue[:i], s.queue[i+1:]...)
+				break
+			}
+		}
+	}
+	bld.result, bld.err = result, err
+	close(bld.done)
+	updates := s.dispatch()
+	s.mu.Unlock()
+
+	s.publishQueuePositions(updates)
+}
+
+// QueuePosition returns the 1-based position of a build in the queue, or zero if the build isn't queued
+func (s *buildScheduler) QueuePosition(bld *scheduledBuild) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, b := range s.queue {
+		if b == bld {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Queued lists the builds that wait in the queue
+func (s *buildScheduler) Queued() []*api.BuildInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]*api.BuildInfo, 0, len(s.queue))
+	for i, b := range s.queue {
+		res = append(res, queuedBuildInfo(b, i+1))
+	}
+	return res
+}
+
+// dispatch starts the queued builds which fit into the concurrency limits. Builds of organizations which are at their
+// limit don't block the builds of others. Returns the queued builds whose position changed. Callers must hold s.mu.
+func (s *buildScheduler) dispatch() map[*scheduledBuild]int {
+	var (
+		remaining = s.queue[:0]
+		positions = make(map[*scheduledBuild]int)
+	)
+	for _, b := range s.queue {
+		if s.canStart(b) {
+			s.running++
+			s.runningByOrg[b.OrganizationID]++
+			b.position = 0
+			close(b.started)
+			continue
+		}
+		remaining = append(remaining, b)
+		if b.position != len(remaining) {
+			b.position = len(remaining)
+			positions[b] = b.position
+		}
+	}
+	for i := len(remaining); i < len(s.queue); i++ {
+		s.queue[i] = nil
+	}
+	s.queue = remaining
+	s.metrics.BuildsQueued(len(s.queue))
+	return positions
+}
+
+// coalescingKey identifies the requests which may share a build. Besides the workspace image, everything that
+// goes into the build workspace must match: a request must neither run with someone else's secrets, SSH keys or
+// supervisor, nor join a build it asked to bypass. Who triggered a build doesn't matter, so that identical builds
+// of different members of an organization share one build.
+func coalescingKey(ref string, req *api.BuildRequest) string {
+	h := sha256.New()
+	field := func(v string) {
+		fmt.Fprintf(h, "%d:%s;", len(v), v)
+	}
+	field(ref)
+	field(strconv.FormatBool(req.GetForceRebuild()))
+	field(req.GetSupervisorRef())
+	for _, sec := range req.GetSecrets() {
+		field("secret")
+		field(sec.Id)
+		field(sec.SecretValue)
+	}
+	for _, k := range req.GetSshKeys() {
+		field("ssh")
+		field(k.Id)
+		field(k.PrivateKey)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (s *buildScheduler) canStart(b *scheduledBuild) bool {
+	if s.cfg.MaxConcurrentBuilds > 0 && s.running >= s.cfg.MaxConcurrentBuilds {
+		return false
+	}
+	if s.cfg.MaxConcurrentBuildsPerOrganization > 0 && b.OrganizationID != "" && s.runningByOrg[b.OrganizationID] >= s.cfg.MaxConcurrentBuildsPerOrganization {
+		return false
+	}
+	return true
+}
+
+func (s *buildScheduler) publishQueuePositions(positions map[*scheduledBuild]int) {
+	for b, pos := range positions {
+		s.publish(b.ID, queuedBuildResponse(b, pos))
+	}
+}
+
+func queuedBuildInfo(b *scheduledBuild, position int) *api.BuildInfo {
+	return &api.BuildInfo{
+		BuildId:       b.ID,
+		Ref:           b.Ref,
+		BaseRef:       b.BaseRef,
+		Status:        api.BuildStatus_queued,
+		QueuePosition: int32(position),
+	}
+}
+
+func queuedBuildResponse(b *scheduledBuild, position int) *api.BuildResponse {
+	return &api.BuildResponse{
+		Ref:     b.Ref,     // set for backwards compatibilty - new clients should consume Info
+		BaseRef: b.BaseRef, // set for backwards compatibilty - new clients should consume Info
+		Status:  api.BuildStatus_queued,
+		Message: fmt.Sprintf("waiting for other image builds to finish (position %d in the queue)", position),
+		Info:    queuedBuildInfo(b, position),
+	}
+}
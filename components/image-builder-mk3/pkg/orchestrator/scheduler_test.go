@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/image-builder/api"
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+)
+
+func TestBuildScheduler(t *testing.T) {
+	published := make(map[string]int32)
+	s := newBuildScheduler(&config.BuildQueueConfig{
+		MaxConcurrentBuilds:                2,
+		MaxConcurrentBuildsPerOrganization: 1,
+	}, func(buildID string, resp *api.BuildResponse) {
+		published[buildID] = resp.Info.QueuePosition
+	}, newMetrics())
+
+	schedule := func(ref, org string, expectCreated bool) *scheduledBuild {
+		t.Helper()
+		bld, created, err := s.Schedule(ref, ref, "base", org)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created != expectCreated {
+			t.Fatalf("Schedule(%s) created = %v, expected %v", ref, created, expectCreated)
+		}
+		return bld
+	}
+	isStarted := func(bld *scheduledBuild) bool {
+		select {
+		case <-bld.started:
+			return true
+		default:
+			return false
+		}
+	}
+
+	a := schedule("a", "org1", true)
+	if joined := schedule("a", "org2", false); joined != a {
+		t.Error("requests for the same ref were not coalesced")
+	}
+	b := schedule("b", "org1", true)
+	c := schedule("c", "org2", true)
+	d := schedule("d", "org3", true)
+
+	for bld, expectation := range map[*scheduledBuild]bool{a: true, b: false, c: true, d: false} {
+		if act := isStarted(bld); act != expectation {
+			t.Errorf("build %s started = %v, expected %v", bld.Ref, act, expectation)
+		}
+	}
+	if diff := cmp.Diff([]int{1, 2}, []int{s.QueuePosition(b), s.QueuePosition(d)}); diff != "" {
+		t.Errorf("unexpected queue positions (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"b", "d"}, refs(s.Queued())); diff != "" {
+		t.Errorf("unexpected queued builds (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]int32{b.ID: 1, d.ID: 2}, published); diff != "" {
+		t.Errorf("unexpected published queue positions (-want +got):\n%s", diff)
+	}
+	published = make(map[string]int32)
+
+	// a finishing makes room for the next build of org1, but d still waits for a free slot
+	s.Finish(a, &api.BuildResponse{Status: api.BuildStatus_done_success}, nil)
+	select {
+	case <-a.done:
+	default:
+		t.Error("finished build is not done")
+	}
+	if !isStarted(b) || isStarted(d) {
+		t.Errorf("unexpected builds started after a finished: b = %v, d = %v", isStarted(b), isStarted(d))
+	}
+	if diff := cmp.Diff(map[string]int32{d.ID: 1}, published); diff != "" {
+		t.Errorf("unexpected published queue positions (-want +got):\n%s", diff)
+	}
+	if joined := schedule("a", "org1", true); joined == a {
+		t.Error("finished build was reused")
+	}
+
+	// a build that fails while it's queued leaves the queue
+	s.Finish(d, nil, errOutOfRetries)
+	if len(s.Queued()) != 1 {
+		t.Errorf("unexpected queued builds: %v", refs(s.Queued()))
+	}
+}
+
+func refs(builds []*api.BuildInfo) []string {
+	res := make([]string, 0, len(builds))
+	for _, b := range builds {
+		res = append(res, b.Ref)
+	}
+	return res
+}
+
+func TestCoalescingKey(t *testing.T) {
+	base := &api.BuildRequest{TriggeredBy: "user1", SupervisorRef: "supervisor:1"}
+	tests := []struct {
+		Name        string
+		Req         *api.BuildRequest
+		Ref         string
+		Expectation bool
+	}{
+		{Name: "same request", Req: &api.BuildRequest{TriggeredBy: "user1", SupervisorRef: "supervisor:1"}, Expectation: true},
+		{Name: "different ref", Req: base, Ref: "other", Expectation: false},
+		{Name: "different owner", Req: &api.BuildRequest{TriggeredBy: "user2", SupervisorRef: "supervisor:1"}, Expectation: true},
+		{Name: "different supervisor", Req: &api.BuildRequest{TriggeredBy: "user1", SupervisorRef: "supervisor:2"}, Expectation: false},
+		{Name: "force rebuild", Req: &api.BuildRequest{TriggeredBy: "user1", SupervisorRef: "supervisor:1", ForceRebuild: true}, Expectation: false},
+		{Name: "secrets", Req: &api.BuildRequest{TriggeredBy: "user1", SupervisorRef: "supervisor:1", Secrets: []*api.BuildSecret{{Id: "npmrc", SecretValue: "abc"}}}, Expectation: false},
+		{Name: "SSH keys", Req: &api.BuildRequest{TriggeredBy: "user1", SupervisorRef: "supervisor:1", SshKeys: []*api.BuildSSHKey{{PrivateKey: "key"}}}, Expectation: false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ref := "ref"
+			if test.Ref != "" {
+				ref = test.Ref
+			}
+			act := coalescingKey("ref", base) == coalescingKey(ref, test.Req)
+			if act != test.Expectation {
+				t.Errorf("coalesced = %v, expected %v", act, test.Expectation)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	protocol "github.com/gitpod-io/gitpod/image-builder/api"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+// buildSecretID matches the secret and SSH key IDs we pass on to buildctl
+var buildSecretID = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// buildSecretsKeyEntry is the entry of the build secrets key secret which holds the key
+const buildSecretsKeyEntry = "key"
+
+// loadBuildSecretsKey reads the key we encrypt build secrets with. bob expects the key to be exactly 32 bytes long.
+func loadBuildSecretsKey(fn string) (string, error) {
+	if tproot := os.Getenv("TELEPRESENCE_ROOT"); tproot != "" {
+		fn = filepath.Join(tproot, fn)
+	}
+	key, err := os.ReadFile(fn)
+	if err != nil {
+		return "", xerrors.Errorf("cannot read build secrets key: %w", err)
+	}
+	if len(key) != 32 {
+		return "", xerrors.Errorf("build secrets key must be exactly 32 bytes long, not %d", len(key))
+	}
+	return string(key), nil
+}
+
+// buildSecretsEnv passes the build secrets and SSH keys of a request on to bob. The values are encrypted
+// so that they don't show up in the build workspace's spec in plain text. The build workspace gets the key
+// by reference to the secret named by keySecret.
+// It returns the environment variables and the words we must censor in the build log.
+func buildSecretsEnv(req *protocol.BuildRequest, keySecret, key string) (env []*wsmanapi.EnvironmentVariable, censored []string, err error) {
+	if len(req.Secrets) == 0 && len(req.SshKeys) == 0 {
+		return nil, nil, nil
+	}
+	if keySecret == "" || key == "" {
+		return nil, nil, xerrors.Errorf("build secrets are not enabled")
+	}
+
+	secrets := make(map[string]string, len(req.Secrets))
+	for _, s := range req.Secrets {
+		if !buildSecretID.MatchString(s.Id) {
+			return nil, nil, xerrors.Errorf("invalid build secret id %q", s.Id)
+		}
+		if _, exists := secrets[s.Id]; exists {
+			return nil, nil, xerrors.Errorf("duplicate build secret id %q", s.Id)
+		}
+		secrets[s.Id] = s.SecretValue
+		censored = append(censored, censoredWords(s.SecretValue)...)
+	}
+	sshKeys := make(map[string]string, len(req.SshKeys))
+	for _, k := range req.SshKeys {
+		id := k.Id
+		if id == "" {
+			id = "default"
+		}
+		if !buildSecretID.MatchString(id) {
+			return nil, nil, xerrors.Errorf("invalid build SSH key id %q", id)
+		}
+		if _, exists := sshKeys[id]; exists {
+			return nil, nil, xerrors.Errorf("duplicate build SSH key id %q", id)
+		}
+		sshKeys[id] = k.PrivateKey
+		censored = append(censored, censoredWords(k.PrivateKey)...)
+	}
+
+	env = []*wsmanapi.EnvironmentVariable{{
+		Name: "BOB_AUTH_KEY",
+		Secret: &wsmanapi.EnvironmentVariable_SecretKeyRef{
+			SecretName: keySecret,
+			Key:        buildSecretsKeyEntry,
+		},
+	}}
+	for _, e := range []struct {
+		Name   string
+		Values map[string]string
+	}{
+		{"BOB_SECRETS", secrets},
+		{"BOB_SSH_KEYS", sshKeys},
+	} {
+		if len(e.Values) == 0 {
+			continue
+		}
+		plain, err := json.Marshal(e.Values)
+		if err != nil {
+			return nil, nil, err
+		}
+		enc, err := encrypt(plain, key)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("cannot encrypt %s: %w", e.Name, err)
+		}
+		env = append(env, &wsmanapi.EnvironmentVariable{Name: e.Name, Value: enc})
+	}
+	return env, censored, nil
+}
+
+// censoredWords produces the words to censor for a secret value. Multi-line values such as SSH keys
+// are censored line by line, as the build log doesn't necessarily arrive in whole.
+func censoredWords(value string) []string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	res := []string{value}
+	if !strings.Contains(value, "\n") {
+		return res
+	}
+	for _, l := range strings.Split(value, "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" || strings.HasPrefix(l, "-----") {
+			continue
+		}
+		res = append(res, l)
+	}
+	return res
+}
+
+// encrypt is the counterpart of the decryption bob uses for its auth configuration
+func encrypt(plaintext []byte, key string) (string, error) {
+	c, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(c)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(gcm.Seal(nonce, nonce, plaintext, nil)), nil
+}
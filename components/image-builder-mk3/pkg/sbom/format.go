@@ -0,0 +1,229 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// MediaTypeSPDX is the media type of SPDX JSON documents
+	MediaTypeSPDX = "application/spdx+json"
+	// MediaTypeCycloneDX is the media type of CycloneDX JSON documents
+	MediaTypeCycloneDX = "application/vnd.cyclonedx+json"
+
+	toolName = "gitpod-image-builder"
+)
+
+// Document describes what we know about the image an SBOM is produced for
+type Document struct {
+	// Image is the ref of the image, in digest form
+	Image     string
+	Created   time.Time
+	Inventory *Inventory
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	PrimaryPurpose   string            `json:"primaryPackagePurpose,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// EncodeSPDX produces an SPDX 2.3 JSON document
+func EncodeSPDX(doc *Document) ([]byte, error) {
+	const imageID = "SPDXRef-Image"
+
+	res := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              doc.Image,
+		DocumentNamespace: "https://gitpod.io/spdxdocs/" + documentID(doc).String(),
+		CreationInfo: spdxCreationInfo{
+			Created:  doc.Created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages: []spdxPackage{{
+			Name:             doc.Image,
+			SPDXID:           imageID,
+			DownloadLocation: "NOASSERTION",
+			PrimaryPurpose:   "CONTAINER",
+		}},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: imageID,
+		}},
+	}
+	for i, pkg := range doc.Inventory.Packages {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i)
+		res.Packages = append(res.Packages, spdxPackage{
+			Name:             pkg.Name,
+			SPDXID:           id,
+			VersionInfo:      pkg.Version,
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  pkg.PURL(),
+			}},
+		})
+		res.Relationships = append(res.Relationships, spdxRelationship{
+			SPDXElementID:      imageID,
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: id,
+		})
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string `json:"timestamp"`
+	Tools     struct {
+		Components []cycloneDXComponent `json:"components"`
+	} `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXComponent struct {
+	Type    string `json:"type"`
+	BOMRef  string `json:"bom-ref,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+// EncodeCycloneDX produces a CycloneDX 1.5 JSON document
+func EncodeCycloneDX(doc *Document) ([]byte, error) {
+	res := cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: documentID(doc).URN(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: doc.Created.UTC().Format(time.RFC3339),
+			Component: cycloneDXComponent{
+				Type:   "container",
+				BOMRef: "image",
+				Name:   doc.Image,
+			},
+		},
+	}
+	res.Metadata.Tools.Components = []cycloneDXComponent{{Type: "application", Name: toolName}}
+	if d := doc.Inventory.Distro; d.ID != "" {
+		res.Components = append(res.Components, cycloneDXComponent{
+			Type:    "operating-system",
+			BOMRef:  "os",
+			Name:    d.ID,
+			Version: d.VersionID,
+		})
+	}
+	for _, pkg := range doc.Inventory.Packages {
+		purl := pkg.PURL()
+		res.Components = append(res.Components, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  purl,
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			PURL:    purl,
+		})
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+// documentID identifies the SBOM of an image. We want the same ID for all SBOMs of an image, no matter the format.
+func documentID(doc *Document) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(doc.Image))
+}
+
+// Decode reads the package inventory from an SBOM we produced earlier
+func Decode(mediaType string, content []byte) (*Inventory, error) {
+	var purls []string
+	switch mediaType {
+	case MediaTypeSPDX:
+		var doc spdxDocument
+		err := json.Unmarshal(content, &doc)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal SPDX document: %w", err)
+		}
+		for _, pkg := range doc.Packages {
+			for _, ref := range pkg.ExternalRefs {
+				if ref.ReferenceType == "purl" {
+					purls = append(purls, ref.ReferenceLocator)
+				}
+			}
+		}
+	case MediaTypeCycloneDX:
+		var doc cycloneDXDocument
+		err := json.Unmarshal(content, &doc)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal CycloneDX document: %w", err)
+		}
+		for _, c := range doc.Components {
+			if c.PURL != "" {
+				purls = append(purls, c.PURL)
+			}
+		}
+	default:
+		return nil, xerrors.Errorf("unsupported SBOM media type %s", mediaType)
+	}
+
+	res := &Inventory{}
+	for _, purl := range purls {
+		pkg, err := ParsePURL(purl)
+		if err != nil {
+			return nil, err
+		}
+		res.Distro = pkg.Distro
+		res.Packages = append(res.Packages, pkg)
+	}
+	return res, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"net/url"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	// PackageTypeDeb marks packages installed by dpkg
+	PackageTypeDeb = "deb"
+	// PackageTypeAPK marks packages installed by apk
+	PackageTypeAPK = "apk"
+)
+
+// Distro identifies the Linux distribution of an image
+type Distro struct {
+	// ID is the distribution's ID from os-release, e.g. debian, ubuntu or alpine
+	ID string
+	// VersionID is the distribution's VERSION_ID from os-release, e.g. 12 or 3.18.4
+	VersionID string
+}
+
+// Ecosystem returns the OSV ecosystem of the distribution's packages, e.g. Debian:12.
+// Returns an empty string if there's no OSV ecosystem for this distribution.
+func (d Distro) Ecosystem() string {
+	if d.VersionID == "" {
+		return ""
+	}
+	switch d.ID {
+	case "debian":
+		return "Debian:" + strings.SplitN(d.VersionID, ".", 2)[0]
+	case "ubuntu":
+		return "Ubuntu:" + d.VersionID
+	case "alpine":
+		segs := strings.SplitN(d.VersionID, ".", 3)
+		if len(segs) < 2 {
+			return ""
+		}
+		return "Alpine:v" + segs[0] + "." + segs[1]
+	default:
+		return ""
+	}
+}
+
+func (d Distro) String() string {
+	if d.ID == "" {
+		return ""
+	}
+	return d.ID + "-" + d.VersionID
+}
+
+// Package is an operating system package installed in an image
+type Package struct {
+	Type    string
+	Name    string
+	Version string
+	Arch    string
+
+	// Source names the source package this package was built from, which vulnerability databases refer to.
+	// SourceVersion is set if it differs from the package version.
+	Source        string
+	SourceVersion string
+
+	Distro Distro
+}
+
+// PURL produces the package URL (https://github.com/package-url/purl-spec) of the package
+func (p Package) PURL() string {
+	q := url.Values{}
+	if p.Arch != "" {
+		q.Set("arch", p.Arch)
+	}
+	if d := p.Distro.String(); d != "" {
+		q.Set("distro", d)
+	}
+	if p.Source != "" {
+		upstream := p.Source
+		if p.SourceVersion != "" {
+			upstream += "@" + p.SourceVersion
+		}
+		q.Set("upstream", upstream)
+	}
+
+	res := "pkg:" + p.Type + "/" + purlEscape(p.Distro.ID) + "/" + purlEscape(p.Name) + "@" + purlEscape(p.Version)
+	if len(q) > 0 {
+		// Encode sorts the qualifiers by key, as the spec demands
+		res += "?" + q.Encode()
+	}
+	return res
+}
+
+func purlEscape(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "+", "%2B")
+}
+
+// ParsePURL parses a package URL produced by Package.PURL
+func ParsePURL(purl string) (Package, error) {
+	var res Package
+
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return res, xerrors.Errorf("invalid purl %s: missing pkg: scheme", purl)
+	}
+	rest, qualifiers, _ := strings.Cut(rest, "?")
+	segs := strings.Split(rest, "/")
+	if len(segs) != 3 {
+		return res, xerrors.Errorf("invalid purl %s: expected type, namespace and name", purl)
+	}
+	name, version, ok := strings.Cut(segs[2], "@")
+	if !ok {
+		return res, xerrors.Errorf("invalid purl %s: missing version", purl)
+	}
+
+	var err error
+	res.Type = segs[0]
+	if res.Name, err = url.PathUnescape(name); err != nil {
+		return res, xerrors.Errorf("invalid purl %s: %w", purl, err)
+	}
+	if res.Version, err = url.PathUnescape(version); err != nil {
+		return res, xerrors.Errorf("invalid purl %s: %w", purl, err)
+	}
+
+	q, err := url.ParseQuery(qualifiers)
+	if err != nil {
+		return res, xerrors.Errorf("invalid purl %s: %w", purl, err)
+	}
+	res.Arch = q.Get("arch")
+	if d := q.Get("distro"); d != "" {
+		res.Distro.ID, res.Distro.VersionID, _ = strings.Cut(d, "-")
+	} else {
+		res.Distro.ID, _ = url.PathUnescape(segs[1])
+	}
+	if u := q.Get("upstream"); u != "" {
+		res.Source, res.SourceVersion, _ = strings.Cut(u, "@")
+	}
+	return res, nil
+}
+
+// parseOSRelease parses an os-release file (https://www.freedesktop.org/software/systemd/man/os-release.html)
+func parseOSRelease(content []byte) Distro {
+	var res Distro
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(value, `"'`)
+		switch key {
+		case "ID":
+			res.ID = value
+		case "VERSION_ID":
+			res.VersionID = value
+		}
+	}
+	return res
+}
+
+// parseDpkgStatus parses the dpkg status database, which lists the installed packages in stanzas like
+//
+//	Package: curl
+//	Status: install ok installed
+//	Architecture: amd64
+//	Source: curl (7.88.1-10)
+//	Version: 7.88.1-10+deb12u5
+func parseDpkgStatus(content []byte) []Package {
+	var res []Package
+	for _, stanza := range splitStanzas(content) {
+		if st, ok := stanza["Status"]; ok && !strings.HasSuffix(st, " installed") {
+			continue
+		}
+		if stanza["Package"] == "" || stanza["Version"] == "" {
+			continue
+		}
+
+		pkg := Package{
+			Type:    PackageTypeDeb,
+			Name:    stanza["Package"],
+			Version: stanza["Version"],
+			Arch:    stanza["Architecture"],
+		}
+		if src := stanza["Source"]; src != "" {
+			name, version, ok := strings.Cut(src, " ")
+			pkg.Source = name
+			if ok {
+				pkg.SourceVersion = strings.Trim(version, "()")
+			}
+		}
+		res = append(res, pkg)
+	}
+	return res
+}
+
+// parseAPKInstalled parses the apk database, which lists the installed packages in stanzas like
+//
+//	P:musl
+//	V:1.2.4-r2
+//	A:x86_64
+//	o:musl
+func parseAPKInstalled(content []byte) []Package {
+	var res []Package
+	for _, stanza := range splitStanzas(content) {
+		if stanza["P"] == "" || stanza["V"] == "" {
+			continue
+		}
+		pkg := Package{
+			Type:    PackageTypeAPK,
+			Name:    stanza["P"],
+			Version: stanza["V"],
+			Arch:    stanza["A"],
+		}
+		if o := stanza["o"]; o != "" && o != pkg.Name {
+			pkg.Source = o
+		}
+		res = append(res, pkg)
+	}
+	return res
+}
+
+// splitStanzas splits a package database into its blank-line separated stanzas of "key: value" (dpkg)
+// or "key:value" (apk) lines. We keep the first line of multi-line values only.
+func splitStanzas(content []byte) []map[string]string {
+	var (
+		res     []map[string]string
+		current = make(map[string]string)
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				res = append(res, current)
+				current = make(map[string]string)
+			}
+			continue
+		}
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			// continuation of a multi-line value
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		if _, exists := current[key]; !exists {
+			current[key] = strings.TrimSpace(value)
+		}
+	}
+	if len(current) > 0 {
+		res = append(res, current)
+	}
+	return res
+}
+
+func sortPackages(pkgs []Package) {
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].Name != pkgs[j].Name {
+			return pkgs[i].Name < pkgs[j].Name
+		}
+		return pkgs[i].Version < pkgs[j].Version
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/remotes"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+// ErrNotFound is returned when an image has no referrer of the requested artifact type
+var ErrNotFound = xerrors.Errorf("not found")
+
+// emptyConfig is the config blob of artifacts which have no config
+var emptyConfig = []byte("{}")
+
+// PushReferrer pushes content as an OCI artifact which refers to the subject image (https://github.com/opencontainers/distribution-spec/blob/main/spec.md#listing-referrers).
+// An earlier referrer of the same artifact type is replaced.
+//
+// Not all registries support the referrers API yet, hence we maintain the referrers tag schema which
+// every registry supports: a sha256-<digest> tag which points to an index of all referrers.
+func PushReferrer(ctx context.Context, resolver remotes.Resolver, repo string, subject ociv1.Descriptor, artifactType string, data []byte) error {
+	pusher, err := resolver.Pusher(ctx, repo)
+	if err != nil {
+		return xerrors.Errorf("cannot create pusher: %w", err)
+	}
+	configDesc := ociv1.Descriptor{
+		// registries which don't know the artifactType field find the artifact type in the config media type
+		MediaType: artifactType,
+		Digest:    digest.FromBytes(emptyConfig),
+		Size:      int64(len(emptyConfig)),
+	}
+	err = pushBlob(ctx, pusher, configDesc, emptyConfig)
+	if err != nil {
+		return xerrors.Errorf("cannot push artifact config: %w", err)
+	}
+	layerDesc := ociv1.Descriptor{
+		MediaType: artifactType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+	err = pushBlob(ctx, pusher, layerDesc, data)
+	if err != nil {
+		return xerrors.Errorf("cannot push artifact: %w", err)
+	}
+
+	subject = ociv1.Descriptor{MediaType: subject.MediaType, Digest: subject.Digest, Size: subject.Size}
+	manifest := ociv1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociv1.MediaTypeImageManifest,
+		Config:    configDesc,
+		Layers:    []ociv1.Descriptor{layerDesc},
+		Subject:   &subject,
+		Annotations: map[string]string{
+			ociv1.AnnotationCreated: time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	manifestData, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	manifestDesc := ociv1.Descriptor{
+		MediaType:    ociv1.MediaTypeImageManifest,
+		Digest:       digest.FromBytes(manifestData),
+		Size:         int64(len(manifestData)),
+		ArtifactType: artifactType,
+		Annotations:  manifest.Annotations,
+	}
+	manifestPusher, err := resolver.Pusher(ctx, repo+"@"+manifestDesc.Digest.String())
+	if err != nil {
+		return xerrors.Errorf("cannot create pusher: %w", err)
+	}
+	err = pushBlob(ctx, manifestPusher, manifestDesc, manifestData)
+	if err != nil {
+		return xerrors.Errorf("cannot push artifact manifest: %w", err)
+	}
+
+	tagRef := referrersTag(repo, subject.Digest)
+	index, err := fetchReferrersIndex(ctx, resolver, tagRef)
+	if err != nil && !xerrors.Is(err, ErrNotFound) {
+		return err
+	}
+	manifests := []ociv1.Descriptor{manifestDesc}
+	for _, m := range index.Manifests {
+		if m.ArtifactType == artifactType {
+			continue
+		}
+		manifests = append(manifests, m)
+	}
+	index.Manifests = manifests
+	indexData, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	indexPusher, err := resolver.Pusher(ctx, tagRef)
+	if err != nil {
+		return xerrors.Errorf("cannot create pusher: %w", err)
+	}
+	err = pushBlob(ctx, indexPusher, ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageIndex,
+		Digest:    digest.FromBytes(indexData),
+		Size:      int64(len(indexData)),
+	}, indexData)
+	if err != nil {
+		return xerrors.Errorf("cannot push referrers index: %w", err)
+	}
+	return nil
+}
+
+// FetchReferrer fetches the content of the subject's referrer of the given artifact type
+func FetchReferrer(ctx context.Context, resolver remotes.Resolver, repo string, subject digest.Digest, artifactType string) ([]byte, error) {
+	index, err := fetchReferrersIndex(ctx, resolver, referrersTag(repo, subject))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifestDesc *ociv1.Descriptor
+	for i, m := range index.Manifests {
+		if m.ArtifactType == artifactType {
+			manifestDesc = &index.Manifests[i]
+			break
+		}
+	}
+	if manifestDesc == nil {
+		return nil, ErrNotFound
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, repo)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create fetcher: %w", err)
+	}
+	var manifest ociv1.Manifest
+	err = fetchJSON(ctx, fetcher, *manifestDesc, &manifest)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch artifact manifest: %w", err)
+	}
+	if len(manifest.Layers) != 1 {
+		return nil, xerrors.Errorf("artifact %s has %d layers, expected one", manifestDesc.Digest, len(manifest.Layers))
+	}
+	return fetchBlob(ctx, fetcher, manifest.Layers[0])
+}
+
+// referrersTag produces the tag of the referrers index of a subject, e.g. registry/repo:sha256-abc...
+func referrersTag(repo string, subject digest.Digest) string {
+	return repo + ":" + strings.Replace(subject.String(), ":", "-", 1)
+}
+
+func fetchReferrersIndex(ctx context.Context, resolver remotes.Resolver, tagRef string) (ociv1.Index, error) {
+	res := ociv1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociv1.MediaTypeImageIndex,
+	}
+
+	name, desc, err := resolver.Resolve(ctx, tagRef)
+	if errdefs.IsNotFound(err) {
+		return res, ErrNotFound
+	}
+	if err != nil {
+		return res, xerrors.Errorf("cannot resolve referrers index: %w", err)
+	}
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return res, xerrors.Errorf("cannot create fetcher: %w", err)
+	}
+	err = fetchJSON(ctx, fetcher, desc, &res)
+	if err != nil {
+		return res, xerrors.Errorf("cannot fetch referrers index: %w", err)
+	}
+	return res, nil
+}
+
+func pushBlob(ctx context.Context, pusher remotes.Pusher, desc ociv1.Descriptor, data []byte) error {
+	w, err := pusher.Push(ctx, desc)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	err = content.Copy(ctx, w, bytes.NewReader(data), desc.Size, desc.Digest)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) ([]byte, error) {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, maxDatabaseSize))
+}
+
+func fetchJSON(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor, dst interface{}) error {
+	data, err := fetchBlob(ctx, fetcher, desc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// Repository returns the repository of an image ref, e.g. registry/repo for registry/repo:tag
+func Repository(ref string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", err
+	}
+	return reference.TrimNamed(named).String(), nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	debianOSRelease = `PRETTY_NAME="Debian GNU/Linux 12 (bookworm)"
+NAME="Debian GNU/Linux"
+VERSION_ID="12"
+ID=debian
+`
+	dpkgStatusContent = `Package: curl
+Status: install ok installed
+Architecture: amd64
+Version: 7.88.1-10+deb12u5
+Description: command line tool for transferring data with URL syntax
+ curl is a command line tool for transferring data with URL syntax.
+
+Package: libssl3
+Status: install ok installed
+Architecture: amd64
+Source: openssl (3.0.11-1~deb12u2)
+Version: 3.0.11-1~deb12u2
+
+Package: removed
+Status: deinstall ok config-files
+Version: 1.0
+`
+)
+
+type layer map[string]string
+
+type memFetcher map[digest.Digest][]byte
+
+func (f memFetcher) Fetch(ctx context.Context, desc ociv1.Descriptor) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(f[desc.Digest])), nil
+}
+
+func (f memFetcher) add(t *testing.T, l layer) ociv1.Descriptor {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range l {
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if content == "symlink" {
+			hdr = &tar.Header{Name: name, Linkname: "../usr/lib/os-release", Typeflag: tar.TypeSymlink}
+		}
+		err := tw.WriteHeader(hdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			_, err = tw.Write([]byte(content))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dgst := digest.FromBytes(buf.Bytes())
+	f[dgst] = buf.Bytes()
+	return ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: dgst, Size: int64(buf.Len())}
+}
+
+func TestScan(t *testing.T) {
+	var (
+		debian = Distro{ID: "debian", VersionID: "12"}
+		curl   = Package{Type: PackageTypeDeb, Name: "curl", Version: "7.88.1-10+deb12u5", Arch: "amd64", Distro: debian}
+		libssl = Package{Type: PackageTypeDeb, Name: "libssl3", Version: "3.0.11-1~deb12u2", Arch: "amd64", Source: "openssl", SourceVersion: "3.0.11-1~deb12u2", Distro: debian}
+	)
+
+	zstd := ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerZstd, Digest: digest.FromString("zstd")}
+
+	tests := []struct {
+		Name        string
+		Layers      []layer
+		Unsupported []ociv1.Descriptor
+		Expectation *Inventory
+	}{
+		{
+			Name: "debian",
+			Layers: []layer{
+				{"usr/lib/os-release": debianOSRelease, "etc/os-release": "symlink"},
+				{"./var/lib/dpkg/status": dpkgStatusContent},
+			},
+			Expectation: &Inventory{Distro: debian, Packages: []Package{curl, libssl}},
+		},
+		{
+			Name: "alpine",
+			Layers: []layer{{
+				"etc/os-release":       "ID=alpine\nVERSION_ID=3.18.4\n",
+				"lib/apk/db/installed": "C:Q1abc=\nP:musl\nV:1.2.4-r2\nA:x86_64\no:musl\n\nP:libcrypto3\nV:3.1.4-r1\nA:x86_64\no:openssl\n",
+			}},
+			Expectation: &Inventory{
+				Distro: Distro{ID: "alpine", VersionID: "3.18.4"},
+				Packages: []Package{
+					{Type: PackageTypeAPK, Name: "libcrypto3", Version: "3.1.4-r1", Arch: "x86_64", Source: "openssl", Distro: Distro{ID: "alpine", VersionID: "3.18.4"}},
+					{Type: PackageTypeAPK, Name: "musl", Version: "1.2.4-r2", Arch: "x86_64", Distro: Distro{ID: "alpine", VersionID: "3.18.4"}},
+				},
+			},
+		},
+		{
+			Name: "whiteout",
+			Layers: []layer{
+				{"etc/os-release": debianOSRelease, "var/lib/dpkg/status": dpkgStatusContent},
+				{"var/lib/dpkg/.wh.status": ""},
+			},
+			Expectation: &Inventory{Distro: debian},
+		},
+		{
+			Name: "opaque directory",
+			Layers: []layer{
+				{"etc/os-release": debianOSRelease, "var/lib/dpkg/status": dpkgStatusContent},
+				{"var/lib/dpkg/.wh..wh..opq": "", "var/lib/dpkg/status.d/curl": dpkgStatusContent[:155]},
+			},
+			Expectation: &Inventory{Distro: debian, Packages: []Package{curl}},
+		},
+		{
+			Name: "unsupported layer",
+			Layers: []layer{
+				{"etc/os-release": debianOSRelease, "var/lib/dpkg/status": dpkgStatusContent},
+			},
+			Unsupported: []ociv1.Descriptor{zstd},
+			Expectation: &Inventory{Distro: debian, Packages: []Package{curl, libssl}, SkippedLayers: []digest.Digest{zstd.Digest}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fetcher := make(memFetcher)
+			var layers []ociv1.Descriptor
+			for _, l := range test.Layers {
+				layers = append(layers, fetcher.add(t, l))
+			}
+			layers = append(layers, test.Unsupported...)
+
+			act, err := Scan(context.Background(), fetcher, layers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("Scan() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	doc := &Document{
+		Image:   "registry/workspace@sha256:1234",
+		Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Inventory: &Inventory{
+			Distro: Distro{ID: "debian", VersionID: "12"},
+			Packages: []Package{
+				{Type: PackageTypeDeb, Name: "curl", Version: "7.88.1-10+deb12u5", Arch: "amd64", Distro: Distro{ID: "debian", VersionID: "12"}},
+				{Type: PackageTypeDeb, Name: "libssl3", Version: "1:3.0.11-1~deb12u2", Arch: "amd64", Source: "openssl", SourceVersion: "3.0.11", Distro: Distro{ID: "debian", VersionID: "12"}},
+			},
+		},
+	}
+
+	for mediaType, encode := range map[string]func(*Document) ([]byte, error){
+		MediaTypeSPDX:      EncodeSPDX,
+		MediaTypeCycloneDX: EncodeCycloneDX,
+	} {
+		t.Run(mediaType, func(t *testing.T) {
+			data, err := encode(doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act, err := Decode(mediaType, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(doc.Inventory, act); diff != "" {
+				t.Errorf("Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPURL(t *testing.T) {
+	pkg := Package{Type: PackageTypeDeb, Name: "libssl3", Version: "1:3.0.11-1~deb12u2+b1", Arch: "amd64", Source: "openssl", Distro: Distro{ID: "debian", VersionID: "12"}}
+	const expectation = "pkg:deb/debian/libssl3@1:3.0.11-1~deb12u2%2Bb1?arch=amd64&distro=debian-12&upstream=openssl"
+	if act := pkg.PURL(); act != expectation {
+		t.Errorf("unexpected purl: %s", act)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+const (
+	dpkgStatus        = "var/lib/dpkg/status"
+	dpkgStatusDir     = "var/lib/dpkg/status.d/"
+	apkInstalled      = "lib/apk/db/installed"
+	osRelease         = "etc/os-release"
+	osReleaseFallback = "usr/lib/os-release"
+
+	// maxDatabaseSize limits the size of the package databases we read from an image
+	maxDatabaseSize = 64 * 1024 * 1024
+
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+// Fetcher fetches the blobs of an image
+type Fetcher interface {
+	Fetch(ctx context.Context, desc ociv1.Descriptor) (io.ReadCloser, error)
+}
+
+// Inventory lists the packages installed in an image
+type Inventory struct {
+	Distro   Distro
+	Packages []Package
+	// SkippedLayers are the layers we could not scan because we don't support their media type, e.g. zstd.
+	// Packages installed or updated in those layers are missing from the inventory.
+	SkippedLayers []digest.Digest
+}
+
+// Scan lists the packages installed in an image by reading the package databases from its layers
+func Scan(ctx context.Context, fetcher Fetcher, layers []ociv1.Descriptor) (*Inventory, error) {
+	var (
+		files   = make(map[string][]byte)
+		skipped []digest.Digest
+	)
+	for _, l := range layers {
+		if !isSupportedLayer(l.MediaType) {
+			skipped = append(skipped, l.Digest)
+			continue
+		}
+		err := scanLayer(ctx, fetcher, l, files)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot scan layer %s: %w", l.Digest, err)
+		}
+	}
+	inv := inventoryFromFiles(files)
+	inv.SkippedLayers = skipped
+	return inv, nil
+}
+
+func isSupportedLayer(mediaType string) bool {
+	return strings.HasSuffix(mediaType, "gzip") || strings.HasSuffix(mediaType, "tar")
+}
+
+// scanLayer applies a layer to files, which holds the content of the package databases of the image
+func scanLayer(ctx context.Context, fetcher Fetcher, desc ociv1.Descriptor, files map[string][]byte) error {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var r io.Reader = rc
+	switch {
+	case strings.HasSuffix(desc.MediaType, "gzip"):
+		gr, err := gzip.NewReader(rc)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		r = gr
+	case strings.HasSuffix(desc.MediaType, "tar"):
+	default:
+		return xerrors.Errorf("unsupported layer media type %s", desc.MediaType)
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimPrefix(path.Clean("/"+hdr.Name), "/")
+		dir, base := path.Split(name)
+		if base == whiteoutOpaque {
+			for fn := range files {
+				if strings.HasPrefix(fn, dir) {
+					delete(files, fn)
+				}
+			}
+			continue
+		}
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			deleted := dir + strings.TrimPrefix(base, whiteoutPrefix)
+			for fn := range files {
+				if fn == deleted || strings.HasPrefix(fn, deleted+"/") {
+					delete(files, fn)
+				}
+			}
+			continue
+		}
+
+		if !isPackageDatabase(name) {
+			continue
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			// e.g. /etc/os-release is a symlink to /usr/lib/os-release in most images
+			delete(files, name)
+			continue
+		}
+		if hdr.Size > maxDatabaseSize {
+			return xerrors.Errorf("%s is too large: %d bytes", name, hdr.Size)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		files[name] = content
+	}
+}
+
+func isPackageDatabase(name string) bool {
+	switch name {
+	case dpkgStatus, apkInstalled, osRelease, osReleaseFallback:
+		return true
+	}
+	return strings.HasPrefix(name, dpkgStatusDir)
+}
+
+func inventoryFromFiles(files map[string][]byte) *Inventory {
+	res := &Inventory{}
+	if c, ok := files[osRelease]; ok {
+		res.Distro = parseOSRelease(c)
+	} else if c, ok := files[osReleaseFallback]; ok {
+		res.Distro = parseOSRelease(c)
+	}
+
+	var pkgs []Package
+	for fn, c := range files {
+		switch {
+		case fn == dpkgStatus, strings.HasPrefix(fn, dpkgStatusDir):
+			// distroless images have no status file, but one file per package in status.d
+			pkgs = append(pkgs, parseDpkgStatus(c)...)
+		case fn == apkInstalled:
+			pkgs = append(pkgs, parseAPKInstalled(c)...)
+		}
+	}
+	for i := range pkgs {
+		pkgs[i].Distro = res.Distro
+	}
+	sortPackages(pkgs)
+	res.Packages = pkgs
+
+	return res
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"strconv"
+	"strings"
+)
+
+// compareVersions compares two versions of a package of the given type.
+// Returns a negative number if a < b, zero if a == b and a positive number if a > b.
+func compareVersions(pkgType, a, b string) int {
+	if pkgType == PackageTypeAPK {
+		return compareAPKVersions(a, b)
+	}
+	return compareDebianVersions(a, b)
+}
+
+// compareDebianVersions compares [epoch:]upstream[-revision] versions the way dpkg does
+func compareDebianVersions(a, b string) int {
+	ea, ua, ra := splitDebianVersion(a)
+	eb, ub, rb := splitDebianVersion(b)
+	if ea != eb {
+		return ea - eb
+	}
+	if c := compareDebianPart(ua, ub); c != 0 {
+		return c
+	}
+	return compareDebianPart(ra, rb)
+}
+
+func splitDebianVersion(v string) (epoch int, upstream, revision string) {
+	if e, rest, ok := strings.Cut(v, ":"); ok {
+		epoch, _ = strconv.Atoi(e)
+		v = rest
+	}
+	if idx := strings.LastIndex(v, "-"); idx >= 0 {
+		return epoch, v[:idx], v[idx+1:]
+	}
+	return epoch, v, ""
+}
+
+// compareDebianPart compares alternating runs of non-digits and digits. Non-digits compare lexically,
+// except that letters sort before non-letters and ~ sorts before anything, even the end of the string.
+func compareDebianPart(a, b string) int {
+	order := func(s string, i int) int {
+		if i >= len(s) {
+			return 0
+		}
+		c := s[i]
+		switch {
+		case c == '~':
+			return -1
+		case c >= '0' && c <= '9':
+			return 0
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+			return int(c)
+		default:
+			return int(c) + 256
+		}
+	}
+	isDigit := func(s string, i int) bool { return i < len(s) && s[i] >= '0' && s[i] <= '9' }
+
+	var i, j int
+	for i < len(a) || j < len(b) {
+		for (i < len(a) && !isDigit(a, i)) || (j < len(b) && !isDigit(b, j)) {
+			oa, ob := order(a, i), order(b, j)
+			if oa != ob {
+				return oa - ob
+			}
+			i++
+			j++
+		}
+
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		var diff int
+		for isDigit(a, i) && isDigit(b, j) {
+			if diff == 0 {
+				diff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if isDigit(a, i) {
+			return 1
+		}
+		if isDigit(b, j) {
+			return -1
+		}
+		if diff != 0 {
+			return diff
+		}
+	}
+	return 0
+}
+
+// apkSuffixes orders the suffixes of apk versions relative to a version without suffix
+var apkSuffixes = map[string]int{
+	"alpha": -4,
+	"beta":  -3,
+	"pre":   -2,
+	"rc":    -1,
+	"cvs":   1,
+	"svn":   2,
+	"git":   3,
+	"hg":    4,
+	"p":     5,
+}
+
+// compareAPKVersions compares versions like 1.2.3a_rc1_p2-r4 the way apk does
+func compareAPKVersions(a, b string) int {
+	va, ra := splitAPKRelease(a)
+	vb, rb := splitAPKRelease(b)
+
+	sa, sb := strings.Split(va, "_"), strings.Split(vb, "_")
+	if c := compareAPKNumbers(sa[0], sb[0]); c != 0 {
+		return c
+	}
+	for i := 1; i < len(sa) || i < len(sb); i++ {
+		if c := compareAPKSuffix(apkSuffix(sa, i), apkSuffix(sb, i)); c != 0 {
+			return c
+		}
+	}
+	return ra - rb
+}
+
+func splitAPKRelease(v string) (version string, release int) {
+	if idx := strings.LastIndex(v, "-r"); idx >= 0 {
+		if r, err := strconv.Atoi(v[idx+2:]); err == nil {
+			return v[:idx], r
+		}
+	}
+	return v, 0
+}
+
+// compareAPKNumbers compares the dot separated numbers of a version. The last number may carry a letter.
+func compareAPKNumbers(a, b string) int {
+	na, nb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(na) && i < len(nb); i++ {
+		da, la := splitTrailingLetter(na[i])
+		db, lb := splitTrailingLetter(nb[i])
+		if da != db {
+			return da - db
+		}
+		if la != lb {
+			return strings.Compare(la, lb)
+		}
+	}
+	return len(na) - len(nb)
+}
+
+func splitTrailingLetter(s string) (int, string) {
+	idx := len(s)
+	for idx > 0 && (s[idx-1] < '0' || s[idx-1] > '9') {
+		idx--
+	}
+	n, _ := strconv.Atoi(s[:idx])
+	return n, s[idx:]
+}
+
+type apkVersionSuffix struct {
+	Weight int
+	Number int
+}
+
+func apkSuffix(segs []string, i int) apkVersionSuffix {
+	if i >= len(segs) {
+		return apkVersionSuffix{}
+	}
+	s := segs[i]
+	idx := strings.IndexAny(s, "0123456789")
+	if idx < 0 {
+		idx = len(s)
+	}
+	n, _ := strconv.Atoi(s[idx:])
+	return apkVersionSuffix{Weight: apkSuffixes[s[:idx]], Number: n}
+}
+
+func compareAPKSuffix(a, b apkVersionSuffix) int {
+	if a.Weight != b.Weight {
+		return a.Weight - b.Weight
+	}
+	return a.Number - b.Number
+}
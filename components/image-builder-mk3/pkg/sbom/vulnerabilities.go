@@ -0,0 +1,404 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Severity rates how bad a vulnerability is
+type Severity int
+
+const (
+	SeverityUnknown Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSeverity parses the severity ratings used by the OSV databases of the distributions
+func ParseSeverity(s string) Severity {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "negligible", "unimportant", "low":
+		return SeverityLow
+	case "medium", "moderate":
+		return SeverityMedium
+	case "high", "important":
+		return SeverityHigh
+	case "critical":
+		return SeverityCritical
+	default:
+		return SeverityUnknown
+	}
+}
+
+// Vulnerability is a vulnerability of an installed package
+type Vulnerability struct {
+	ID       string
+	Aliases  []string
+	Summary  string
+	Severity Severity
+	Package  Package
+	// FixedVersion is the first version of the package which is not affected. Empty if there's no fix yet.
+	FixedVersion string
+}
+
+// Database is an offline vulnerability database of OSV records (https://ossf.github.io/osv-schema/)
+type Database struct {
+	// entries indexes the records by the ecosystem and name of the affected packages
+	entries map[string][]*osvEntry
+}
+
+type osvEntry struct {
+	ID       string   `json:"id"`
+	Aliases  []string `json:"aliases"`
+	Summary  string   `json:"summary"`
+	Severity []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	Affected         []osvAffected          `json:"affected"`
+	DatabaseSpecific map[string]interface{} `json:"database_specific"`
+}
+
+type osvAffected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	Ranges []struct {
+		Type   string              `json:"type"`
+		Events []map[string]string `json:"events"`
+	} `json:"ranges"`
+	Versions          []string               `json:"versions"`
+	EcosystemSpecific map[string]interface{} `json:"ecosystem_specific"`
+	DatabaseSpecific  map[string]interface{} `json:"database_specific"`
+}
+
+// LoadDatabase reads the OSV records in dir. Records are either JSON files, or JSON files in zip archives.
+func LoadDatabase(dir string) (*Database, error) {
+	db := &Database{entries: make(map[string][]*osvEntry)}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		switch filepath.Ext(path) {
+		case ".json":
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return db.add(path, f)
+		case ".zip":
+			zr, err := zip.OpenReader(path)
+			if err != nil {
+				return xerrors.Errorf("cannot open %s: %w", path, err)
+			}
+			defer zr.Close()
+			for _, f := range zr.File {
+				if filepath.Ext(f.Name) != ".json" {
+					continue
+				}
+				r, err := f.Open()
+				if err != nil {
+					return xerrors.Errorf("cannot open %s in %s: %w", f.Name, path, err)
+				}
+				err = db.add(path+"/"+f.Name, r)
+				r.Close()
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot load vulnerability database: %w", err)
+	}
+	return db, nil
+}
+
+func (db *Database) add(name string, r io.Reader) error {
+	var entry osvEntry
+	err := json.NewDecoder(r).Decode(&entry)
+	if err != nil {
+		return xerrors.Errorf("cannot decode %s: %w", name, err)
+	}
+	// a record commonly affects the same package in several releases of a distribution
+	keys := make(map[string]struct{})
+	for _, a := range entry.Affected {
+		keys[databaseKey(ecosystemName(a.Package.Ecosystem), a.Package.Name)] = struct{}{}
+	}
+	for key := range keys {
+		db.entries[key] = append(db.entries[key], &entry)
+	}
+	return nil
+}
+
+// Len returns the number of records in the database
+func (db *Database) Len() int {
+	ids := make(map[string]struct{})
+	for _, entries := range db.entries {
+		for _, e := range entries {
+			ids[e.ID] = struct{}{}
+		}
+	}
+	return len(ids)
+}
+
+func databaseKey(ecosystem, name string) string {
+	return ecosystem + "/" + name
+}
+
+// ecosystemName strips the release from an ecosystem, e.g. Debian:12 becomes Debian
+func ecosystemName(ecosystem string) string {
+	name, _, _ := strings.Cut(ecosystem, ":")
+	return name
+}
+
+// Match lists the vulnerabilities of the packages
+func (db *Database) Match(pkgs []Package) []Vulnerability {
+	var res []Vulnerability
+	for _, pkg := range pkgs {
+		ecosystem := pkg.Distro.Ecosystem()
+		if ecosystem == "" {
+			continue
+		}
+		name, version := pkg.Name, pkg.Version
+		if pkg.Source != "" {
+			name = pkg.Source
+		}
+		if pkg.SourceVersion != "" {
+			version = pkg.SourceVersion
+		}
+
+		for _, entry := range db.entries[databaseKey(ecosystemName(ecosystem), name)] {
+			for _, a := range entry.Affected {
+				if a.Package.Name != name || (a.Package.Ecosystem != ecosystem && !strings.HasPrefix(a.Package.Ecosystem, ecosystem+":")) {
+					continue
+				}
+				affected, fixed := a.affects(pkg.Type, version)
+				if !affected {
+					continue
+				}
+				res = append(res, Vulnerability{
+					ID:           entry.ID,
+					Aliases:      entry.Aliases,
+					Summary:      entry.Summary,
+					Severity:     entry.severity(a),
+					Package:      pkg,
+					FixedVersion: fixed,
+				})
+				break
+			}
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Severity > res[j].Severity })
+	return res
+}
+
+// affects determines if version is affected, and if so which version fixes the vulnerability
+func (a osvAffected) affects(pkgType, version string) (affected bool, fixed string) {
+	for _, v := range a.Versions {
+		if v == version {
+			affected = true
+		}
+	}
+
+	for _, r := range a.Ranges {
+		if r.Type != "ECOSYSTEM" {
+			continue
+		}
+
+		events := make([]map[string]string, len(r.Events))
+		copy(events, r.Events)
+		eventVersion := func(e map[string]string) string {
+			for _, v := range e {
+				return v
+			}
+			return ""
+		}
+		sort.SliceStable(events, func(i, j int) bool {
+			vi, vj := eventVersion(events[i]), eventVersion(events[j])
+			if vi == "0" || vj == "0" {
+				return vi == "0" && vj != "0"
+			}
+			return compareVersions(pkgType, vi, vj) < 0
+		})
+
+		var inRange bool
+		for _, e := range events {
+			switch {
+			case e["introduced"] != "":
+				if v := e["introduced"]; v == "0" || compareVersions(pkgType, version, v) >= 0 {
+					inRange = true
+				}
+			case e["fixed"] != "":
+				if compareVersions(pkgType, version, e["fixed"]) >= 0 {
+					inRange = false
+				} else if inRange && fixed == "" {
+					fixed = e["fixed"]
+				}
+			case e["last_affected"] != "":
+				if compareVersions(pkgType, version, e["last_affected"]) > 0 {
+					inRange = false
+				}
+			}
+		}
+		if inRange {
+			affected = true
+		}
+	}
+	if !affected {
+		fixed = ""
+	}
+	return
+}
+
+// severity rates the vulnerability. The distributions' databases rate vulnerabilities differently: Debian
+// has an urgency per package, Ubuntu a priority per record and others CVSS vectors only.
+func (e *osvEntry) severity(a osvAffected) Severity {
+	for _, m := range []map[string]interface{}{a.EcosystemSpecific, a.DatabaseSpecific, e.DatabaseSpecific} {
+		for _, key := range []string{"urgency", "severity"} {
+			if s, ok := m[key].(string); ok {
+				if sev := ParseSeverity(strings.TrimSuffix(s, "*")); sev != SeverityUnknown {
+					return sev
+				}
+			}
+		}
+	}
+
+	var res Severity
+	for _, s := range e.Severity {
+		var sev Severity
+		switch s.Type {
+		case "CVSS_V3":
+			sev = cvssSeverity(cvss3BaseScore(s.Score))
+		default:
+			sev = ParseSeverity(s.Score)
+		}
+		if sev > res {
+			res = sev
+		}
+	}
+	return res
+}
+
+// cvss3BaseScore computes the base score of a CVSS 3.x vector, e.g. CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H.
+// Returns -1 if the vector is invalid.
+func cvss3BaseScore(vector string) float64 {
+	metrics := make(map[string]string)
+	for _, seg := range strings.Split(vector, "/") {
+		k, v, _ := strings.Cut(seg, ":")
+		metrics[k] = v
+	}
+
+	weights := map[string]map[string]float64{
+		"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+		"AC": {"L": 0.77, "H": 0.44},
+		"UI": {"N": 0.85, "R": 0.62},
+		"C":  {"H": 0.56, "L": 0.22, "N": 0},
+		"I":  {"H": 0.56, "L": 0.22, "N": 0},
+		"A":  {"H": 0.56, "L": 0.22, "N": 0},
+	}
+	w := make(map[string]float64)
+	for m, values := range weights {
+		v, ok := values[metrics[m]]
+		if !ok {
+			return -1
+		}
+		w[m] = v
+	}
+
+	scopeChanged := metrics["S"] == "C"
+	if !scopeChanged && metrics["S"] != "U" {
+		return -1
+	}
+	switch metrics["PR"] {
+	case "N":
+		w["PR"] = 0.85
+	case "L":
+		w["PR"] = 0.62
+		if scopeChanged {
+			w["PR"] = 0.68
+		}
+	case "H":
+		w["PR"] = 0.27
+		if scopeChanged {
+			w["PR"] = 0.5
+		}
+	default:
+		return -1
+	}
+
+	iss := 1 - (1-w["C"])*(1-w["I"])*(1-w["A"])
+	impact := 6.42 * iss
+	if scopeChanged {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0
+	}
+	exploitability := 8.22 * w["AV"] * w["AC"] * w["PR"] * w["UI"]
+	if scopeChanged {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10))
+	}
+	return roundUp(math.Min(impact+exploitability, 10))
+}
+
+// roundUp rounds up to one decimal, as defined by CVSS 3.1
+func roundUp(v float64) float64 {
+	i := int64(math.Round(v * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return float64(i/10000+1) / 10
+}
+
+func cvssSeverity(score float64) Severity {
+	switch {
+	case score >= 9:
+		return SeverityCritical
+	case score >= 7:
+		return SeverityHigh
+	case score >= 4:
+		return SeverityMedium
+	case score > 0:
+		return SeverityLow
+	default:
+		return SeverityUnknown
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		Type        string
+		A, B        string
+		Expectation int
+	}{
+		{PackageTypeDeb, "1.0", "1.0", 0},
+		{PackageTypeDeb, "1.0", "1.1", -1},
+		{PackageTypeDeb, "1.10", "1.9", 1},
+		{PackageTypeDeb, "1:1.0", "2.0", 1},
+		{PackageTypeDeb, "1.0~rc1", "1.0", -1},
+		{PackageTypeDeb, "1.0-1", "1.0-1+deb12u1", -1},
+		{PackageTypeDeb, "7.88.1-10+deb12u5", "7.88.1-10+deb12u10", -1},
+		{PackageTypeDeb, "1.0a", "1.0+", -1},
+		{PackageTypeAPK, "1.2.4-r2", "1.2.4-r10", -1},
+		{PackageTypeAPK, "1.2.4", "1.2.4.1", -1},
+		{PackageTypeAPK, "1.2.4_rc1", "1.2.4", -1},
+		{PackageTypeAPK, "1.2.4_p1", "1.2.4", 1},
+		{PackageTypeAPK, "1.2.4a", "1.2.4b", -1},
+		{PackageTypeAPK, "3.1.4-r1", "3.1.4-r1", 0},
+	}
+	for _, test := range tests {
+		act := compareVersions(test.Type, test.A, test.B)
+		if (act < 0 && test.Expectation >= 0) || (act > 0 && test.Expectation <= 0) || (act == 0 && test.Expectation != 0) {
+			t.Errorf("compareVersions(%s, %s, %s) = %d, expected sign of %d", test.Type, test.A, test.B, act, test.Expectation)
+		}
+	}
+}
+
+func TestCVSS3BaseScore(t *testing.T) {
+	tests := map[string]float64{
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": 9.8,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N": 6.1,
+		"CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N": 5.5,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N": 0,
+		"CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": -1,
+	}
+	for vector, expectation := range tests {
+		if act := cvss3BaseScore(vector); act != expectation {
+			t.Errorf("cvss3BaseScore(%s) = %v, expected %v", vector, act, expectation)
+		}
+	}
+}
+
+func TestDatabaseMatch(t *testing.T) {
+	const (
+		debianRecord = `{
+			"id": "DSA-1234-1",
+			"aliases": ["CVE-2023-0001"],
+			"summary": "curl - security update",
+			"affected": [
+				{"package": {"ecosystem": "Debian:11", "name": "curl"}, "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "7.74.0-1.3+deb11u9"}]}], "ecosystem_specific": {"urgency": "medium"}},
+				{"package": {"ecosystem": "Debian:12", "name": "curl"}, "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "7.88.1-10+deb12u6"}]}], "ecosystem_specific": {"urgency": "high"}}
+			]
+		}`
+		opensslRecord = `{
+			"id": "DSA-5678-1",
+			"affected": [
+				{"package": {"ecosystem": "Debian:12", "name": "openssl"}, "ranges": [{"type": "ECOSYSTEM", "events": [{"fixed": "3.0.10-1"}, {"introduced": "0"}]}]}
+			]
+		}`
+		alpineRecord = `{
+			"id": "ALPINE-CVE-2023-0002",
+			"severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}],
+			"affected": [
+				{"package": {"ecosystem": "Alpine:v3.18", "name": "openssl"}, "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "3.1.0-r0"}, {"last_affected": "3.1.4-r1"}]}]}
+			]
+		}`
+	)
+
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "DSA-1234-1.json"), []byte(debianRecord), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(filepath.Join(dir, "all.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for name, content := range map[string]string{"DSA-5678-1.json": opensslRecord, "ALPINE-CVE-2023-0002.json": alpineRecord} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.Write([]byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	db, err := LoadDatabase(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Len() != 3 {
+		t.Errorf("unexpected number of records: %d", db.Len())
+	}
+
+	var (
+		bookworm = Distro{ID: "debian", VersionID: "12"}
+		alpine   = Distro{ID: "alpine", VersionID: "3.18.4"}
+		curl     = Package{Type: PackageTypeDeb, Name: "curl", Version: "7.88.1-10+deb12u5", Distro: bookworm}
+		libssl   = Package{Type: PackageTypeDeb, Name: "libssl3", Version: "3.0.11-1~deb12u2", Source: "openssl", Distro: bookworm}
+		crypto   = Package{Type: PackageTypeAPK, Name: "libcrypto3", Version: "3.1.4-r1", Source: "openssl", Distro: alpine}
+		musl     = Package{Type: PackageTypeAPK, Name: "musl", Version: "1.2.4-r2", Distro: alpine}
+	)
+	act := db.Match([]Package{curl, libssl, crypto, musl})
+	expectation := []Vulnerability{
+		{ID: "ALPINE-CVE-2023-0002", Severity: SeverityCritical, Package: crypto},
+		{ID: "DSA-1234-1", Aliases: []string{"CVE-2023-0001"}, Summary: "curl - security update", Severity: SeverityHigh, Package: curl, FixedVersion: "7.88.1-10+deb12u6"},
+	}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("Match() mismatch (-want +got):\n%s", diff)
+	}
+}
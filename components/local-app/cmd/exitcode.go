@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of the gitpod CLI. Scripts rely on them, hence they must not change.
+const (
+	// ExitCodeOK means the command succeeded
+	ExitCodeOK = 0
+	// ExitCodeError means the command failed for a reason not covered by any other exit code
+	ExitCodeError = 1
+	// ExitCodeUsage means the command was invoked incorrectly, e.g. with an unknown flag or the wrong number of arguments
+	ExitCodeUsage = 2
+	// ExitCodeAuth means the user is not logged in, or is not allowed to perform the operation
+	ExitCodeAuth = 3
+	// ExitCodeNotFound means a resource, e.g. a workspace, does not exist
+	ExitCodeNotFound = 4
+	// ExitCodeUnavailable means Gitpod could not be reached or did not answer in time. Retrying may help.
+	ExitCodeUnavailable = 5
+)
+
+// exitCodeError attaches an exit code to an error
+type exitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *exitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// usageError marks an error as caused by an incorrect invocation
+func usageError(err error) error {
+	return &exitCodeError{Code: ExitCodeUsage, Err: err}
+}
+
+// authError marks an error as caused by missing or insufficient credentials
+func authError(err error) error {
+	return &exitCodeError{Code: ExitCodeAuth, Err: err}
+}
+
+// exitCode determines the exit code of the CLI for the error returned by a command
+func exitCode(err error) int {
+	if err == nil {
+		return ExitCodeOK
+	}
+
+	if ee := new(exitCodeError); errors.As(err, &ee) {
+		return ee.Code
+	}
+	if errors.Is(err, config.ErrNoContext) {
+		return ExitCodeAuth
+	}
+	if ce := new(connect.Error); errors.As(err, &ce) {
+		switch ce.Code() {
+		case connect.CodeUnauthenticated, connect.CodePermissionDenied:
+			return ExitCodeAuth
+		case connect.CodeNotFound:
+			return ExitCodeNotFound
+		case connect.CodeUnavailable, connect.CodeDeadlineExceeded:
+			return ExitCodeUnavailable
+		}
+		return ExitCodeError
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ExitCodeUnavailable
+	}
+	var (
+		urlErr *url.Error
+		netErr net.Error
+	)
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return ExitCodeUnavailable
+	}
+	// cobra does not offer a typed error for unknown commands
+	if strings.HasPrefix(err.Error(), "unknown command ") {
+		return ExitCodeUsage
+	}
+
+	return ExitCodeError
+}
+
+// markArgsErrors marks the errors of all argument validators as usage errors
+func markArgsErrors(cmd *cobra.Command) {
+	if args := cmd.Args; args != nil {
+		cmd.Args = func(cmd *cobra.Command, a []string) error {
+			err := args(cmd, a)
+			if err != nil {
+				return usageError(err)
+			}
+			return nil
+		}
+	}
+	for _, c := range cmd.Commands() {
+		markArgsErrors(c)
+	}
+}
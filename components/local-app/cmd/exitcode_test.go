@@ -0,0 +1,65 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
+	"github.com/spf13/cobra"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Err         error
+		Expectation int
+	}{
+		{Name: "no error", Err: nil, Expectation: ExitCodeOK},
+		{Name: "generic error", Err: errors.New("boom"), Expectation: ExitCodeError},
+		{Name: "usage error", Err: usageError(errors.New("unknown flag: --foo")), Expectation: ExitCodeUsage},
+		{Name: "unknown command", Err: errors.New(`unknown command "foo" for "gitpod"`), Expectation: ExitCodeUsage},
+		{Name: "no context", Err: config.ErrNoContext, Expectation: ExitCodeAuth},
+		{Name: "wrapped no context", Err: fmt.Errorf("cannot list workspaces: %w", config.ErrNoContext), Expectation: ExitCodeAuth},
+		{Name: "auth error with resolution", Err: authError(prettyprint.AddResolution(errors.New("no token"), "login")), Expectation: ExitCodeAuth},
+		{Name: "unauthenticated", Err: connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated")), Expectation: ExitCodeAuth},
+		{Name: "permission denied", Err: connect.NewError(connect.CodePermissionDenied, errors.New("denied")), Expectation: ExitCodeAuth},
+		{Name: "not found", Err: connect.NewError(connect.CodeNotFound, errors.New("not found")), Expectation: ExitCodeNotFound},
+		{Name: "unavailable", Err: connect.NewError(connect.CodeUnavailable, errors.New("unavailable")), Expectation: ExitCodeUnavailable},
+		{Name: "other connect error", Err: connect.NewError(connect.CodeInternal, errors.New("internal")), Expectation: ExitCodeError},
+		{Name: "deadline exceeded", Err: fmt.Errorf("cannot start workspace: %w", context.DeadlineExceeded), Expectation: ExitCodeUnavailable},
+		{Name: "network error", Err: &url.Error{Op: "Get", URL: "https://api.gitpod.io", Err: errors.New("connection refused")}, Expectation: ExitCodeUnavailable},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := exitCode(test.Err)
+			if act != test.Expectation {
+				t.Errorf("expected exit code %d, got %d", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestMarkArgsErrors(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	child := &cobra.Command{Use: "child", Args: cobra.ExactArgs(1)}
+	root.AddCommand(child)
+	markArgsErrors(root)
+
+	err := child.Args(child, nil)
+	if act := exitCode(err); act != ExitCodeUsage {
+		t.Errorf("expected exit code %d, got %d", ExitCodeUsage, act)
+	}
+	err = child.Args(child, []string{"foo"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -30,8 +30,12 @@ import (
 var rootOpts struct {
 	ConfigLocation string
 	Verbose        bool
+	Output         string
 }
 
+// rootOutput is the parsed value of the --output flag
+var rootOutput prettyprint.Output
+
 var rootCmd = &cobra.Command{
 	Use:   "gitpod",
 	Short: "Gitpod: Always ready to code.",
@@ -51,16 +55,27 @@ var rootCmd = &cobra.Command{
 	`),
 	SilenceErrors: true,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		output, err := prettyprint.ParseOutput(rootOpts.Output)
+		if err != nil {
+			return usageError(err)
+		}
+		rootOutput = output
+
 		level := slog.LevelInfo
 		if rootOpts.Verbose {
 			level = slog.LevelDebug
 		}
+		// stdout carries the SSH connection of the proxy command and machine-readable output, hence they log to stderr
+		logOut := os.Stdout
+		if isSSHProxyCommand(cmd) || rootOutput.Kind != prettyprint.OutputTable {
+			logOut = os.Stderr
+		}
 		var noColor bool
-		if !isatty.IsTerminal(os.Stdout.Fd()) {
+		if !isatty.IsTerminal(logOut.Fd()) {
 			noColor = true
 			color.Disable()
 		}
-		slog.SetDefault(slog.New(tint.NewHandler(os.Stdout, &tint.Options{
+		slog.SetDefault(slog.New(tint.NewHandler(logOut, &tint.Options{
 			Level:      level,
 			NoColor:    noColor,
 			TimeFormat: time.StampMilli,
@@ -102,7 +117,7 @@ var rootCmd = &cobra.Command{
 		telemetry.Init(telemetryEnabled, cfg.Telemetry.Identity, constants.Version.String(), level, host)
 		telemetry.RecordCommand(cmd)
 
-		if !isVersionCommand(cmd) {
+		if !isVersionCommand(cmd) && !isSSHProxyCommand(cmd) {
 			waitForUpdate := selfupdate.Autoupdate(cmd.Context(), cfg)
 			cmd.PostRunE = func(cmd *cobra.Command, args []string) error {
 				waitForUpdate()
@@ -115,18 +130,18 @@ var rootCmd = &cobra.Command{
 }
 
 func Execute() {
+	markArgsErrors(rootCmd)
 	err := rootCmd.Execute()
 
-	var exitCode int
+	code := exitCode(err)
 	if err != nil {
-		exitCode = 1
 		prettyprint.PrintError(os.Stderr, os.Args[0], err)
 
 		telemetry.RecordError(err)
 	}
 
 	telemetry.Close()
-	os.Exit(exitCode)
+	os.Exit(code)
 }
 
 func init() {
@@ -140,6 +155,10 @@ func init() {
 	}
 	rootCmd.PersistentFlags().StringVar(&rootOpts.ConfigLocation, "config", configLocation, "Location of the configuration file")
 	rootCmd.PersistentFlags().BoolVarP(&rootOpts.Verbose, "verbose", "v", false, "Display verbose output for more detailed logging")
+	rootCmd.PersistentFlags().StringVarP(&rootOpts.Output, "output", "o", "", "Output format for scripts: json, yaml or template=<go-template>. Defaults to human-readable tables")
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return usageError(err)
+	})
 }
 
 var rootTestingOpts struct {
@@ -162,11 +181,11 @@ func getGitpodClient(ctx context.Context) (*client.Gitpod, error) {
 
 	host := gpctx.Host
 	if host == nil {
-		return nil, prettyprint.AddResolution(fmt.Errorf("active context has no host configured"),
+		return nil, authError(prettyprint.AddResolution(fmt.Errorf("active context has no host configured"),
 			"set a host using `gitpod config set-context --current --host <host>`",
 			"login again using `gitpod login`",
 			"change to a different context using `gitpod config use-context <context>`",
-		)
+		))
 	}
 
 	if rootTestingOpts.Client != nil {
@@ -185,12 +204,12 @@ func getGitpodClient(ctx context.Context) (*client.Gitpod, error) {
 		}
 	}
 	if token == "" {
-		return nil, prettyprint.AddResolution(fmt.Errorf("no token found for active context"),
+		return nil, authError(prettyprint.AddResolution(fmt.Errorf("no token found for active context"),
 			"provide a token by setting the GITPOD_TOKEN environment variable",
 			"login again using `gitpod login`",
 			"change to a different context using `gitpod config use-context <context>`",
 			"set a token explicitly using `gitpod config set-context --current --token <token>`",
-		)
+		))
 	}
 
 	var apiHost = *gpctx.Host.URL
@@ -217,16 +236,39 @@ type formatOpts struct {
 
 // WriteTabular writes the given tabular data to the writer
 func WriteTabular[T any](v []T, opts formatOpts, format prettyprint.WriterFormat) error {
+	w, err := newWriter[T](opts, format)
+	if err != nil {
+		return err
+	}
+	return w.Write(v)
+}
+
+// newStreamWriter returns a writer which writes one row at a time, e.g. when watching for changes
+func newStreamWriter[T any](opts formatOpts, format prettyprint.WriterFormat) (*prettyprint.StreamWriter[T], error) {
+	w, err := newWriter[T](opts, format)
+	if err != nil {
+		return nil, err
+	}
+	return &prettyprint.StreamWriter[T]{Writer: *w}, nil
+}
+
+func newWriter[T any](opts formatOpts, format prettyprint.WriterFormat) (*prettyprint.Writer[T], error) {
+	if opts.Field != "" && rootOutput.Kind != prettyprint.OutputTable {
+		return nil, usageError(prettyprint.AddResolution(fmt.Errorf("--field cannot be combined with --output"),
+			"use either --field or --output",
+		))
+	}
+
 	var out io.Writer = os.Stdout
 	if rootTestingOpts.WriterOut != nil {
 		out = rootTestingOpts.WriterOut
 	}
-	w := &prettyprint.Writer[T]{
+	return &prettyprint.Writer[T]{
 		Field:  opts.Field,
 		Format: format,
+		Output: rootOutput,
 		Out:    out,
-	}
-	return w.Write(v)
+	}, nil
 }
 
 func addFormatFlags(cmd *cobra.Command, opts *formatOpts) {
@@ -19,6 +19,8 @@ import (
 	"github.com/gitpod-io/local-app/pkg/config"
 	"github.com/gitpod-io/local-app/pkg/prettyprint"
 	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type CommandTest struct {
@@ -97,6 +99,7 @@ func RunCommandTests(t *testing.T, tests []CommandTest) {
 			rootCmd.SetOut(actual)
 			rootCmd.SetErr(actual)
 			rootTestingOpts.WriterOut = actual
+			resetFlags(rootCmd)
 			rootOpts.ConfigLocation = cfgfn.Name()
 			err = rootCmd.Execute()
 
@@ -119,6 +122,22 @@ func RunCommandTests(t *testing.T, tests []CommandTest) {
 	}
 }
 
+// resetFlags resets the flags of all commands to their defaults, because the commands are shared between tests
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if !f.Changed {
+			return
+		}
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	}
+	cmd.PersistentFlags().VisitAll(reset)
+	cmd.Flags().VisitAll(reset)
+	for _, c := range cmd.Commands() {
+		resetFlags(c)
+	}
+}
+
 // fixtureWorkspace returns a workspace fixture
 func fixtureWorkspace() *v1.Workspace {
 	return &v1.Workspace{
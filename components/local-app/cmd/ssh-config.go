@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/gitpod-io/local-app/pkg/helper"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
+	"github.com/gitpod-io/local-app/pkg/sshconfig"
+	"github.com/spf13/cobra"
+)
+
+var sshConfigOpts struct {
+	Path      string
+	NoInclude bool
+}
+
+// sshConfigCmd writes ssh_config entries for the user's workspaces
+var sshConfigCmd = &cobra.Command{
+	Use:   "ssh-config",
+	Short: "Writes SSH config entries for your workspaces",
+	Long: `Writes SSH config entries for your workspaces, so that plain ssh, VS Code Remote-SSH, JetBrains Gateway and other SSH clients can connect to them.
+
+Every workspace gets an entry named <workspace-id>.gitpod. Stopped workspaces are started when you connect to them.
+The entries are written to a separate file which is included from ~/.ssh/config. Run this command again to pick up new workspaces.`,
+	Example: `  # write the SSH config and connect to a workspace
+  $ gitpod ssh-config
+  $ ssh <workspace-id>.gitpod`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+		defer cancel()
+
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		var (
+			sshDir = filepath.Join(home, ".ssh")
+			fn     = sshConfigOpts.Path
+		)
+		if fn == "" {
+			fn = filepath.Join(sshDir, "gitpod", "config")
+		}
+		privateKeyFN, _, err := sshconfig.EnsureKey(filepath.Dir(fn))
+		if err != nil {
+			return fmt.Errorf("cannot create SSH key: %w", err)
+		}
+
+		exe, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		proxyCommand := []string{exe}
+		if rootOpts.ConfigLocation != config.DEFAULT_LOCATION {
+			proxyCommand = append(proxyCommand, "--config", rootOpts.ConfigLocation)
+		}
+
+		gitpod, err := getGitpodClient(ctx)
+		if err != nil {
+			return err
+		}
+		gpctx, err := config.FromContext(ctx).GetActiveContext()
+		if err != nil {
+			return err
+		}
+		workspaces, err := gitpod.Workspaces.ListWorkspaces(ctx, connect.NewRequest(&v1.ListWorkspacesRequest{
+			OrganizationId: gpctx.OrganizationID,
+		}))
+		if err != nil {
+			return err
+		}
+
+		hosts := make([]sshconfig.Host, 0, len(workspaces.Msg.GetResult()))
+		for _, ws := range workspaces.Msg.GetResult() {
+			hosts = append(hosts, sshconfig.Host{
+				WorkspaceID:  ws.WorkspaceId,
+				HostName:     helper.WorkspaceSSHHost(ws),
+				ProxyCommand: slices.Concat(proxyCommand, []string{"workspace", "ssh-proxy", ws.WorkspaceId, "--public-key", privateKeyFN + ".pub"}),
+				IdentityFile: privateKeyFN,
+			})
+		}
+		err = os.WriteFile(fn, []byte(sshconfig.Render(hosts)), 0600)
+		if err != nil {
+			return fmt.Errorf("cannot write SSH config: %w", err)
+		}
+		slog.Info("wrote SSH config", "path", fn, "workspaces", len(hosts))
+
+		if !sshConfigOpts.NoInclude {
+			sshConfigFN := filepath.Join(sshDir, "config")
+			changed, err := sshconfig.EnsureInclude(sshConfigFN, fn)
+			if err != nil {
+				return fmt.Errorf("cannot include Gitpod SSH config in %s: %w", sshConfigFN, err)
+			}
+			if changed {
+				slog.Info("included Gitpod SSH config", "path", sshConfigFN)
+			}
+		}
+
+		if rootOutput.Kind != prettyprint.OutputTable {
+			res := make([]tabularSSHHost, 0, len(hosts))
+			for _, h := range hosts {
+				res = append(res, tabularSSHHost{WorkspaceID: h.WorkspaceID, Host: h.WorkspaceID + sshconfig.HostSuffix})
+			}
+			return WriteTabular(res, formatOpts{}, prettyprint.WriterFormatWide)
+		}
+		if len(hosts) > 0 {
+			slog.Info(fmt.Sprintf("connect to a workspace using `ssh %s%s`", hosts[0].WorkspaceID, sshconfig.HostSuffix))
+		}
+		return nil
+	},
+}
+
+type tabularSSHHost struct {
+	WorkspaceID string `print:"workspace id"`
+	Host        string `print:"host"`
+}
+
+func init() {
+	rootCmd.AddCommand(sshConfigCmd)
+	sshConfigCmd.Flags().StringVar(&sshConfigOpts.Path, "path", "", "file to write the SSH config entries to (defaults to ~/.ssh/gitpod/config)")
+	sshConfigCmd.Flags().BoolVar(&sshConfigOpts.NoInclude, "no-include", false, "don't include the SSH config entries from ~/.ssh/config")
+}
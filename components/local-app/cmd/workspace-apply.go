@@ -0,0 +1,263 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/components/public-api/go/client"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/v1"
+	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/gitpod-io/local-app/pkg/manifest"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
+	"github.com/spf13/cobra"
+)
+
+var workspaceApplyOpts struct {
+	File     string
+	DryRun   bool
+	Diff     bool
+	Prune    bool
+	Recreate bool
+}
+
+// workspaceApplyCmd creates, starts, stops and deletes workspaces so that they match a manifest
+var workspaceApplyCmd = &cobra.Command{
+	Use:   "apply -f <manifest>",
+	Short: "Creates, starts and stops workspaces so that they match a manifest",
+	Long: `Creates, starts and stops workspaces so that they match a manifest. Applying the same manifest again changes nothing.
+
+A manifest describes a set of workspaces in YAML:
+
+  name: onboarding                  # identifies the workspaces managed by this manifest
+  workspaces:
+    - name: backend                 # identifies the workspace within the manifest
+      contextUrl: https://github.com/gitpod-io/gitpod
+      class: g1-large               # optional, defaults to the class of the organization
+      editor: code                  # optional, defaults to your preferred editor
+      env:                          # optional
+        FOO: bar
+      state: running                # optional, running (default) or stopped
+
+Workspaces are managed using their description, which is set to <manifest>/<workspace> [<hash>]. Workspaces
+with other descriptions are never changed. When the contextUrl, class, editor or env of a workspace change,
+the workspace is reported as out of date. Use --recreate to replace it, i.e. delete it and create it again,
+which discards all changes in it. Use --diff or --dry-run to review the changes first.`,
+	Example: `  # review the changes, then apply them
+  $ gitpod workspace apply -f workspaces.yaml --diff
+  $ gitpod workspace apply -f workspaces.yaml
+
+  # also delete workspaces which were removed from the manifest
+  $ gitpod workspace apply -f workspaces.yaml --prune
+
+  # replace workspaces which are out of date
+  $ gitpod workspace apply -f workspaces.yaml --recreate`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		m, err := manifest.Load(workspaceApplyOpts.File)
+		if err != nil {
+			return usageError(prettyprint.AddResolution(fmt.Errorf("cannot load manifest: %w", err),
+				"check the manifest format using `{gitpod} workspace apply --help`",
+			))
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Minute)
+		defer cancel()
+
+		gitpod, err := getGitpodClient(ctx)
+		if err != nil {
+			return err
+		}
+		gpctx, err := config.FromContext(ctx).GetActiveContext()
+		if err != nil {
+			return err
+		}
+
+		existing, err := listExistingWorkspaces(ctx, gitpod, gpctx.OrganizationID)
+		if err != nil {
+			return err
+		}
+		plan := m.Plan(existing, workspaceApplyOpts.Prune, workspaceApplyOpts.Recreate)
+		for _, act := range plan {
+			if act.Kind == manifest.ActionOutdated {
+				slog.Warn("workspace is out of date, use --recreate to replace it", "name", act.Name, "workspaceID", act.Existing.ID)
+			}
+		}
+
+		if workspaceApplyOpts.Diff {
+			var out io.Writer = os.Stdout
+			if rootTestingOpts.WriterOut != nil {
+				out = rootTestingOpts.WriterOut
+			}
+			return manifest.WriteDiff(out, plan)
+		}
+		if workspaceApplyOpts.DryRun {
+			res := make([]tabularApplyAction, 0, len(plan))
+			for _, act := range plan {
+				res = append(res, newTabularApplyAction(act, ""))
+			}
+			return WriteTabular(res, formatOpts{}, prettyprint.WriterFormatWide)
+		}
+
+		res := make([]tabularApplyAction, 0, len(plan))
+		for _, act := range plan {
+			workspaceID, err := applyAction(ctx, gitpod, gpctx.OrganizationID, m, act)
+			if err != nil {
+				return fmt.Errorf("cannot %s workspace %s: %w", act.Kind, act.Name, err)
+			}
+			res = append(res, newTabularApplyAction(act, workspaceID))
+		}
+		return WriteTabular(res, formatOpts{}, prettyprint.WriterFormatWide)
+	},
+}
+
+// listExistingWorkspaces lists all workspaces of the user in an organization
+func listExistingWorkspaces(ctx context.Context, gitpod *client.Gitpod, orgID string) ([]manifest.Existing, error) {
+	var (
+		res   []manifest.Existing
+		token string
+	)
+	for {
+		resp, err := gitpod.WorkspaceService.ListWorkspaces(ctx, connect.NewRequest(&v1.ListWorkspacesRequest{
+			OrganizationId: orgID,
+			Pagination:     &v1.PaginationRequest{PageSize: 100, Token: token},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		for _, ws := range resp.Msg.GetWorkspaces() {
+			phase := ws.GetStatus().GetPhase().GetName()
+			res = append(res, manifest.Existing{
+				ID:          ws.GetId(),
+				Description: ws.GetMetadata().GetName(),
+				ContextURL:  ws.GetMetadata().GetOriginalContextUrl(),
+				Class:       ws.GetSpec().GetClass(),
+				Editor:      ws.GetSpec().GetEditor().GetName(),
+				Stopped:     phase == v1.WorkspacePhase_PHASE_STOPPING || phase == v1.WorkspacePhase_PHASE_STOPPED,
+			})
+		}
+		token = resp.Msg.GetPagination().GetNextToken()
+		if token == "" {
+			return res, nil
+		}
+	}
+}
+
+// applyAction applies a single action and returns the ID of the resulting workspace
+func applyAction(ctx context.Context, gitpod *client.Gitpod, orgID string, m *manifest.Manifest, act manifest.Action) (workspaceID string, err error) {
+	if act.Existing != nil {
+		workspaceID = act.Existing.ID
+	}
+
+	switch act.Kind {
+	case manifest.ActionNone, manifest.ActionOutdated:
+		return workspaceID, nil
+	case manifest.ActionStart:
+		slog.Info("starting workspace", "name", act.Name, "workspaceID", workspaceID)
+		_, err = gitpod.WorkspaceService.StartWorkspace(ctx, connect.NewRequest(&v1.StartWorkspaceRequest{WorkspaceId: workspaceID}))
+		return workspaceID, err
+	case manifest.ActionStop:
+		slog.Info("stopping workspace", "name", act.Name, "workspaceID", workspaceID)
+		_, err = gitpod.WorkspaceService.StopWorkspace(ctx, connect.NewRequest(&v1.StopWorkspaceRequest{WorkspaceId: workspaceID}))
+		return workspaceID, err
+	case manifest.ActionDelete:
+		slog.Info("deleting workspace", "name", act.Name, "workspaceID", workspaceID)
+		_, err = gitpod.WorkspaceService.DeleteWorkspace(ctx, connect.NewRequest(&v1.DeleteWorkspaceRequest{WorkspaceId: workspaceID}))
+		return workspaceID, err
+	case manifest.ActionReplace:
+		slog.Info("deleting workspace to replace it", "name", act.Name, "workspaceID", workspaceID)
+		_, err = gitpod.WorkspaceService.DeleteWorkspace(ctx, connect.NewRequest(&v1.DeleteWorkspaceRequest{WorkspaceId: workspaceID}))
+		if err != nil {
+			return workspaceID, err
+		}
+		return createManifestWorkspace(ctx, gitpod, orgID, m, *act.Desired)
+	case manifest.ActionCreate:
+		return createManifestWorkspace(ctx, gitpod, orgID, m, *act.Desired)
+	default:
+		return workspaceID, prettyprint.MarkExceptional(fmt.Errorf("unknown action %s", act.Kind))
+	}
+}
+
+// createManifestWorkspace creates and starts a workspace, and marks it as managed by the manifest
+func createManifestWorkspace(ctx context.Context, gitpod *client.Gitpod, orgID string, m *manifest.Manifest, ws manifest.Workspace) (string, error) {
+	slog.Info("creating workspace", "name", ws.Name, "contextURL", ws.ContextURL)
+	contextURL := &v1.CreateAndStartWorkspaceRequest_ContextURL{
+		Url:            ws.ContextURLWithEnv(),
+		WorkspaceClass: ws.Class,
+	}
+	if ws.Editor != "" {
+		contextURL.Editor = &v1.EditorReference{Name: ws.Editor}
+	}
+	resp, err := gitpod.WorkspaceService.CreateAndStartWorkspace(ctx, connect.NewRequest(&v1.CreateAndStartWorkspaceRequest{
+		Metadata: &v1.WorkspaceMetadata{OrganizationId: orgID},
+		Source:   &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: contextURL},
+	}))
+	if err != nil {
+		return "", err
+	}
+	workspaceID := resp.Msg.GetWorkspace().GetId()
+	if workspaceID == "" {
+		return "", prettyprint.MarkExceptional(fmt.Errorf("workspace was not created"))
+	}
+
+	// the description is not taken from the create request, hence we set it separately
+	description := m.Description(ws)
+	_, err = gitpod.WorkspaceService.UpdateWorkspace(ctx, connect.NewRequest(&v1.UpdateWorkspaceRequest{
+		WorkspaceId: workspaceID,
+		Metadata:    &v1.UpdateWorkspaceRequest_UpdateWorkspaceMetadata{Name: &description},
+	}))
+	if err != nil {
+		// without its description the workspace isn't managed by the manifest, and would be created again next time
+		_, derr := gitpod.WorkspaceService.DeleteWorkspace(ctx, connect.NewRequest(&v1.DeleteWorkspaceRequest{WorkspaceId: workspaceID}))
+		if derr != nil {
+			slog.Warn("cannot delete workspace which is not managed by the manifest", "workspaceID", workspaceID, "err", derr)
+		}
+		return "", fmt.Errorf("cannot set workspace description: %w", err)
+	}
+
+	if ws.State == manifest.StateStopped {
+		slog.Info("stopping workspace", "name", ws.Name, "workspaceID", workspaceID)
+		_, err = gitpod.WorkspaceService.StopWorkspace(ctx, connect.NewRequest(&v1.StopWorkspaceRequest{WorkspaceId: workspaceID}))
+		if err != nil {
+			return workspaceID, err
+		}
+	}
+	return workspaceID, nil
+}
+
+type tabularApplyAction struct {
+	Name        string `print:"name"`
+	Action      string `print:"action"`
+	WorkspaceID string `print:"workspace id"`
+}
+
+func newTabularApplyAction(act manifest.Action, workspaceID string) tabularApplyAction {
+	if workspaceID == "" && act.Existing != nil {
+		workspaceID = act.Existing.ID
+	}
+	return tabularApplyAction{
+		Name:        act.Name,
+		Action:      string(act.Kind),
+		WorkspaceID: workspaceID,
+	}
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspaceApplyCmd)
+	workspaceApplyCmd.Flags().StringVarP(&workspaceApplyOpts.File, "file", "f", "", "manifest to apply, or - to read it from stdin")
+	workspaceApplyCmd.Flags().BoolVar(&workspaceApplyOpts.DryRun, "dry-run", false, "print the changes without applying them")
+	workspaceApplyCmd.Flags().BoolVar(&workspaceApplyOpts.Diff, "diff", false, "print the changes as a diff without applying them")
+	workspaceApplyCmd.Flags().BoolVar(&workspaceApplyOpts.Prune, "prune", false, "delete workspaces of the manifest which are no longer part of it")
+	workspaceApplyCmd.Flags().BoolVar(&workspaceApplyOpts.Recreate, "recreate", false, "delete and create again workspaces which are out of date, discarding all changes in them")
+	_ = workspaceApplyCmd.MarkFlagRequired("file")
+}
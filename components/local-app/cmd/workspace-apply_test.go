@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/v1"
+	gitpod_v1connect "github.com/gitpod-io/gitpod/components/public-api/go/v1/v1connect"
+	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/gitpod-io/local-app/pkg/manifest"
+)
+
+func TestWorkspaceApplyCmd(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		fn      = filepath.Join(dir, "workspaces.yaml")
+		missing = filepath.Join(dir, "missing.yaml")
+	)
+	err := os.WriteFile(fn, []byte(`name: onboarding
+workspaces:
+  - name: backend
+    contextUrl: https://github.com/gitpod-io/gitpod
+  - name: docs
+    contextUrl: https://github.com/gitpod-io/website
+  - name: frontend
+    contextUrl: https://github.com/gitpod-io/gitpod
+    class: g1-large
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := manifest.Load(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := &testWorkspaceApplyCmdWorkspaceSrv{
+		Resp: &v1.ListWorkspacesResponse{
+			Workspaces: []*v1.Workspace{
+				{
+					Id:       "ws-backend",
+					Metadata: &v1.WorkspaceMetadata{Name: m.Description(m.Workspaces[0])},
+					Status:   &v1.WorkspaceStatus{Phase: &v1.WorkspacePhase{Name: v1.WorkspacePhase_PHASE_RUNNING}},
+				},
+				{
+					Id:       "ws-frontend",
+					Metadata: &v1.WorkspaceMetadata{Name: "onboarding/frontend [00000000]"},
+					Status:   &v1.WorkspaceStatus{Phase: &v1.WorkspacePhase{Name: v1.WorkspacePhase_PHASE_RUNNING}},
+				},
+				{
+					Id:       "ws-unmanaged",
+					Metadata: &v1.WorkspaceMetadata{Name: "my workspace"},
+				},
+			},
+		},
+	}
+
+	RunCommandTests(t, []CommandTest{
+		{
+			Name:        "missing manifest",
+			Commandline: []string{"workspace", "apply", "-f", missing},
+			Expectation: CommandTestExpectation{
+				Error:          "cannot load manifest: open " + missing + ": no such file or directory",
+				HasResolutions: true,
+			},
+		},
+		{
+			Name:        "dry run",
+			Commandline: []string{"workspace", "apply", "-f", fn, "--dry-run"},
+			Config: &config.Config{
+				ActiveContext: "test",
+			},
+			PrepServer: func(mux *http.ServeMux) {
+				mux.Handle(gitpod_v1connect.NewWorkspaceServiceHandler(srv))
+			},
+			Expectation: CommandTestExpectation{
+				Output: "NAME     ACTION      WORKSPACE ID \nbackend  unchanged   ws-backend   \ndocs     create                   \nfrontend out of date ws-frontend  \n",
+			},
+		},
+		{
+			Name:        "dry run with recreate",
+			Commandline: []string{"workspace", "apply", "-f", fn, "--dry-run", "--recreate"},
+			Config: &config.Config{
+				ActiveContext: "test",
+			},
+			PrepServer: func(mux *http.ServeMux) {
+				mux.Handle(gitpod_v1connect.NewWorkspaceServiceHandler(srv))
+			},
+			Expectation: CommandTestExpectation{
+				Output: "NAME     ACTION    WORKSPACE ID \nbackend  unchanged ws-backend   \ndocs     create                 \nfrontend replace   ws-frontend  \n",
+			},
+		},
+		{
+			Name:        "diff",
+			Commandline: []string{"workspace", "apply", "-f", fn, "--diff"},
+			Config: &config.Config{
+				ActiveContext: "test",
+			},
+			PrepServer: func(mux *http.ServeMux) {
+				mux.Handle(gitpod_v1connect.NewWorkspaceServiceHandler(srv))
+			},
+			Expectation: CommandTestExpectation{
+				Output: "+ docs\n+   contextUrl: https://github.com/gitpod-io/website\n+   state: running\n~ frontend (out of date, ws-frontend)\n+   contextUrl: https://github.com/gitpod-io/gitpod\n+   class: g1-large\n",
+			},
+		},
+	})
+}
+
+type testWorkspaceApplyCmdWorkspaceSrv struct {
+	Resp *v1.ListWorkspacesResponse
+	gitpod_v1connect.UnimplementedWorkspaceServiceHandler
+}
+
+func (srv testWorkspaceApplyCmdWorkspaceSrv) ListWorkspaces(context.Context, *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error) {
+	return &connect.Response[v1.ListWorkspacesResponse]{Msg: srv.Resp}, nil
+}
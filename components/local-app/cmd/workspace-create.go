@@ -24,6 +24,9 @@ var workspaceCreateCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
+		if err := workspaceCreateOpts.StartOpts.validate(); err != nil {
+			return err
+		}
 		repoURL := args[0]
 
 		cfg := config.FromContext(cmd.Context())
@@ -120,9 +123,12 @@ var workspaceCreateCmd = &cobra.Command{
 		}
 
 		if workspaceCreateOpts.StartOpts.DontWait {
-			// There is no more information to print other than the workspace ID. No need to faff with tabular pretty printing.
-			fmt.Println(workspaceID)
-			return nil
+			if rootOutput.Kind == prettyprint.OutputTable {
+				// There is no more information to print other than the workspace ID. No need to faff with tabular pretty printing.
+				fmt.Println(workspaceID)
+				return nil
+			}
+			return writeWorkspaceResult(ctx, gitpod, workspaceID)
 		}
 
 		_, err = helper.ObserveWorkspaceUntilStarted(ctx, gitpod, workspaceID)
@@ -133,6 +139,10 @@ var workspaceCreateCmd = &cobra.Command{
 		if workspaceCreateOpts.StartOpts.OpenSSH {
 			return helper.SSHConnectToWorkspace(ctx, gitpod, workspaceID, false)
 		}
+		err = writeWorkspaceResult(ctx, gitpod, workspaceID)
+		if err != nil {
+			return err
+		}
 		if workspaceCreateOpts.StartOpts.OpenEditor {
 			return helper.OpenWorkspaceInPreferredEditor(ctx, gitpod, workspaceID)
 		}
@@ -7,6 +7,8 @@ package cmd
 import (
 	"context"
 	"log/slog"
+	"os"
+	"os/signal"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -17,12 +19,18 @@ import (
 
 var workspaceGetOpts struct {
 	Format formatOpts
+	Watch  bool
 }
 
 var workspaceGetCmd = &cobra.Command{
 	Use:   "get <workspace-id>",
 	Short: "Retrieves metadata about a given workspace",
 	Args:  cobra.MinimumNArgs(1),
+	Example: `  # print the status of a workspace
+  $ gitpod workspace get <workspace-id> --output 'template={{ .status }}'
+
+  # print the workspace whenever its status changes
+  $ gitpod workspace get <workspace-id> --watch`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var workspaces []tabularWorkspace
 		for _, workspaceID := range args {
@@ -46,11 +54,40 @@ var workspaceGetCmd = &cobra.Command{
 			}
 			workspaces = append(workspaces, *r)
 		}
-		return WriteTabular(workspaces, workspaceGetOpts.Format, prettyprint.WriterFormatNarrow)
+		if !workspaceGetOpts.Watch {
+			return WriteTabular(workspaces, workspaceGetOpts.Format, prettyprint.WriterFormatNarrow)
+		}
+
+		gitpod, err := getGitpodClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		out, err := newStreamWriter[tabularWorkspace](workspaceGetOpts.Format, prettyprint.WriterFormatNarrow)
+		if err != nil {
+			return err
+		}
+		watcher := &workspaceWatcher{
+			Client: gitpod,
+			Out:    out,
+		}
+		if len(args) == 1 {
+			watcher.WorkspaceID = args[0]
+		}
+		for _, ws := range workspaces {
+			err := watcher.Add(ws)
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer cancel()
+		return watcher.Watch(ctx)
 	},
 }
 
 func init() {
 	workspaceCmd.AddCommand(workspaceGetCmd)
 	addFormatFlags(workspaceGetCmd, &workspaceGetOpts.Format)
+	workspaceGetCmd.Flags().BoolVarP(&workspaceGetOpts.Watch, "watch", "w", false, "Keep printing the workspaces as their status changes")
 }
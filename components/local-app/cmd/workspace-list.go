@@ -7,6 +7,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -23,6 +25,14 @@ var workspaceListCmd = &cobra.Command{
 	Use:     "list",
 	Short:   "Lists workspaces",
 	Aliases: []string{"ls"},
+	Example: `  # list all workspaces of the current organization
+  $ gitpod workspace list
+
+  # print the IDs of all running workspaces
+  $ gitpod workspace list --running-only --output 'template={{ .id }}'
+
+  # keep listing workspaces as their status changes
+  $ gitpod workspace list --watch`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
 
@@ -41,15 +51,22 @@ var workspaceListCmd = &cobra.Command{
 		}
 		orgId := gpctx.OrganizationID
 
-		workspaces, err := gitpod.Workspaces.ListWorkspaces(ctx, connect.NewRequest(&v1.ListWorkspacesRequest{
-			OrganizationId: orgId,
-		}))
+		listWorkspaces := func(ctx context.Context) ([]*v1.Workspace, error) {
+			workspaces, err := gitpod.Workspaces.ListWorkspaces(ctx, connect.NewRequest(&v1.ListWorkspacesRequest{
+				OrganizationId: orgId,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			return workspaces.Msg.GetResult(), nil
+		}
+		workspaces, err := listWorkspaces(ctx)
 		if err != nil {
 			return err
 		}
 
-		result := make([]tabularWorkspace, 0, len(workspaces.Msg.GetResult()))
-		for _, ws := range workspaces.Msg.GetResult() {
+		result := make([]tabularWorkspace, 0, len(workspaces))
+		for _, ws := range workspaces {
 			r := newTabularWorkspace(ws)
 			if r == nil {
 				continue
@@ -60,7 +77,46 @@ var workspaceListCmd = &cobra.Command{
 			result = append(result, *r)
 		}
 
-		return WriteTabular(result, workspaceListOpts.Format, prettyprint.WriterFormatWide)
+		if !workspaceListOpts.Watch {
+			return WriteTabular(result, workspaceListOpts.Format, prettyprint.WriterFormatWide)
+		}
+
+		out, err := newStreamWriter[tabularWorkspace](workspaceListOpts.Format, prettyprint.WriterFormatWide)
+		if err != nil {
+			return err
+		}
+		watcher := &workspaceWatcher{
+			Client: gitpod,
+			Out:    out,
+			// workspaces of other organizations are not part of the list, hence we look for new workspaces in the list
+			Lookup: func(ctx context.Context, workspaceID string) (*tabularWorkspace, error) {
+				workspaces, err := listWorkspaces(ctx)
+				if err != nil {
+					return nil, err
+				}
+				for _, ws := range workspaces {
+					if ws.WorkspaceId == workspaceID {
+						return newTabularWorkspace(ws), nil
+					}
+				}
+				return nil, nil
+			},
+		}
+		if workspaceListOpts.RunningOnly {
+			watcher.Filter = func(ws tabularWorkspace) bool {
+				return ws.Status == prettyprint.FormatWorkspacePhase(v1.WorkspaceInstanceStatus_PHASE_RUNNING)
+			}
+		}
+		for _, r := range result {
+			err := watcher.Add(r)
+			if err != nil {
+				return err
+			}
+		}
+
+		watchCtx, cancelWatch := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer cancelWatch()
+		return watcher.Watch(watchCtx)
 	},
 }
 
@@ -80,10 +136,7 @@ func newTabularWorkspace(ws *v1.Workspace) *tabularWorkspace {
 	}
 	var branch string
 	if ws.Status.Instance.Status.GitStatus != nil {
-		branch = ws.Status.Instance.Status.GitStatus.Branch
-		if branch == "" || branch == "(detached)" {
-			branch = ""
-		}
+		branch = formatBranch(ws.Status.Instance.Status.GitStatus.Branch)
 	}
 	return &tabularWorkspace{
 		ID:         ws.WorkspaceId,
@@ -93,6 +146,14 @@ func newTabularWorkspace(ws *v1.Workspace) *tabularWorkspace {
 	}
 }
 
+// formatBranch hides detached heads, which have no meaningful branch name
+func formatBranch(branch string) string {
+	if branch == "(detached)" {
+		return ""
+	}
+	return branch
+}
+
 type tabularWorkspace struct {
 	ID         string `print:"id"`
 	Repository string `print:"repository"`
@@ -103,10 +164,12 @@ type tabularWorkspace struct {
 var workspaceListOpts struct {
 	Format      formatOpts
 	RunningOnly bool
+	Watch       bool
 }
 
 func init() {
 	workspaceCmd.AddCommand(workspaceListCmd)
 	addFormatFlags(workspaceListCmd, &workspaceListOpts.Format)
 	workspaceListCmd.Flags().BoolVarP(&workspaceListOpts.RunningOnly, "running-only", "r", false, "Only list running workspaces")
+	workspaceListCmd.Flags().BoolVarP(&workspaceListOpts.Watch, "watch", "w", false, "Keep listing workspaces as their status changes")
 }
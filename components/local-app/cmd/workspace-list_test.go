@@ -42,6 +42,41 @@ func TestWorkspaceListCmd(t *testing.T) {
 				Output: "ID          REPOSITORY BRANCH STATUS  \nworkspaceID owner/name        running \n",
 			},
 		},
+		{
+			Name:        "test one workspace as json",
+			Commandline: []string{"workspace", "list", "--output", "json"},
+			Config: &config.Config{
+				ActiveContext: "test",
+			},
+			PrepServer: func(mux *http.ServeMux) {
+				mux.Handle(gitpod_experimental_v1connect.NewWorkspacesServiceHandler(&testWorkspaceListCmdWorkspaceSrv{
+					Resp: &v1.ListWorkspacesResponse{
+						Result: []*v1.Workspace{fixtureWorkspace()},
+					},
+				}))
+			},
+			Expectation: CommandTestExpectation{
+				Output: "[\n  {\n    \"branch\": \"\",\n    \"id\": \"workspaceID\",\n    \"repository\": \"owner/name\",\n    \"status\": \"running\"\n  }\n]\n",
+			},
+		},
+		{
+			Name:        "test field with output",
+			Commandline: []string{"workspace", "list", "--output", "json", "--field", "id"},
+			Config: &config.Config{
+				ActiveContext: "test",
+			},
+			PrepServer: func(mux *http.ServeMux) {
+				mux.Handle(gitpod_experimental_v1connect.NewWorkspacesServiceHandler(&testWorkspaceListCmdWorkspaceSrv{
+					Resp: &v1.ListWorkspacesResponse{
+						Result: []*v1.Workspace{fixtureWorkspace()},
+					},
+				}))
+			},
+			Expectation: CommandTestExpectation{
+				Error:          "--field cannot be combined with --output",
+				HasResolutions: true,
+			},
+		},
 		{
 			Name:        "test no workspace",
 			Commandline: []string{"workspace", "list"},
@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/components/public-api/go/client"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	"github.com/gitpod-io/local-app/pkg/helper"
+	"github.com/gitpod-io/local-app/pkg/portforward"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
+	"github.com/spf13/cobra"
+)
+
+var workspacePortForwardOpts struct {
+	Auto        bool
+	BindAddress string
+}
+
+// workspacePortForwardCmd forwards ports of a workspace to the local machine
+var workspacePortForwardCmd = &cobra.Command{
+	Use:   "port-forward <workspace-id> [[bind-address:]local-port:]remote-port...",
+	Short: "Forwards ports of a workspace to your machine",
+	Args:  cobra.MinimumNArgs(1),
+	Example: `  # forward port 3000 of the workspace to localhost:3000
+  $ gitpod workspace port-forward <workspace-id> 3000
+
+  # forward port 3000 of the workspace to localhost:8080
+  $ gitpod workspace port-forward <workspace-id> 8080:3000
+
+  # forward all ports which are opened in the workspace, as they are opened
+  $ gitpod workspace port-forward <workspace-id> --auto`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		if err := rejectOutput("by workspace port-forward, as it keeps forwarding until interrupted"); err != nil {
+			return err
+		}
+
+		workspaceID := args[0]
+		var specs []portforward.Spec
+		for _, arg := range args[1:] {
+			spec, err := portforward.ParseSpec(arg)
+			if err != nil {
+				return prettyprint.AddResolution(err,
+					"specify ports as [[bind-address:]local-port:]remote-port, e.g. 3000 or 8080:3000",
+				)
+			}
+			specs = append(specs, spec)
+		}
+		if len(specs) == 0 && !workspacePortForwardOpts.Auto {
+			return prettyprint.AddResolution(fmt.Errorf("no ports to forward"),
+				"specify the ports to forward, e.g. `{gitpod} workspace port-forward "+workspaceID+" 3000`",
+				"forward all ports which are opened in the workspace using `{gitpod} workspace port-forward "+workspaceID+" --auto`",
+			)
+		}
+
+		ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer cancel()
+
+		gitpod, err := getGitpodClient(ctx)
+		if err != nil {
+			return err
+		}
+		ws, err := helper.EnsureWorkspaceRunning(ctx, gitpod, workspaceID)
+		if err != nil {
+			return err
+		}
+		sshClient, err := helper.SSHClient(ctx, gitpod, workspaceID)
+		if err != nil {
+			return err
+		}
+		defer sshClient.Close()
+
+		explicitPorts := make(map[int]struct{}, len(specs))
+		for _, spec := range specs {
+			f, err := portforward.Listen(sshClient, spec)
+			if err != nil {
+				return fmt.Errorf("cannot forward port %d: %w", spec.RemotePort, err)
+			}
+			defer f.Close()
+			explicitPorts[spec.RemotePort] = struct{}{}
+			slog.Info("forwarding port", "port", spec.RemotePort, "local", f.Addr().String())
+		}
+
+		closed := make(chan error, 1)
+		go func() {
+			closed <- sshClient.Wait()
+		}()
+
+		if workspacePortForwardOpts.Auto {
+			auto := &portforward.Auto{
+				Dialer:      sshClient,
+				BindAddress: workspacePortForwardOpts.BindAddress,
+			}
+
+			update := func(status *v1.WorkspaceStatus) {
+				var ports []int
+				for _, p := range status.GetInstance().GetStatus().GetPorts() {
+					if _, explicit := explicitPorts[int(p.Port)]; explicit {
+						continue
+					}
+					ports = append(ports, int(p.Port))
+				}
+				auto.Update(ports)
+			}
+			update(ws)
+
+			observed := make(chan struct{})
+			go func() {
+				defer close(observed)
+				observeWorkspaceStatus(ctx, gitpod, workspaceID, update)
+			}()
+			defer func() {
+				// stop observing before we close the forwarded ports, so that they aren't opened again
+				cancel()
+				<-observed
+				auto.Close()
+			}()
+		}
+
+		slog.Info("press Ctrl+C to stop forwarding")
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-closed:
+			if err == nil {
+				err = fmt.Errorf("connection to workspace was closed")
+			} else {
+				err = fmt.Errorf("connection to workspace was closed: %w", err)
+			}
+			return prettyprint.AddResolution(err,
+				"make sure the workspace is still running using `{gitpod} workspace get "+workspaceID+"`",
+			)
+		}
+	},
+}
+
+// observeWorkspaceStatus calls onUpdate for every status update of a workspace until the context is canceled
+func observeWorkspaceStatus(ctx context.Context, gitpod *client.Gitpod, workspaceID string, onUpdate func(*v1.WorkspaceStatus)) {
+	for ctx.Err() == nil {
+		stream, err := gitpod.Workspaces.StreamWorkspaceStatus(ctx, connect.NewRequest(&v1.StreamWorkspaceStatusRequest{WorkspaceId: workspaceID}))
+		if err == nil {
+			for stream.Receive() {
+				if msg := stream.Msg(); msg != nil {
+					onUpdate(msg.GetResult())
+				}
+			}
+			err = stream.Err()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Debug("workspace status stream ended, reconnecting", "err", err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspacePortForwardCmd)
+	workspacePortForwardCmd.Flags().BoolVar(&workspacePortForwardOpts.Auto, "auto", false, "forward all ports which are opened in the workspace, as they are opened")
+	workspacePortForwardCmd.Flags().StringVar(&workspacePortForwardOpts.BindAddress, "address", portforward.DefaultBindAddress, "local address to listen on for automatically forwarded ports")
+}
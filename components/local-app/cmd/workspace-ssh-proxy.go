@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gitpod-io/local-app/pkg/helper"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+)
+
+// workspaceSSHPort is the port of the SSH server within a workspace
+const workspaceSSHPort = "23001"
+
+var workspaceSSHProxyOpts struct {
+	PublicKey string
+}
+
+// workspaceSSHProxyCmd connects stdin and stdout to the SSH server of a workspace. It's used as ProxyCommand in the entries written by ssh-config.
+var workspaceSSHProxyCmd = &cobra.Command{
+	Use:    "ssh-proxy <workspace-id>",
+	Short:  "Connects stdin and stdout to the SSH server of a workspace, for use as ProxyCommand",
+	Hidden: true,
+	Args:   cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		var (
+			ctx         = cmd.Context()
+			workspaceID = args[0]
+		)
+		gitpod, err := getGitpodClient(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = helper.EnsureWorkspaceRunning(ctx, gitpod, workspaceID)
+		if err != nil {
+			return err
+		}
+		client, err := helper.SSHClient(ctx, gitpod, workspaceID)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if workspaceSSHProxyOpts.PublicKey != "" {
+			key, err := os.ReadFile(workspaceSSHProxyOpts.PublicKey)
+			if err != nil {
+				return fmt.Errorf("cannot read public key: %w", err)
+			}
+			err = installAuthorizedKey(client, strings.TrimSpace(string(key)))
+			if err != nil {
+				return fmt.Errorf("cannot authorize public key in workspace: %w", err)
+			}
+		}
+
+		conn, err := client.Dial("tcp", "localhost:"+workspaceSSHPort)
+		if err != nil {
+			return fmt.Errorf("cannot connect to SSH server of workspace: %w", err)
+		}
+		defer conn.Close()
+
+		go func() {
+			_, _ = io.Copy(conn, os.Stdin)
+			if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+				_ = cw.CloseWrite()
+			}
+		}()
+		_, err = io.Copy(os.Stdout, conn)
+		return err
+	},
+}
+
+// installAuthorizedKey adds a public key to the authorized keys of the workspace user unless it's present already
+func installAuthorizedKey(client *ssh.Client, key string) error {
+	sess, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer sess.Close()
+
+	out, err := sess.CombinedOutput(fmt.Sprintf("mkdir -p ~/.ssh && touch ~/.ssh/authorized_keys && (grep -qxF %[1]s ~/.ssh/authorized_keys || echo %[1]s >> ~/.ssh/authorized_keys)", shellQuote(key)))
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, out)
+	}
+	return nil
+}
+
+func isSSHProxyCommand(cmd *cobra.Command) bool {
+	return cmd == workspaceSSHProxyCmd
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspaceSSHProxyCmd)
+	workspaceSSHProxyCmd.Flags().StringVar(&workspaceSSHProxyOpts.PublicKey, "public-key", "", "public key file to add to the authorized keys of the workspace")
+}
@@ -22,6 +22,9 @@ var workspaceStartCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
+		if err := workspaceStartOpts.validate(); err != nil {
+			return err
+		}
 
 		workspaceID := args[0]
 
@@ -41,12 +44,12 @@ var workspaceStartCmd = &cobra.Command{
 
 		if wsInfo.Msg.GetResult().Status.Instance.Status.Phase == v1.WorkspaceInstanceStatus_PHASE_RUNNING {
 			slog.Info("workspace already running")
-			return nil
+			return writeWorkspaceResult(ctx, gitpod, workspaceID)
 		}
 
 		if workspaceStartOpts.DontWait {
 			slog.Info("workspace initialization started")
-			return nil
+			return writeWorkspaceResult(ctx, gitpod, workspaceID)
 		}
 
 		_, err = helper.ObserveWorkspaceUntilStarted(ctx, gitpod, workspaceID)
@@ -54,10 +57,14 @@ var workspaceStartCmd = &cobra.Command{
 			return err
 		}
 
-		switch {
-		case workspaceStartOpts.OpenSSH:
+		if workspaceStartOpts.OpenSSH {
 			return helper.SSHConnectToWorkspace(ctx, gitpod, workspaceID, false)
-		case workspaceStartOpts.OpenEditor:
+		}
+		err = writeWorkspaceResult(ctx, gitpod, workspaceID)
+		if err != nil {
+			return err
+		}
+		if workspaceStartOpts.OpenEditor {
 			return helper.OpenWorkspaceInPreferredEditor(ctx, gitpod, workspaceID)
 		}
 
@@ -71,6 +78,14 @@ type workspaceStartOptions struct {
 	OpenEditor bool
 }
 
+// validate rejects --output for --ssh, as the SSH session owns the terminal
+func (opts workspaceStartOptions) validate() error {
+	if !opts.OpenSSH {
+		return nil
+	}
+	return rejectOutput("together with --ssh")
+}
+
 func addWorkspaceStartOptions(cmd *cobra.Command, opts *workspaceStartOptions) {
 	cmd.Flags().BoolVar(&opts.DontWait, "dont-wait", false, "do not wait for workspace to fully start, only initialize")
 	cmd.Flags().BoolVar(&opts.OpenSSH, "ssh", false, "open an SSH connection to workspace after starting")
@@ -44,15 +44,15 @@ var workspaceStopCommand = &cobra.Command{
 		switch wsPhase {
 		case v1.WorkspaceInstanceStatus_PHASE_STOPPED:
 			slog.Info("workspace is already stopped")
-			return nil
+			return writeWorkspaceResult(ctx, gitpod, workspaceID)
 		case v1.WorkspaceInstanceStatus_PHASE_STOPPING:
 			slog.Info("workspace is already stopping")
-			return nil
+			return writeWorkspaceResult(ctx, gitpod, workspaceID)
 		}
 
 		if stopDontWait {
 			slog.Info("workspace stopping")
-			return nil
+			return writeWorkspaceResult(ctx, gitpod, workspaceID)
 		}
 
 		stream, err := gitpod.Workspaces.StreamWorkspaceStatus(ctx, connect.NewRequest(&v1.StreamWorkspaceStatusRequest{WorkspaceId: workspaceID}))
@@ -78,7 +78,7 @@ var workspaceStopCommand = &cobra.Command{
 			case v1.WorkspaceInstanceStatus_PHASE_STOPPED:
 				{
 					slog.Info("workspace stopped")
-					return nil
+					return writeWorkspaceResult(ctx, gitpod, workspaceID)
 				}
 			case v1.WorkspaceInstanceStatus_PHASE_RUNNING:
 				// Skip reporting the "running" status as it is often the initial state and seems confusing to the user.
@@ -100,7 +100,7 @@ var workspaceStopCommand = &cobra.Command{
 			return err
 		}
 
-		return nil
+		return writeWorkspaceResult(ctx, gitpod, workspaceID)
 	},
 }
 
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	gitpod_experimental_v1connect "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1/v1connect"
+	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
+)
+
+func TestWorkspaceStopCmd(t *testing.T) {
+	stopped := fixtureWorkspace()
+	stopped.Status.Instance.Status.Phase = v1.WorkspaceInstanceStatus_PHASE_STOPPED
+
+	prepServer := func(mux *http.ServeMux) {
+		mux.Handle(gitpod_experimental_v1connect.NewWorkspacesServiceHandler(&testWorkspaceStopCmdWorkspaceSrv{Workspace: stopped}))
+	}
+
+	RunCommandTests(t, []CommandTest{
+		{
+			Name:        "already stopped",
+			Commandline: []string{"workspace", "stop", "workspaceID"},
+			Config:      &config.Config{ActiveContext: "test"},
+			PrepServer:  prepServer,
+			Expectation: CommandTestExpectation{},
+		},
+		{
+			Name:        "already stopped as json",
+			Commandline: []string{"workspace", "stop", "workspaceID", "--output", "json"},
+			Config:      &config.Config{ActiveContext: "test"},
+			PrepServer:  prepServer,
+			Expectation: CommandTestExpectation{
+				Output: "[\n  {\n    \"branch\": \"\",\n    \"id\": \"workspaceID\",\n    \"repository\": \"owner/name\",\n    \"status\": \"stopped\"\n  }\n]\n",
+			},
+		},
+		{
+			Name:        "up rejects output",
+			Commandline: []string{"workspace", "up", "--output", "json"},
+			Config:      &config.Config{ActiveContext: "test"},
+			Expectation: CommandTestExpectation{
+				Error:          "--output is not supported by workspace up, as it stays connected to the workspace",
+				HasResolutions: true,
+			},
+		},
+		{
+			Name:        "start with ssh rejects output",
+			Commandline: []string{"workspace", "start", "workspaceID", "--ssh", "--output", "yaml"},
+			Config:      &config.Config{ActiveContext: "test"},
+			Expectation: CommandTestExpectation{
+				Error:          "--output is not supported together with --ssh",
+				HasResolutions: true,
+			},
+		},
+	})
+
+	t.Run("rejected output is a usage error", func(t *testing.T) {
+		defer func(o prettyprint.Output) { rootOutput = o }(rootOutput)
+		rootOutput.Kind = prettyprint.OutputJSON
+
+		if act := exitCode(rejectOutput("by this test")); act != ExitCodeUsage {
+			t.Errorf("expected exit code %d, got %d", ExitCodeUsage, act)
+		}
+	})
+}
+
+type testWorkspaceStopCmdWorkspaceSrv struct {
+	Workspace *v1.Workspace
+	gitpod_experimental_v1connect.UnimplementedWorkspacesServiceHandler
+}
+
+func (srv testWorkspaceStopCmdWorkspaceSrv) StopWorkspace(context.Context, *connect.Request[v1.StopWorkspaceRequest]) (*connect.Response[v1.StopWorkspaceResponse], error) {
+	return &connect.Response[v1.StopWorkspaceResponse]{Msg: &v1.StopWorkspaceResponse{Result: srv.Workspace}}, nil
+}
+
+func (srv testWorkspaceStopCmdWorkspaceSrv) GetWorkspace(context.Context, *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error) {
+	return &connect.Response[v1.GetWorkspaceResponse]{Msg: &v1.GetWorkspaceResponse{Result: srv.Workspace}}, nil
+}
@@ -17,8 +17,10 @@ import (
 	"time"
 
 	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/components/public-api/go/client"
 	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
 	"github.com/gitpod-io/local-app/pkg/config"
+	"github.com/gitpod-io/local-app/pkg/filesync"
 	"github.com/gitpod-io/local-app/pkg/helper"
 	"github.com/gitpod-io/local-app/pkg/prettyprint"
 	"github.com/go-git/go-git/v5"
@@ -29,14 +31,49 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
-// workspaceUpCmd creates a new workspace
+const (
+	// workspaceUpCheckoutDir is the working copy in the workspace
+	workspaceUpCheckoutDir = "/workspace/empty"
+	// workspaceUpRemoteRepo is the repository in the workspace we push to
+	workspaceUpRemoteRepo = "/workspace/remote"
+	// workspaceUpBranchPrefix prefixes the branches which hold uncommitted changes
+	workspaceUpBranchPrefix = "gitpod-up/"
+	// workspaceUpAppliedRef points to the uncommitted changes which were applied to the working copy in the workspace
+	workspaceUpAppliedRef = "refs/gitpod-up/applied"
+	// workspaceUpConfigSection and workspaceUpConfigWorkspaceID store the workspace of a working copy in its Git config
+	workspaceUpConfigSection     = "gitpod"
+	workspaceUpConfigWorkspaceID = "workspaceId"
+
+	workspaceUpSyncInterval = 2 * time.Second
+)
+
+var workspaceUpOpts struct {
+	New  bool
+	Sync bool
+}
+
+// workspaceUpCmd creates or reuses a workspace for a local Git working copy
 var workspaceUpCmd = &cobra.Command{
-	Use:    "up [path/to/git/working-copy]",
-	Hidden: true,
-	Short:  "Creates a new workspace, pushes the Git working copy and adds it as remote",
-	Args:   cobra.MaximumNArgs(1),
+	Use:   "up [path/to/git/working-copy]",
+	Short: "Creates or reuses a workspace for a Git working copy, pushes it and adds it as remote",
+	Long: `Creates or reuses a workspace for a Git working copy, pushes it including uncommitted changes and adds the workspace as remote.
+
+The workspace is remembered in the Git config of the working copy and reused by subsequent runs, unless --new is passed.
+Uncommitted changes are pushed to the branch gitpod-up/<branch> and applied to the working copy in the workspace.
+
+With --sync, files are synced in both directions between the working copy and the workspace until the command is stopped.
+Files which are ignored by Git are not synced.`,
+	Example: `  # Start a workspace for the working copy in the current directory
+  $ gitpod workspace up
+
+  # Start a workspace and keep syncing files while you're connected via SSH
+  $ gitpod workspace up --sync --ssh`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
+		if err := rejectOutput("by workspace up, as it stays connected to the workspace"); err != nil {
+			return err
+		}
 
 		workingDir := "."
 		if len(args) != 0 {
@@ -145,34 +182,58 @@ var workspaceUpCmd = &cobra.Command{
 		if err != nil {
 			return prettyprint.MarkExceptional(fmt.Errorf("cannot open Git working copy at %s: %w", currentDir, err))
 		}
-		_ = repo.DeleteRemote("gitpod")
 		head, err := repo.Head()
 		if err != nil {
 			return prettyprint.MarkExceptional(fmt.Errorf("cannot get HEAD: %w", err))
 		}
-		branch := head.Name().Short()
-
-		newWorkspace, err := gitpod.Workspaces.CreateAndStartWorkspace(ctx, connect.NewRequest(
-			&v1.CreateAndStartWorkspaceRequest{
-				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: "GITPODCLI_CONTENT_INIT=push/https://github.com/gitpod-io/empty"},
-				OrganizationId: orgId,
-				StartSpec: &v1.StartWorkspaceSpec{
-					IdeSettings: &v1.IDESettings{
-						DefaultIde:       workspaceCreateOpts.Editor,
-						UseLatestVersion: false,
+		var (
+			branch   = head.Name().Short()
+			upBranch = workspaceUpBranchPrefix + branch
+		)
+
+		var workspaceID string
+		if !workspaceUpOpts.New {
+			workspaceID, err = findUpWorkspace(ctx, gitpod, repo)
+			if err != nil {
+				return err
+			}
+		}
+		created := workspaceID == ""
+		if created {
+			newWorkspace, err := gitpod.Workspaces.CreateAndStartWorkspace(ctx, connect.NewRequest(
+				&v1.CreateAndStartWorkspaceRequest{
+					Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: "GITPODCLI_CONTENT_INIT=push/https://github.com/gitpod-io/empty"},
+					OrganizationId: orgId,
+					StartSpec: &v1.StartWorkspaceSpec{
+						IdeSettings: &v1.IDESettings{
+							DefaultIde:       workspaceCreateOpts.Editor,
+							UseLatestVersion: false,
+						},
+						WorkspaceClass: workspaceCreateOpts.WorkspaceClass,
 					},
-					WorkspaceClass: workspaceCreateOpts.WorkspaceClass,
 				},
-			},
-		))
-		if err != nil {
-			return err
-		}
-		workspaceID := newWorkspace.Msg.WorkspaceId
-		if len(workspaceID) == 0 {
-			return prettyprint.MarkExceptional(prettyprint.AddResolution(fmt.Errorf("workspace was not created"),
-				"try to create the workspace again",
 			))
+			if err != nil {
+				return err
+			}
+			workspaceID = newWorkspace.Msg.WorkspaceId
+			if len(workspaceID) == 0 {
+				return prettyprint.MarkExceptional(prettyprint.AddResolution(fmt.Errorf("workspace was not created"),
+					"try to create the workspace again",
+				))
+			}
+
+			repoCfg, err := repo.Config()
+			if err != nil {
+				return fmt.Errorf("cannot read Git config: %w", err)
+			}
+			repoCfg.Raw.Section(workspaceUpConfigSection).SetOption(workspaceUpConfigWorkspaceID, workspaceID)
+			err = repo.SetConfig(repoCfg)
+			if err != nil {
+				return fmt.Errorf("cannot remember workspace in Git config: %w", err)
+			}
+		} else {
+			slog.Info("reusing workspace of this working copy, use --new to create a new one", "workspaceID", workspaceID)
 		}
 		ws, err := helper.ObserveWorkspaceUntilStarted(ctx, gitpod, workspaceID)
 		if err != nil {
@@ -202,18 +263,20 @@ var workspaceUpCmd = &cobra.Command{
 		}
 		defer sess.Close()
 
-		slog.Debug("initializing remote workspace Git repository")
-		err = runSSHCommand(ctx, sess, "rm", "-r", "/workspace/empty/.git")
-		if err != nil {
-			return err
-		}
-		err = runSSHCommand(ctx, sess, "git", "init", "/workspace/remote")
-		if err != nil {
-			return err
+		if created {
+			slog.Debug("initializing remote workspace Git repository")
+			err = runSSHCommand(ctx, sess, "rm", "-r", workspaceUpCheckoutDir+"/.git")
+			if err != nil {
+				return err
+			}
+			err = runSSHCommand(ctx, sess, "git", "init", "--bare", workspaceUpRemoteRepo)
+			if err != nil {
+				return err
+			}
 		}
 
-		slog.Debug("pushing to workspace")
-		sshRemote := fmt.Sprintf("%s#%s@%s:/workspace/remote", workspaceID, ownerToken, helper.WorkspaceSSHHost(&v1.Workspace{WorkspaceId: workspaceID, Status: ws}))
+		_ = repo.DeleteRemote("gitpod")
+		sshRemote := fmt.Sprintf("%s#%s@%s:%s", workspaceID, ownerToken, helper.WorkspaceSSHHost(&v1.Workspace{WorkspaceId: workspaceID, Status: ws}), workspaceUpRemoteRepo)
 		_, err = repo.CreateRemote(&gitcfg.RemoteConfig{
 			Name: "gitpod",
 			URLs: []string{sshRemote},
@@ -222,9 +285,19 @@ var workspaceUpCmd = &cobra.Command{
 			return fmt.Errorf("cannot create remote: %w", err)
 		}
 
+		changes, err := snapshotWorkingCopy(currentDir, branch)
+		if err != nil {
+			return fmt.Errorf("cannot collect uncommitted changes: %w", err)
+		}
+		refspecs := []string{branch}
+		if changes != "" {
+			// the temporary branch is overwritten every time
+			refspecs = append(refspecs, "+"+changes+":refs/heads/"+upBranch)
+		}
+
 		// Pushing using Go git is tricky because of the SSH host verification. Shelling out to git is easier.
 		slog.Info("pushing to local working copy to remote workspace")
-		pushcmd := exec.Command("git", "push", "--progress", "gitpod")
+		pushcmd := exec.Command("git", append([]string{"push", "--progress", "gitpod"}, refspecs...)...)
 		pushcmd.Stdout = os.Stdout
 		pushcmd.Stderr = os.Stderr
 		pushcmd.Dir = currentDir
@@ -235,23 +308,108 @@ var workspaceUpCmd = &cobra.Command{
 		}
 
 		slog.Debug("checking out branch in workspace")
-		err = runSSHCommand(ctx, sess, "sh -c 'cd /workspace/empty && git clone /workspace/remote .'")
-		if err != nil {
-			return err
+		script := []string{
+			"set -e",
+			"cd " + workspaceUpCheckoutDir,
 		}
-		err = runSSHCommand(ctx, sess, "sh -c 'cd /workspace/empty && git checkout "+branch+"'")
-		if err != nil {
+		if created {
+			script = append(script,
+				"git clone -q "+workspaceUpRemoteRepo+" .",
+				"git checkout -q "+shellQuote(branch),
+				"git config receive.denyCurrentBranch ignore",
+			)
+		} else {
+			script = append(script,
+				`if [ -n "$(git status --porcelain)" ]; then`,
+				// we only discard changes in the workspace if they were applied by a previous run
+				"GIT_INDEX_FILE=.git/gitpod-up-index git read-tree HEAD",
+				"GIT_INDEX_FILE=.git/gitpod-up-index git add --all",
+				`test "$(GIT_INDEX_FILE=.git/gitpod-up-index git write-tree)" = "$(git rev-parse -q --verify `+workspaceUpAppliedRef+`^{tree})"`,
+				"git reset -q --hard",
+				"git clean -fdq",
+				"fi",
+				"git fetch -q origin",
+				"git checkout -q "+shellQuote(branch),
+				"git merge -q --ff-only "+shellQuote("origin/"+branch),
+			)
+		}
+		if changes != "" {
+			// apply the uncommitted changes to the working copy without committing them
+			script = append(script,
+				"git read-tree -u --reset "+changes,
+				"git reset -q",
+				"git update-ref "+workspaceUpAppliedRef+" "+changes,
+			)
+		} else {
+			script = append(script, "git update-ref -d "+workspaceUpAppliedRef)
+		}
+		err = runSSHCommand(ctx, sess, "sh", "-c", shellQuote(strings.Join(script, "\n")))
+		if err != nil && created {
 			return err
 		}
-		err = runSSHCommand(ctx, sess, "sh -c 'cd /workspace/empty && git config receive.denyCurrentBranch ignore'")
 		if err != nil {
-			return err
+			slog.Warn("cannot update the working copy in the workspace, it might have changes of its own", "err", err)
+			if changes != "" {
+				slog.Warn(fmt.Sprintf("your uncommitted changes were pushed to the branch %s of the workspace", upBranch))
+			}
+			if workspaceUpOpts.Sync {
+				return prettyprint.AddResolution(fmt.Errorf("cannot sync files with the workspace: %w", err),
+					"commit or discard the changes in the workspace, then run the command again",
+					"run the command without --sync and use Git to exchange changes",
+				)
+			}
 		}
 
 		doneBanner := fmt.Sprintf("\n\n%s\n\nDon't forget to pull your changes to your local working copy before stopping the workspace.\nUse `cd %s && git pull gitpod %s`\n\n", color.New(color.FgGreen, color.Bold).Sprintf("Workspace ready!"), currentDir, branch)
+		if workspaceUpOpts.Sync {
+			doneBanner = fmt.Sprintf("\n\n%s\n\nFiles are synced between %s and the workspace until you stop this command.\n\n", color.New(color.FgGreen, color.Bold).Sprintf("Workspace ready!"), currentDir)
+		}
+
+		var sync *filesync.Sync
+		if workspaceUpOpts.Sync {
+			sftpClient, err := sess.NewSftp()
+			if err != nil {
+				return prettyprint.AddResolution(fmt.Errorf("cannot sync files with the workspace: %w", err),
+					"run the command without --sync and use Git to exchange changes",
+				)
+			}
+			defer sftpClient.Close()
+			ignore, err := filesync.LoadIgnore(currentDir)
+			if err != nil {
+				return err
+			}
+			sync = &filesync.Sync{
+				Local:  filesync.OS(currentDir),
+				Remote: filesync.SFTP(sftpClient, workspaceUpCheckoutDir),
+				Ignore: ignore,
+			}
+			// the first step compares both sides, we want to know about conflicts before we connect
+			err = sync.Step()
+			var conflict *filesync.ConflictError
+			if errors.As(err, &conflict) {
+				return prettyprint.AddResolution(fmt.Errorf("cannot sync files with the workspace: %w", err),
+					"commit or discard the changes in the workspace, then run the command again",
+				)
+			}
+			if err != nil {
+				return err
+			}
+		}
 		slog.Info(doneBanner)
 
 		switch {
+		case workspaceCreateOpts.StartOpts.OpenSSH && sync != nil:
+			syncCtx, cancelSync := context.WithCancel(ctx)
+			syncDone := make(chan error, 1)
+			go func() {
+				syncDone <- sync.Run(syncCtx, workspaceUpSyncInterval)
+			}()
+			err = helper.SSHConnectToWorkspace(ctx, gitpod, workspaceID, false)
+			cancelSync()
+			if err != nil && err.Error() != "exit status 255" {
+				return err
+			}
+			return <-syncDone
 		case workspaceCreateOpts.StartOpts.OpenSSH:
 			err = helper.SSHConnectToWorkspace(ctx, gitpod, workspaceID, false)
 			if err != nil && err.Error() == "exit status 255" {
@@ -260,7 +418,14 @@ var workspaceUpCmd = &cobra.Command{
 				return err
 			}
 		case workspaceCreateOpts.StartOpts.OpenEditor:
-			return helper.OpenWorkspaceInPreferredEditor(ctx, gitpod, workspaceID)
+			err = helper.OpenWorkspaceInPreferredEditor(ctx, gitpod, workspaceID)
+			if err != nil || sync == nil {
+				return err
+			}
+			return sync.Run(ctx, workspaceUpSyncInterval)
+		case sync != nil:
+			slog.Info("Access your workspace at", "url", ws.Instance.Status.Url)
+			return sync.Run(ctx, workspaceUpSyncInterval)
 		default:
 			slog.Info("Access your workspace at", "url", ws.Instance.Status.Url)
 		}
@@ -268,6 +433,102 @@ var workspaceUpCmd = &cobra.Command{
 	},
 }
 
+// findUpWorkspace returns the workspace which was created for a working copy before,
+// and starts it if needed. It returns an empty ID if there is no such workspace (anymore).
+func findUpWorkspace(ctx context.Context, gitpod *client.Gitpod, repo *git.Repository) (string, error) {
+	repoCfg, err := repo.Config()
+	if err != nil {
+		return "", fmt.Errorf("cannot read Git config: %w", err)
+	}
+	workspaceID := repoCfg.Raw.Section(workspaceUpConfigSection).Option(workspaceUpConfigWorkspaceID)
+	if workspaceID == "" {
+		return "", nil
+	}
+
+	ws, err := gitpod.Workspaces.GetWorkspace(ctx, connect.NewRequest(&v1.GetWorkspaceRequest{WorkspaceId: workspaceID}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		slog.Debug("workspace of working copy does not exist anymore", "workspaceID", workspaceID)
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch ws.Msg.GetResult().GetStatus().GetInstance().GetStatus().GetPhase() {
+	case v1.WorkspaceInstanceStatus_PHASE_STOPPING, v1.WorkspaceInstanceStatus_PHASE_STOPPED:
+		slog.Info("workspace is not running, starting it...")
+		_, err = gitpod.Workspaces.StartWorkspace(ctx, connect.NewRequest(&v1.StartWorkspaceRequest{WorkspaceId: workspaceID}))
+		if err != nil {
+			return "", err
+		}
+	}
+	return workspaceID, nil
+}
+
+// snapshotWorkingCopy commits the uncommitted changes of a working copy, including untracked files which are not ignored.
+// Neither the index nor any branch of the working copy are changed. It returns an empty commit if there are no uncommitted changes.
+func snapshotWorkingCopy(dir, branch string) (string, error) {
+	tmpdir, err := os.MkdirTemp("", "gitpod-up-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpdir)
+	index := filepath.Join(tmpdir, "index")
+
+	run := func(args ...string) (string, error) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_INDEX_FILE="+index)
+		out, err := cmd.Output()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("git %s: %w: %s", args[0], err, exitErr.Stderr)
+		}
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+
+	// start from a copy of the actual index, so that git doesn't need to hash unchanged files
+	actualIndex, err := run("rev-parse", "--path-format=absolute", "--git-path", "index")
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(actualIndex)
+	if err == nil {
+		err = os.WriteFile(index, content, 0644)
+	}
+	if os.IsNotExist(err) {
+		_, err = run("read-tree", "HEAD")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	_, err = run("add", "--all")
+	if err != nil {
+		return "", err
+	}
+	tree, err := run("write-tree")
+	if err != nil {
+		return "", err
+	}
+	headTree, err := run("rev-parse", "HEAD^{tree}")
+	if err != nil {
+		return "", err
+	}
+	if tree == headTree {
+		return "", nil
+	}
+	return run("commit-tree", tree, "-p", "HEAD", "-m", "Uncommitted changes of "+branch)
+}
+
+// shellQuote quotes a string for use in a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 func runSSHCommand(ctx context.Context, sess *goph.Client, name string, args ...string) error {
 	cmd, err := sess.Command(name, args...)
 	if err != nil {
@@ -291,6 +552,8 @@ func init() {
 
 	workspaceUpCmd.Flags().StringVar(&workspaceCreateOpts.WorkspaceClass, "class", "", "the workspace class")
 	workspaceUpCmd.Flags().StringVar(&workspaceCreateOpts.Editor, "editor", "code", "the editor to use")
+	workspaceUpCmd.Flags().BoolVar(&workspaceUpOpts.New, "new", false, "create a new workspace instead of reusing the one of the working copy")
+	workspaceUpCmd.Flags().BoolVar(&workspaceUpOpts.Sync, "sync", false, "sync files between the working copy and the workspace until the command is stopped")
 
 	_ = workspaceUpCmd.RegisterFlagCompletionFunc("class", classCompletionFunc)
 	_ = workspaceUpCmd.RegisterFlagCompletionFunc("editor", editorCompletionFunc)
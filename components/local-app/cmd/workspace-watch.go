@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/components/public-api/go/client"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/v1"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
+)
+
+// workspaceWatcher prints workspaces whenever their status changes
+type workspaceWatcher struct {
+	Client *client.Gitpod
+	Out    *prettyprint.StreamWriter[tabularWorkspace]
+
+	// WorkspaceID restricts the watch to a single workspace. If empty, all workspaces of the user are watched.
+	WorkspaceID string
+	// Lookup retrieves workspaces which were not printed initially. If nil, such workspaces are ignored.
+	Lookup func(ctx context.Context, workspaceID string) (*tabularWorkspace, error)
+	// Filter decides if a workspace is printed. If nil, all workspaces are printed.
+	Filter func(ws tabularWorkspace) bool
+
+	rows map[string]tabularWorkspace
+}
+
+// Add adds a workspace which is known already and prints it
+func (w *workspaceWatcher) Add(ws tabularWorkspace) error {
+	if w.Filter != nil && !w.Filter(ws) {
+		return nil
+	}
+	if w.rows == nil {
+		w.rows = make(map[string]tabularWorkspace)
+	}
+	w.rows[ws.ID] = ws
+	return w.Out.Write(ws)
+}
+
+// Watch prints status updates until the context is canceled. Lost connections are re-established.
+func (w *workspaceWatcher) Watch(ctx context.Context) error {
+	for {
+		stream, err := w.Client.WorkspaceService.WatchWorkspaceStatus(ctx, connect.NewRequest(&v1.WatchWorkspaceStatusRequest{WorkspaceId: w.WorkspaceID}))
+		if err == nil {
+			for stream.Receive() {
+				err = w.update(ctx, stream.Msg())
+				if err != nil {
+					stream.Close()
+					return err
+				}
+			}
+			err = stream.Err()
+			stream.Close()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		if c := connect.CodeOf(err); c == connect.CodeUnauthenticated || c == connect.CodePermissionDenied || c == connect.CodeNotFound {
+			return err
+		}
+		slog.Debug("workspace status stream ended, reconnecting", "err", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (w *workspaceWatcher) update(ctx context.Context, msg *v1.WatchWorkspaceStatusResponse) error {
+	id := msg.GetWorkspaceId()
+	row, known := w.rows[id]
+	if !known {
+		if w.Lookup == nil {
+			return nil
+		}
+		ws, err := w.Lookup(ctx, id)
+		if err != nil {
+			return err
+		}
+		if ws == nil {
+			return nil
+		}
+		row = *ws
+	}
+
+	updated := row
+	updated.Status = prettyprint.FormatWorkspacePhase(msg.GetStatus().GetPhase().GetName())
+	if gs := msg.GetStatus().GetGitStatus(); gs != nil {
+		updated.Branch = formatBranch(gs.GetBranch())
+	}
+	if known && updated == row {
+		return nil
+	}
+	// workspaces which were printed once are printed until the watch ends, so that their last change isn't lost
+	if !known && w.Filter != nil && !w.Filter(updated) {
+		return nil
+	}
+
+	if w.rows == nil {
+		w.rows = make(map[string]tabularWorkspace)
+	}
+	w.rows[id] = updated
+	return w.Out.Write(updated)
+}
@@ -5,6 +5,13 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/components/public-api/go/client"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	"github.com/gitpod-io/local-app/pkg/prettyprint"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +21,35 @@ var workspaceCmd = &cobra.Command{
 	Aliases: []string{"workspaces", "ws"},
 }
 
+// writeWorkspaceResult writes the workspace a command acted on for --output, using the same record as workspace get.
+// Humans follow the progress of such commands in the log, hence nothing is written for the default table output.
+func writeWorkspaceResult(ctx context.Context, gitpod *client.Gitpod, workspaceID string) error {
+	if rootOutput.Kind == prettyprint.OutputTable {
+		return nil
+	}
+
+	ws, err := gitpod.Workspaces.GetWorkspace(ctx, connect.NewRequest(&v1.GetWorkspaceRequest{WorkspaceId: workspaceID}))
+	if err != nil {
+		return err
+	}
+	r := newTabularWorkspace(ws.Msg.GetResult())
+	if r == nil {
+		// the workspace has no instance yet
+		r = &tabularWorkspace{ID: workspaceID}
+	}
+	return WriteTabular([]tabularWorkspace{*r}, formatOpts{}, prettyprint.WriterFormatNarrow)
+}
+
+// rejectOutput fails commands which have no result to write for --output, e.g. because they keep a connection open
+func rejectOutput(reason string) error {
+	if rootOutput.Kind == prettyprint.OutputTable {
+		return nil
+	}
+	return usageError(prettyprint.AddResolution(fmt.Errorf("--output is not supported %s", reason),
+		"omit the --output flag",
+	))
+}
+
 func init() {
 	rootCmd.AddCommand(workspaceCmd)
 }
@@ -0,0 +1,343 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package filesync keeps the files of two directories in sync, e.g. a local Git working copy
+// and its checkout in a workspace.
+package filesync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/pkg/sftp"
+)
+
+const (
+	// tempFilePrefix is the prefix of the files we write before renaming them to their final name
+	tempFilePrefix = ".gitpod-sync-"
+	// maxStepRetries is the number of consecutive steps which may fail with transient errors before Run gives up
+	maxStepRetries = 5
+)
+
+// ConflictError is returned by the first step of a sync if files exist on both sides with different content
+type ConflictError struct {
+	Paths []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%d files differ between both sides: %s", len(e.Paths), strings.Join(e.Paths, ", "))
+}
+
+// FileInfo describes a synced file
+type FileInfo struct {
+	Size    int64
+	ModTime time.Time
+	Mode    fs.FileMode
+}
+
+func (f FileInfo) equal(o FileInfo) bool {
+	return f.Size == o.Size && f.ModTime.Equal(o.ModTime) && f.Mode == o.Mode
+}
+
+// FileSystem is one side of a sync. All paths are relative to its root and use forward slashes.
+type FileSystem interface {
+	// Walk calls fn for all files and directories below the root.
+	// If fn returns fs.SkipDir for a directory, its content is skipped.
+	Walk(fn func(path string, info fs.FileInfo) error) error
+	// Stat describes a file
+	Stat(path string) (fs.FileInfo, error)
+	// Open opens a file for reading
+	Open(path string) (io.ReadCloser, error)
+	// WriteFile atomically replaces a file, creating its parent directories if needed
+	WriteFile(path string, mode fs.FileMode, content io.Reader) error
+	// Remove removes a file
+	Remove(path string) error
+}
+
+// LoadIgnore reads the ignore rules of a Git working copy, i.e. its .gitignore files and .git/info/exclude
+func LoadIgnore(dir string) (gitignore.Matcher, error) {
+	patterns, err := gitignore.ReadPatterns(osfs.New(dir), nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read ignore rules of %s: %w", dir, err)
+	}
+	return gitignore.NewMatcher(patterns), nil
+}
+
+// Sync keeps the files of two file systems in sync in both directions.
+//
+// Files which exist on both sides when the sync starts must have the same content, otherwise the first step
+// fails with a ConflictError without changing either side. Afterwards changes of either side are copied to the other side. If a file was changed on both sides,
+// the local change wins. Changes win over deletions.
+type Sync struct {
+	Local  FileSystem
+	Remote FileSystem
+	// Ignore matches the files which are not synced. The .git directory is never synced.
+	Ignore gitignore.Matcher
+
+	// state are the files as of their last sync, by path
+	state       map[string]syncedFile
+	initialized bool
+}
+
+type syncedFile struct {
+	Local  FileInfo
+	Remote FileInfo
+}
+
+// Run syncs both file systems every interval until the context is canceled or syncing fails.
+// Steps which fail with transient errors, e.g. because a file was removed while we copied it, are retried.
+func (s *Sync) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var failures int
+	for {
+		err := s.Step()
+		if err != nil && isTransient(err) && failures < maxStepRetries {
+			failures++
+			slog.Warn("cannot sync files, retrying", "err", err)
+		} else if err != nil {
+			return err
+		} else {
+			failures = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step syncs all changes since the last step
+func (s *Sync) Step() error {
+	local, err := s.list(s.Local)
+	if err != nil {
+		return fmt.Errorf("cannot list local files: %w", err)
+	}
+	remote, err := s.list(s.Remote)
+	if err != nil {
+		return fmt.Errorf("cannot list remote files: %w", err)
+	}
+
+	if !s.initialized {
+		state := make(map[string]syncedFile)
+		var conflicts []string
+		for p, l := range local {
+			r, ok := remote[p]
+			if !ok {
+				continue
+			}
+			same, err := s.sameContent(p, l, r)
+			if err != nil {
+				return err
+			}
+			if !same {
+				conflicts = append(conflicts, p)
+				continue
+			}
+			state[p] = syncedFile{Local: l, Remote: r}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			return &ConflictError{Paths: conflicts}
+		}
+		s.state = state
+		s.initialized = true
+	}
+
+	paths := make(map[string]struct{}, len(local)+len(remote))
+	for p := range local {
+		paths[p] = struct{}{}
+	}
+	for p := range remote {
+		paths[p] = struct{}{}
+	}
+	for p := range s.state {
+		paths[p] = struct{}{}
+	}
+	sorted := make([]string, 0, len(paths))
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+
+	for _, p := range sorted {
+		err := s.syncFile(p, local, remote)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Sync) syncFile(p string, local, remote map[string]FileInfo) error {
+	l, lok := local[p]
+	r, rok := remote[p]
+	st, synced := s.state[p]
+
+	var localChanged, remoteChanged bool
+	if synced {
+		localChanged = !lok || !l.equal(st.Local)
+		remoteChanged = !rok || !r.equal(st.Remote)
+	} else {
+		localChanged, remoteChanged = lok, rok
+	}
+
+	switch {
+	case !localChanged && !remoteChanged:
+		return nil
+	case !lok && !rok:
+		delete(s.state, p)
+		return nil
+	case localChanged && remoteChanged && lok && rok:
+		slog.Warn("file changed locally and in the workspace, keeping the local change", "path", p)
+		return s.upload(p, l)
+	case localChanged && lok:
+		return s.upload(p, l)
+	case remoteChanged && rok:
+		return s.download(p, r)
+	case localChanged:
+		// deleted locally
+		slog.Info("removing from workspace", "path", p)
+		delete(s.state, p)
+		return s.Remote.Remove(p)
+	default:
+		// deleted in the workspace
+		slog.Info("removing locally", "path", p)
+		delete(s.state, p)
+		return s.Local.Remove(p)
+	}
+}
+
+func (s *Sync) upload(p string, l FileInfo) error {
+	slog.Info("copying to workspace", "path", p)
+	r, err := copyFile(s.Local, s.Remote, p, l.Mode)
+	if err != nil {
+		return err
+	}
+	s.state[p] = syncedFile{Local: l, Remote: r}
+	return nil
+}
+
+func (s *Sync) download(p string, r FileInfo) error {
+	slog.Info("copying from workspace", "path", p)
+	l, err := copyFile(s.Remote, s.Local, p, r.Mode)
+	if err != nil {
+		return err
+	}
+	s.state[p] = syncedFile{Local: l, Remote: r}
+	return nil
+}
+
+// sameContent reports whether a file has the same content on both sides
+func (s *Sync) sameContent(p string, l, r FileInfo) (bool, error) {
+	if l.Size != r.Size {
+		return false, nil
+	}
+	lh, err := hashFile(s.Local, p)
+	if err != nil {
+		return false, fmt.Errorf("cannot read local %s: %w", p, err)
+	}
+	rh, err := hashFile(s.Remote, p)
+	if err != nil {
+		return false, fmt.Errorf("cannot read remote %s: %w", p, err)
+	}
+	return bytes.Equal(lh, rh), nil
+}
+
+func hashFile(fsys FileSystem, p string) ([]byte, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// isTransient reports whether an error might go away in the next step
+func isTransient(err error) bool {
+	if errors.Is(err, fs.ErrNotExist) {
+		// the file was removed while we copied it
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var statusErr *sftp.StatusError
+	return errors.As(err, &statusErr) && statusErr.FxCode() == sftp.ErrSSHFxFailure
+}
+
+// copyFile copies a file and returns how it looks like at its destination
+func copyFile(src, dst FileSystem, p string, mode fs.FileMode) (FileInfo, error) {
+	in, err := src.Open(p)
+	if err != nil {
+		return FileInfo{}, fmt.Errorf("cannot read %s: %w", p, err)
+	}
+	defer in.Close()
+
+	err = dst.WriteFile(p, mode, in)
+	if err != nil {
+		return FileInfo{}, fmt.Errorf("cannot write %s: %w", p, err)
+	}
+	stat, err := dst.Stat(p)
+	if err != nil {
+		return FileInfo{}, err
+	}
+	return fileInfo(stat), nil
+}
+
+// list returns the regular files of a file system which are not ignored
+func (s *Sync) list(fsys FileSystem) (map[string]FileInfo, error) {
+	res := make(map[string]FileInfo)
+	err := fsys.Walk(func(p string, info fs.FileInfo) error {
+		if p == "" || p == "." {
+			return nil
+		}
+		segments := strings.Split(p, "/")
+		if segments[0] == ".git" || (s.Ignore != nil && s.Ignore.Match(segments, info.IsDir())) {
+			if info.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() || strings.HasPrefix(path.Base(p), tempFilePrefix) {
+			return nil
+		}
+		res[p] = fileInfo(info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func fileInfo(info fs.FileInfo) FileInfo {
+	return FileInfo{
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+		Mode:    info.Mode().Perm(),
+	}
+}
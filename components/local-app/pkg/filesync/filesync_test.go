@@ -0,0 +1,235 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package filesync
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSync(t *testing.T) {
+	var (
+		localDir  = t.TempDir()
+		remoteDir = t.TempDir()
+		mtime     = time.Now().Add(-time.Hour)
+	)
+	write := func(dir, name, content string) {
+		t.Helper()
+		fn := filepath.Join(dir, filepath.FromSlash(name))
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// make sure every write is seen as a change, no matter the resolution of the clock
+		mtime = mtime.Add(time.Second)
+		err = os.Chtimes(fn, mtime, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	remove := func(dir, name string) {
+		t.Helper()
+		err := os.Remove(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := func(dir string) map[string]string {
+		t.Helper()
+		res := make(map[string]string)
+		err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			rel, _ := filepath.Rel(dir, p)
+			res[filepath.ToSlash(rel)] = string(content)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	write(localDir, "main.go", "package main")
+	write(localDir, "shared.txt", "shared")
+	write(localDir, "debug.log", "ignored")
+	write(localDir, ".git/HEAD", "ref: refs/heads/main")
+	write(remoteDir, "shared.txt", "shared")
+	write(remoteDir, "pkg/generated.go", "package pkg")
+
+	sync := &Sync{
+		Local:  OS(localDir),
+		Remote: OS(remoteDir),
+		Ignore: gitignore.NewMatcher([]gitignore.Pattern{gitignore.ParsePattern("*.log", nil)}),
+	}
+	step := func(name string, expectedLocal, expectedRemote map[string]string) {
+		t.Helper()
+		err := sync.Step()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if diff := cmp.Diff(expectedLocal, files(localDir)); diff != "" {
+			t.Errorf("%s: unexpected local files (-want +got):\n%s", name, diff)
+		}
+		if diff := cmp.Diff(expectedRemote, files(remoteDir)); diff != "" {
+			t.Errorf("%s: unexpected remote files (-want +got):\n%s", name, diff)
+		}
+	}
+
+	// files with the same content on both sides are in sync initially
+	step("initial sync", map[string]string{
+		"main.go":          "package main",
+		"shared.txt":       "shared",
+		"debug.log":        "ignored",
+		".git/HEAD":        "ref: refs/heads/main",
+		"pkg/generated.go": "package pkg",
+	}, map[string]string{
+		"main.go":          "package main",
+		"shared.txt":       "shared",
+		"pkg/generated.go": "package pkg",
+	})
+
+	write(localDir, "main.go", "package main // changed")
+	remove(remoteDir, "pkg/generated.go")
+	step("changes on both sides", map[string]string{
+		"main.go":    "package main // changed",
+		"shared.txt": "shared",
+		"debug.log":  "ignored",
+		".git/HEAD":  "ref: refs/heads/main",
+	}, map[string]string{
+		"main.go":    "package main // changed",
+		"shared.txt": "shared",
+	})
+
+	write(localDir, "shared.txt", "local change")
+	write(remoteDir, "shared.txt", "remote change")
+	write(remoteDir, "main.go", "package main // remote change")
+	remove(localDir, "main.go")
+	step("conflicts", map[string]string{
+		"main.go":    "package main // remote change",
+		"shared.txt": "local change",
+		"debug.log":  "ignored",
+		".git/HEAD":  "ref: refs/heads/main",
+	}, map[string]string{
+		"main.go":    "package main // remote change",
+		"shared.txt": "local change",
+	})
+
+	step("no changes", map[string]string{
+		"main.go":    "package main // remote change",
+		"shared.txt": "local change",
+		"debug.log":  "ignored",
+		".git/HEAD":  "ref: refs/heads/main",
+	}, map[string]string{
+		"main.go":    "package main // remote change",
+		"shared.txt": "local change",
+	})
+}
+
+func TestSyncConflicts(t *testing.T) {
+	var (
+		localDir  = t.TempDir()
+		remoteDir = t.TempDir()
+	)
+	for dir, files := range map[string]map[string]string{
+		localDir:  {"same.txt": "same", "size.txt": "local", "content.txt": "local"},
+		remoteDir: {"same.txt": "same", "size.txt": "remote", "content.txt": "other"},
+	} {
+		for name, content := range files {
+			err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	sync := &Sync{Local: OS(localDir), Remote: OS(remoteDir)}
+	err := sync.Step()
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+	if diff := cmp.Diff([]string{"content.txt", "size.txt"}, conflict.Paths); diff != "" {
+		t.Errorf("unexpected conflicts (-want +got):\n%s", diff)
+	}
+	content, _ := os.ReadFile(filepath.Join(remoteDir, "size.txt"))
+	if string(content) != "remote" {
+		t.Errorf("conflicting file was overwritten: %q", content)
+	}
+}
+
+// flakyFileSystem fails to walk the first times
+type flakyFileSystem struct {
+	FileSystem
+	failures int
+	err      error
+}
+
+func (f *flakyFileSystem) Walk(fn func(path string, info fs.FileInfo) error) error {
+	if f.failures > 0 {
+		f.failures--
+		return f.err
+	}
+	return f.FileSystem.Walk(fn)
+}
+
+func TestRunRetries(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Failures    int
+		Err         error
+		ExpectError bool
+	}{
+		{Name: "transient error", Failures: 2, Err: fs.ErrNotExist},
+		{Name: "persistent transient error", Failures: maxStepRetries + 1, Err: fs.ErrNotExist, ExpectError: true},
+		{Name: "other error", Failures: 1, Err: fs.ErrPermission, ExpectError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				localDir  = t.TempDir()
+				remoteDir = t.TempDir()
+			)
+			err := os.WriteFile(filepath.Join(localDir, "main.go"), []byte("package main"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sync := &Sync{
+				Local:  OS(localDir),
+				Remote: &flakyFileSystem{FileSystem: OS(remoteDir), failures: test.Failures, err: test.Err},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+			err = sync.Run(ctx, time.Millisecond)
+			if (err != nil) != test.ExpectError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.ExpectError {
+				return
+			}
+			if _, err := os.Stat(filepath.Join(remoteDir, "main.go")); err != nil {
+				t.Errorf("file was not synced after retrying: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package filesync
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/sftp"
+)
+
+// OS returns the file system of a local directory
+func OS(root string) FileSystem {
+	return osFileSystem{root: root}
+}
+
+type osFileSystem struct {
+	root string
+}
+
+func (o osFileSystem) path(p string) string {
+	return filepath.Join(o.root, filepath.FromSlash(p))
+}
+
+func (o osFileSystem) Walk(fn func(path string, info fs.FileInfo) error) error {
+	return filepath.WalkDir(o.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if os.IsNotExist(err) {
+			// removed while we were walking
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(o.root, p)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(rel), info)
+	})
+}
+
+func (o osFileSystem) Stat(p string) (fs.FileInfo, error) {
+	return os.Stat(o.path(p))
+}
+
+func (o osFileSystem) Open(p string) (io.ReadCloser, error) {
+	return os.Open(o.path(p))
+}
+
+func (o osFileSystem) WriteFile(p string, mode fs.FileMode, content io.Reader) error {
+	fn := o.path(p)
+	err := os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(fn), tempFilePrefix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = io.Copy(f, content)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(f.Name(), mode)
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), fn)
+}
+
+func (o osFileSystem) Remove(p string) error {
+	err := os.Remove(o.path(p))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// SFTP returns the file system of a directory on an SFTP server
+func SFTP(client *sftp.Client, root string) FileSystem {
+	return sftpFileSystem{client: client, root: root}
+}
+
+type sftpFileSystem struct {
+	client *sftp.Client
+	root   string
+}
+
+func (s sftpFileSystem) path(p string) string {
+	return path.Join(s.root, p)
+}
+
+func (s sftpFileSystem) Walk(fn func(path string, info fs.FileInfo) error) error {
+	walker := s.client.Walk(s.root)
+	for walker.Step() {
+		if err := walker.Err(); os.IsNotExist(err) {
+			// removed while we were walking
+			continue
+		} else if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(walker.Path(), s.root), "/")
+		err := fn(rel, walker.Stat())
+		if err == fs.SkipDir {
+			walker.SkipDir()
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s sftpFileSystem) Stat(p string) (fs.FileInfo, error) {
+	return s.client.Stat(s.path(p))
+}
+
+func (s sftpFileSystem) Open(p string) (io.ReadCloser, error) {
+	return s.client.Open(s.path(p))
+}
+
+func (s sftpFileSystem) WriteFile(p string, mode fs.FileMode, content io.Reader) error {
+	fn := s.path(p)
+	err := s.client.MkdirAll(path.Dir(fn))
+	if err != nil {
+		return err
+	}
+	tmp := path.Join(path.Dir(fn), tempFilePrefix+uuid.New().String())
+	f, err := s.client.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = s.client.Remove(tmp)
+	}()
+
+	_, err = io.Copy(f, content)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	err = s.client.Chmod(tmp, mode)
+	if err != nil {
+		return err
+	}
+	return s.client.PosixRename(tmp, fn)
+}
+
+func (s sftpFileSystem) Remove(p string) error {
+	err := s.client.Remove(s.path(p))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -22,6 +23,7 @@ import (
 	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
 	"github.com/gitpod-io/local-app/pkg/prettyprint"
 	"github.com/skratchdot/open-golang/open"
+	"golang.org/x/crypto/ssh"
 )
 
 // OpenWorkspaceInPreferredEditor opens the workspace in the user's preferred editor
@@ -136,6 +138,43 @@ func SSHConnectToWorkspace(ctx context.Context, clnt *client.Gitpod, workspaceID
 	return nil
 }
 
+// SSHClient connects to the SSH gateway of a running workspace
+func SSHClient(ctx context.Context, clnt *client.Gitpod, workspaceID string) (*ssh.Client, error) {
+	workspace, err := clnt.Workspaces.GetWorkspace(ctx, connect.NewRequest(&v1.GetWorkspaceRequest{WorkspaceId: workspaceID}))
+	if err != nil {
+		return nil, err
+	}
+
+	wsInfo := workspace.Msg.GetResult()
+	if !HasInstanceStatus(wsInfo) || wsInfo.Status.Instance.Status.Phase != v1.WorkspaceInstanceStatus_PHASE_RUNNING {
+		return nil, fmt.Errorf("cannot connect, workspace is not running")
+	}
+
+	token, err := clnt.Workspaces.GetOwnerToken(ctx, connect.NewRequest(&v1.GetOwnerTokenRequest{WorkspaceId: workspaceID}))
+	if err != nil {
+		return nil, err
+	}
+
+	addr := net.JoinHostPort(WorkspaceSSHHost(wsInfo), "22")
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, prettyprint.AddResolution(fmt.Errorf("cannot connect to workspace: %w", err),
+			"make sure you can connect to SSH servers on port 22",
+		)
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, &ssh.ClientConfig{
+		User:            fmt.Sprintf("%s#%s", workspaceID, token.Msg.Token),
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         10 * time.Second,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("cannot connect to workspace: %w", err)
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
 func WorkspaceSSHHost(ws *v1.Workspace) string {
 	if ws == nil || ws.Status == nil || ws.Status.Instance == nil || ws.Status.Instance.Status == nil {
 		return ""
@@ -156,6 +195,26 @@ func HasInstanceStatus(ws *v1.Workspace) bool {
 	return true
 }
 
+// EnsureWorkspaceRunning starts the workspace if it's not running and waits until it is
+func EnsureWorkspaceRunning(ctx context.Context, clnt *client.Gitpod, workspaceID string) (*v1.WorkspaceStatus, error) {
+	ws, err := clnt.Workspaces.GetWorkspace(ctx, connect.NewRequest(&v1.GetWorkspaceRequest{WorkspaceId: workspaceID}))
+	if err != nil {
+		return nil, err
+	}
+
+	switch ws.Msg.GetResult().GetStatus().GetInstance().GetStatus().GetPhase() {
+	case v1.WorkspaceInstanceStatus_PHASE_RUNNING:
+		return ws.Msg.Result.Status, nil
+	case v1.WorkspaceInstanceStatus_PHASE_STOPPING, v1.WorkspaceInstanceStatus_PHASE_STOPPED, v1.WorkspaceInstanceStatus_PHASE_UNSPECIFIED:
+		slog.Info("workspace is not running, starting it...")
+		_, err := clnt.Workspaces.StartWorkspace(ctx, connect.NewRequest(&v1.StartWorkspaceRequest{WorkspaceId: workspaceID}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ObserveWorkspaceUntilStarted(ctx, clnt, workspaceID)
+}
+
 // ObserveWorkspaceUntilStarted waits for the workspace to start and prints the status
 func ObserveWorkspaceUntilStarted(ctx context.Context, clnt *client.Gitpod, workspaceID string) (*v1.WorkspaceStatus, error) {
 	wsInfo, err := clnt.Workspaces.GetWorkspace(ctx, connect.NewRequest(&v1.GetWorkspaceRequest{WorkspaceId: workspaceID}))
@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package manifest describes sets of workspaces declaratively, and plans the changes
+// needed to turn the existing workspaces into the ones described.
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// State is the desired state of a workspace
+type State string
+
+const (
+	// StateRunning means the workspace should be running. This is the default.
+	StateRunning State = "running"
+	// StateStopped means the workspace should exist but be stopped
+	StateStopped State = "stopped"
+)
+
+// Manifest describes a set of workspaces
+type Manifest struct {
+	// Name identifies the workspaces managed by this manifest. Applying a manifest never touches workspaces of other manifests.
+	Name       string      `yaml:"name"`
+	Workspaces []Workspace `yaml:"workspaces"`
+}
+
+// Workspace describes a single workspace of a manifest
+type Workspace struct {
+	// Name identifies the workspace within the manifest
+	Name       string            `yaml:"name" json:"name"`
+	ContextURL string            `yaml:"contextUrl" json:"contextUrl"`
+	Class      string            `yaml:"class,omitempty" json:"class,omitempty"`
+	Editor     string            `yaml:"editor,omitempty" json:"editor,omitempty"`
+	Env        map[string]string `yaml:"env,omitempty" json:"env,omitempty"`
+	State      State             `yaml:"state,omitempty" json:"-"`
+}
+
+var (
+	namePattern   = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	envVarPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// Load reads a manifest from a file. The filename "-" reads from stdin.
+func Load(fn string) (*Manifest, error) {
+	if fn == "-" {
+		return Parse(os.Stdin)
+	}
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse reads and validates a manifest
+func Parse(r io.Reader) (*Manifest, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var res Manifest
+	err := dec.Decode(&res)
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("manifest is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse manifest: %w", err)
+	}
+	err = res.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Validate checks the manifest for errors and sets defaults
+func (m *Manifest) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("manifest name %q is invalid: use lower case letters, digits and dashes", m.Name)
+	}
+
+	names := make(map[string]struct{}, len(m.Workspaces))
+	for i := range m.Workspaces {
+		ws := &m.Workspaces[i]
+		if !namePattern.MatchString(ws.Name) {
+			return fmt.Errorf("workspace name %q is invalid: use lower case letters, digits and dashes", ws.Name)
+		}
+		if _, exists := names[ws.Name]; exists {
+			return fmt.Errorf("workspace %s is defined more than once", ws.Name)
+		}
+		names[ws.Name] = struct{}{}
+
+		if ws.ContextURL == "" {
+			return fmt.Errorf("workspace %s has no contextUrl", ws.Name)
+		}
+		for k, v := range ws.Env {
+			if !envVarPattern.MatchString(k) {
+				return fmt.Errorf("workspace %s: environment variable name %q is invalid: use letters, digits, underscores and dashes", ws.Name, k)
+			}
+			// Gitpod ignores empty values in context URLs
+			if v == "" {
+				return fmt.Errorf("workspace %s: environment variable %s has no value", ws.Name, k)
+			}
+		}
+		switch ws.State {
+		case "":
+			ws.State = StateRunning
+		case StateRunning, StateStopped:
+		default:
+			return fmt.Errorf("workspace %s: state %q is invalid: use %s or %s", ws.Name, ws.State, StateRunning, StateStopped)
+		}
+	}
+	return nil
+}
+
+// ContextURLWithEnv returns the context URL to create the workspace from, including its environment variables
+func (ws Workspace) ContextURLWithEnv() string {
+	if len(ws.Env) == 0 {
+		return ws.ContextURL
+	}
+
+	keys := make([]string, 0, len(ws.Env))
+	for k := range ws.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	vars := make([]string, 0, len(keys))
+	for _, k := range keys {
+		// Gitpod decodes values using decodeURIComponent, which does not turn + into a space
+		v := strings.ReplaceAll(url.QueryEscape(ws.Env[k]), "+", "%20")
+		vars = append(vars, k+"="+v)
+	}
+	return strings.Join(vars, ",") + "/" + ws.ContextURL
+}
+
+// SpecHash identifies the configuration of a workspace. Workspaces whose configuration changes are replaced.
+// The state is not part of the hash, as workspaces can be started and stopped.
+func (ws Workspace) SpecHash() string {
+	// json.Marshal sorts map keys, which makes the hash stable
+	spec, _ := json.Marshal(ws)
+	sum := sha256.Sum256(spec)
+	return hex.EncodeToString(sum[:])[:8]
+}
+
+// descriptionPattern matches the descriptions produced by Description
+var descriptionPattern = regexp.MustCompile(`^([a-z0-9-]+)/([a-z0-9-]+) \[([0-9a-f]{8})\]$`)
+
+// Description returns the workspace description which marks a workspace as managed by this manifest
+func (m *Manifest) Description(ws Workspace) string {
+	return fmt.Sprintf("%s/%s [%s]", m.Name, ws.Name, ws.SpecHash())
+}
+
+// ParseDescription parses a description produced by Description. ok is false for workspaces which aren't managed by a manifest.
+func ParseDescription(description string) (manifest, workspace, specHash string, ok bool) {
+	match := descriptionPattern.FindStringSubmatch(description)
+	if match == nil {
+		return "", "", "", false
+	}
+	return match[1], match[2], match[3], true
+}
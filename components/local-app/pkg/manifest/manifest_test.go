@@ -0,0 +1,271 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package manifest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Input       string
+		Expectation *Manifest
+		Error       string
+	}{
+		{
+			Name: "valid",
+			Input: `name: onboarding
+workspaces:
+  - name: backend
+    contextUrl: https://github.com/gitpod-io/gitpod
+    class: g1-large
+    editor: code
+    env:
+      FOO: bar
+  - name: docs
+    contextUrl: https://github.com/gitpod-io/website
+    state: stopped
+`,
+			Expectation: &Manifest{
+				Name: "onboarding",
+				Workspaces: []Workspace{
+					{Name: "backend", ContextURL: "https://github.com/gitpod-io/gitpod", Class: "g1-large", Editor: "code", Env: map[string]string{"FOO": "bar"}, State: StateRunning},
+					{Name: "docs", ContextURL: "https://github.com/gitpod-io/website", State: StateStopped},
+				},
+			},
+		},
+		{
+			Name:  "empty",
+			Input: "",
+			Error: "manifest is empty",
+		},
+		{
+			Name:  "unknown field",
+			Input: "name: onboarding\nworkspace: []\n",
+			Error: "cannot parse manifest: yaml: unmarshal errors:\n  line 2: field workspace not found in type manifest.Manifest",
+		},
+		{
+			Name:  "missing name",
+			Input: "workspaces: []\n",
+			Error: `manifest name "" is invalid: use lower case letters, digits and dashes`,
+		},
+		{
+			Name:  "invalid workspace name",
+			Input: "name: onboarding\nworkspaces:\n  - name: Backend\n    contextUrl: https://github.com/gitpod-io/gitpod\n",
+			Error: `workspace name "Backend" is invalid: use lower case letters, digits and dashes`,
+		},
+		{
+			Name:  "duplicate workspace",
+			Input: "name: onboarding\nworkspaces:\n  - name: backend\n    contextUrl: a\n  - name: backend\n    contextUrl: b\n",
+			Error: "workspace backend is defined more than once",
+		},
+		{
+			Name:  "missing context URL",
+			Input: "name: onboarding\nworkspaces:\n  - name: backend\n",
+			Error: "workspace backend has no contextUrl",
+		},
+		{
+			Name:  "invalid env var",
+			Input: "name: onboarding\nworkspaces:\n  - name: backend\n    contextUrl: a\n    env:\n      FOO BAR: baz\n",
+			Error: `workspace backend: environment variable name "FOO BAR" is invalid: use letters, digits, underscores and dashes`,
+		},
+		{
+			Name:  "empty env var",
+			Input: "name: onboarding\nworkspaces:\n  - name: backend\n    contextUrl: a\n    env:\n      FOO: \"\"\n",
+			Error: "workspace backend: environment variable FOO has no value",
+		},
+		{
+			Name:  "invalid state",
+			Input: "name: onboarding\nworkspaces:\n  - name: backend\n    contextUrl: a\n    state: deleted\n",
+			Error: `workspace backend: state "deleted" is invalid: use running or stopped`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := Parse(strings.NewReader(test.Input))
+			var actErr string
+			if err != nil {
+				actErr = err.Error()
+			}
+			if actErr != test.Error {
+				t.Fatalf("unexpected error: want %q, got %q", test.Error, actErr)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestContextURLWithEnv(t *testing.T) {
+	ws := Workspace{
+		ContextURL: "https://github.com/gitpod-io/gitpod",
+		Env:        map[string]string{"FOO": "a b/c,d=e", "BAR": "1"},
+	}
+	act := ws.ContextURLWithEnv()
+	exp := "BAR=1,FOO=a%20b%2Fc%2Cd%3De/https://github.com/gitpod-io/gitpod"
+	if act != exp {
+		t.Errorf("expected %s, got %s", exp, act)
+	}
+}
+
+func TestDescription(t *testing.T) {
+	m := &Manifest{Name: "onboarding"}
+	ws := Workspace{Name: "backend", ContextURL: "https://github.com/gitpod-io/gitpod"}
+
+	desc := m.Description(ws)
+	manifest, name, hash, ok := ParseDescription(desc)
+	if !ok || manifest != "onboarding" || name != "backend" || hash != ws.SpecHash() {
+		t.Errorf("cannot parse description %q: got %q %q %q %v", desc, manifest, name, hash, ok)
+	}
+
+	stopped := ws
+	stopped.State = StateStopped
+	if m.Description(stopped) != desc {
+		t.Errorf("state must not change the description")
+	}
+	changed := ws
+	changed.Class = "g1-large"
+	if m.Description(changed) == desc {
+		t.Errorf("class must change the description")
+	}
+
+	if _, _, _, ok := ParseDescription("my workspace"); ok {
+		t.Errorf("unmanaged description must not parse")
+	}
+}
+
+func TestPlan(t *testing.T) {
+	m := &Manifest{
+		Name: "onboarding",
+		Workspaces: []Workspace{
+			{Name: "unchanged", ContextURL: "a", State: StateRunning},
+			{Name: "new", ContextURL: "b", State: StateRunning},
+			{Name: "stopped", ContextURL: "c", State: StateRunning},
+			{Name: "running", ContextURL: "d", State: StateStopped},
+			{Name: "changed", ContextURL: "e", Class: "g1-large", State: StateRunning},
+		},
+	}
+	desc := func(name string) string {
+		for _, ws := range m.Workspaces {
+			if ws.Name == name {
+				return m.Description(ws)
+			}
+		}
+		return m.Description(Workspace{Name: name})
+	}
+	existing := []Existing{
+		{ID: "ws-unchanged", Description: desc("unchanged")},
+		{ID: "ws-stopped", Description: desc("stopped"), Stopped: true},
+		{ID: "ws-running", Description: desc("running")},
+		{ID: "ws-changed", Description: "onboarding/changed [00000000]"},
+		{ID: "ws-removed", Description: desc("removed")},
+		{ID: "ws-duplicate", Description: desc("unchanged")},
+		{ID: "ws-other-manifest", Description: "other/unchanged [00000000]"},
+		{ID: "ws-unmanaged", Description: "my workspace"},
+	}
+
+	type result struct {
+		Kind ActionKind
+		Name string
+		ID   string
+	}
+	summarize := func(actions []Action) []result {
+		var res []result
+		for _, act := range actions {
+			r := result{Kind: act.Kind, Name: act.Name}
+			if act.Existing != nil {
+				r.ID = act.Existing.ID
+			}
+			res = append(res, r)
+		}
+		return res
+	}
+
+	expectation := []result{
+		{Kind: ActionNone, Name: "unchanged", ID: "ws-unchanged"},
+		{Kind: ActionCreate, Name: "new"},
+		{Kind: ActionStart, Name: "stopped", ID: "ws-stopped"},
+		{Kind: ActionStop, Name: "running", ID: "ws-running"},
+		{Kind: ActionOutdated, Name: "changed", ID: "ws-changed"},
+	}
+	if diff := cmp.Diff(expectation, summarize(m.Plan(existing, false, false))); diff != "" {
+		t.Errorf("Plan() mismatch (-want +got):\n%s", diff)
+	}
+
+	expectation = append(expectation,
+		result{Kind: ActionDelete, Name: "unchanged", ID: "ws-duplicate"},
+		result{Kind: ActionDelete, Name: "removed", ID: "ws-removed"},
+	)
+	if diff := cmp.Diff(expectation, summarize(m.Plan(existing, true, false))); diff != "" {
+		t.Errorf("Plan() with prune mismatch (-want +got):\n%s", diff)
+	}
+
+	expectation[4].Kind = ActionReplace
+	if diff := cmp.Diff(expectation, summarize(m.Plan(existing, true, true))); diff != "" {
+		t.Errorf("Plan() with recreate mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	actions := []Action{
+		{
+			Kind:     ActionNone,
+			Name:     "unchanged",
+			Desired:  &Workspace{Name: "unchanged", ContextURL: "a", State: StateRunning},
+			Existing: &Existing{ID: "ws-unchanged", ContextURL: "a"},
+		},
+		{
+			Kind:    ActionCreate,
+			Name:    "new",
+			Desired: &Workspace{Name: "new", ContextURL: "b", Editor: "code", Env: map[string]string{"FOO": "bar"}, State: StateRunning},
+		},
+		{
+			Kind:     ActionReplace,
+			Name:     "changed",
+			Desired:  &Workspace{Name: "changed", ContextURL: "c", Class: "g1-large", State: StateRunning},
+			Existing: &Existing{ID: "ws-changed", ContextURL: "c", Class: "g1-standard", Editor: "code"},
+		},
+		{
+			Kind:     ActionStart,
+			Name:     "stopped",
+			Desired:  &Workspace{Name: "stopped", ContextURL: "d", State: StateRunning},
+			Existing: &Existing{ID: "ws-stopped", ContextURL: "d", Stopped: true},
+		},
+		{
+			Kind:     ActionDelete,
+			Name:     "removed",
+			Existing: &Existing{ID: "ws-removed", ContextURL: "e", Stopped: true},
+		},
+	}
+	expectation := `+ new
++   contextUrl: FOO=bar/b
++   editor: code
++   state: running
+~ changed (replace, ws-changed)
+-   class: g1-standard
++   class: g1-large
+~ stopped (start, ws-stopped)
+-   state: stopped
++   state: running
+- removed (ws-removed)
+-   contextUrl: e
+-   state: stopped
+`
+
+	var out bytes.Buffer
+	err := WriteDiff(&out, actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expectation, out.String()); diff != "" {
+		t.Errorf("WriteDiff() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package manifest
+
+import (
+	"fmt"
+	"io"
+)
+
+// Existing is a workspace which exists already
+type Existing struct {
+	ID          string
+	Description string
+	ContextURL  string
+	Class       string
+	Editor      string
+	Stopped     bool
+}
+
+// ActionKind is the kind of change applied to a workspace
+type ActionKind string
+
+const (
+	ActionNone     ActionKind = "unchanged"
+	ActionCreate   ActionKind = "create"
+	ActionStart    ActionKind = "start"
+	ActionStop     ActionKind = "stop"
+	ActionReplace  ActionKind = "replace"
+	ActionDelete   ActionKind = "delete"
+	ActionOutdated ActionKind = "out of date"
+)
+
+// Action is a change to a single workspace
+type Action struct {
+	Kind ActionKind
+	Name string
+	// Desired is the workspace from the manifest. It's nil for deletions.
+	Desired *Workspace
+	// Existing is the workspace which exists already. It's nil for creations.
+	Existing *Existing
+}
+
+// Plan determines the actions needed to turn the existing workspaces into the ones of the manifest.
+// Workspaces which aren't managed by the manifest are ignored. Managed workspaces which are no longer
+// part of the manifest are only deleted if prune is true. Workspaces whose contextUrl, class, editor or env
+// changed are only replaced if recreate is true, otherwise they are left alone and reported as out of date.
+func (m *Manifest) Plan(existing []Existing, prune, recreate bool) []Action {
+	var (
+		managed = make(map[string]*Existing)
+		orphans []*Existing
+	)
+	for i := range existing {
+		ws := &existing[i]
+		manifest, name, _, ok := ParseDescription(ws.Description)
+		if !ok || manifest != m.Name {
+			continue
+		}
+		if _, dup := managed[name]; dup {
+			orphans = append(orphans, ws)
+			continue
+		}
+		managed[name] = ws
+	}
+
+	res := make([]Action, 0, len(m.Workspaces))
+	for i := range m.Workspaces {
+		desired := &m.Workspaces[i]
+		act := Action{Name: desired.Name, Desired: desired, Existing: managed[desired.Name]}
+		delete(managed, desired.Name)
+
+		switch {
+		case act.Existing == nil:
+			act.Kind = ActionCreate
+		case act.Existing.Description != m.Description(*desired) && recreate:
+			act.Kind = ActionReplace
+		case act.Existing.Description != m.Description(*desired):
+			act.Kind = ActionOutdated
+		case desired.State == StateRunning && act.Existing.Stopped:
+			act.Kind = ActionStart
+		case desired.State == StateStopped && !act.Existing.Stopped:
+			act.Kind = ActionStop
+		default:
+			act.Kind = ActionNone
+		}
+		res = append(res, act)
+	}
+
+	if !prune {
+		return res
+	}
+	for i := range existing {
+		ws := &existing[i]
+		_, name, _, _ := ParseDescription(ws.Description)
+		if managed[name] == ws {
+			orphans = append(orphans, ws)
+		}
+	}
+	for _, ws := range orphans {
+		_, name, _, _ := ParseDescription(ws.Description)
+		res = append(res, Action{Kind: ActionDelete, Name: name, Existing: ws})
+	}
+	return res
+}
+
+// WriteDiff writes the changes of the given actions as a diff. Unchanged workspaces are omitted.
+func WriteDiff(out io.Writer, actions []Action) error {
+	for _, act := range actions {
+		var err error
+		switch act.Kind {
+		case ActionNone:
+			continue
+		case ActionCreate:
+			_, err = fmt.Fprintf(out, "+ %s\n", act.Name)
+			for _, f := range desiredFields(act.Desired) {
+				if err == nil && f.Value != "" {
+					_, err = fmt.Fprintf(out, "+   %s: %s\n", f.Name, f.Value)
+				}
+			}
+		case ActionDelete:
+			_, err = fmt.Fprintf(out, "- %s (%s)\n", act.Name, act.Existing.ID)
+			for _, f := range existingFields(act.Existing) {
+				if err == nil && f.Value != "" {
+					_, err = fmt.Fprintf(out, "-   %s: %s\n", f.Name, f.Value)
+				}
+			}
+		default:
+			_, err = fmt.Fprintf(out, "~ %s (%s, %s)\n", act.Name, act.Kind, act.Existing.ID)
+			var (
+				desired  = desiredFields(act.Desired)
+				existing = existingFields(act.Existing)
+			)
+			for i := range desired {
+				// empty fields use the default of Gitpod, hence they don't differ from whatever exists
+				if err != nil || desired[i].Value == "" || desired[i].Value == existing[i].Value {
+					continue
+				}
+				if existing[i].Value != "" {
+					_, err = fmt.Fprintf(out, "-   %s: %s\n", existing[i].Name, existing[i].Value)
+				}
+				if err == nil {
+					_, err = fmt.Fprintf(out, "+   %s: %s\n", desired[i].Name, desired[i].Value)
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type field struct {
+	Name  string
+	Value string
+}
+
+// desiredFields and existingFields must return the same fields in the same order
+func desiredFields(ws *Workspace) []field {
+	return []field{
+		{"contextUrl", ws.ContextURLWithEnv()},
+		{"class", ws.Class},
+		{"editor", ws.Editor},
+		{"state", string(ws.State)},
+	}
+}
+
+func existingFields(ws *Existing) []field {
+	state := StateRunning
+	if ws.Stopped {
+		state = StateStopped
+	}
+	return []field{
+		{"contextUrl", ws.ContextURL},
+		{"class", ws.Class},
+		{"editor", ws.Editor},
+		{"state", string(state)},
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package portforward forwards local ports to ports of a workspace, e.g. through an SSH connection.
+package portforward
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBindAddress is the local address forwarded ports listen on if not specified otherwise
+const DefaultBindAddress = "127.0.0.1"
+
+// Dialer opens connections to ports of a workspace, e.g. an *ssh.Client
+type Dialer interface {
+	Dial(network, addr string) (net.Conn, error)
+}
+
+// Spec describes a forwarded port
+type Spec struct {
+	// LocalAddr is the local address to listen on. A port of 0 picks a random port.
+	LocalAddr string
+	// RemotePort is the port in the workspace
+	RemotePort int
+}
+
+// ParseSpec parses a port forwarding spec of the form [[bind-address:]local-port:]remote-port
+func ParseSpec(s string) (Spec, error) {
+	var (
+		local  string
+		remote = s
+	)
+	if idx := strings.LastIndex(s, ":"); idx >= 0 {
+		local, remote = s[:idx], s[idx+1:]
+	}
+	remotePort, err := parsePort(remote, false)
+	if err != nil {
+		return Spec{}, fmt.Errorf("invalid port forwarding %s: %w", s, err)
+	}
+	if local == "" {
+		local = remote
+	}
+
+	bindAddr, localPort := DefaultBindAddress, local
+	if idx := strings.LastIndex(local, ":"); idx >= 0 {
+		bindAddr, localPort = strings.Trim(local[:idx], "[]"), local[idx+1:]
+	}
+	if _, err := parsePort(localPort, true); err != nil {
+		return Spec{}, fmt.Errorf("invalid port forwarding %s: %w", s, err)
+	}
+	return Spec{
+		LocalAddr:  net.JoinHostPort(bindAddr, localPort),
+		RemotePort: remotePort,
+	}, nil
+}
+
+func parsePort(s string, allowZero bool) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 0 || port > 65535 || (port == 0 && !allowZero) {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return port, nil
+}
+
+// Forward forwards the connections accepted on a local port to a port in the workspace
+type Forward struct {
+	Spec Spec
+
+	dialer   Dialer
+	listener net.Listener
+
+	mu     sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+// Listen starts forwarding a port. Call Close to stop forwarding.
+func Listen(dialer Dialer, spec Spec) (*Forward, error) {
+	l, err := net.Listen("tcp", spec.LocalAddr)
+	if err != nil {
+		return nil, err
+	}
+	f := &Forward{
+		Spec:     spec,
+		dialer:   dialer,
+		listener: l,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	go f.serve()
+	return f, nil
+}
+
+// Addr returns the local address the port is forwarded on
+func (f *Forward) Addr() net.Addr {
+	return f.listener.Addr()
+}
+
+// Close stops forwarding and closes all forwarded connections
+func (f *Forward) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+	for conn := range f.conns {
+		conn.Close()
+	}
+	return f.listener.Close()
+}
+
+func (f *Forward) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *Forward) handle(conn net.Conn) {
+	if !f.track(conn) {
+		return
+	}
+	defer f.untrack(conn)
+
+	remote, err := f.dialer.Dial("tcp", net.JoinHostPort("localhost", strconv.Itoa(f.Spec.RemotePort)))
+	if err != nil {
+		slog.Warn("cannot connect to workspace port", "port", f.Spec.RemotePort, "err", err)
+		return
+	}
+	if !f.track(remote) {
+		return
+	}
+	defer f.untrack(remote)
+
+	pipe(conn, remote)
+}
+
+// track registers a connection so that it's closed with the forward. It returns false if the forward is closed already.
+func (f *Forward) track(conn net.Conn) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		conn.Close()
+		return false
+	}
+	f.conns[conn] = struct{}{}
+	return true
+}
+
+func (f *Forward) untrack(conn net.Conn) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.conns, conn)
+	conn.Close()
+}
+
+// pipe copies data in both directions until both directions are done
+func pipe(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+	cp := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			_ = cw.CloseWrite()
+		} else {
+			_ = dst.Close()
+		}
+		done <- struct{}{}
+	}
+	go cp(a, b)
+	go cp(b, a)
+	<-done
+	<-done
+}
+
+// Auto mirrors a changing set of workspace ports locally. If a port is in use locally, a random port is used instead.
+type Auto struct {
+	Dialer      Dialer
+	BindAddress string
+
+	mu       sync.Mutex
+	forwards map[int]*Forward
+}
+
+// Update forwards the given ports and stops forwarding all others
+func (a *Auto) Update(ports []int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.forwards == nil {
+		a.forwards = make(map[int]*Forward)
+	}
+	bindAddr := a.BindAddress
+	if bindAddr == "" {
+		bindAddr = DefaultBindAddress
+	}
+
+	wanted := make(map[int]struct{}, len(ports))
+	for _, port := range ports {
+		wanted[port] = struct{}{}
+		if _, exists := a.forwards[port]; exists {
+			continue
+		}
+
+		f, err := Listen(a.Dialer, Spec{LocalAddr: net.JoinHostPort(bindAddr, strconv.Itoa(port)), RemotePort: port})
+		if err != nil {
+			slog.Debug("cannot forward port to same local port, using random port instead", "port", port, "err", err)
+			f, err = Listen(a.Dialer, Spec{LocalAddr: net.JoinHostPort(bindAddr, "0"), RemotePort: port})
+		}
+		if err != nil {
+			slog.Warn("cannot forward port", "port", port, "err", err)
+			continue
+		}
+		slog.Info("forwarding port", "port", port, "local", f.Addr().String())
+		a.forwards[port] = f
+	}
+	for port, f := range a.forwards {
+		if _, ok := wanted[port]; ok {
+			continue
+		}
+		slog.Info("port was closed, stopping forwarding", "port", port)
+		f.Close()
+		delete(a.forwards, port)
+	}
+}
+
+// Addr returns the local address a workspace port is forwarded on, or nil if it's not forwarded
+func (a *Auto) Addr(port int) net.Addr {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, ok := a.forwards[port]
+	if !ok {
+		return nil
+	}
+	return f.Addr()
+}
+
+// Ports returns the forwarded workspace ports
+func (a *Auto) Ports() []int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	res := make([]int, 0, len(a.forwards))
+	for port := range a.forwards {
+		res = append(res, port)
+	}
+	sort.Ints(res)
+	return res
+}
+
+// Close stops forwarding all ports
+func (a *Auto) Close() {
+	a.Update(nil)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package portforward
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSpec(t *testing.T) {
+	tests := []struct {
+		Input       string
+		Expectation Spec
+		Error       bool
+	}{
+		{Input: "3000", Expectation: Spec{LocalAddr: "127.0.0.1:3000", RemotePort: 3000}},
+		{Input: "8080:3000", Expectation: Spec{LocalAddr: "127.0.0.1:8080", RemotePort: 3000}},
+		{Input: "0:3000", Expectation: Spec{LocalAddr: "127.0.0.1:0", RemotePort: 3000}},
+		{Input: "0.0.0.0:8080:3000", Expectation: Spec{LocalAddr: "0.0.0.0:8080", RemotePort: 3000}},
+		{Input: "[::1]:8080:3000", Expectation: Spec{LocalAddr: "[::1]:8080", RemotePort: 3000}},
+		{Input: "0", Error: true},
+		{Input: "3000:", Error: true},
+		{Input: "foo:3000", Error: true},
+		{Input: "8080:70000", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			act, err := ParseSpec(test.Input)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected spec (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForward(t *testing.T) {
+	workspace := newWorkspace(t)
+
+	f, err := Listen(workspace, Spec{LocalAddr: "127.0.0.1:0", RemotePort: workspace.port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if act := roundtrip(t, f.Addr().String(), "hello"); act != "hello" {
+		t.Errorf("unexpected response %q", act)
+	}
+}
+
+func TestAuto(t *testing.T) {
+	workspace := newWorkspace(t)
+
+	auto := &Auto{Dialer: workspace}
+	defer auto.Close()
+
+	auto.Update([]int{workspace.port})
+	if diff := cmp.Diff([]int{workspace.port}, auto.Ports()); diff != "" {
+		t.Errorf("unexpected ports (-want +got):\n%s", diff)
+	}
+	addr := auto.Addr(workspace.port)
+	if addr == nil {
+		t.Fatal("port was not forwarded")
+	}
+	// the workspace port is in use locally, hence a random port is used
+	if addr.(*net.TCPAddr).Port == workspace.port {
+		t.Errorf("expected random local port, got %s", addr)
+	}
+	if act := roundtrip(t, addr.String(), "hello"); act != "hello" {
+		t.Errorf("unexpected response %q", act)
+	}
+
+	auto.Update(nil)
+	if len(auto.Ports()) != 0 {
+		t.Errorf("expected no forwarded ports, got %v", auto.Ports())
+	}
+	if _, err := net.Dial("tcp", addr.String()); err == nil {
+		t.Errorf("expected %s to be closed", addr)
+	}
+}
+
+// fakeWorkspace is an echo server which pretends to listen on localhost in the workspace
+type fakeWorkspace struct {
+	port int
+}
+
+func newWorkspace(t *testing.T) *fakeWorkspace {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				line, _ := bufio.NewReader(conn).ReadString('\n')
+				_, _ = conn.Write([]byte(line))
+			}()
+		}
+	}()
+	return &fakeWorkspace{port: l.Addr().(*net.TCPAddr).Port}
+}
+
+func (w *fakeWorkspace) Dial(network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if host != "localhost" || port != strconv.Itoa(w.port) {
+		return nil, fmt.Errorf("connection refused: %s", addr)
+	}
+	return net.Dial(network, "127.0.0.1:"+port)
+}
+
+func roundtrip(t *testing.T, addr, msg string) string {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(msg + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	return line[:len(line)-1]
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package prettyprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputKind is the kind of output a writer produces
+type OutputKind int
+
+const (
+	// OutputTable produces tables for humans. Their format may change at any time.
+	OutputTable OutputKind = iota
+	// OutputJSON produces a JSON list of objects, keyed by field name
+	OutputJSON
+	// OutputYAML produces a YAML list of objects, keyed by field name
+	OutputYAML
+	// OutputTemplate executes a Go template for every object, keyed by field name
+	OutputTemplate
+)
+
+// Output describes how a writer formats its data
+type Output struct {
+	Kind     OutputKind
+	Template *template.Template
+}
+
+// ParseOutput parses the value of the --output flag, i.e. one of json, yaml or template=<go-template>.
+// An empty value produces tables.
+func ParseOutput(s string) (Output, error) {
+	switch {
+	case s == "" || s == "table":
+		return Output{Kind: OutputTable}, nil
+	case s == "json":
+		return Output{Kind: OutputJSON}, nil
+	case s == "yaml":
+		return Output{Kind: OutputYAML}, nil
+	case strings.HasPrefix(s, "template="):
+		tpl, err := template.New("output").Option("missingkey=error").Parse(strings.TrimPrefix(s, "template="))
+		if err != nil {
+			return Output{}, AddResolution(fmt.Errorf("invalid output template: %w", err),
+				"use Go template syntax and refer to fields by name, e.g. --output 'template={{ .id }}'",
+			)
+		}
+		return Output{Kind: OutputTemplate, Template: tpl}, nil
+	default:
+		return Output{}, AddResolution(fmt.Errorf("unknown output format: %s", s),
+			"use one of json, yaml or template=<go-template>",
+		)
+	}
+}
+
+// writeStructured writes the given data in a machine-readable format
+func (w Writer[T]) writeStructured(data []T) error {
+	fields, err := reflectFields[T]()
+	if err != nil {
+		return err
+	}
+	objs := make([]map[string]any, 0, len(data))
+	for _, row := range data {
+		objs = append(objs, reflectValues(fields, row))
+	}
+
+	switch w.Output.Kind {
+	case OutputJSON:
+		enc := json.NewEncoder(w.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(objs)
+	case OutputYAML:
+		enc := yaml.NewEncoder(w.Out)
+		enc.SetIndent(2)
+		err := enc.Encode(objs)
+		if err != nil {
+			return err
+		}
+		return enc.Close()
+	case OutputTemplate:
+		for _, obj := range objs {
+			err := executeTemplate(w.Out, w.Output.Template, obj)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return MarkExceptional(fmt.Errorf("unsupported output kind: %d", w.Output.Kind))
+	}
+}
+
+// executeTemplate writes the output of a template followed by a newline
+func executeTemplate(out io.Writer, tpl *template.Template, obj map[string]any) error {
+	err := tpl.Execute(out, obj)
+	if err != nil {
+		return AddResolution(fmt.Errorf("cannot execute output template: %w", err),
+			"refer to fields by name, e.g. --output 'template={{ .id }}'",
+		)
+	}
+	_, err = fmt.Fprintln(out)
+	return err
+}
+
+// StreamWriter writes one row at a time, e.g. when watching for changes.
+// Tables print their header only once, JSON produces one object per line and YAML one document per row.
+type StreamWriter[T any] struct {
+	Writer[T]
+
+	tw      *tabwriter.Writer
+	written bool
+}
+
+// Write writes a single row
+func (w *StreamWriter[T]) Write(row T) error {
+	if w.Field != "" || w.Output.Kind == OutputTable {
+		return w.writeTabular(row)
+	}
+
+	fields, err := reflectFields[T]()
+	if err != nil {
+		return err
+	}
+	obj := reflectValues(fields, row)
+	switch w.Output.Kind {
+	case OutputJSON:
+		return json.NewEncoder(w.Out).Encode(obj)
+	case OutputYAML:
+		enc := yaml.NewEncoder(w.Out)
+		enc.SetIndent(2)
+		_, err := io.WriteString(w.Out, "---\n")
+		if err != nil {
+			return err
+		}
+		err = enc.Encode(obj)
+		if err != nil {
+			return err
+		}
+		return enc.Close()
+	case OutputTemplate:
+		return executeTemplate(w.Out, w.Output.Template, obj)
+	default:
+		return MarkExceptional(fmt.Errorf("unsupported output kind: %d", w.Output.Kind))
+	}
+}
+
+func (w *StreamWriter[T]) writeTabular(row T) error {
+	header, rows, err := reflectTabular([]T{row})
+	if err != nil {
+		return err
+	}
+	if w.tw == nil {
+		w.tw = tabwriter.NewWriter(w.Out, 0, 4, 1, ' ', 0)
+	}
+	defer w.tw.Flush()
+
+	switch {
+	case w.Field != "":
+		return w.writeField(w.tw, header, rows)
+	case w.Format == WriterFormatNarrow:
+		if w.written {
+			_, _ = w.tw.Write([]byte("\n"))
+		}
+		w.written = true
+		return w.writeNarrowFormat(w.tw, header, rows)
+	case !w.written:
+		w.written = true
+		return w.writeWideFormat(w.tw, header, rows)
+	default:
+		return w.writeWideRows(w.tw, header, rows)
+	}
+}
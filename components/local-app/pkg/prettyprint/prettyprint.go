@@ -11,25 +11,25 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
-
-	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
 )
 
-func reflectTabular[T any](data []T) (header []string, rows []map[string]string, err error) {
-	type field struct {
-		Name  string
-		Field *reflect.StructField
-	}
-	var fields []field
+type tabularField struct {
+	Name  string
+	Field reflect.StructField
+}
 
+// reflectFields returns the fields of T which are printed
+func reflectFields[T any]() ([]tabularField, error) {
 	var dt T
 	t := reflect.TypeOf(dt)
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
 	if t.Kind() != reflect.Struct {
-		return nil, nil, MarkExceptional(fmt.Errorf("can only reflect tabular data from structs"))
+		return nil, MarkExceptional(fmt.Errorf("can only reflect tabular data from structs"))
 	}
+
+	var fields []tabularField
 	for i := 0; i < t.NumField(); i++ {
 		f := t.Field(i)
 		switch f.Type.Kind() {
@@ -41,26 +41,44 @@ func reflectTabular[T any](data []T) (header []string, rows []map[string]string,
 		if name == "" {
 			name = f.Name
 		}
-		fields = append(fields, field{Name: name, Field: &f})
-		header = append(header, name)
+		fields = append(fields, tabularField{Name: name, Field: f})
+	}
+	return fields, nil
+}
+
+// reflectValues returns the values of the printed fields of a row by field name
+func reflectValues[T any](fields []tabularField, row T) map[string]any {
+	v := reflect.ValueOf(row)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	res := make(map[string]any, len(fields))
+	for _, f := range fields {
+		res[f.Name] = v.FieldByIndex(f.Field.Index).Interface()
+	}
+	return res
+}
+
+func reflectTabular[T any](data []T) (header []string, rows []map[string]string, err error) {
+	fields, err := reflectFields[T]()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range fields {
+		header = append(header, f.Name)
 	}
 
-	rows = make([]map[string]string, 0, len(rows))
+	rows = make([]map[string]string, 0, len(data))
 	for _, row := range data {
 		r := make(map[string]string)
-		for _, f := range fields {
-			v := reflect.ValueOf(row)
-			if v.Kind() == reflect.Ptr {
-				v = v.Elem()
-			}
-			v = v.FieldByName(f.Field.Name)
-			switch v.Kind() {
+		for name, v := range reflectValues(fields, row) {
+			switch v := reflect.ValueOf(v); v.Kind() {
 			case reflect.String:
-				r[f.Name] = v.String()
+				r[name] = v.String()
 			case reflect.Bool:
-				r[f.Name] = FormatBool(v.Bool())
+				r[name] = FormatBool(v.Bool())
 			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				r[f.Name] = strconv.FormatInt(v.Int(), 10)
+				r[name] = strconv.FormatInt(v.Int(), 10)
 			}
 		}
 		rows = append(rows, r)
@@ -87,10 +105,16 @@ type Writer[T any] struct {
 	Out    io.Writer
 	Format WriterFormat
 	Field  string
+	// Output selects machine-readable output instead of tables
+	Output Output
 }
 
 // Write writes the given tabular data to the writer
 func (w Writer[T]) Write(data []T) error {
+	if w.Field == "" && w.Output.Kind != OutputTable {
+		return w.writeStructured(data)
+	}
+
 	header, rows, err := reflectTabular(data)
 	if err != nil {
 		return err
@@ -160,6 +184,11 @@ func (w Writer[T]) writeWideFormat(tw *tabwriter.Writer, header []string, rows [
 		}
 	}
 	_, _ = tw.Write([]byte("\n"))
+	return w.writeWideRows(tw, header, rows)
+}
+
+// writeWideRows writes the rows of the wide format without a header
+func (w Writer[T]) writeWideRows(tw *tabwriter.Writer, header []string, rows []map[string]string) error {
 	for _, row := range rows {
 		for _, h := range header {
 			_, err := tw.Write([]byte(fmt.Sprintf("%s\t", row[h])))
@@ -181,7 +210,7 @@ func FormatBool(b bool) string {
 }
 
 // FormatWorkspacePhase returns a user-facing representation of the given workspace phase
-func FormatWorkspacePhase(phase v1.WorkspaceInstanceStatus_Phase) string {
+func FormatWorkspacePhase[P fmt.Stringer](phase P) string {
 	return strings.ToLower(strings.TrimPrefix(phase.String(), "PHASE_"))
 }
 
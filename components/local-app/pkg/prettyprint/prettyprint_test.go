@@ -26,6 +26,7 @@ func TestWriterWrite(t *testing.T) {
 		Expectation Expectation
 		Format      WriterFormat
 		Field       string
+		Output      string
 		Data        []R
 	}{
 		{
@@ -92,19 +93,82 @@ func TestWriterWrite(t *testing.T) {
 			Field:       "foo",
 			Data:        []R{{}},
 		},
+		{
+			Name: "json",
+			Expectation: Expectation{
+				Out: "[\n  {\n    \"foo\": \"foo\",\n    \"foobar\": \"bar\",\n    \"number\": 42\n  }\n]\n",
+			},
+			Output: "json",
+			Data: []R{
+				{Foo: "foo", Number: 42, DiffName: "bar"},
+			},
+		},
+		{
+			Name: "empty json",
+			Expectation: Expectation{
+				Out: "[]\n",
+			},
+			Output: "json",
+		},
+		{
+			Name: "yaml",
+			Expectation: Expectation{
+				Out: "- foo: foo\n  foobar: bar\n  number: 42\n",
+			},
+			Output: "yaml",
+			Data: []R{
+				{Foo: "foo", Number: 42, DiffName: "bar"},
+			},
+		},
+		{
+			Name: "template",
+			Expectation: Expectation{
+				Out: "foo=42\nbar=0\n",
+			},
+			Output: "template={{ .foo }}={{ .number }}",
+			Data: []R{
+				{Foo: "foo", Number: 42},
+				{Foo: "bar"},
+			},
+		},
+		{
+			Name: "template with unknown field",
+			Expectation: Expectation{
+				Error: `cannot execute output template: template: output:1:3: executing "output" at <.unknown>: map has no entry for key "unknown"`,
+			},
+			Output: "template={{ .unknown }}",
+			Data:   []R{{}},
+		},
+		{
+			Name: "field takes precedence over output",
+			Expectation: Expectation{
+				Out: "foo\n",
+			},
+			Field:  "foo",
+			Output: "json",
+			Data: []R{
+				{Foo: "foo"},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var act Expectation
 
+			output, err := ParseOutput(test.Output)
+			if err != nil {
+				t.Fatal(err)
+			}
+
 			out := bytes.NewBuffer(nil)
 			w := Writer[R]{
 				Out:    out,
 				Format: test.Format,
 				Field:  test.Field,
+				Output: output,
 			}
-			err := w.Write(test.Data)
+			err = w.Write(test.Data)
 			if err != nil {
 				act.Error = err.Error()
 			}
@@ -116,3 +180,83 @@ func TestWriterWrite(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOutput(t *testing.T) {
+	tests := []struct {
+		Input       string
+		Expectation OutputKind
+		Error       bool
+	}{
+		{Input: "", Expectation: OutputTable},
+		{Input: "table", Expectation: OutputTable},
+		{Input: "json", Expectation: OutputJSON},
+		{Input: "yaml", Expectation: OutputYAML},
+		{Input: "template={{ .id }}", Expectation: OutputTemplate},
+		{Input: "template={{ .id", Error: true},
+		{Input: "xml", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			act, err := ParseOutput(test.Input)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if act.Kind != test.Expectation {
+				t.Errorf("expected output kind %d, got %d", test.Expectation, act.Kind)
+			}
+		})
+	}
+}
+
+func TestStreamWriterWrite(t *testing.T) {
+	type R struct {
+		Foo    string `print:"foo"`
+		Number int    `print:"number"`
+	}
+	tests := []struct {
+		Name        string
+		Format      WriterFormat
+		Output      string
+		Expectation string
+	}{
+		{
+			Name:        "wide format",
+			Format:      WriterFormatWide,
+			Expectation: "FOO NUMBER \nfoo 1      \nbar 2 \n",
+		},
+		{
+			Name:        "narrow format",
+			Format:      WriterFormatNarrow,
+			Expectation: "Foo:    foo\nNumber: 1\n\nFoo:    bar\nNumber: 2\n",
+		},
+		{
+			Name:        "json",
+			Output:      "json",
+			Expectation: "{\"foo\":\"foo\",\"number\":1}\n{\"foo\":\"bar\",\"number\":2}\n",
+		},
+		{
+			Name:        "yaml",
+			Output:      "yaml",
+			Expectation: "---\nfoo: foo\nnumber: 1\n---\nfoo: bar\nnumber: 2\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			output, err := ParseOutput(test.Output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := bytes.NewBuffer(nil)
+			w := &StreamWriter[R]{Writer: Writer[R]{Out: out, Format: test.Format, Output: output}}
+			for _, row := range []R{{Foo: "foo", Number: 1}, {Foo: "bar", Number: 2}} {
+				err := w.Write(row)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, out.String()); diff != "" {
+				t.Errorf("Write() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package sshconfig writes OpenSSH client configuration for workspaces, so that
+// plain ssh and editors like VS Code Remote-SSH or JetBrains Gateway can connect to them.
+package sshconfig
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// HostSuffix is appended to the workspace ID to form the host name used with ssh
+const HostSuffix = ".gitpod"
+
+// Host is the ssh_config entry of a workspace
+type Host struct {
+	WorkspaceID string
+	// HostName is the SSH gateway host of the workspace. Connections are established through the ProxyCommand.
+	HostName string
+	// ProxyCommand are the command and arguments which connect to the workspace
+	ProxyCommand []string
+	IdentityFile string
+}
+
+// Render produces the ssh_config entries of the given hosts
+func Render(hosts []Host) string {
+	var res strings.Builder
+	res.WriteString("# Generated by `gitpod ssh-config`, changes will be overwritten\n")
+	for _, h := range hosts {
+		fmt.Fprintf(&res, "\nHost %s%s\n", h.WorkspaceID, HostSuffix)
+		if h.HostName != "" {
+			fmt.Fprintf(&res, "  HostName %s\n", h.HostName)
+		}
+		fmt.Fprintf(&res, "  User gitpod\n")
+		fmt.Fprintf(&res, "  IdentityFile %s\n", quote(h.IdentityFile))
+		fmt.Fprintf(&res, "  IdentitiesOnly yes\n")
+		proxyCommand := make([]string, 0, len(h.ProxyCommand))
+		for _, arg := range h.ProxyCommand {
+			proxyCommand = append(proxyCommand, quote(arg))
+		}
+		fmt.Fprintf(&res, "  ProxyCommand %s\n", strings.Join(proxyCommand, " "))
+		// the host key of a workspace changes with every start
+		fmt.Fprintf(&res, "  StrictHostKeyChecking no\n")
+		fmt.Fprintf(&res, "  UserKnownHostsFile /dev/null\n")
+	}
+	return res.String()
+}
+
+// quote quotes a value which contains spaces
+func quote(s string) string {
+	if !strings.ContainsAny(s, " \t") {
+		return s
+	}
+	return `"` + s + `"`
+}
+
+// EnsureKey returns the private key file used to authenticate with workspaces and its public key, and generates them if needed
+func EnsureKey(dir string) (privateKeyFN string, publicKey string, err error) {
+	privateKeyFN = filepath.Join(dir, "id_ed25519")
+	pub, err := os.ReadFile(privateKeyFN + ".pub")
+	if err == nil {
+		if _, err := os.Stat(privateKeyFN); err == nil {
+			return privateKeyFN, strings.TrimSpace(string(pub)), nil
+		}
+	}
+
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return "", "", err
+	}
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	privBlock, err := ssh.MarshalPrivateKey(privKey, "gitpod")
+	if err != nil {
+		return "", "", err
+	}
+	err = os.WriteFile(privateKeyFN, pem.EncodeToMemory(privBlock), 0600)
+	if err != nil {
+		return "", "", err
+	}
+	sshPubKey, err := ssh.NewPublicKey(pubKey)
+	if err != nil {
+		return "", "", err
+	}
+	publicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPubKey)))
+	err = os.WriteFile(privateKeyFN+".pub", []byte(publicKey+"\n"), 0644)
+	if err != nil {
+		return "", "", err
+	}
+	return privateKeyFN, publicKey, nil
+}
+
+// EnsureInclude adds an Include directive for includeFN at the top of the ssh_config file sshConfigFN
+// unless it exists already. It returns true if the file was changed.
+func EnsureInclude(sshConfigFN, includeFN string) (bool, error) {
+	content, err := os.ReadFile(sshConfigFN)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		keyword, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+		if ok && strings.EqualFold(keyword, "Include") && strings.Trim(strings.TrimSpace(value), `"`) == includeFN {
+			return false, nil
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(sshConfigFN), 0700)
+	if err != nil {
+		return false, err
+	}
+	// Include directives only apply to all hosts if they appear before the first Host block
+	content = append([]byte(fmt.Sprintf("Include %s\n\n", quote(includeFN))), content...)
+	err = os.WriteFile(sshConfigFN, content, 0600)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
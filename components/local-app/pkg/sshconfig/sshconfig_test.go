@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRender(t *testing.T) {
+	act := Render([]Host{
+		{
+			WorkspaceID:  "gitpodio-empty-def456",
+			ProxyCommand: []string{"/Applications/My Tools/gitpod", "workspace", "ssh-proxy", "gitpodio-empty-def456"},
+			IdentityFile: "/home/My User/.ssh/gitpod/id_ed25519",
+		},
+		{
+			WorkspaceID:  "gitpodio-gitpod-abc123",
+			HostName:     "gitpodio-gitpod-abc123.ssh.ws.gitpod.io",
+			ProxyCommand: []string{"/usr/local/bin/gitpod", "workspace", "ssh-proxy", "gitpodio-gitpod-abc123"},
+			IdentityFile: "/home/My User/.ssh/gitpod/id_ed25519",
+		},
+	})
+	expectation := "# Generated by `gitpod ssh-config`, changes will be overwritten\n" +
+		"\n" +
+		"Host gitpodio-empty-def456.gitpod\n" +
+		"  User gitpod\n" +
+		"  IdentityFile \"/home/My User/.ssh/gitpod/id_ed25519\"\n" +
+		"  IdentitiesOnly yes\n" +
+		"  ProxyCommand \"/Applications/My Tools/gitpod\" workspace ssh-proxy gitpodio-empty-def456\n" +
+		"  StrictHostKeyChecking no\n" +
+		"  UserKnownHostsFile /dev/null\n" +
+		"\n" +
+		"Host gitpodio-gitpod-abc123.gitpod\n" +
+		"  HostName gitpodio-gitpod-abc123.ssh.ws.gitpod.io\n" +
+		"  User gitpod\n" +
+		"  IdentityFile \"/home/My User/.ssh/gitpod/id_ed25519\"\n" +
+		"  IdentitiesOnly yes\n" +
+		"  ProxyCommand /usr/local/bin/gitpod workspace ssh-proxy gitpodio-gitpod-abc123\n" +
+		"  StrictHostKeyChecking no\n" +
+		"  UserKnownHostsFile /dev/null\n"
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected config (-want +got):\n%s", diff)
+	}
+}
+
+func TestEnsureInclude(t *testing.T) {
+	var (
+		dir       = t.TempDir()
+		sshConfig = filepath.Join(dir, "config")
+		include   = filepath.Join(dir, "gitpod", "config")
+	)
+	err := os.WriteFile(sshConfig, []byte("Host example.com\n  User foo\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expectChanged := range []bool{true, false} {
+		changed, err := EnsureInclude(sshConfig, include)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed != expectChanged {
+			t.Errorf("run %d: expected changed=%v, got %v", i, expectChanged, changed)
+		}
+	}
+
+	content, err := os.ReadFile(sshConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectation := "Include " + include + "\n\nHost example.com\n  User foo\n"
+	if diff := cmp.Diff(expectation, string(content)); diff != "" {
+		t.Errorf("unexpected config (-want +got):\n%s", diff)
+	}
+}
+
+func TestEnsureKey(t *testing.T) {
+	dir := t.TempDir()
+	fn, pub, err := EnsureKey(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn2, pub2, err := EnsureKey(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fn != fn2 || pub != pub2 {
+		t.Errorf("expected existing key to be reused")
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	gitpod_experimental_v1connect "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1/v1connect"
+	gitpod_v1connect "github.com/gitpod-io/gitpod/components/public-api/go/v1/v1connect"
 )
 
 type Gitpod struct {
@@ -23,6 +24,9 @@ type Gitpod struct {
 	PersonalAccessTokens gitpod_experimental_v1connect.TokensServiceClient
 	IdentityProvider     gitpod_experimental_v1connect.IdentityProviderServiceClient
 	User                 gitpod_experimental_v1connect.UserServiceClient
+
+	// WorkspaceService is the workspace service of the gitpod.v1 API
+	WorkspaceService gitpod_v1connect.WorkspaceServiceClient
 }
 
 func New(options ...Option) (*Gitpod, error) {
@@ -53,6 +57,7 @@ func New(options ...Option) (*Gitpod, error) {
 		Editors:              gitpod_experimental_v1connect.NewEditorServiceClient(client, url, serviceOpts...),
 		IdentityProvider:     gitpod_experimental_v1connect.NewIdentityProviderServiceClient(client, url, serviceOpts...),
 		User:                 gitpod_experimental_v1connect.NewUserServiceClient(client, url, serviceOpts...),
+		WorkspaceService:     gitpod_v1connect.NewWorkspaceServiceClient(client, url, serviceOpts...),
 	}, nil
 }
 
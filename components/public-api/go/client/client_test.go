@@ -32,6 +32,7 @@ func TestNew(t *testing.T) {
 		require.NotNil(t, gitpod.Projects)
 		require.NotNil(t, gitpod.PersonalAccessTokens)
 		require.NotNil(t, gitpod.User)
+		require.NotNil(t, gitpod.WorkspaceService)
 	})
 
 	t.Run("fails when no credentials specified", func(t *testing.T) {
@@ -6,7 +6,9 @@ package config
 
 import (
 	"encoding/json"
+	"net"
 	"os"
+	"time"
 
 	"golang.org/x/xerrors"
 )
@@ -39,6 +41,34 @@ func GetConfig(fn string) (*ServiceConfig, error) {
 		}
 	}
 
+	if cfg.Registry.DiskCache != nil {
+		err = cfg.Registry.DiskCache.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Registry.Policy != nil {
+		err = cfg.Registry.Policy.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Registry.Prewarm != nil {
+		err = cfg.Registry.Prewarm.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Registry.LazyPull != nil {
+		err = cfg.Registry.LazyPull.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if cfg.Registry.RedisCache != nil {
 		rd := cfg.Registry.RedisCache
 		rd.Password = os.Getenv("REDIS_PASSWORD")
@@ -73,6 +103,24 @@ type Config struct {
 	IPFSCache *IPFSCacheConfig `json:"ipfs,omitempty"`
 
 	RedisCache *RedisCacheConfig `json:"redis,omitempty"`
+
+	DiskCache *DiskCacheConfig `json:"diskCache,omitempty"`
+
+	// Platform is the platform (e.g. linux/arm64) whose manifest we serve for multi-platform images.
+	// Defaults to the platform registry-facade runs on, i.e. the platform of its node.
+	Platform string `json:"platform,omitempty"`
+	// MultiArch makes registry-facade serve an image index covering all platforms of multi-platform
+	// images to clients which accept one, instead of a single manifest.
+	MultiArch bool `json:"multiArch,omitempty"`
+
+	// Policy configures the verification of base images before we serve them
+	Policy *ImagePolicyConfig `json:"policy,omitempty"`
+
+	// Prewarm enables pulling images into the caches of this node ahead of workspace starts
+	Prewarm *PrewarmConfig `json:"prewarm,omitempty"`
+
+	// LazyPull makes registry-facade serve base image layers in a format which supports lazy pulling
+	LazyPull *LazyPullConfig `json:"lazyPull,omitempty"`
 }
 
 type RedisCacheConfig struct {
@@ -92,8 +140,215 @@ type IPFSCacheConfig struct {
 	IPFSAddr string `json:"ipfsAddr"`
 }
 
+// DiskCacheConfig configures the node-local blob cache
+type DiskCacheConfig struct {
+	// Dir is the directory the blobs are cached in
+	Dir string `json:"dir"`
+	// MaxSize is the number of bytes the cache may occupy. Once exceeded, we evict the least recently used blobs.
+	MaxSize int64 `json:"maxSize"`
+	// MaxBlobSize is the size in bytes above which we don't cache a blob. Defaults to MaxSize.
+	MaxBlobSize int64 `json:"maxBlobSize,omitempty"`
+}
+
+// Validate returns an error if the disk cache config is invalid
+func (c *DiskCacheConfig) Validate() error {
+	if c.Dir == "" {
+		return xerrors.Errorf("disk cache requires a directory")
+	}
+	if c.MaxSize <= 0 {
+		return xerrors.Errorf("disk cache maxSize must be positive")
+	}
+	if c.MaxBlobSize < 0 || c.MaxBlobSize > c.MaxSize {
+		return xerrors.Errorf("disk cache maxBlobSize must be between 0 and maxSize")
+	}
+	return nil
+}
+
 // StaticLayerCfg configure statically added layer
 type StaticLayerCfg struct {
 	Ref  string `json:"ref"`
 	Type string `json:"type"`
 }
+
+// ImagePolicyConfig configures which base images registry-facade is willing to serve
+type ImagePolicyConfig struct {
+	// Default applies to all workspaces whose organization has no policy of its own.
+	// If there is no default policy, such workspaces can use any image.
+	Default *ImagePolicy `json:"default,omitempty"`
+	// Organizations maps organization IDs to their policy
+	Organizations map[string]*ImagePolicy `json:"organizations,omitempty"`
+}
+
+// Validate returns an error if any of the policies is invalid
+func (c *ImagePolicyConfig) Validate() error {
+	if c.Default != nil {
+		err := c.Default.Validate()
+		if err != nil {
+			return xerrors.Errorf("default image policy: %w", err)
+		}
+	}
+	for org, p := range c.Organizations {
+		if p == nil {
+			return xerrors.Errorf("image policy of organization %s is empty", org)
+		}
+		err := p.Validate()
+		if err != nil {
+			return xerrors.Errorf("image policy of organization %s: %w", org, err)
+		}
+	}
+	return nil
+}
+
+type ImagePolicyMode string
+
+const (
+	// ImagePolicyModeEnforce rejects images which violate the policy
+	ImagePolicyModeEnforce ImagePolicyMode = "enforce"
+	// ImagePolicyModeAudit logs policy violations but serves the images anyways
+	ImagePolicyModeAudit ImagePolicyMode = "audit"
+)
+
+// ImagePolicy describes the trust requirements for base images
+type ImagePolicy struct {
+	Mode ImagePolicyMode `json:"mode"`
+	// Exempt lists image name prefixes (e.g. docker.io/gitpod/) the policy does not apply to
+	Exempt []string `json:"exempt,omitempty"`
+
+	// Signature requires images to be signed using cosign or Notary v2
+	Signature *SignaturePolicy `json:"signature,omitempty"`
+	// Provenance requires images to come with a signed SLSA provenance attestation
+	Provenance *ProvenancePolicy `json:"provenance,omitempty"`
+}
+
+// Validate returns an error if the policy is invalid
+func (p *ImagePolicy) Validate() error {
+	switch p.Mode {
+	case ImagePolicyModeEnforce, ImagePolicyModeAudit:
+	default:
+		return xerrors.Errorf("invalid mode %q", p.Mode)
+	}
+	if p.Signature == nil && p.Provenance == nil {
+		return xerrors.Errorf("policy requires neither signature nor provenance")
+	}
+	if p.Signature != nil && len(p.Signature.CosignKeys) == 0 && len(p.Signature.NotaryRoots) == 0 {
+		return xerrors.Errorf("signature policy has neither cosign keys nor Notary roots")
+	}
+	if p.Provenance != nil && len(p.Provenance.Keys) == 0 {
+		return xerrors.Errorf("provenance policy has no keys")
+	}
+	return nil
+}
+
+// SignaturePolicy is satisfied if the image carries at least one valid signature
+type SignaturePolicy struct {
+	// CosignKeys are paths to PEM encoded public keys we accept cosign signatures from
+	CosignKeys []string `json:"cosignKeys,omitempty"`
+	// NotaryRoots are paths to PEM encoded CA certificates Notary v2 signing certificates must chain up to
+	NotaryRoots []string `json:"notaryRoots,omitempty"`
+}
+
+// ProvenancePolicy is satisfied if the image carries a valid SLSA provenance attestation
+type ProvenancePolicy struct {
+	// Keys are paths to PEM encoded public keys we accept attestations from
+	Keys []string `json:"keys"`
+	// Builders lists the builder IDs we trust. If empty, we trust any builder.
+	Builders []string `json:"builders,omitempty"`
+}
+
+type LazyPullFormat string
+
+const (
+	// LazyPullFormatEStargz converts layers to eStargz, see https://github.com/containerd/stargz-snapshotter/blob/main/docs/estargz.md.
+	// Workspace nodes need to run the stargz snapshotter to benefit from this.
+	LazyPullFormatEStargz LazyPullFormat = "estargz"
+)
+
+// LazyPullConfig configures the conversion of base image layers to a lazy-pullable format.
+// Layers are converted in the background, i.e. a base image is served as-is until all its
+// layers are converted.
+type LazyPullConfig struct {
+	// Format is the format we convert layers to. Only eStargz is supported, SOCI indices are not.
+	Format LazyPullFormat `json:"format"`
+	// Dir is the directory converted layers are stored in. Converted layers are not shared between
+	// replicas, hence Dir should be node-local storage which survives restarts.
+	Dir string `json:"dir"`
+	// MinLayerSize is the size in bytes below which we don't bother converting a layer
+	MinLayerSize int64 `json:"minLayerSize,omitempty"`
+	// Concurrency limits the number of layers we convert at the same time. Defaults to 1.
+	Concurrency int `json:"concurrency,omitempty"`
+}
+
+// Validate returns an error if the lazy pull config is invalid
+func (c *LazyPullConfig) Validate() error {
+	if c.Format != LazyPullFormatEStargz {
+		return xerrors.Errorf("unsupported lazy pull format %q", c.Format)
+	}
+	if c.Dir == "" {
+		return xerrors.Errorf("lazy pull requires a directory for converted layers")
+	}
+	if c.MinLayerSize < 0 || c.Concurrency < 0 {
+		return xerrors.Errorf("lazy pull minLayerSize and concurrency must not be negative")
+	}
+	return nil
+}
+
+// PrewarmConfig configures the prewarming of images on the node registry-facade runs on
+type PrewarmConfig struct {
+	// Addr is the address the ImagePrewarmer gRPC service listens on. The service is neither authenticated
+	// nor encrypted, hence Addr must be a loopback address, e.g. 127.0.0.1:8087. Clients reach it through
+	// a port-forward to the registry-facade pod.
+	Addr string `json:"addr"`
+	// ContainerdSocket is the socket of the node's containerd. If set, prewarmed images are also pulled into its content store.
+	ContainerdSocket string `json:"containerdSocket,omitempty"`
+	// ContainerdNamespace is the containerd namespace images are pulled into. Defaults to k8s.io.
+	ContainerdNamespace string `json:"containerdNamespace,omitempty"`
+	// Schedule makes registry-facade prewarm images periodically
+	Schedule *PrewarmSchedule `json:"schedule,omitempty"`
+}
+
+// Validate returns an error if the prewarm config is invalid
+func (c *PrewarmConfig) Validate() error {
+	if c.Addr == "" {
+		return xerrors.Errorf("prewarm requires an address to listen on")
+	}
+	host, _, err := net.SplitHostPort(c.Addr)
+	if err != nil {
+		return xerrors.Errorf("invalid prewarm address: %w", err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return xerrors.Errorf("prewarm address %s must be a loopback address", c.Addr)
+	}
+	if c.Schedule != nil {
+		_, err := time.ParseDuration(c.Schedule.Interval)
+		if err != nil {
+			return xerrors.Errorf("invalid prewarm interval: %w", err)
+		}
+		if len(c.Schedule.Images) == 0 && c.Schedule.Popular == nil {
+			return xerrors.Errorf("prewarm schedule has neither images nor popular images")
+		}
+		if c.Schedule.Popular != nil && c.Schedule.Popular.PrometheusURL == "" {
+			return xerrors.Errorf("prewarming popular images requires a Prometheus URL")
+		}
+	}
+	return nil
+}
+
+// PrewarmSchedule lists the images registry-facade prewarms periodically
+type PrewarmSchedule struct {
+	// Interval is the time between two prewarm runs, e.g. 30m
+	Interval string `json:"interval"`
+	// Images are prewarmed in every run
+	Images []string `json:"images,omitempty"`
+	// Popular adds the images used by the most workspaces to every run
+	Popular *PopularImagesConfig `json:"popular,omitempty"`
+}
+
+// PopularImagesConfig determines the most popular workspace images using the metrics of ws-manager-mk2
+type PopularImagesConfig struct {
+	PrometheusURL string `json:"prometheusURL"`
+	// Query must produce an instant vector with an image label. Defaults to the workspace images most used by
+	// workspaces over the last day, according to ws-manager-mk2.
+	Query string `json:"query,omitempty"`
+	// Limit is the maximum number of popular images we prewarm. Defaults to 10.
+	Limit int `json:"limit,omitempty"`
+}
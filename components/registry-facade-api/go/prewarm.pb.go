@@ -0,0 +1,329 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: prewarm.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PrewarmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// refs are the images to prewarm, e.g. workspace images of recent starts or prebuilds
+	Refs []string `protobuf:"bytes,1,rep,name=refs,proto3" json:"refs,omitempty"`
+}
+
+func (x *PrewarmRequest) Reset() {
+	*x = PrewarmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prewarm_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrewarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrewarmRequest) ProtoMessage() {}
+
+func (x *PrewarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prewarm_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrewarmRequest.ProtoReflect.Descriptor instead.
+func (*PrewarmRequest) Descriptor() ([]byte, []int) {
+	return file_prewarm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PrewarmRequest) GetRefs() []string {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+type PrewarmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*PrewarmResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *PrewarmResponse) Reset() {
+	*x = PrewarmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prewarm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrewarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrewarmResponse) ProtoMessage() {}
+
+func (x *PrewarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prewarm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrewarmResponse.ProtoReflect.Descriptor instead.
+func (*PrewarmResponse) Descriptor() ([]byte, []int) {
+	return file_prewarm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrewarmResponse) GetResults() []*PrewarmResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PrewarmResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// error is empty if the image was prewarmed successfully
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// layers is the number of layers of the image
+	Layers int32 `protobuf:"varint,3,opt,name=layers,proto3" json:"layers,omitempty"`
+	// bytes_fetched is the number of bytes we downloaded to prewarm the image
+	BytesFetched int64 `protobuf:"varint,4,opt,name=bytes_fetched,json=bytesFetched,proto3" json:"bytes_fetched,omitempty"`
+	// containerd is true if the image was pulled into the node's containerd content store
+	Containerd bool `protobuf:"varint,5,opt,name=containerd,proto3" json:"containerd,omitempty"`
+}
+
+func (x *PrewarmResult) Reset() {
+	*x = PrewarmResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prewarm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrewarmResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrewarmResult) ProtoMessage() {}
+
+func (x *PrewarmResult) ProtoReflect() protoreflect.Message {
+	mi := &file_prewarm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrewarmResult.ProtoReflect.Descriptor instead.
+func (*PrewarmResult) Descriptor() ([]byte, []int) {
+	return file_prewarm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PrewarmResult) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *PrewarmResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PrewarmResult) GetLayers() int32 {
+	if x != nil {
+		return x.Layers
+	}
+	return 0
+}
+
+func (x *PrewarmResult) GetBytesFetched() int64 {
+	if x != nil {
+		return x.BytesFetched
+	}
+	return 0
+}
+
+func (x *PrewarmResult) GetContainerd() bool {
+	if x != nil {
+		return x.Containerd
+	}
+	return false
+}
+
+var File_prewarm_proto protoreflect.FileDescriptor
+
+var file_prewarm_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x66, 0x61, 0x63, 0x61, 0x64, 0x65, 0x22,
+	0x24, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x4a, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x66, 0x61, 0x63, 0x61, 0x64, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x94, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x32, 0x5e, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x07, 0x50, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x6d, 0x12, 0x1e, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x66, 0x61, 0x63, 0x61, 0x64, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x66, 0x61, 0x63, 0x61, 0x64, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2d, 0x69, 0x6f,
+	0x2f, 0x67, 0x69, 0x74, 0x70, 0x6f, 0x64, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x2d, 0x66, 0x61, 0x63, 0x61, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_prewarm_proto_rawDescOnce sync.Once
+	file_prewarm_proto_rawDescData = file_prewarm_proto_rawDesc
+)
+
+func file_prewarm_proto_rawDescGZIP() []byte {
+	file_prewarm_proto_rawDescOnce.Do(func() {
+		file_prewarm_proto_rawDescData = protoimpl.X.CompressGZIP(file_prewarm_proto_rawDescData)
+	})
+	return file_prewarm_proto_rawDescData
+}
+
+var file_prewarm_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_prewarm_proto_goTypes = []interface{}{
+	(*PrewarmRequest)(nil),  // 0: registryfacade.PrewarmRequest
+	(*PrewarmResponse)(nil), // 1: registryfacade.PrewarmResponse
+	(*PrewarmResult)(nil),   // 2: registryfacade.PrewarmResult
+}
+var file_prewarm_proto_depIdxs = []int32{
+	2, // 0: registryfacade.PrewarmResponse.results:type_name -> registryfacade.PrewarmResult
+	0, // 1: registryfacade.ImagePrewarmer.Prewarm:input_type -> registryfacade.PrewarmRequest
+	1, // 2: registryfacade.ImagePrewarmer.Prewarm:output_type -> registryfacade.PrewarmResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_prewarm_proto_init() }
+func file_prewarm_proto_init() {
+	if File_prewarm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_prewarm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrewarmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prewarm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrewarmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prewarm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrewarmResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_prewarm_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prewarm_proto_goTypes,
+		DependencyIndexes: file_prewarm_proto_depIdxs,
+		MessageInfos:      file_prewarm_proto_msgTypes,
+	}.Build()
+	File_prewarm_proto = out.File
+	file_prewarm_proto_rawDesc = nil
+	file_prewarm_proto_goTypes = nil
+	file_prewarm_proto_depIdxs = nil
+}
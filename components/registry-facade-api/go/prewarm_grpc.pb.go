@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: prewarm.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ImagePrewarmerClient is the client API for ImagePrewarmer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImagePrewarmerClient interface {
+	// Prewarm pulls images into the caches of the node registry-facade runs on, so that workspaces
+	// using those images start faster. Images which are cached already are not downloaded again.
+	Prewarm(ctx context.Context, in *PrewarmRequest, opts ...grpc.CallOption) (*PrewarmResponse, error)
+}
+
+type imagePrewarmerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImagePrewarmerClient(cc grpc.ClientConnInterface) ImagePrewarmerClient {
+	return &imagePrewarmerClient{cc}
+}
+
+func (c *imagePrewarmerClient) Prewarm(ctx context.Context, in *PrewarmRequest, opts ...grpc.CallOption) (*PrewarmResponse, error) {
+	out := new(PrewarmResponse)
+	err := c.cc.Invoke(ctx, "/registryfacade.ImagePrewarmer/Prewarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImagePrewarmerServer is the server API for ImagePrewarmer service.
+// All implementations must embed UnimplementedImagePrewarmerServer
+// for forward compatibility
+type ImagePrewarmerServer interface {
+	// Prewarm pulls images into the caches of the node registry-facade runs on, so that workspaces
+	// using those images start faster. Images which are cached already are not downloaded again.
+	Prewarm(context.Context, *PrewarmRequest) (*PrewarmResponse, error)
+	mustEmbedUnimplementedImagePrewarmerServer()
+}
+
+// UnimplementedImagePrewarmerServer must be embedded to have forward compatible implementations.
+type UnimplementedImagePrewarmerServer struct {
+}
+
+func (UnimplementedImagePrewarmerServer) Prewarm(context.Context, *PrewarmRequest) (*PrewarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prewarm not implemented")
+}
+func (UnimplementedImagePrewarmerServer) mustEmbedUnimplementedImagePrewarmerServer() {}
+
+// UnsafeImagePrewarmerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImagePrewarmerServer will
+// result in compilation errors.
+type UnsafeImagePrewarmerServer interface {
+	mustEmbedUnimplementedImagePrewarmerServer()
+}
+
+func RegisterImagePrewarmerServer(s grpc.ServiceRegistrar, srv ImagePrewarmerServer) {
+	s.RegisterService(&ImagePrewarmer_ServiceDesc, srv)
+}
+
+func _ImagePrewarmer_Prewarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrewarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImagePrewarmerServer).Prewarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/registryfacade.ImagePrewarmer/Prewarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImagePrewarmerServer).Prewarm(ctx, req.(*PrewarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImagePrewarmer_ServiceDesc is the grpc.ServiceDesc for ImagePrewarmer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImagePrewarmer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "registryfacade.ImagePrewarmer",
+	HandlerType: (*ImagePrewarmerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prewarm",
+			Handler:    _ImagePrewarmer_Prewarm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prewarm.proto",
+}
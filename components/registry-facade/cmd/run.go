@@ -25,12 +25,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 
 	common_grpc "github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/pprof"
 	"github.com/gitpod-io/gitpod/common-go/watch"
+	"github.com/gitpod-io/gitpod/registry-facade/api"
 	"github.com/gitpod-io/gitpod/registry-facade/api/config"
 	"github.com/gitpod-io/gitpod/registry-facade/pkg/registry"
 )
@@ -105,6 +107,22 @@ var runCmd = &cobra.Command{
 
 			return docker.NewResolver(resolverOpts)
 		}
+		registryHosts := func(host string) ([]docker.RegistryHost, error) {
+			client := registry.NewRetryableHTTPClient()
+			client.Transport = rtt
+
+			authorizer := docker.NewDockerAuthorizer(docker.WithAuthClient(client))
+			dockerCfgMu.RLock()
+			if dockerCfg != nil {
+				authorizer = authorizerFromDockerConfig(dockerCfg)
+			}
+			dockerCfgMu.RUnlock()
+
+			return docker.ConfigureDefaultRegistries(
+				docker.WithAuthorizer(authorizer),
+				docker.WithClient(client),
+			)(host)
+		}
 
 		if cfg.ReadinessProbeAddr != "" {
 			// use the first layer as source for the tests
@@ -138,7 +156,7 @@ var runCmd = &cobra.Command{
 		}
 
 		registryDoneChan := make(chan struct{})
-		reg, err := registry.NewRegistry(cfg.Registry, resolverProvider, prometheus.WrapRegistererWithPrefix("registry_", gpreg))
+		reg, err := registry.NewRegistry(cfg.Registry, resolverProvider, registryHosts, prometheus.WrapRegistererWithPrefix("registry_", gpreg))
 		if err != nil {
 			log.WithError(err).Fatal("cannot create registry")
 		}
@@ -175,6 +193,30 @@ var runCmd = &cobra.Command{
 			log.WithError(err).Fatal("cannot start watch of Docker auth configuration file")
 		}
 
+		if cfg.Registry.Prewarm != nil {
+			prewarmer, err := registry.NewPrewarmer(cfg.Registry.Prewarm, reg, prometheus.WrapRegistererWithPrefix("registry_", gpreg))
+			if err != nil {
+				log.WithError(err).Fatal("cannot create image prewarmer")
+			}
+
+			grpcServer := grpc.NewServer(common_grpc.DefaultServerOptions()...)
+			api.RegisterImagePrewarmerServer(grpcServer, prewarmer)
+			lis, err := net.Listen("tcp", cfg.Registry.Prewarm.Addr)
+			if err != nil {
+				log.WithError(err).WithField("addr", cfg.Registry.Prewarm.Addr).Fatal("cannot listen for image prewarm requests")
+			}
+			go func() {
+				err := grpcServer.Serve(lis)
+				if err != nil {
+					log.WithError(err).Error("image prewarm server failed")
+				}
+			}()
+			defer grpcServer.Stop()
+			log.WithField("addr", cfg.Registry.Prewarm.Addr).Info("started image prewarm server")
+
+			go prewarmer.Run(ctx)
+		}
+
 		go func() {
 			defer close(registryDoneChan)
 			reg.MustServe()
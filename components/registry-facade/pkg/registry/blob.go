@@ -11,6 +11,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -76,6 +77,9 @@ func (reg *Registry) handleBlob(ctx context.Context, r *http.Request) http.Handl
 			reg.LayerSource,
 		},
 		ConfigModifier: reg.ConfigModifier,
+		Platform:       reg.platform,
+		LazyPull:       reg.LazyPull,
+		DiskCache:      reg.DiskCache,
 
 		Metrics: reg.metrics,
 	}
@@ -103,6 +107,9 @@ type blobHandler struct {
 	IPFS              *IPFSBlobCache
 	AdditionalSources []BlobSource
 	ConfigModifier    ConfigModifier
+	Platform          ociv1.Platform
+	LazyPull          *LazyPullConverter
+	DiskCache         *DiskBlobCache
 
 	Metrics *metrics
 }
@@ -121,7 +128,7 @@ func (bh *blobHandler) getBlob(w http.ResponseWriter, r *http.Request) {
 	//nolint:staticcheck,ineffassign
 	span, ctx := opentracing.StartSpanFromContext(r.Context(), "getBlob")
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(contextWithPlatform(context.Background(), bh.Platform))
 	defer cancel()
 
 	err := func() error {
@@ -137,17 +144,25 @@ func (bh *blobHandler) getBlob(w http.ResponseWriter, r *http.Request) {
 
 		// 1. local store (faster)
 		srcs = append(srcs, storeBlobSource{Store: bh.Store})
+		if bh.LazyPull != nil {
+			srcs = append(srcs, lazyLayerBlobSource{Converter: bh.LazyPull})
+		}
+
+		// 2. node-local disk cache (if configured)
+		if bh.DiskCache != nil {
+			srcs = append(srcs, diskCacheBlobSource{Cache: bh.DiskCache})
+		}
 
-		// 2. IPFS (if configured)
+		// 3. IPFS (if configured)
 		if bh.IPFS != nil {
 			ipfsSrc := ipfsBlobSource{source: bh.IPFS}
 			srcs = append(srcs, ipfsSrc)
 		}
 
-		// 3. upstream registry
+		// 4. upstream registry
 		srcs = append(srcs, proxyingBlobSource{Fetcher: fetcher, Blobs: manifest.Layers})
 
-		srcs = append(srcs, &configBlobSource{Fetcher: fetcher, Spec: bh.Spec, Manifest: manifest, ConfigModifier: bh.ConfigModifier})
+		srcs = append(srcs, &configBlobSource{Fetcher: fetcher, Spec: bh.Spec, Manifest: manifest, ConfigModifier: bh.ConfigModifier, LazyPull: bh.LazyPull})
 		srcs = append(srcs, bh.AdditionalSources...)
 
 		w.Header().Set("Etag", bh.Digest.String())
@@ -155,12 +170,13 @@ func (bh *blobHandler) getBlob(w http.ResponseWriter, r *http.Request) {
 		var retrieved bool
 		var src BlobSource
 		var dontCache bool
+		size := blobSize(manifest, bh.Digest)
 		for _, s := range srcs {
 			if !s.HasBlob(ctx, bh.Spec, bh.Digest) {
 				continue
 			}
 
-			retrieved, dontCache, err = bh.retrieveFromSource(ctx, s, w, r)
+			retrieved, dontCache, err = bh.retrieveFromSource(ctx, s, size, w, r)
 			if err != nil {
 				log.WithField("src", s.Name()).WithError(err).Error("unable to retrieve blob")
 			}
@@ -211,7 +227,21 @@ func (bh *blobHandler) getBlob(w http.ResponseWriter, r *http.Request) {
 	tracing.FinishSpan(span, &err)
 }
 
-func (bh *blobHandler) retrieveFromSource(ctx context.Context, src BlobSource, w http.ResponseWriter, r *http.Request) (handled, dontCache bool, err error) {
+// blobSize returns the size of a blob of the base image, or -1 if the blob isn't part of the base image
+func blobSize(manifest *ociv1.Manifest, dgst digest.Digest) int64 {
+	if manifest.Config.Digest == dgst {
+		return manifest.Config.Size
+	}
+	for _, l := range manifest.Layers {
+		if l.Digest == dgst {
+			return l.Size
+		}
+	}
+	return -1
+}
+
+// retrieveFromSource serves a blob from src. size is the expected size of the blob, or -1 if it is unknown.
+func (bh *blobHandler) retrieveFromSource(ctx context.Context, src BlobSource, size int64, w http.ResponseWriter, r *http.Request) (handled, dontCache bool, err error) {
 	log.Debugf("retrieving blob %s from %s", bh.Digest, src.Name())
 	dontCache, mediaType, url, rc, err := src.GetBlob(ctx, bh.Spec, bh.Digest)
 	if err != nil {
@@ -228,6 +258,37 @@ func (bh *blobHandler) retrieveFromSource(ctx context.Context, src BlobSource, w
 
 	w.Header().Set("Content-Type", mediaType)
 
+	// Snapshotters which pull lazily (e.g. stargz) request parts of a layer only. We can serve those
+	// if the blob source gives us random access (local store, disk cache, IPFS and the upstream registry do).
+	// For all other sources we skip ahead to the requested range, provided we know the blob's size.
+	// Without random access we serve single ranges only, because multiple ranges may overlap or be out of order.
+	rs, seekable := rc.(io.ReadSeeker)
+	if !seekable && rc != nil && size >= 0 {
+		rs = &forwardSeeker{r: rc, size: size}
+	}
+	if rs != nil {
+		w.Header().Set("Accept-Ranges", "bytes")
+		rng := r.Header.Get("Range")
+		if rng != "" && (seekable || !strings.Contains(rng, ",")) {
+			http.ServeContent(w, r, "", time.Time{}, rs)
+			if bh.Metrics != nil {
+				bh.Metrics.BlobDownloadCounter.WithLabelValues(src.Name(), "true").Inc()
+			}
+			// the client has only received part of the blob, hence there's no point in caching it
+			return true, true, nil
+		}
+	}
+
+	if bh.DiskCache != nil && !dontCache {
+		switch src.(type) {
+		case diskCacheBlobSource, lazyLayerBlobSource:
+			// those blobs are on local disk already
+		default:
+			rc = bh.DiskCache.Tee(bh.Digest, mediaType, rc)
+			defer rc.Close()
+		}
+	}
+
 	bp := bufPool.Get().(*[]byte)
 	defer bufPool.Put(bp)
 
@@ -288,6 +349,68 @@ func (r *reader) Read(b []byte) (n int, err error) {
 	return
 }
 
+func (r *reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.Size()
+	default:
+		return 0, xerrors.Errorf("invalid whence: %d", whence)
+	}
+	if offset < 0 {
+		return 0, xerrors.Errorf("negative position: %d", offset)
+	}
+	r.off = offset
+	return offset, nil
+}
+
+// forwardSeeker provides seeking on readers which support sequential access only.
+// It skips ahead when seeking forward and fails when asked to read before its current position.
+type forwardSeeker struct {
+	r    io.Reader
+	size int64
+	// off is the number of bytes consumed from r
+	off int64
+	// pos is the position the next read starts at
+	pos int64
+}
+
+func (f *forwardSeeker) Read(b []byte) (n int, err error) {
+	if f.pos < f.off {
+		return 0, xerrors.Errorf("cannot seek backwards from %d to %d", f.off, f.pos)
+	}
+	if f.pos > f.off {
+		skipped, err := io.CopyN(io.Discard, f.r, f.pos-f.off)
+		f.off += skipped
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err = f.r.Read(b)
+	f.off += int64(n)
+	f.pos = f.off
+	return
+}
+
+func (f *forwardSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.pos
+	case io.SeekEnd:
+		offset += f.size
+	default:
+		return 0, xerrors.Errorf("invalid whence: %d", whence)
+	}
+	if offset < 0 {
+		return 0, xerrors.Errorf("negative position: %d", offset)
+	}
+	f.pos = offset
+	return offset, nil
+}
+
 // BlobSource can provide blobs for download
 type BlobSource interface {
 	// HasBlob checks if a digest can be served by this blob source
@@ -371,6 +494,7 @@ type configBlobSource struct {
 	Spec           *api.ImageSpec
 	Manifest       *ociv1.Manifest
 	ConfigModifier ConfigModifier
+	LazyPull       *LazyPullConverter
 }
 
 func (sbs configBlobSource) Name() string {
@@ -378,45 +502,76 @@ func (sbs configBlobSource) Name() string {
 }
 
 func (pbs *configBlobSource) HasBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) bool {
-	cfg, err := pbs.getConfig(ctx)
+	cfg, err := pbs.getConfig(ctx, dgst)
 	if err != nil {
 		log.WithError(err).Error("cannot (re-)produce image config")
 		return false
 	}
-
-	cfgDgst := digest.FromBytes(cfg)
-	return cfgDgst == dgst
+	return cfg != nil
 }
 
 func (pbs *configBlobSource) GetBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) (dontCache bool, mediaType string, url string, data io.ReadCloser, err error) {
-	if !pbs.HasBlob(ctx, spec, dgst) {
-		err = distv2.ErrorCodeBlobUnknown
+	cfg, err := pbs.getConfig(ctx, dgst)
+	if err != nil {
 		return
 	}
-
-	cfg, err := pbs.getConfig(ctx)
-	if err != nil {
+	if cfg == nil {
+		err = distv2.ErrorCodeBlobUnknown
 		return
 	}
+
 	mediaType = pbs.Manifest.Config.MediaType
 	data = io.NopCloser(bytes.NewReader(cfg))
 	return
 }
 
-func (pbs *configBlobSource) getConfig(ctx context.Context) (rawCfg []byte, err error) {
+// getConfig re-produces the image config with digest dgst, or returns nil if the config we produce has a different digest.
+// If layers of the image have been converted for lazy pulling, the image was served either with or without them,
+// depending on when it was requested. Hence we try both variants.
+func (pbs *configBlobSource) getConfig(ctx context.Context, dgst digest.Digest) (rawCfg []byte, err error) {
 	manifest := *pbs.Manifest
 	cfg, err := DownloadConfig(ctx, AsFetcherFunc(pbs.Fetcher), "", manifest.Config)
 	if err != nil {
 		return
 	}
 
-	_, err = pbs.ConfigModifier(ctx, pbs.Spec, cfg)
-	if err != nil {
-		return
+	var candidates []*ociv1.Image
+	if pbs.LazyPull != nil {
+		// the config modifier changes the config in place, hence we need a deep copy
+		var lazyCfg ociv1.Image
+		err = deepCopyConfig(cfg, &lazyCfg)
+		if err != nil {
+			return nil, err
+		}
+		if pbs.LazyPull.Apply(&manifest, &lazyCfg) {
+			candidates = append(candidates, &lazyCfg)
+		}
 	}
+	candidates = append(candidates, cfg)
 
-	rawCfg, err = json.Marshal(cfg)
-	return
+	for _, c := range candidates {
+		_, err = pbs.ConfigModifier(ctx, pbs.Spec, c)
+		if err != nil {
+			return nil, err
+		}
+
+		rawCfg, err = json.Marshal(c)
+		if err != nil {
+			return nil, err
+		}
+		if digest.FromBytes(rawCfg) == dgst {
+			return rawCfg, nil
+		}
+	}
+	return nil, nil
+}
+
+func deepCopyConfig(src, dst *ociv1.Image) error {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dst)
 }
 
 type ipfsBlobSource struct {
@@ -7,6 +7,7 @@ package registry
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	"github.com/alicebob/miniredis/v2"
 	"github.com/containerd/containerd/remotes"
 	"github.com/containerd/containerd/remotes/docker"
+	"github.com/google/go-cmp/cmp"
 	httpapi "github.com/ipfs/kubo/client/rpc"
 	oldcmds "github.com/ipfs/kubo/commands"
 	config "github.com/ipfs/kubo/config"
@@ -29,9 +31,11 @@ import (
 	"github.com/ipfs/kubo/repo/fsrepo"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	redis "github.com/redis/go-redis/v9"
 
 	rfapi "github.com/gitpod-io/gitpod/registry-facade/api"
+	rfconfig "github.com/gitpod-io/gitpod/registry-facade/api/config"
 )
 
 var loadPluginsOnce sync.Once
@@ -250,3 +254,233 @@ func (rw *failFirstResponseWriter) Write(buf []byte) (int, error) {
 func (rw *failFirstResponseWriter) WriteHeader(code int) {
 	rw.code = code
 }
+
+func TestSeek(t *testing.T) {
+	const data = "0123456789"
+	type seek struct {
+		Offset int64
+		Whence int
+	}
+	tests := []struct {
+		Name     string
+		Seeks    []seek
+		Expected string
+		Error    bool
+	}{
+		{Name: "start", Seeks: []seek{{3, io.SeekStart}}, Expected: "3456789"},
+		{Name: "current", Seeks: []seek{{3, io.SeekStart}, {2, io.SeekCurrent}}, Expected: "56789"},
+		{Name: "end", Seeks: []seek{{-4, io.SeekEnd}}, Expected: "6789"},
+		{Name: "size first", Seeks: []seek{{0, io.SeekEnd}, {5, io.SeekStart}}, Expected: "56789"},
+		{Name: "negative", Seeks: []seek{{-1, io.SeekStart}}, Error: true},
+	}
+
+	seekers := map[string]func() io.ReadSeeker{
+		"reader": func() io.ReadSeeker {
+			return &reader{ReaderAt: bytesReaderAt{bytes.NewReader([]byte(data))}}
+		},
+		"forwardSeeker": func() io.ReadSeeker {
+			return &forwardSeeker{r: bytes.NewBufferString(data), size: int64(len(data))}
+		},
+	}
+	for name, newSeeker := range seekers {
+		for _, test := range tests {
+			t.Run(name+"/"+test.Name, func(t *testing.T) {
+				rs := newSeeker()
+				var err error
+				for _, s := range test.Seeks {
+					_, err = rs.Seek(s.Offset, s.Whence)
+					if err != nil {
+						break
+					}
+				}
+				if test.Error {
+					if err == nil {
+						t.Fatal("expected error")
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				act, err := io.ReadAll(rs)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(test.Expected, string(act)); diff != "" {
+					t.Errorf("unexpected content (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestForwardSeekerBackwards(t *testing.T) {
+	fs := &forwardSeeker{r: bytes.NewBufferString("0123456789"), size: 10}
+	_, err := fs.Seek(5, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = fs.Read(make([]byte, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = fs.Seek(2, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = fs.Read(make([]byte, 2))
+	if err == nil {
+		t.Fatal("expected error when reading before the current position")
+	}
+}
+
+type bytesReaderAt struct {
+	*bytes.Reader
+}
+
+func (bytesReaderAt) Close() error { return nil }
+
+type seekableReadCloser struct {
+	*bytes.Reader
+}
+
+func (seekableReadCloser) Close() error { return nil }
+
+type fakeBlobSource struct {
+	Content  string
+	Seekable bool
+}
+
+func (fakeBlobSource) Name() string { return "fake" }
+
+func (fakeBlobSource) HasBlob(ctx context.Context, spec *rfapi.ImageSpec, dgst digest.Digest) bool {
+	return true
+}
+
+func (s fakeBlobSource) GetBlob(ctx context.Context, spec *rfapi.ImageSpec, dgst digest.Digest) (dontCache bool, mediaType string, url string, data io.ReadCloser, err error) {
+	if s.Seekable {
+		return false, "application/octet-stream", "", seekableReadCloser{bytes.NewReader([]byte(s.Content))}, nil
+	}
+	return false, "application/octet-stream", "", io.NopCloser(bytes.NewBufferString(s.Content)), nil
+}
+
+func TestRetrieveFromSourceRange(t *testing.T) {
+	const data = "0123456789"
+	tests := []struct {
+		Name           string
+		Seekable       bool
+		Size           int64
+		Range          string
+		ExpectedStatus int
+		ExpectedBody   string
+		ExpectedRanges string
+	}{
+		{Name: "seekable", Seekable: true, Size: -1, Range: "bytes=2-5", ExpectedStatus: http.StatusPartialContent, ExpectedBody: "2345", ExpectedRanges: "bytes"},
+		{Name: "seekable without range", Seekable: true, Size: -1, ExpectedStatus: http.StatusOK, ExpectedBody: data, ExpectedRanges: "bytes"},
+		{Name: "sequential", Size: 10, Range: "bytes=2-5", ExpectedStatus: http.StatusPartialContent, ExpectedBody: "2345", ExpectedRanges: "bytes"},
+		{Name: "sequential suffix", Size: 10, Range: "bytes=-3", ExpectedStatus: http.StatusPartialContent, ExpectedBody: "789", ExpectedRanges: "bytes"},
+		{Name: "sequential multiple ranges", Size: 10, Range: "bytes=6-7,0-1", ExpectedStatus: http.StatusOK, ExpectedBody: data, ExpectedRanges: "bytes"},
+		{Name: "sequential unknown size", Size: -1, Range: "bytes=2-5", ExpectedStatus: http.StatusOK, ExpectedBody: data},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			bh := &blobHandler{
+				Digest: digest.FromString(data),
+				Spec:   &rfapi.ImageSpec{},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/v2/foo/blobs/"+bh.Digest.String(), nil)
+			if test.Range != "" {
+				req.Header.Set("Range", test.Range)
+			}
+			rec := httptest.NewRecorder()
+
+			handled, _, err := bh.retrieveFromSource(context.Background(), fakeBlobSource{Content: data, Seekable: test.Seekable}, test.Size, rec, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !handled {
+				t.Fatal("blob was not handled")
+			}
+			if rec.Code != test.ExpectedStatus {
+				t.Errorf("unexpected status: want %d, got %d", test.ExpectedStatus, rec.Code)
+			}
+			if diff := cmp.Diff(test.ExpectedBody, rec.Body.String()); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpectedRanges, rec.Header().Get("Accept-Ranges")); diff != "" {
+				t.Errorf("unexpected Accept-Ranges header (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigBlobSourceGetConfig(t *testing.T) {
+	var (
+		layer  = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: digest.FromString("layer"), Size: 1000}
+		diffID = digest.FromString("layer-diff")
+		conv   = &lazyLayer{Source: layer.Digest, Digest: digest.FromString("layer-conv"), Size: 1100, MediaType: ociv1.MediaTypeImageLayerGzip, DiffID: digest.FromString("conv-diff"), TOCDigest: digest.FromString("toc")}
+	)
+	baseCfg := ociv1.Image{
+		Config: ociv1.ImageConfig{Env: []string{"FOO=bar"}},
+		RootFS: ociv1.RootFS{Type: "layers", DiffIDs: []digest.Digest{diffID}},
+	}
+	rawBaseCfg, err := json.Marshal(baseCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfgDesc := ociv1.Descriptor{MediaType: ociv1.MediaTypeImageConfig, Digest: digest.FromBytes(rawBaseCfg), Size: int64(len(rawBaseCfg))}
+
+	modifier := func(ctx context.Context, spec *rfapi.ImageSpec, cfg *ociv1.Image) ([]ociv1.Descriptor, error) {
+		cfg.Config.Env = append(cfg.Config.Env, "GITPOD=true")
+		return nil, nil
+	}
+	produce := func(diffIDs ...digest.Digest) []byte {
+		cfg := baseCfg
+		cfg.Config.Env = []string{"FOO=bar", "GITPOD=true"}
+		cfg.RootFS.DiffIDs = diffIDs
+		res, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	plain, lazy := produce(diffID), produce(conv.DiffID)
+
+	tests := []struct {
+		Name      string
+		Digest    digest.Digest
+		Converted bool
+		Expected  []byte
+	}{
+		{Name: "modified config", Digest: digest.FromBytes(plain), Expected: plain},
+		{Name: "unknown digest", Digest: digest.FromString("unknown")},
+		{Name: "base config", Digest: cfgDesc.Digest},
+		{Name: "lazy pull config", Digest: digest.FromBytes(lazy), Converted: true, Expected: lazy},
+		{Name: "lazy pull enabled but served as-is", Digest: digest.FromBytes(plain), Converted: true, Expected: plain},
+		{Name: "lazy pull config before conversion", Digest: digest.FromBytes(lazy)},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			src := &configBlobSource{
+				Fetcher:        &fakeFetcher{Content: map[string][]byte{cfgDesc.Digest.Encoded(): rawBaseCfg}},
+				Spec:           &rfapi.ImageSpec{},
+				Manifest:       &ociv1.Manifest{Config: cfgDesc, Layers: []ociv1.Descriptor{layer}},
+				ConfigModifier: modifier,
+			}
+			if test.Converted {
+				src.LazyPull = &LazyPullConverter{
+					Config:   rfconfig.LazyPullConfig{MinLayerSize: 100},
+					bySource: map[digest.Digest]*lazyLayer{conv.Source: conv},
+				}
+			}
+
+			act, err := src.getConfig(context.Background(), test.Digest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(test.Expected), string(act)); diff != "" {
+				t.Errorf("unexpected config (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
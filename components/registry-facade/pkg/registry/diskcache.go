@@ -0,0 +1,412 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/registry-facade/api"
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+// DiskBlobCache caches blobs on node-local disk, so that repeated starts of the same image on a node
+// don't hit the upstream registry. Once the cache exceeds its size limit, the least recently used blobs are evicted.
+type DiskBlobCache struct {
+	Store       content.Store
+	MaxSize     int64
+	MaxBlobSize int64
+
+	mu      sync.Mutex
+	entries map[digest.Digest]*list.Element
+	lru     *list.List
+	size    int64
+	labels  *fileLabelStore
+
+	requestCounter *prometheus.CounterVec
+	writeCounter   *prometheus.CounterVec
+	evictCounter   prometheus.Counter
+	sizeGauge      prometheus.Gauge
+}
+
+type diskCacheEntry struct {
+	Digest digest.Digest
+	Size   int64
+}
+
+// NewDiskBlobCache creates a new disk cache and indexes the blobs cached previously
+func NewDiskBlobCache(cfg *config.DiskCacheConfig, reg prometheus.Registerer) (*DiskBlobCache, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	labels := &fileLabelStore{Dir: filepath.Join(cfg.Dir, "labels")}
+	store, err := local.NewLabeledStore(filepath.Join(cfg.Dir, "blobs"), labels)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create disk cache store: %w", err)
+	}
+
+	maxBlobSize := cfg.MaxBlobSize
+	if maxBlobSize == 0 {
+		maxBlobSize = cfg.MaxSize
+	}
+
+	res := &DiskBlobCache{
+		Store:       store,
+		MaxSize:     cfg.MaxSize,
+		MaxBlobSize: maxBlobSize,
+		entries:     make(map[digest.Digest]*list.Element),
+		lru:         list.New(),
+		labels:      labels,
+
+		requestCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "blob_disk_cache_requests_total",
+			Help: "number of blob requests to the disk cache by result",
+		}, []string{"result"}),
+		writeCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "blob_disk_cache_writes_total",
+			Help: "number of attempts to add a blob to the disk cache by result",
+		}, []string{"result"}),
+		evictCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "blob_disk_cache_evictions_total",
+			Help: "number of blobs evicted from the disk cache",
+		}),
+		sizeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "blob_disk_cache_size_bytes",
+			Help: "number of bytes in the disk cache",
+		}),
+	}
+	for _, c := range []prometheus.Collector{res.requestCounter, res.writeCounter, res.evictCounter, res.sizeGauge} {
+		err = reg.Register(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = res.index(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// index populates the LRU list from the store, using the access time of the blobs as recency
+func (c *DiskBlobCache) index(ctx context.Context) error {
+	var infos []content.Info
+	err := c.Store.Walk(ctx, func(info content.Info) error {
+		infos = append(infos, info)
+		return nil
+	})
+	// the store creates its directories only once it's written to for the first time
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return xerrors.Errorf("cannot index disk cache: %w", err)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].UpdatedAt.Before(infos[j].UpdatedAt) })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, info := range infos {
+		c.entries[info.Digest] = c.lru.PushFront(&diskCacheEntry{Digest: info.Digest, Size: info.Size})
+		c.size += info.Size
+	}
+	c.evict(ctx)
+	log.WithField("blobs", len(infos)).WithField("size", c.size).Info("indexed disk blob cache")
+	return nil
+}
+
+// Has returns true if the blob is in the cache
+func (c *DiskBlobCache) Has(dgst digest.Digest) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.entries[dgst]
+	return ok
+}
+
+// Get provides access to a cached blob and marks it as recently used
+func (c *DiskBlobCache) Get(ctx context.Context, dgst digest.Digest) (mediaType string, r content.ReaderAt, err error) {
+	c.mu.Lock()
+	if e, ok := c.entries[dgst]; ok {
+		c.lru.MoveToFront(e)
+	}
+	c.mu.Unlock()
+
+	info, err := c.Store.Info(ctx, dgst)
+	if err != nil {
+		c.requestCounter.WithLabelValues("miss").Inc()
+		return "", nil, err
+	}
+	r, err = c.Store.ReaderAt(ctx, ociv1.Descriptor{Digest: dgst})
+	if err != nil {
+		c.requestCounter.WithLabelValues("miss").Inc()
+		return "", nil, err
+	}
+	c.requestCounter.WithLabelValues("hit").Inc()
+	return info.Labels["Content-Type"], r, nil
+}
+
+// Tee returns a reader which adds the blob to the cache while it's read. The blob is added only once it has been
+// read completely and its content matches dgst. Closing the returned reader does not close r.
+func (c *DiskBlobCache) Tee(dgst digest.Digest, mediaType string, r io.Reader) io.ReadCloser {
+	if c.Has(dgst) {
+		return io.NopCloser(r)
+	}
+
+	ref := "diskcache-" + dgst.Encoded()
+	w, err := content.OpenWriter(context.Background(), c.Store, content.WithRef(ref), content.WithDescriptor(ociv1.Descriptor{Digest: dgst}))
+	if err != nil {
+		// most likely another request is caching the same blob right now
+		log.WithError(err).WithField("digest", dgst).Debug("not adding blob to disk cache")
+		return io.NopCloser(r)
+	}
+	// discard what a previous, interrupted attempt might have left behind
+	err = w.Truncate(0)
+	if err != nil {
+		w.Close()
+		log.WithError(err).WithField("digest", dgst).Debug("not adding blob to disk cache")
+		return io.NopCloser(r)
+	}
+	return &cachingReader{
+		Reader:    r,
+		Cache:     c,
+		Digest:    dgst,
+		MediaType: mediaType,
+		Ref:       ref,
+		w:         w,
+	}
+}
+
+func (c *DiskBlobCache) add(ctx context.Context, dgst digest.Digest, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[dgst]; ok {
+		return
+	}
+	c.entries[dgst] = c.lru.PushFront(&diskCacheEntry{Digest: dgst, Size: size})
+	c.size += size
+	c.evict(ctx)
+}
+
+// evict removes the least recently used blobs until the cache fits its size limit. Callers must hold c.mu.
+func (c *DiskBlobCache) evict(ctx context.Context) {
+	for c.size > c.MaxSize {
+		e := c.lru.Back()
+		if e == nil {
+			break
+		}
+		entry := e.Value.(*diskCacheEntry)
+		err := c.Store.Delete(ctx, entry.Digest)
+		if err != nil && !errdefs.IsNotFound(err) {
+			log.WithError(err).WithField("digest", entry.Digest).Warn("cannot evict blob from disk cache")
+		}
+		err = c.labels.Set(entry.Digest, nil)
+		if err != nil {
+			log.WithError(err).WithField("digest", entry.Digest).Warn("cannot remove labels of evicted blob")
+		}
+
+		c.lru.Remove(e)
+		delete(c.entries, entry.Digest)
+		c.size -= entry.Size
+		c.evictCounter.Inc()
+	}
+	c.sizeGauge.Set(float64(c.size))
+}
+
+// cachingReader writes everything that's read from it to the disk cache
+type cachingReader struct {
+	io.Reader
+
+	Cache     *DiskBlobCache
+	Digest    digest.Digest
+	MediaType string
+	Ref       string
+
+	w content.Writer
+	n int64
+}
+
+func (r *cachingReader) Read(b []byte) (n int, err error) {
+	n, err = r.Reader.Read(b)
+	if r.w != nil && n > 0 {
+		r.n += int64(n)
+		if r.n > r.Cache.MaxBlobSize {
+			r.abort("too_large")
+		} else if _, werr := r.w.Write(b[:n]); werr != nil {
+			log.WithError(werr).WithField("digest", r.Digest).Warn("cannot write blob to disk cache")
+			r.abort("failed")
+		}
+	}
+	if r.w != nil && errors.Is(err, io.EOF) {
+		r.commit()
+	}
+	return
+}
+
+func (r *cachingReader) commit() {
+	ctx := context.Background()
+	defer func() {
+		r.w.Close()
+		r.w = nil
+	}()
+
+	// Commit verifies that the content we've read matches the digest
+	err := r.w.Commit(ctx, r.n, r.Digest, content.WithLabels(contentTypeLabel(r.MediaType)))
+	switch {
+	case err == nil, errdefs.IsAlreadyExists(err):
+	case errdefs.IsFailedPrecondition(err):
+		log.WithError(err).WithField("digest", r.Digest).Warn("blob content does not match its digest - not adding it to the disk cache")
+		r.Cache.writeCounter.WithLabelValues("digest_mismatch").Inc()
+		_ = r.Cache.Store.Abort(ctx, r.Ref)
+		return
+	default:
+		log.WithError(err).WithField("digest", r.Digest).Warn("cannot add blob to disk cache")
+		r.Cache.writeCounter.WithLabelValues("failed").Inc()
+		_ = r.Cache.Store.Abort(ctx, r.Ref)
+		return
+	}
+
+	r.Cache.writeCounter.WithLabelValues("stored").Inc()
+	r.Cache.add(ctx, r.Digest, r.n)
+}
+
+func (r *cachingReader) abort(reason string) {
+	r.w.Close()
+	r.w = nil
+	_ = r.Cache.Store.Abort(context.Background(), r.Ref)
+	r.Cache.writeCounter.WithLabelValues(reason).Inc()
+}
+
+func (r *cachingReader) Close() error {
+	if r.w != nil {
+		// the blob wasn't read completely
+		r.abort("incomplete")
+	}
+	return nil
+}
+
+// diskCacheBlobSource serves blobs from the disk cache
+type diskCacheBlobSource struct {
+	Cache *DiskBlobCache
+}
+
+func (dbs diskCacheBlobSource) Name() string {
+	return "diskcache"
+}
+
+func (dbs diskCacheBlobSource) HasBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) bool {
+	return dbs.Cache.Has(dgst)
+}
+
+func (dbs diskCacheBlobSource) GetBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) (dontCache bool, mediaType string, url string, data io.ReadCloser, err error) {
+	mediaType, r, err := dbs.Cache.Get(ctx, dgst)
+	if err != nil {
+		return
+	}
+	return false, mediaType, "", &reader{ReaderAt: r}, nil
+}
+
+// fileLabelStore keeps the labels of the disk cache blobs in files, so that they survive restarts
+type fileLabelStore struct {
+	Dir string
+
+	mu sync.Mutex
+}
+
+var _ local.LabelStore = &fileLabelStore{}
+
+func (s *fileLabelStore) path(dgst digest.Digest) string {
+	return filepath.Join(s.Dir, dgst.Algorithm().String(), dgst.Encoded()+".json")
+}
+
+func (s *fileLabelStore) Get(dgst digest.Digest) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.get(dgst)
+}
+
+func (s *fileLabelStore) get(dgst digest.Digest) (map[string]string, error) {
+	fc, err := os.ReadFile(s.path(dgst))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]string
+	err = json.Unmarshal(fc, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *fileLabelStore) Set(dgst digest.Digest, labels map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set(dgst, labels)
+}
+
+func (s *fileLabelStore) set(dgst digest.Digest, labels map[string]string) error {
+	fn := s.path(dgst)
+	if len(labels) == 0 {
+		err := os.Remove(fn)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	fc, err := json.Marshal(labels)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fn, fc, 0644)
+}
+
+func (s *fileLabelStore) Update(dgst digest.Digest, update map[string]string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	labels, err := s.get(dgst)
+	if err != nil {
+		return nil, err
+	}
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for k, v := range update {
+		if v == "" {
+			delete(labels, k)
+		} else {
+			labels[k] = v
+		}
+	}
+	return labels, s.set(dgst, labels)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+func TestDiskBlobCache(t *testing.T) {
+	blob := func(s string) ([]byte, digest.Digest) {
+		b := bytes.Repeat([]byte(s), 100)
+		return b, digest.FromBytes(b)
+	}
+	// cache reads the blob through the cache's tee, reading only the first n bytes if n >= 0
+	cache := func(c *DiskBlobCache, dgst digest.Digest, content []byte, n int) {
+		r := c.Tee(dgst, ociv1.MediaTypeImageLayerGzip, bytes.NewReader(content))
+		var err error
+		if n < 0 {
+			_, err = io.Copy(io.Discard, r)
+		} else {
+			_, err = io.CopyN(io.Discard, r, int64(n))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Close()
+	}
+
+	tests := []struct {
+		Name     string
+		Run      func(t *testing.T, c *DiskBlobCache)
+		Expected []string
+	}{
+		{
+			Name: "complete read",
+			Run: func(t *testing.T, c *DiskBlobCache) {
+				b, dgst := blob("a")
+				cache(c, dgst, b, -1)
+			},
+			Expected: []string{"a"},
+		},
+		{
+			Name: "incomplete read",
+			Run: func(t *testing.T, c *DiskBlobCache) {
+				b, dgst := blob("a")
+				cache(c, dgst, b, 10)
+			},
+		},
+		{
+			Name: "digest mismatch",
+			Run: func(t *testing.T, c *DiskBlobCache) {
+				b, _ := blob("a")
+				_, dgst := blob("b")
+				cache(c, dgst, b, -1)
+			},
+		},
+		{
+			Name: "too large",
+			Run: func(t *testing.T, c *DiskBlobCache) {
+				b, dgst := blob("abcd")
+				cache(c, dgst, b, -1)
+			},
+		},
+		{
+			Name: "evict least recently used",
+			Run: func(t *testing.T, c *DiskBlobCache) {
+				for _, s := range []string{"a", "b", "c"} {
+					b, dgst := blob(s)
+					cache(c, dgst, b, -1)
+				}
+				_, dgst := blob("a")
+				_, r, err := c.Get(context.Background(), dgst)
+				if err != nil {
+					t.Fatal(err)
+				}
+				r.Close()
+
+				b, dgst := blob("d")
+				cache(c, dgst, b, -1)
+			},
+			Expected: []string{"a", "c", "d"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			c, err := NewDiskBlobCache(&config.DiskCacheConfig{
+				Dir:         t.TempDir(),
+				MaxSize:     300,
+				MaxBlobSize: 200,
+			}, prometheus.NewRegistry())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.Run(t, c)
+
+			var size int64
+			for _, s := range test.Expected {
+				b, dgst := blob(s)
+				if !c.Has(dgst) {
+					t.Errorf("expected %s to be cached", s)
+					continue
+				}
+				mediaType, r, err := c.Get(context.Background(), dgst)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act, err := io.ReadAll(content.NewReader(r))
+				r.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(act, b) {
+					t.Errorf("unexpected content of %s", s)
+				}
+				if mediaType != ociv1.MediaTypeImageLayerGzip {
+					t.Errorf("unexpected media type of %s: %s", s, mediaType)
+				}
+				size += int64(len(b))
+			}
+			if c.size != size || len(c.entries) != len(test.Expected) {
+				t.Errorf("unexpected cache content: %d blobs with %d bytes, expected %d blobs with %d bytes", len(c.entries), c.size, len(test.Expected), size)
+			}
+
+			var stored int
+			err = c.Store.Walk(context.Background(), func(info content.Info) error {
+				stored++
+				return nil
+			})
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				t.Fatal(err)
+			}
+			if stored != len(test.Expected) {
+				t.Errorf("unexpected number of blobs in store: %d", stored)
+			}
+		})
+	}
+}
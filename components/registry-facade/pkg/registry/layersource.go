@@ -16,6 +16,7 @@ import (
 	"sync"
 
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/opencontainers/go-digest"
@@ -400,6 +401,7 @@ func NewSpecMappedImageSource(resolver ResolverProvider, refSource RefSource) (*
 		RefSource: refSource,
 		Resolver:  resolver,
 		cache:     cache,
+		platforms: make(map[string]ociv1.Platform),
 	}, nil
 }
 
@@ -410,6 +412,11 @@ type SpecMappedImagedSource struct {
 
 	// TODO: add ttl
 	cache *lru.Cache
+
+	// platforms contains all platforms we've provided layers for. Blob requests don't tell us the platform
+	// they're for, hence we need to look for blobs among the layers of all those platforms.
+	mu        sync.Mutex
+	platforms map[string]ociv1.Platform
 }
 
 func (src *SpecMappedImagedSource) Name() string {
@@ -458,38 +465,51 @@ func (src *SpecMappedImagedSource) GetLayer(ctx context.Context, spec *api.Image
 
 // HasBlob checks if a digest can be served by this blob source
 func (src *SpecMappedImagedSource) HasBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) bool {
-	lsrcs, err := src.getDelegate(ctx, spec)
-	if err != nil {
-		return false
-	}
-	for _, lsrc := range lsrcs {
-		if lsrc == nil {
-			continue
-		}
-		if lsrc.HasBlob(ctx, spec, dgst) {
-			return true
-		}
-	}
-	return false
+	lsrc, err := src.findBlob(ctx, spec, dgst)
+	return err == nil && lsrc != nil
 }
 
 // GetBlob provides access to a blob. If a ReadCloser is returned the receiver is expected to
 // call close on it eventually.
 func (src *SpecMappedImagedSource) GetBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) (dontCache bool, mediaType string, url string, data io.ReadCloser, err error) {
-	lsrcs, err := src.getDelegate(ctx, spec)
+	lsrc, err := src.findBlob(ctx, spec, dgst)
 	if err != nil {
 		return
 	}
-	for _, lsrc := range lsrcs {
-		if lsrc == nil {
-			continue
+	if lsrc == nil {
+		err = errdefs.ErrNotFound
+		return
+	}
+	return lsrc.GetBlob(ctx, spec, dgst)
+}
+
+// findBlob returns the delegate which can serve a blob, starting with the platform from the context
+func (src *SpecMappedImagedSource) findBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) (LayerSource, error) {
+	platform := platformFromContext(ctx)
+	candidates := []ociv1.Platform{platform}
+	src.mu.Lock()
+	for k, p := range src.platforms {
+		if k != platforms.Format(platform) {
+			candidates = append(candidates, p)
 		}
-		if lsrc.HasBlob(ctx, spec, dgst) {
-			return lsrc.GetBlob(ctx, spec, dgst)
+	}
+	src.mu.Unlock()
+
+	for _, p := range candidates {
+		lsrcs, err := src.getDelegate(contextWithPlatform(ctx, p), spec)
+		if err != nil {
+			return nil, err
+		}
+		for _, lsrc := range lsrcs {
+			if lsrc == nil {
+				continue
+			}
+			if lsrc.HasBlob(ctx, spec, dgst) {
+				return lsrc, nil
+			}
 		}
 	}
-	err = errdefs.ErrNotFound
-	return
+	return nil, nil
 }
 
 // getDelegate returns the cached layer source delegate computed from the image spec
@@ -500,11 +520,19 @@ func (src *SpecMappedImagedSource) getDelegate(ctx context.Context, spec *api.Im
 	}
 	layers := make([]LayerSource, len(refs))
 
+	platform := platformFromContext(ctx)
+	platformName := platforms.Format(platform)
+	src.mu.Lock()
+	src.platforms[platformName] = platform
+	src.mu.Unlock()
+
 	for i, ref := range refs {
 		if ref == "" {
 			continue
 		}
-		if s, ok := src.cache.Get(ref); ok {
+		// The same ref can resolve to different images for different platforms
+		key := ref + "@" + platformName
+		if s, ok := src.cache.Get(key); ok {
 			layers[i] = s.(LayerSource)
 			continue
 		}
@@ -512,7 +540,7 @@ func (src *SpecMappedImagedSource) getDelegate(ctx context.Context, spec *api.Im
 		if err != nil {
 			return nil, err
 		}
-		src.cache.Add(ref, lsrc)
+		src.cache.Add(key, lsrc)
 		layers[i] = lsrc
 	}
 	return layers, nil
@@ -0,0 +1,398 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/stargz-snapshotter/estargz"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/registry-facade/api"
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+// lazyPullConversionTimeout limits how long we spend converting a single layer
+const lazyPullConversionTimeout = 30 * time.Minute
+
+// lazyLayer describes a base image layer we have converted to eStargz
+type lazyLayer struct {
+	Source    digest.Digest `json:"source"`
+	Digest    digest.Digest `json:"digest"`
+	Size      int64         `json:"size"`
+	MediaType string        `json:"mediaType"`
+	DiffID    digest.Digest `json:"diffID"`
+	TOCDigest digest.Digest `json:"tocDigest"`
+}
+
+// Descriptor produces the manifest entry of the converted layer
+func (l *lazyLayer) Descriptor() ociv1.Descriptor {
+	return ociv1.Descriptor{
+		MediaType: l.MediaType,
+		Digest:    l.Digest,
+		Size:      l.Size,
+		Annotations: map[string]string{
+			estargz.TOCJSONDigestAnnotation: l.TOCDigest.String(),
+		},
+	}
+}
+
+// LazyPullConverter converts base image layers to eStargz so that snapshotters which support lazy
+// pulling can start workspace containers before all of their image has been downloaded.
+//
+// Conversion happens in the background: until all layers of an image are converted, the image is served as-is.
+// Converted layers are stored on local disk and served as blobs like any other.
+//
+// The converted layers are not shared between registry-facade replicas: each replica converts the layers it
+// serves on its own. This is fine because registry-facade runs on every node and only serves the node it runs on,
+// so a node always sees the same manifests and blobs. Dir should survive restarts of the replica though (e.g. a
+// host path), because manifests served before a restart reference converted layers we could no longer serve.
+type LazyPullConverter struct {
+	Config config.LazyPullConfig
+	Store  content.Store
+
+	mu       sync.RWMutex
+	bySource map[digest.Digest]*lazyLayer
+	byDigest map[digest.Digest]*lazyLayer
+	inflight map[digest.Digest]struct{}
+	sem      chan struct{}
+
+	conversionCounter *prometheus.CounterVec
+	conversionHist    prometheus.Histogram
+}
+
+// NewLazyPullConverter creates a new converter and loads the layers converted previously
+func NewLazyPullConverter(cfg *config.LazyPullConfig, reg prometheus.Registerer) (*LazyPullConverter, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := local.NewStore(filepath.Join(cfg.Dir, "blobs"))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create store for converted layers: %w", err)
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency == 0 {
+		concurrency = 1
+	}
+
+	conversionCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lazy_pull_conversions_total",
+		Help: "number of layers converted to a lazy-pullable format",
+	}, []string{"success"})
+	conversionHist := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "lazy_pull_conversion_duration_seconds",
+		Help:    "time it takes to convert a layer to a lazy-pullable format",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+	})
+	for _, c := range []prometheus.Collector{conversionCounter, conversionHist} {
+		err = reg.Register(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &LazyPullConverter{
+		Config:            *cfg,
+		Store:             store,
+		bySource:          make(map[digest.Digest]*lazyLayer),
+		byDigest:          make(map[digest.Digest]*lazyLayer),
+		inflight:          make(map[digest.Digest]struct{}),
+		sem:               make(chan struct{}, concurrency),
+		conversionCounter: conversionCounter,
+		conversionHist:    conversionHist,
+	}
+	err = res.loadRecords()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *LazyPullConverter) recordsDir() string {
+	return filepath.Join(c.Config.Dir, "records")
+}
+
+func (c *LazyPullConverter) loadRecords() error {
+	err := os.MkdirAll(c.recordsDir(), 0755)
+	if err != nil {
+		return xerrors.Errorf("cannot create lazy pull records directory: %w", err)
+	}
+	entries, err := os.ReadDir(c.recordsDir())
+	if err != nil {
+		return xerrors.Errorf("cannot read lazy pull records: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		fn := filepath.Join(c.recordsDir(), e.Name())
+		fc, err := os.ReadFile(fn)
+		if err != nil {
+			return xerrors.Errorf("cannot read lazy pull record %s: %w", fn, err)
+		}
+		var l lazyLayer
+		err = json.Unmarshal(fc, &l)
+		if err != nil {
+			log.WithError(err).WithField("fn", fn).Warn("ignoring invalid lazy pull record")
+			continue
+		}
+		if _, err := c.Store.Info(context.Background(), l.Digest); err != nil {
+			log.WithError(err).WithField("fn", fn).Warn("ignoring lazy pull record whose layer is gone")
+			continue
+		}
+		c.bySource[l.Source] = &l
+		c.byDigest[l.Digest] = &l
+	}
+	log.WithField("layers", len(c.bySource)).Info("loaded converted layers")
+	return nil
+}
+
+func (c *LazyPullConverter) saveRecord(l *lazyLayer) error {
+	fc, err := json.Marshal(l)
+	if err != nil {
+		return err
+	}
+	fn := filepath.Join(c.recordsDir(), l.Source.Encoded()+".json")
+	err = os.WriteFile(fn+".tmp", fc, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(fn+".tmp", fn)
+}
+
+// convertible returns true if we'd convert the layer
+func (c *LazyPullConverter) convertible(desc ociv1.Descriptor) bool {
+	if desc.Size < c.Config.MinLayerSize || len(desc.URLs) > 0 {
+		return false
+	}
+	if _, ok := desc.Annotations[estargz.TOCJSONDigestAnnotation]; ok {
+		return false
+	}
+	return lazyLayerMediaType(desc.MediaType) != ""
+}
+
+// lazyLayerMediaType returns the media type of a converted layer, or an empty string if we cannot convert
+// layers of the given media type. eStargz layers are valid gzip layers, hence we stick to the gzip media type
+// of the original manifest format.
+func lazyLayerMediaType(mediaType string) string {
+	switch mediaType {
+	case ociv1.MediaTypeImageLayer, ociv1.MediaTypeImageLayerGzip:
+		return ociv1.MediaTypeImageLayerGzip
+	case images.MediaTypeDockerSchema2Layer, images.MediaTypeDockerSchema2LayerGzip:
+		return images.MediaTypeDockerSchema2LayerGzip
+	default:
+		return ""
+	}
+}
+
+// Apply replaces the layers of the manifest, and their diff IDs in the image config, with their converted
+// counterparts. It modifies manifest and cfg only if all convertible layers have been converted, because
+// a partially converted image would change whenever another of its layers is converted.
+// Apply returns true if the image was modified.
+func (c *LazyPullConverter) Apply(manifest *ociv1.Manifest, cfg *ociv1.Image) bool {
+	if len(cfg.RootFS.DiffIDs) != len(manifest.Layers) {
+		return false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var (
+		layers  = make([]ociv1.Descriptor, len(manifest.Layers))
+		diffIDs = make([]digest.Digest, len(cfg.RootFS.DiffIDs))
+		changed bool
+	)
+	for i, l := range manifest.Layers {
+		layers[i], diffIDs[i] = l, cfg.RootFS.DiffIDs[i]
+		if !c.convertible(l) {
+			continue
+		}
+		conv, ok := c.bySource[l.Digest]
+		if !ok {
+			return false
+		}
+		layers[i], diffIDs[i] = conv.Descriptor(), conv.DiffID
+		changed = true
+	}
+	if !changed {
+		return false
+	}
+
+	manifest.Layers = layers
+	cfg.RootFS.DiffIDs = diffIDs
+	return true
+}
+
+// ConvertInBackground starts converting all convertible layers which haven't been converted yet
+func (c *LazyPullConverter) ConvertInBackground(fetcher remotes.Fetcher, layers []ociv1.Descriptor) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, l := range layers {
+		if !c.convertible(l) {
+			continue
+		}
+		if _, ok := c.bySource[l.Digest]; ok {
+			continue
+		}
+		if _, ok := c.inflight[l.Digest]; ok {
+			continue
+		}
+		c.inflight[l.Digest] = struct{}{}
+
+		go func(desc ociv1.Descriptor) {
+			c.sem <- struct{}{}
+			defer func() {
+				<-c.sem
+
+				c.mu.Lock()
+				delete(c.inflight, desc.Digest)
+				c.mu.Unlock()
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), lazyPullConversionTimeout)
+			defer cancel()
+
+			log := log.WithField("layer", desc.Digest)
+			t0 := time.Now()
+			conv, err := c.convert(ctx, fetcher, desc)
+			if err != nil {
+				log.WithError(err).Warn("cannot convert layer for lazy pulling")
+				c.conversionCounter.WithLabelValues("false").Inc()
+				return
+			}
+			c.conversionCounter.WithLabelValues("true").Inc()
+			c.conversionHist.Observe(time.Since(t0).Seconds())
+			log.WithField("converted", conv.Digest).WithField("duration", time.Since(t0)).Debug("converted layer for lazy pulling")
+
+			c.mu.Lock()
+			c.bySource[conv.Source] = conv
+			c.byDigest[conv.Digest] = conv
+			c.mu.Unlock()
+		}(l)
+	}
+}
+
+func (c *LazyPullConverter) convert(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) (*lazyLayer, error) {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch layer: %w", err)
+	}
+	defer rc.Close()
+
+	// estargz needs random access to the layer, hence we download it first
+	f, err := os.CreateTemp(c.Config.Dir, "layer-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	digester := desc.Digest.Algorithm().Digester()
+	n, err := io.Copy(io.MultiWriter(f, digester.Hash()), rc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download layer: %w", err)
+	}
+	if digester.Digest() != desc.Digest {
+		return nil, xerrors.Errorf("layer digest mismatch: expected %s, got %s", desc.Digest, digester.Digest())
+	}
+
+	blob, err := estargz.Build(io.NewSectionReader(f, 0, n), estargz.WithContext(ctx))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot convert layer: %w", err)
+	}
+	defer blob.Close()
+
+	w, err := content.OpenWriter(ctx, c.Store, content.WithRef("lazypull-"+desc.Digest.Encoded()))
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	convDigester := digest.Canonical.Digester()
+	size, err := io.Copy(io.MultiWriter(w, convDigester.Hash()), blob)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot store converted layer: %w", err)
+	}
+	// the diff ID is available only once the blob is closed
+	err = blob.Close()
+	if err != nil {
+		return nil, err
+	}
+	err = w.Commit(ctx, size, convDigester.Digest())
+	if err != nil && !errdefs.IsAlreadyExists(err) {
+		return nil, xerrors.Errorf("cannot store converted layer: %w", err)
+	}
+
+	res := &lazyLayer{
+		Source:    desc.Digest,
+		Digest:    convDigester.Digest(),
+		Size:      size,
+		MediaType: lazyLayerMediaType(desc.MediaType),
+		DiffID:    blob.DiffID(),
+		TOCDigest: blob.TOCDigest(),
+	}
+	err = c.saveRecord(res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot save lazy pull record: %w", err)
+	}
+	return res, nil
+}
+
+// lazyLayerBlobSource serves converted layers
+type lazyLayerBlobSource struct {
+	Converter *LazyPullConverter
+}
+
+func (lbs lazyLayerBlobSource) Name() string {
+	return "lazypull"
+}
+
+func (lbs lazyLayerBlobSource) layer(dgst digest.Digest) (*lazyLayer, bool) {
+	lbs.Converter.mu.RLock()
+	defer lbs.Converter.mu.RUnlock()
+
+	l, ok := lbs.Converter.byDigest[dgst]
+	return l, ok
+}
+
+func (lbs lazyLayerBlobSource) HasBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) bool {
+	_, ok := lbs.layer(dgst)
+	return ok
+}
+
+func (lbs lazyLayerBlobSource) GetBlob(ctx context.Context, spec *api.ImageSpec, dgst digest.Digest) (dontCache bool, mediaType string, url string, data io.ReadCloser, err error) {
+	l, ok := lbs.layer(dgst)
+	if !ok {
+		err = errdefs.ErrNotFound
+		return
+	}
+
+	r, err := lbs.Converter.Store.ReaderAt(ctx, l.Descriptor())
+	if err != nil {
+		return
+	}
+	return false, l.MediaType, "", &reader{ReaderAt: r}, nil
+}
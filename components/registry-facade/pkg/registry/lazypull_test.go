@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/stargz-snapshotter/estargz"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+func TestLazyPullApply(t *testing.T) {
+	var (
+		small     = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: digest.FromString("small"), Size: 10}
+		large     = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: digest.FromString("large"), Size: 1000}
+		dockerTar = ociv1.Descriptor{MediaType: images.MediaTypeDockerSchema2Layer, Digest: digest.FromString("docker"), Size: 1000}
+		zstd      = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerZstd, Digest: digest.FromString("zstd"), Size: 1000}
+		estgz     = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: digest.FromString("estargz"), Size: 1000, Annotations: map[string]string{estargz.TOCJSONDigestAnnotation: "sha256:toc"}}
+
+		convLarge = &lazyLayer{Source: large.Digest, Digest: digest.FromString("large-conv"), Size: 1100, MediaType: ociv1.MediaTypeImageLayerGzip, DiffID: digest.FromString("large-diff"), TOCDigest: digest.FromString("large-toc")}
+		convTar   = &lazyLayer{Source: dockerTar.Digest, Digest: digest.FromString("docker-conv"), Size: 500, MediaType: images.MediaTypeDockerSchema2LayerGzip, DiffID: digest.FromString("docker-diff"), TOCDigest: digest.FromString("docker-toc")}
+	)
+	diffIDs := func(n int) []digest.Digest {
+		res := make([]digest.Digest, n)
+		for i := range res {
+			res[i] = digest.FromString(string(rune('a' + i)))
+		}
+		return res
+	}
+
+	tests := []struct {
+		Name            string
+		Layers          []ociv1.Descriptor
+		Converted       []*lazyLayer
+		ExpectedChange  bool
+		ExpectedLayers  []ociv1.Descriptor
+		ExpectedDiffIDs []digest.Digest
+	}{
+		{
+			Name:      "all converted",
+			Layers:    []ociv1.Descriptor{small, large, dockerTar, zstd, estgz},
+			Converted: []*lazyLayer{convLarge, convTar},
+
+			ExpectedChange:  true,
+			ExpectedLayers:  []ociv1.Descriptor{small, convLarge.Descriptor(), convTar.Descriptor(), zstd, estgz},
+			ExpectedDiffIDs: []digest.Digest{diffIDs(5)[0], convLarge.DiffID, convTar.DiffID, diffIDs(5)[3], diffIDs(5)[4]},
+		},
+		{
+			Name:      "partially converted",
+			Layers:    []ociv1.Descriptor{large, dockerTar},
+			Converted: []*lazyLayer{convLarge},
+
+			ExpectedLayers:  []ociv1.Descriptor{large, dockerTar},
+			ExpectedDiffIDs: diffIDs(2),
+		},
+		{
+			Name:      "nothing to convert",
+			Layers:    []ociv1.Descriptor{small, zstd, estgz},
+			Converted: []*lazyLayer{convLarge},
+
+			ExpectedLayers:  []ociv1.Descriptor{small, zstd, estgz},
+			ExpectedDiffIDs: diffIDs(3),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			conv := &LazyPullConverter{
+				Config:   config.LazyPullConfig{MinLayerSize: 100},
+				bySource: make(map[digest.Digest]*lazyLayer),
+			}
+			for _, l := range test.Converted {
+				conv.bySource[l.Source] = l
+			}
+
+			manifest := &ociv1.Manifest{Layers: test.Layers}
+			cfg := &ociv1.Image{RootFS: ociv1.RootFS{Type: "layers", DiffIDs: diffIDs(len(test.Layers))}}
+			changed := conv.Apply(manifest, cfg)
+			if changed != test.ExpectedChange {
+				t.Errorf("unexpected change: %v", changed)
+			}
+			if diff := cmp.Diff(test.ExpectedLayers, manifest.Layers); diff != "" {
+				t.Errorf("unexpected layers (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpectedDiffIDs, cfg.RootFS.DiffIDs); diff != "" {
+				t.Errorf("unexpected diff IDs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,13 @@ import (
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
+	"github.com/docker/distribution/registry/api/errcode"
 	distv2 "github.com/docker/distribution/registry/api/v2"
 	"github.com/gorilla/handlers"
 	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
 	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -70,6 +73,10 @@ func (reg *Registry) handleManifest(ctx context.Context, r *http.Request) http.H
 		Resolver:       reg.Resolver(),
 		Store:          reg.Store,
 		ConfigModifier: reg.ConfigModifier,
+		Platform:       reg.platform,
+		MultiArch:      reg.Config.MultiArch,
+		Policy:         reg.Policy,
+		LazyPull:       reg.LazyPull,
 	}
 	reference := getReference(ctx)
 	dgst, err := digest.Parse(reference)
@@ -102,6 +109,10 @@ type manifestHandler struct {
 	Resolver       remotes.Resolver
 	Store          BlobStore
 	ConfigModifier ConfigModifier
+	Platform       ociv1.Platform
+	MultiArch      bool
+	Policy         *PolicyEngine
+	LazyPull       *LazyPullConverter
 
 	Name   string
 	Tag    string
@@ -111,6 +122,7 @@ type manifestHandler struct {
 func (mh *manifestHandler) getManifest(w http.ResponseWriter, r *http.Request) {
 	//nolint:staticcheck,ineffassign
 	span, ctx := opentracing.StartSpanFromContext(r.Context(), "getManifest")
+	ctx = contextWithPlatform(ctx, mh.Platform)
 	logFields := log.OWI("", "", mh.Name)
 	logFields["tag"] = mh.Tag
 	logFields["spec"] = mh.Spec
@@ -119,34 +131,58 @@ func (mh *manifestHandler) getManifest(w http.ResponseWriter, r *http.Request) {
 		tracing.LogMessageSafe(span, "spec", mh.Spec)
 
 		var (
-			acceptType string
-			err        error
+			acceptManifest bool
+			acceptIndex    bool
 		)
 		for _, acceptHeader := range r.Header["Accept"] {
 			for _, mediaType := range strings.Split(acceptHeader, ",") {
-				if mediaType, _, err = mime.ParseMediaType(strings.TrimSpace(mediaType)); err != nil {
+				mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaType))
+				if err != nil {
 					continue
 				}
 
-				if mediaType == ociv1.MediaTypeImageManifest ||
-					mediaType == images.MediaTypeDockerSchema2Manifest ||
-					mediaType == "*" {
-
-					acceptType = ociv1.MediaTypeImageManifest
-					break
+				switch mediaType {
+				case ociv1.MediaTypeImageManifest, images.MediaTypeDockerSchema2Manifest, "*":
+					acceptManifest = true
+				case ociv1.MediaTypeImageIndex, images.MediaTypeDockerSchema2ManifestList:
+					acceptIndex = true
 				}
 			}
-			if acceptType != "" {
-				break
-			}
 		}
-		if acceptType == "" {
+		if !acceptManifest {
 			return distv2.ErrorCodeManifestUnknown.WithMessage("Accept header does not include OCIv1 or v2 manifests")
 		}
 
-		// Note: we ignore the mh.Digest for now because we always return a manifest, never a manifest index.
 		ref := mh.Spec.BaseRef
 
+		// HEAD requests don't carry a body which would tell the client why we refuse the image. Clients follow
+		// up with a GET though, which then fails with the policy violation as error message.
+		verifyPolicy := func(digests ...digest.Digest) error {
+			if mh.Policy == nil || r.Method == http.MethodHead {
+				return nil
+			}
+			err := mh.Policy.Verify(ctx, mh.Spec, ref, digests...)
+			var violation *PolicyViolationError
+			if errors.As(err, &violation) {
+				return errcode.ErrorCodeDenied.WithMessage(violation.Error())
+			}
+			return err
+		}
+
+		if mh.Digest != "" {
+			// Platform manifests of an index we served earlier are requested by digest. The policy might have
+			// changed since, hence we verify the base image again before serving the manifest from the store.
+			if p, mediaType, baseDigests, ok := mh.storedManifest(ctx, mh.Digest); ok {
+				err := verifyPolicy(baseDigests...)
+				if err != nil {
+					return err
+				}
+				serveManifest(w, mediaType, p)
+				return nil
+			}
+		}
+
+		// Note: unless we find the digest in the store, we ignore mh.Digest and build the manifest for our spec.
 		_, desc, err := mh.Resolver.Resolve(ctx, ref)
 		if err != nil {
 			log.WithError(err).WithField("ref", ref).WithFields(logFields).Error("cannot resolve")
@@ -168,86 +204,43 @@ func (mh *manifestHandler) getManifest(w http.ResponseWriter, r *http.Request) {
 			return fcache, nil
 		}
 
-		manifest, ndesc, err := DownloadManifest(ctx, fetch, desc, WithStore(mh.Store))
-		if err != nil {
-			log.WithError(err).WithField("desc", desc).WithFields(logFields).WithField("ref", ref).Error("cannot download manifest")
-			return distv2.ErrorCodeManifestUnknown.WithDetail(err)
-		}
-		desc = *ndesc
-
-		var p []byte
-		switch desc.MediaType {
-		case images.MediaTypeDockerSchema2Manifest, ociv1.MediaTypeImageManifest:
-			// download config
-			cfg, err := DownloadConfig(ctx, fetch, ref, manifest.Config, WithStore(mh.Store))
+		if mh.MultiArch && acceptIndex && isIndexMediaType(desc.MediaType) {
+			err = verifyPolicy(desc.Digest)
 			if err != nil {
-				log.WithError(err).WithFields(logFields).Error("cannot download config")
 				return err
 			}
 
-			// modify config
-			addonLayer, err := mh.ConfigModifier(ctx, mh.Spec, cfg)
+			p, err := mh.buildIndex(ctx, fetch, ref, desc)
 			if err != nil {
-				log.WithError(err).WithFields(logFields).Error("cannot modify config")
-				return err
-			}
-			manifest.Layers = append(manifest.Layers, addonLayer...)
-
-			// place config in store
-			rawCfg, err := json.Marshal(cfg)
-			if err != nil {
-				log.WithError(err).WithFields(logFields).Error("cannot marshal config")
-				return err
-			}
-			cfgDgst := digest.FromBytes(rawCfg)
-
-			// update config digest in manifest
-			manifest.Config.Digest = cfgDgst
-			manifest.Config.URLs = nil
-			manifest.Config.Size = int64(len(rawCfg))
-
-			// optimization: we store the config in the store just in case the client attempts to download the config blob
-			// 				 from us. If they download it from a registry facade from which the manifest hasn't been downloaded
-			//               we'll re-create the config on the fly.
-			if w, err := mh.Store.Writer(ctx, content.WithRef(ref), content.WithDescriptor(manifest.Config)); err == nil {
-				defer w.Close()
-
-				_, err = w.Write(rawCfg)
-				if err != nil {
-					log.WithError(err).WithFields(logFields).Warn("cannot write config to store - we'll regenerate it on demand")
-				}
-				err = w.Commit(ctx, 0, cfgDgst, content.WithLabels(contentTypeLabel(manifest.Config.MediaType)))
-				if err != nil {
-					log.WithError(err).WithFields(logFields).Warn("cannot commit config to store - we'll regenerate it on demand")
-				}
+				log.WithError(err).WithField("desc", desc).WithFields(logFields).WithField("ref", ref).Error("cannot build image index")
+				return distv2.ErrorCodeManifestUnknown.WithDetail(err)
 			}
+			serveManifest(w, ociv1.MediaTypeImageIndex, p)
+			log.WithFields(logFields).Debug("get manifest (end)")
+			return nil
+		}
 
-			// When serving images.MediaTypeDockerSchema2Manifest we have to set the mediaType in the manifest itself.
-			// Although somewhat compatible with the OCI manifest spec (see https://github.com/opencontainers/image-spec/blob/master/manifest.md),
-			// this field is not part of the OCI Go structs. In this particular case, we'll go ahead and add it ourselves.
-			//
-			// fixes https://github.com/gitpod-io/gitpod/pull/3397
-			if desc.MediaType == images.MediaTypeDockerSchema2Manifest {
-				type ManifestWithMediaType struct {
-					ociv1.Manifest
-					MediaType string `json:"mediaType"`
-				}
-				p, _ = json.Marshal(ManifestWithMediaType{
-					Manifest:  *manifest,
-					MediaType: images.MediaTypeDockerSchema2Manifest,
-				})
-			} else {
-				p, _ = json.Marshal(manifest)
-			}
+		manifest, ndesc, err := DownloadManifest(ctx, fetch, desc, WithStore(mh.Store))
+		if err != nil {
+			log.WithError(err).WithField("desc", desc).WithFields(logFields).WithField("ref", ref).Error("cannot download manifest")
+			return distv2.ErrorCodeManifestUnknown.WithDetail(err)
 		}
 
-		dgst := digest.FromBytes(p).String()
+		digests := []digest.Digest{desc.Digest}
+		if ndesc.Digest != desc.Digest {
+			digests = append(digests, ndesc.Digest)
+		}
+		err = verifyPolicy(digests...)
+		if err != nil {
+			return err
+		}
 
-		w.Header().Set("Content-Type", desc.MediaType)
-		w.Header().Set("Content-Length", fmt.Sprint(len(p)))
-		w.Header().Set("Etag", fmt.Sprintf(`"%s"`, dgst))
-		w.Header().Set("Docker-Content-Digest", dgst)
-		_, _ = w.Write(p)
+		p, err := mh.buildManifest(ctx, fetch, ref, manifest, ndesc.MediaType)
+		if err != nil {
+			log.WithError(err).WithFields(logFields).Error("cannot build manifest")
+			return err
+		}
+		serveManifest(w, ndesc.MediaType, p)
 
 		log.WithFields(logFields).Debug("get manifest (end)")
 		return nil
@@ -260,6 +253,198 @@ func (mh *manifestHandler) getManifest(w http.ResponseWriter, r *http.Request) {
 	tracing.FinishSpan(span, &err)
 }
 
+func serveManifest(w http.ResponseWriter, mediaType string, p []byte) {
+	dgst := digest.FromBytes(p).String()
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Length", fmt.Sprint(len(p)))
+	w.Header().Set("Etag", fmt.Sprintf(`"%s"`, dgst))
+	w.Header().Set("Docker-Content-Digest", dgst)
+	_, _ = w.Write(p)
+}
+
+// buildManifest adds the addon layers and modified config to the manifest of the base image
+func (mh *manifestHandler) buildManifest(ctx context.Context, fetch FetcherFunc, ref string, manifest *ociv1.Manifest, mediaType string) ([]byte, error) {
+	switch mediaType {
+	case images.MediaTypeDockerSchema2Manifest, ociv1.MediaTypeImageManifest:
+	default:
+		return nil, nil
+	}
+
+	// download config
+	cfg, err := DownloadConfig(ctx, fetch, ref, manifest.Config, WithStore(mh.Store))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download config: %w", err)
+	}
+
+	// serve lazy-pullable layers if we have them already, and convert them for next time if we don't
+	if mh.LazyPull != nil && !mh.LazyPull.Apply(manifest, cfg) {
+		fetcher, err := fetch()
+		if err != nil {
+			log.WithError(err).WithField("ref", ref).Warn("cannot convert layers for lazy pulling")
+		} else {
+			mh.LazyPull.ConvertInBackground(fetcher, manifest.Layers)
+		}
+	}
+
+	// modify config
+	addonLayer, err := mh.ConfigModifier(ctx, mh.Spec, cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot modify config: %w", err)
+	}
+	manifest.Layers = append(manifest.Layers, addonLayer...)
+
+	// place config in store
+	rawCfg, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot marshal config: %w", err)
+	}
+	cfgDgst := digest.FromBytes(rawCfg)
+
+	// update config digest in manifest
+	manifest.Config.Digest = cfgDgst
+	manifest.Config.URLs = nil
+	manifest.Config.Size = int64(len(rawCfg))
+
+	// optimization: we store the config in the store just in case the client attempts to download the config blob
+	// 				 from us. If they download it from a registry facade from which the manifest hasn't been downloaded
+	//               we'll re-create the config on the fly.
+	if w, err := mh.Store.Writer(ctx, content.WithRef(ref), content.WithDescriptor(manifest.Config)); err == nil {
+		defer w.Close()
+
+		_, err = w.Write(rawCfg)
+		if err != nil {
+			log.WithError(err).WithField("ref", ref).Warn("cannot write config to store - we'll regenerate it on demand")
+		}
+		err = w.Commit(ctx, 0, cfgDgst, content.WithLabels(contentTypeLabel(manifest.Config.MediaType)))
+		if err != nil {
+			log.WithError(err).WithField("ref", ref).Warn("cannot commit config to store - we'll regenerate it on demand")
+		}
+	}
+
+	// When serving images.MediaTypeDockerSchema2Manifest we have to set the mediaType in the manifest itself.
+	// Although somewhat compatible with the OCI manifest spec (see https://github.com/opencontainers/image-spec/blob/master/manifest.md),
+	// this field is not part of the OCI Go structs. In this particular case, we'll go ahead and add it ourselves.
+	//
+	// fixes https://github.com/gitpod-io/gitpod/pull/3397
+	if mediaType == images.MediaTypeDockerSchema2Manifest {
+		type ManifestWithMediaType struct {
+			ociv1.Manifest
+			MediaType string `json:"mediaType"`
+		}
+		return json.Marshal(ManifestWithMediaType{
+			Manifest:  *manifest,
+			MediaType: images.MediaTypeDockerSchema2Manifest,
+		})
+	}
+	return json.Marshal(manifest)
+}
+
+// buildIndex builds an image index which lists a manifest for each platform of the base image index.
+// The platform manifests are placed in the store, so that we can serve them when they're requested by digest.
+func (mh *manifestHandler) buildIndex(ctx context.Context, fetch FetcherFunc, ref string, desc ociv1.Descriptor) ([]byte, error) {
+	fetcher, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch index: %w", err)
+	}
+	raw, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download index: %w", err)
+	}
+	var base ociv1.Index
+	err = json.Unmarshal(raw, &base)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal index: %w", err)
+	}
+
+	res := ociv1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociv1.MediaTypeImageIndex,
+	}
+	for _, md := range base.Manifests {
+		if md.Platform == nil || isAttestation(md) {
+			continue
+		}
+
+		pctx := contextWithPlatform(ctx, *md.Platform)
+		manifest, ndesc, err := DownloadManifest(pctx, fetch, md, WithStore(mh.Store))
+		if err != nil {
+			return nil, xerrors.Errorf("cannot download manifest for %s: %w", platforms.Format(*md.Platform), err)
+		}
+		p, err := mh.buildManifest(pctx, fetch, ref, manifest, ndesc.MediaType)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot build manifest for %s: %w", platforms.Format(*md.Platform), err)
+		}
+
+		pdesc := ociv1.Descriptor{
+			MediaType: ndesc.MediaType,
+			Digest:    digest.FromBytes(p),
+			Size:      int64(len(p)),
+			Platform:  md.Platform,
+		}
+		labels := contentTypeLabel(pdesc.MediaType)
+		labels[facadeManifestLabel] = mh.Name
+		labels[facadeBaseDigestsLabel] = desc.Digest.String() + "," + md.Digest.String()
+		storeManifest(ctx, mh.Store, pdesc, labels, p)
+		res.Manifests = append(res.Manifests, pdesc)
+	}
+	if len(res.Manifests) == 0 {
+		return nil, xerrors.Errorf("base image index lists no platforms")
+	}
+
+	return json.Marshal(res)
+}
+
+// facadeManifestLabel marks the platform manifests built by buildIndex. Its value is the name of the
+// spec the manifest was built for.
+const facadeManifestLabel = "gitpod.io/registry-facade.manifest"
+
+// facadeBaseDigestsLabel lists the digests of the base image index and platform manifest a platform manifest
+// built by buildIndex is based on, separated by a comma. The image policy is verified against those digests.
+const facadeBaseDigestsLabel = "gitpod.io/registry-facade.base-digests"
+
+// storedManifest returns a platform manifest buildIndex has placed in the store for this spec before,
+// together with the digests of the base image it was built from
+func (mh *manifestHandler) storedManifest(ctx context.Context, dgst digest.Digest) (p []byte, mediaType string, baseDigests []digest.Digest, ok bool) {
+	nfo, err := mh.Store.Info(ctx, dgst)
+	if err != nil {
+		return nil, "", nil, false
+	}
+	if mh.Name == "" || nfo.Labels[facadeManifestLabel] != mh.Name {
+		// The store also holds the manifests of base images - we never serve those as they are.
+		return nil, "", nil, false
+	}
+	mediaType = nfo.Labels["Content-Type"]
+	if mediaType != ociv1.MediaTypeImageManifest && mediaType != images.MediaTypeDockerSchema2Manifest {
+		return nil, "", nil, false
+	}
+	for _, d := range strings.Split(nfo.Labels[facadeBaseDigestsLabel], ",") {
+		bd, err := digest.Parse(d)
+		if err != nil {
+			// Without the base digests we cannot verify the image policy, hence must build the manifest again.
+			return nil, "", nil, false
+		}
+		baseDigests = append(baseDigests, bd)
+	}
+
+	r, err := mh.Store.ReaderAt(ctx, ociv1.Descriptor{Digest: dgst, Size: nfo.Size})
+	if err != nil {
+		return nil, "", nil, false
+	}
+	defer r.Close()
+
+	p, err = io.ReadAll(&reader{ReaderAt: r})
+	if err != nil || digest.FromBytes(p) != dgst {
+		return nil, "", nil, false
+	}
+	return p, mediaType, baseDigests, true
+}
+
 // DownloadConfig downloads and unmarshales OCIv2 image config, referred to by an OCI descriptor.
 func DownloadConfig(ctx context.Context, fetch FetcherFunc, ref string, desc ociv1.Descriptor, options ...ManifestDownloadOption) (cfg *ociv1.Image, err error) {
 	if desc.MediaType != images.MediaTypeDockerSchema2Config &&
@@ -342,7 +527,8 @@ func contentTypeLabel(mt string) map[string]string {
 }
 
 type manifestDownloadOptions struct {
-	Store BlobStore
+	Store    BlobStore
+	Platform *ociv1.Platform
 }
 
 // ManifestDownloadOption alters the default manifest download behaviour
@@ -355,6 +541,14 @@ func WithStore(store BlobStore) ManifestDownloadOption {
 	}
 }
 
+// WithPlatform selects the manifest of an image index for the given platform. By default we select
+// the manifest for the platform from the context, see platformFromContext.
+func WithPlatform(platform ociv1.Platform) ManifestDownloadOption {
+	return func(o *manifestDownloadOptions) {
+		o.Platform = &platform
+	}
+}
+
 type BlobStore interface {
 	ReaderAt(ctx context.Context, desc ociv1.Descriptor) (content.ReaderAt, error)
 
@@ -374,7 +568,7 @@ func AsFetcherFunc(f remotes.Fetcher) FetcherFunc {
 }
 
 // DownloadManifest downloads and unmarshals the manifest of the given desc. If the desc points to manifest list
-// we choose the first manifest in that list.
+// we choose the manifest for our platform from that list.
 func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descriptor, options ...ManifestDownloadOption) (cfg *ociv1.Manifest, rdesc *ociv1.Descriptor, err error) {
 	var opts manifestDownloadOptions
 	for _, o := range options {
@@ -401,6 +595,11 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 				// we have broken data in the store - ignore it and overwrite
 				return
 			}
+			if isIndexMediaType(desc.MediaType) && !isIndexMediaType(nfo.Labels["Content-Type"]) {
+				// Older versions of registry-facade stored the first manifest of an index under the
+				// digest of the index. That manifest isn't necessarily the one for our platform.
+				return
+			}
 
 			r, err := opts.Store.ReaderAt(ctx, desc)
 			if errors.Is(err, errdefs.ErrNotFound) {
@@ -444,11 +643,10 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 	rdesc = &desc
 	rdesc.MediaType = mediaType
 
-	switch rdesc.MediaType {
-	case images.MediaTypeDockerSchema2ManifestList, ociv1.MediaTypeImageIndex:
+	if isIndexMediaType(rdesc.MediaType) {
 		log.WithField("desc", rdesc).Debug("resolving image index")
 
-		// we received a manifest list which means we'll pick the default platform
+		// we received a manifest list which means we'll pick the manifest of our platform
 		// and fetch that manifest
 		var list ociv1.Index
 		err = json.Unmarshal(inpt, &list)
@@ -456,31 +654,24 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 			err = xerrors.Errorf("cannot unmarshal index: %w", err)
 			return
 		}
-		if len(list.Manifests) == 0 {
-			err = xerrors.Errorf("empty manifest")
-			return
-		}
 
-		var fetcher remotes.Fetcher
-		fetcher, err = fetch()
-		if err != nil {
-			return
+		platform := platformFromContext(ctx)
+		if opts.Platform != nil {
+			platform = *opts.Platform
 		}
-
-		// TODO(cw): choose by platform, not just the first manifest
-		md := list.Manifests[0]
-		rc, err = fetcher.Fetch(ctx, md)
+		var md ociv1.Descriptor
+		md, err = selectManifest(list.Manifests, platform)
 		if err != nil {
-			err = xerrors.Errorf("cannot download config: %w", err)
 			return
 		}
-		rdesc = &md
-		inpt, err = io.ReadAll(rc)
-		rc.Close()
-		if err != nil {
-			err = xerrors.Errorf("cannot download manifest: %w", err)
-			return
+
+		if opts.Store != nil && placeInStore {
+			// We store the index as it is, and the platform manifest under its own digest. This way the
+			// store remains correct when it's shared by registry-facades serving different platforms.
+			storeManifest(ctx, opts.Store, desc, contentTypeLabel(rdesc.MediaType), inpt)
 		}
+
+		return DownloadManifest(ctx, fetch, md, options...)
 	}
 
 	switch rdesc.MediaType {
@@ -498,32 +689,35 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 	}
 
 	if opts.Store != nil && placeInStore {
-		// We're cheating here and store the actual image manifest under the desc of what's
-		// possibly an image index. This way we don't have to resolve the image index the next
-		// time one wishes to resolve desc.
-		w, err := opts.Store.Writer(ctx, content.WithDescriptor(desc), content.WithRef(desc.Digest.String()))
-		if err != nil {
-			if err != nil && !strings.Contains(err.Error(), "already exists") {
-				log.WithError(err).WithField("desc", *rdesc).Warn("cannot create store writer")
-			}
-		} else {
-			_, err = io.Copy(w, bytes.NewReader(inpt))
-			if err != nil {
-				log.WithError(err).WithField("desc", *rdesc).Warn("cannot copy manifest")
-			}
-
-			err = w.Commit(ctx, 0, digest.FromBytes(inpt), content.WithLabels(map[string]string{"Content-Type": rdesc.MediaType}))
-			if err != nil {
-				log.WithError(err).WithField("desc", *rdesc).Warn("cannot store manifest")
-			}
-			w.Close()
-		}
+		storeManifest(ctx, opts.Store, desc, contentTypeLabel(rdesc.MediaType), inpt)
 	}
 
 	cfg = &res
 	return
 }
 
+// storeManifest places a manifest or index in the store
+func storeManifest(ctx context.Context, store BlobStore, desc ociv1.Descriptor, labels map[string]string, data []byte) {
+	w, err := store.Writer(ctx, content.WithDescriptor(desc), content.WithRef(desc.Digest.String()))
+	if err != nil {
+		if !strings.Contains(err.Error(), "already exists") {
+			log.WithError(err).WithField("desc", desc).Warn("cannot create store writer")
+		}
+		return
+	}
+	defer w.Close()
+
+	_, err = io.Copy(w, bytes.NewReader(data))
+	if err != nil {
+		log.WithError(err).WithField("desc", desc).Warn("cannot copy manifest")
+	}
+
+	err = w.Commit(ctx, 0, digest.FromBytes(data), content.WithLabels(labels))
+	if err != nil {
+		log.WithError(err).WithField("desc", desc).Warn("cannot store manifest")
+	}
+}
+
 func (mh *manifestHandler) putManifest(w http.ResponseWriter, r *http.Request) {
 	respondWithError(w, distv2.ErrorCodeManifestInvalid)
 }
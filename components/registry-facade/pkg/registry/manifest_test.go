@@ -8,10 +8,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/google/go-cmp/cmp"
 	"github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/specs-go"
 	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
@@ -105,6 +108,161 @@ func TestDownloadManifest(t *testing.T) {
 	}
 }
 
+func TestSelectManifest(t *testing.T) {
+	var (
+		amd64   = ociv1.Descriptor{Digest: "sha256:amd64", Platform: &ociv1.Platform{OS: "linux", Architecture: "amd64"}}
+		arm64   = ociv1.Descriptor{Digest: "sha256:arm64", Platform: &ociv1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}}
+		armv7   = ociv1.Descriptor{Digest: "sha256:armv7", Platform: &ociv1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}}
+		armv6   = ociv1.Descriptor{Digest: "sha256:armv6", Platform: &ociv1.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}}
+		attest  = ociv1.Descriptor{Digest: "sha256:attest", Platform: &ociv1.Platform{OS: "unknown", Architecture: "unknown"}}
+		noPlatf = ociv1.Descriptor{Digest: "sha256:noplatform"}
+	)
+
+	tests := []struct {
+		Name        string
+		Manifests   []ociv1.Descriptor
+		Platform    string
+		Expectation digest.Digest
+		Error       bool
+	}{
+		{
+			Name:        "amd64",
+			Manifests:   []ociv1.Descriptor{attest, arm64, amd64},
+			Platform:    "linux/amd64",
+			Expectation: amd64.Digest,
+		},
+		{
+			Name:        "arm64 without variant",
+			Manifests:   []ociv1.Descriptor{amd64, arm64},
+			Platform:    "linux/arm64",
+			Expectation: arm64.Digest,
+		},
+		{
+			Name:        "best variant",
+			Manifests:   []ociv1.Descriptor{armv6, armv7},
+			Platform:    "linux/arm/v7",
+			Expectation: armv7.Digest,
+		},
+		{
+			Name:        "compatible variant",
+			Manifests:   []ociv1.Descriptor{amd64, armv6},
+			Platform:    "linux/arm/v7",
+			Expectation: armv6.Digest,
+		},
+		{
+			Name:      "no platform information",
+			Manifests: []ociv1.Descriptor{noPlatf, amd64},
+			Platform:  "linux/arm64",
+			Error:     true,
+		},
+		{
+			Name:        "single platform index",
+			Manifests:   []ociv1.Descriptor{noPlatf},
+			Platform:    "linux/arm64",
+			Expectation: noPlatf.Digest,
+		},
+		{
+			Name:      "missing platform",
+			Manifests: []ociv1.Descriptor{amd64, attest},
+			Platform:  "linux/arm64",
+			Error:     true,
+		},
+		{
+			Name:     "empty index",
+			Platform: "linux/amd64",
+			Error:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			platform, err := ParsePlatform(test.Platform)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act, err := selectManifest(test.Manifests, platform)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if act.Digest != test.Expectation {
+				t.Errorf("unexpected manifest: expected %s, got %s", test.Expectation, act.Digest)
+			}
+		})
+	}
+}
+
+func TestDownloadManifestForPlatform(t *testing.T) {
+	var (
+		blobs     = make(map[string][]byte)
+		manifests []ociv1.Descriptor
+	)
+	for _, arch := range []string{"amd64", "arm64"} {
+		cfg, err := json.Marshal(ociv1.Image{OS: "linux", Architecture: arch})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cfgDgst := digest.FromBytes(cfg)
+		mf, err := json.Marshal(ociv1.Manifest{
+			Versioned: specs.Versioned{SchemaVersion: 2},
+			MediaType: ociv1.MediaTypeImageManifest,
+			Config: ociv1.Descriptor{
+				MediaType: ociv1.MediaTypeImageConfig,
+				Digest:    cfgDgst,
+				Size:      int64(len(cfg)),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		mfDgst := digest.FromBytes(mf)
+		blobs[cfgDgst.Encoded()] = cfg
+		blobs[mfDgst.Encoded()] = mf
+		manifests = append(manifests, ociv1.Descriptor{
+			MediaType: ociv1.MediaTypeImageManifest,
+			Digest:    mfDgst,
+			Size:      int64(len(mf)),
+			Platform:  &ociv1.Platform{OS: "linux", Architecture: arch},
+		})
+	}
+	idx, err := json.Marshal(ociv1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociv1.MediaTypeImageIndex,
+		Manifests: manifests,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	idxDgst := digest.FromBytes(idx)
+	blobs[idxDgst.Encoded()] = idx
+	idxDesc := ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageIndex,
+		Digest:    idxDgst,
+		Size:      int64(len(idx)),
+	}
+	fetcher := AsFetcherFunc(&fakeFetcher{Content: blobs})
+
+	for _, m := range manifests {
+		t.Run(m.Platform.Architecture, func(t *testing.T) {
+			_, desc, err := DownloadManifest(context.Background(), fetcher, idxDesc, WithPlatform(*m.Platform))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if desc.Digest != m.Digest {
+				t.Errorf("unexpected manifest: expected %s, got %s", m.Digest, desc.Digest)
+			}
+
+			_, desc, err = DownloadManifest(contextWithPlatform(context.Background(), *m.Platform), fetcher, idxDesc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if desc.Digest != m.Digest {
+				t.Errorf("unexpected manifest from context platform: expected %s, got %s", m.Digest, desc.Digest)
+			}
+		})
+	}
+}
+
 type alwaysNotFoundStore struct{}
 
 func (fbs *alwaysNotFoundStore) ReaderAt(ctx context.Context, desc ociv1.Descriptor) (content.ReaderAt, error) {
@@ -140,3 +298,68 @@ func (fbs *misbehavingStore) Writer(ctx context.Context, opts ...content.WriterO
 func (fbs *misbehavingStore) Info(ctx context.Context, dgst digest.Digest) (content.Info, error) {
 	return content.Info{}, fmt.Errorf("you wish")
 }
+
+func TestStoredManifest(t *testing.T) {
+	dir := t.TempDir()
+	store, err := local.NewLabeledStore(filepath.Join(dir, "blobs"), &fileLabelStore{Dir: filepath.Join(dir, "labels")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		ctx       = context.Background()
+		indexDgst = digest.FromString("index")
+		baseDgst  = digest.FromString("base")
+	)
+	put := func(content string, labels map[string]string) digest.Digest {
+		p := []byte(content)
+		desc := ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest, Digest: digest.FromBytes(p), Size: int64(len(p))}
+		storeManifest(ctx, store, desc, labels, p)
+		return desc.Digest
+	}
+	withLabels := func(mod func(labels map[string]string)) map[string]string {
+		labels := contentTypeLabel(ociv1.MediaTypeImageManifest)
+		labels[facadeManifestLabel] = "spec"
+		labels[facadeBaseDigestsLabel] = indexDgst.String() + "," + baseDgst.String()
+		if mod != nil {
+			mod(labels)
+		}
+		return labels
+	}
+
+	tests := []struct {
+		Name        string
+		Digest      digest.Digest
+		Expectation []digest.Digest
+	}{
+		{
+			Name:        "built for spec",
+			Digest:      put(`{"built":"for spec"}`, withLabels(nil)),
+			Expectation: []digest.Digest{indexDgst, baseDgst},
+		},
+		{
+			Name:   "built for other spec",
+			Digest: put(`{"built":"for other spec"}`, withLabels(func(labels map[string]string) { labels[facadeManifestLabel] = "other" })),
+		},
+		{
+			Name:   "without base digests",
+			Digest: put(`{"built":"without base digests"}`, withLabels(func(labels map[string]string) { delete(labels, facadeBaseDigestsLabel) })),
+		},
+		{
+			Name:   "not stored",
+			Digest: digest.FromString("not stored"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mh := &manifestHandler{Store: store, Name: "spec"}
+			_, _, baseDigests, ok := mh.storedManifest(ctx, test.Digest)
+			if ok != (test.Expectation != nil) {
+				t.Fatalf("unexpected ok: %v", ok)
+			}
+			if diff := cmp.Diff(test.Expectation, baseDigests); diff != "" {
+				t.Errorf("unexpected base digests (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
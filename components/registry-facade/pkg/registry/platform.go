@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+type platformContextKey struct{}
+
+// contextWithPlatform makes everything downstream of ctx resolve images for platform p
+func contextWithPlatform(ctx context.Context, p ociv1.Platform) context.Context {
+	return context.WithValue(ctx, platformContextKey{}, p)
+}
+
+// platformFromContext returns the platform images are resolved for. If ctx carries no platform,
+// that's the platform registry-facade runs on. As registry-facade runs on each workspace node,
+// this is the platform of the node which pulls the image.
+func platformFromContext(ctx context.Context) ociv1.Platform {
+	if p, ok := ctx.Value(platformContextKey{}).(ociv1.Platform); ok {
+		return p
+	}
+	return platforms.DefaultSpec()
+}
+
+// ParsePlatform parses a platform specifier like linux/arm64/v8. An empty specifier
+// yields the platform registry-facade runs on.
+func ParsePlatform(specifier string) (ociv1.Platform, error) {
+	if specifier == "" {
+		return platforms.DefaultSpec(), nil
+	}
+	p, err := platforms.Parse(specifier)
+	if err != nil {
+		return ociv1.Platform{}, xerrors.Errorf("invalid platform %s: %w", specifier, err)
+	}
+	return platforms.Normalize(p), nil
+}
+
+func isIndexMediaType(mediaType string) bool {
+	return mediaType == images.MediaTypeDockerSchema2ManifestList || mediaType == ociv1.MediaTypeImageIndex
+}
+
+// isAttestation returns true if an index entry describes an attestation manifest (e.g. provenance
+// or an SBOM attached by BuildKit) rather than an image.
+func isAttestation(desc ociv1.Descriptor) bool {
+	return desc.Platform != nil && desc.Platform.OS == "unknown" && desc.Platform.Architecture == "unknown"
+}
+
+// selectManifest picks the manifest best matching platform from the entries of an image index.
+// Indices whose entries carry no platform information at all are treated as single-platform,
+// in which case we pick the first entry.
+func selectManifest(manifests []ociv1.Descriptor, platform ociv1.Platform) (ociv1.Descriptor, error) {
+	if len(manifests) == 0 {
+		return ociv1.Descriptor{}, xerrors.Errorf("empty manifest")
+	}
+
+	var (
+		matcher     = platforms.Only(platform)
+		hasPlatform bool
+		found       bool
+		res         ociv1.Descriptor
+	)
+	for _, m := range manifests {
+		if m.Platform == nil {
+			continue
+		}
+		hasPlatform = true
+
+		if !matcher.Match(*m.Platform) {
+			continue
+		}
+		if !found || matcher.Less(*m.Platform, *res.Platform) {
+			res = m
+			found = true
+		}
+	}
+	if found {
+		return res, nil
+	}
+	if !hasPlatform {
+		return manifests[0], nil
+	}
+
+	return ociv1.Descriptor{}, xerrors.Errorf("image has no manifest for platform %s", platforms.Format(platform))
+}
@@ -0,0 +1,374 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/docker/distribution/reference"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/registry-facade/api"
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+const (
+	// verdictTTL is how long we remember that an image does or does not satisfy a policy
+	verdictTTL = 10 * time.Minute
+	// maxArtifactSize limits the size of signature and attestation manifests and blobs we download
+	maxArtifactSize = 1024 * 1024
+	// defaultPolicyName names the default policy in logs and metrics
+	defaultPolicyName = "default"
+)
+
+// PolicyViolationError is returned when an image does not satisfy the image policy
+type PolicyViolationError struct {
+	Ref    string
+	Policy string
+	Reason string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("image %s does not satisfy the %s image policy: %s", e.Ref, e.Policy, e.Reason)
+}
+
+// NewPolicyEngine produces a new policy engine and loads the keys and certificates the policies refer to
+func NewPolicyEngine(cfg *config.ImagePolicyConfig, newResolver ResolverProvider, referrers ReferrersFetcher, reg prometheus.Registerer) (*PolicyEngine, error) {
+	policies := make(map[string]*imagePolicy, len(cfg.Organizations))
+	var (
+		dflt *imagePolicy
+		err  error
+	)
+	if cfg.Default != nil {
+		dflt, err = newImagePolicy(defaultPolicyName, cfg.Default)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for org, p := range cfg.Organizations {
+		policies[org], err = newImagePolicy("organization "+org, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	verdicts, err := lru.New(1024)
+	if err != nil {
+		return nil, err
+	}
+
+	verdictCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "image_policy_verdicts_total",
+		Help: "number of image policy evaluations by verdict",
+	}, []string{"verdict"})
+	err = reg.Register(verdictCounter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PolicyEngine{
+		Resolver:       newResolver,
+		Referrers:      referrers,
+		defaultPolicy:  dflt,
+		policies:       policies,
+		verdicts:       verdicts,
+		verdictCounter: verdictCounter,
+	}, nil
+}
+
+// PolicyEngine verifies base images against the image policy of the organization a workspace belongs to
+type PolicyEngine struct {
+	Resolver  ResolverProvider
+	Referrers ReferrersFetcher
+
+	defaultPolicy *imagePolicy
+	policies      map[string]*imagePolicy
+
+	verdicts       *lru.Cache
+	verdictCounter *prometheus.CounterVec
+}
+
+type imagePolicy struct {
+	*config.ImagePolicy
+	Name string
+
+	cosignKeys     []crypto.PublicKey
+	notaryRoots    *x509.CertPool
+	provenanceKeys []crypto.PublicKey
+}
+
+func newImagePolicy(name string, cfg *config.ImagePolicy) (res *imagePolicy, err error) {
+	res = &imagePolicy{ImagePolicy: cfg, Name: name}
+	if sig := cfg.Signature; sig != nil {
+		if len(sig.CosignKeys) > 0 {
+			res.cosignKeys, err = loadPublicKeys(sig.CosignKeys)
+			if err != nil {
+				return nil, xerrors.Errorf("%s image policy: %w", name, err)
+			}
+		}
+		if len(sig.NotaryRoots) > 0 {
+			res.notaryRoots, err = loadCertPool(sig.NotaryRoots)
+			if err != nil {
+				return nil, xerrors.Errorf("%s image policy: %w", name, err)
+			}
+		}
+	}
+	if cfg.Provenance != nil {
+		res.provenanceKeys, err = loadPublicKeys(cfg.Provenance.Keys)
+		if err != nil {
+			return nil, xerrors.Errorf("%s image policy: %w", name, err)
+		}
+	}
+	return res, nil
+}
+
+func (p *imagePolicy) exempts(named reference.Named) bool {
+	for _, prefix := range p.Exempt {
+		if strings.HasPrefix(named.Name(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type cachedVerdict struct {
+	Reason  string
+	Expires time.Time
+}
+
+// Verify checks the image ref points to against the policy which applies to spec. The policy is satisfied if any
+// of digests (e.g. the digest of an image index or that of the manifest we picked from it) satisfies it.
+// If the image violates a policy in enforce mode, Verify returns a *PolicyViolationError.
+func (pe *PolicyEngine) Verify(ctx context.Context, spec *api.ImageSpec, ref string, digests ...digest.Digest) error {
+	policy := pe.defaultPolicy
+	if p, ok := pe.policies[spec.GetOrganizationId()]; ok {
+		policy = p
+	}
+	if policy == nil {
+		return nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return xerrors.Errorf("cannot parse ref %s: %w", ref, err)
+	}
+	if policy.exempts(named) {
+		pe.verdictCounter.WithLabelValues("exempt").Inc()
+		return nil
+	}
+
+	reason, err := pe.evaluate(ctx, policy, named, digests)
+	if err != nil {
+		// We cannot tell if the image satisfies the policy, e.g. because the registry is unavailable.
+		// Unverified images are treated like those which violate the policy.
+		reason = fmt.Sprintf("cannot verify image: %v", err)
+	}
+	if reason == "" {
+		pe.verdictCounter.WithLabelValues("allowed").Inc()
+		return nil
+	}
+
+	logger := log.WithField("ref", ref).WithField("policy", policy.Name).WithField("reason", reason)
+	if policy.Mode == config.ImagePolicyModeAudit {
+		pe.verdictCounter.WithLabelValues("audit").Inc()
+		logger.Warn("image violates image policy - serving it anyways because the policy is in audit mode")
+		return nil
+	}
+
+	pe.verdictCounter.WithLabelValues("denied").Inc()
+	logger.Info("image violates image policy")
+	return &PolicyViolationError{Ref: ref, Policy: policy.Name, Reason: reason}
+}
+
+// evaluate returns the reason why none of the digests satisfies policy, or an empty string if one does
+func (pe *PolicyEngine) evaluate(ctx context.Context, policy *imagePolicy, named reference.Named, digests []digest.Digest) (reason string, err error) {
+	var reasons []string
+	for _, dgst := range digests {
+		key := policy.Name + "@" + named.Name() + "@" + dgst.String()
+		if v, ok := pe.verdicts.Get(key); ok && time.Now().Before(v.(cachedVerdict).Expires) {
+			reason = v.(cachedVerdict).Reason
+		} else {
+			reason, err = pe.evaluateDigest(ctx, policy, named, dgst)
+			if err != nil {
+				return "", err
+			}
+			pe.verdicts.Add(key, cachedVerdict{Reason: reason, Expires: time.Now().Add(verdictTTL)})
+		}
+
+		if reason == "" {
+			return "", nil
+		}
+		reasons = append(reasons, reason)
+	}
+	if len(reasons) == 0 {
+		return "image has no digest", nil
+	}
+	return reasons[0], nil
+}
+
+func (pe *PolicyEngine) evaluateDigest(ctx context.Context, policy *imagePolicy, named reference.Named, dgst digest.Digest) (reason string, err error) {
+	fetcher, err := pe.Resolver().Fetcher(ctx, named.Name())
+	if err != nil {
+		return "", err
+	}
+
+	if policy.Signature != nil {
+		reason, err := pe.verifySignatures(ctx, policy, fetcher, named, dgst)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+	}
+	if policy.Provenance != nil {
+		reason, err := pe.verifyProvenance(ctx, policy, fetcher, named, dgst)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+	}
+	return "", nil
+}
+
+func (pe *PolicyEngine) verifySignatures(ctx context.Context, policy *imagePolicy, fetcher remotes.Fetcher, named reference.Named, dgst digest.Digest) (reason string, err error) {
+	var problems []string
+	if len(policy.cosignKeys) > 0 {
+		layers, err := pe.artifactLayers(ctx, fetcher, named, dgst, cosignSignatureArtifactType, cosignSignatureTagSuffix, cosignSimpleSigningMediaType)
+		if err != nil {
+			return "", err
+		}
+		for _, l := range layers {
+			err := verifyCosignSignature(policy.cosignKeys, dgst, l.Content, l.Annotations[cosignSignatureAnnotation])
+			if err == nil {
+				return "", nil
+			}
+			problems = append(problems, "cosign: "+err.Error())
+		}
+	}
+	if policy.notaryRoots != nil {
+		layers, err := pe.artifactLayers(ctx, fetcher, named, dgst, notarySignatureArtifactType, "", notaryJWSMediaType)
+		if err != nil {
+			return "", err
+		}
+		for _, l := range layers {
+			err := verifyNotarySignature(policy.notaryRoots, dgst, l.Content, time.Now())
+			if err == nil {
+				return "", nil
+			}
+			problems = append(problems, "notary: "+err.Error())
+		}
+	}
+
+	if len(problems) == 0 {
+		return "image is not signed", nil
+	}
+	return "image has no valid signature (" + strings.Join(problems, "; ") + ")", nil
+}
+
+func (pe *PolicyEngine) verifyProvenance(ctx context.Context, policy *imagePolicy, fetcher remotes.Fetcher, named reference.Named, dgst digest.Digest) (reason string, err error) {
+	layers, err := pe.artifactLayers(ctx, fetcher, named, dgst, dsseEnvelopeMediaType, cosignAttestationTagSuffix, dsseEnvelopeMediaType)
+	if err != nil {
+		return "", err
+	}
+
+	var problems []string
+	for _, l := range layers {
+		err := verifyProvenance(policy.provenanceKeys, policy.Provenance.Builders, dgst, l.Content)
+		if err == nil {
+			return "", nil
+		}
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) == 0 {
+		return "image has no provenance attestation", nil
+	}
+	return "image has no valid provenance (" + strings.Join(problems, "; ") + ")", nil
+}
+
+type artifactLayer struct {
+	Annotations map[string]string
+	Content     []byte
+}
+
+// artifactLayers downloads the layers of type layerMediaType of all artifacts of type artifactType which refer to dgst.
+// If tagSuffix is not empty, we also consider the artifact cosign stores under the digest tag with that suffix.
+func (pe *PolicyEngine) artifactLayers(ctx context.Context, fetcher remotes.Fetcher, named reference.Named, dgst digest.Digest, artifactType, tagSuffix, layerMediaType string) ([]artifactLayer, error) {
+	var manifests [][]byte
+	if pe.Referrers != nil {
+		descs, err := pe.Referrers.Referrers(ctx, named.Name(), dgst, artifactType)
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range descs {
+			buf, err := fetchArtifact(ctx, fetcher, desc)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, buf)
+		}
+	}
+	if tagSuffix != "" {
+		_, buf, err := fetchByTag(ctx, pe.Resolver(), named, referrersTag(dgst, tagSuffix))
+		if err != nil {
+			return nil, err
+		}
+		if buf != nil {
+			manifests = append(manifests, buf)
+		}
+	}
+
+	var res []artifactLayer
+	for _, buf := range manifests {
+		var mf ociv1.Manifest
+		err := json.Unmarshal(buf, &mf)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot decode artifact manifest: %w", err)
+		}
+		for _, l := range mf.Layers {
+			if l.MediaType != layerMediaType {
+				continue
+			}
+			content, err := fetchArtifact(ctx, fetcher, l)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, artifactLayer{Annotations: l.Annotations, Content: content})
+		}
+	}
+	return res, nil
+}
+
+// fetchArtifact downloads a (small) artifact manifest or blob and verifies its digest
+func fetchArtifact(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) ([]byte, error) {
+	if desc.Size > maxArtifactSize {
+		return nil, xerrors.Errorf("artifact %s is too large (%d bytes)", desc.Digest, desc.Size)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot fetch artifact %s: %w", desc.Digest, err)
+	}
+	defer rc.Close()
+
+	buf, err := io.ReadAll(io.LimitReader(rc, maxArtifactSize))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download artifact %s: %w", desc.Digest, err)
+	}
+	if desc.Digest.Validate() != nil || desc.Digest.Algorithm().FromBytes(buf) != desc.Digest {
+		return nil, xerrors.Errorf("artifact %s does not match its digest", desc.Digest)
+	}
+	return buf, nil
+}
@@ -0,0 +1,341 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/registry-facade/api"
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+const (
+	// defaultPopularImagesQuery finds the workspace images used by most workspaces over the last day
+	defaultPopularImagesQuery  = `topk(%d, sum by (image) (avg_over_time(gitpod_ws_manager_mk2_workspace_image_total[1d])))`
+	defaultPopularImagesLimit  = 10
+	defaultContainerdNamespace = "k8s.io"
+
+	// prewarmTimeout limits how long we spend prewarming a single image
+	prewarmTimeout = 30 * time.Minute
+)
+
+// Prewarmer pulls images into the caches of the node registry-facade runs on, so that workspaces
+// using them start without waiting for their base image to download.
+//
+// We fill the caches registry-facade serves blobs from, and start converting the layers for lazy
+// pulling if that's enabled. If we have access to the node's containerd, we pull the base image
+// into its content store as well. Workspace images share their base image layers, hence containerd
+// finds them locally once the workspace image is pulled.
+type Prewarmer struct {
+	Config     config.PrewarmConfig
+	Registry   *Registry
+	Containerd *containerd.Client
+
+	prewarmCounter *prometheus.CounterVec
+	bytesCounter   prometheus.Counter
+
+	api.UnimplementedImagePrewarmerServer
+}
+
+// NewPrewarmer creates a new prewarmer for the images served by reg
+func NewPrewarmer(cfg *config.PrewarmConfig, reg *Registry, promreg prometheus.Registerer) (*Prewarmer, error) {
+	res := &Prewarmer{
+		Config:   *cfg,
+		Registry: reg,
+		prewarmCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "prewarm_images_total",
+			Help: "number of images prewarmed",
+		}, []string{"success"}),
+		bytesCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "prewarm_fetched_bytes_total",
+			Help: "number of layer bytes fetched to prewarm images",
+		}),
+	}
+	err := promreg.Register(res.prewarmCounter)
+	if err != nil {
+		return nil, err
+	}
+	err = promreg.Register(res.bytesCounter)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ContainerdSocket != "" {
+		ns := cfg.ContainerdNamespace
+		if ns == "" {
+			ns = defaultContainerdNamespace
+		}
+		res.Containerd, err = containerd.New(cfg.ContainerdSocket, containerd.WithDefaultNamespace(ns))
+		if err != nil {
+			return nil, xerrors.Errorf("cannot connect to containerd at %s: %w", cfg.ContainerdSocket, err)
+		}
+	}
+
+	return res, nil
+}
+
+// Prewarm pulls the requested images into the caches of this node
+func (p *Prewarmer) Prewarm(ctx context.Context, req *api.PrewarmRequest) (*api.PrewarmResponse, error) {
+	if len(req.Refs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "refs are required")
+	}
+
+	res := &api.PrewarmResponse{}
+	for _, ref := range req.Refs {
+		res.Results = append(res.Results, p.prewarm(ctx, ref))
+	}
+	return res, nil
+}
+
+func (p *Prewarmer) prewarm(ctx context.Context, ref string) *api.PrewarmResult {
+	ctx, cancel := context.WithTimeout(ctx, prewarmTimeout)
+	defer cancel()
+
+	log := log.WithField("ref", ref)
+	res := &api.PrewarmResult{Ref: ref}
+	err := p.prewarmCaches(ctx, ref, res)
+	if err == nil && p.Containerd != nil {
+		_, err = p.Containerd.Fetch(ctx, ref,
+			containerd.WithResolver(p.Registry.Resolver()),
+			containerd.WithPlatformMatcher(platforms.Only(p.Registry.platform)),
+		)
+		if err != nil {
+			err = xerrors.Errorf("cannot pull image into containerd: %w", err)
+		}
+		res.Containerd = err == nil
+	}
+	if err != nil {
+		log.WithError(err).Warn("cannot prewarm image")
+		res.Error = err.Error()
+		p.prewarmCounter.WithLabelValues("false").Inc()
+		return res
+	}
+
+	log.WithField("layers", res.Layers).WithField("bytesFetched", res.BytesFetched).Debug("prewarmed image")
+	p.prewarmCounter.WithLabelValues("true").Inc()
+	return res
+}
+
+// prewarmCaches downloads the manifest and config of the image, and places all of its layers in the blob caches
+func (p *Prewarmer) prewarmCaches(ctx context.Context, ref string, res *api.PrewarmResult) error {
+	reg := p.Registry
+	ctx = contextWithPlatform(ctx, reg.platform)
+
+	resolver := reg.Resolver()
+	_, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return xerrors.Errorf("cannot resolve image: %w", err)
+	}
+	fetcher, err := resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return xerrors.Errorf("cannot get fetcher: %w", err)
+	}
+
+	manifest, _, err := DownloadManifest(ctx, AsFetcherFunc(fetcher), desc, WithStore(reg.Store), WithPlatform(reg.platform))
+	if err != nil {
+		return xerrors.Errorf("cannot download manifest: %w", err)
+	}
+	_, err = DownloadConfig(ctx, AsFetcherFunc(fetcher), ref, manifest.Config, WithStore(reg.Store))
+	if err != nil {
+		return xerrors.Errorf("cannot download config: %w", err)
+	}
+
+	if reg.LazyPull != nil {
+		reg.LazyPull.ConvertInBackground(fetcher, manifest.Layers)
+	}
+
+	for _, l := range manifest.Layers {
+		n, err := p.cacheLayer(ctx, fetcher, l)
+		res.BytesFetched += n
+		p.bytesCounter.Add(float64(n))
+		if err != nil {
+			return xerrors.Errorf("cannot cache layer %s: %w", l.Digest, err)
+		}
+		res.Layers++
+	}
+	return nil
+}
+
+// cacheLayer places a layer in the disk cache and IPFS if they're enabled and don't have it yet.
+// Returns the number of bytes we fetched from the upstream registry.
+func (p *Prewarmer) cacheLayer(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor) (int64, error) {
+	var (
+		reg     = p.Registry
+		inDisk  = reg.DiskCache == nil || reg.DiskCache.Has(desc.Digest)
+		inIPFS  = true
+		fetched int64
+	)
+	if reg.IPFS != nil {
+		_, err := reg.IPFS.Get(ctx, desc.Digest)
+		inIPFS = err == nil
+	}
+	if inDisk && inIPFS {
+		return 0, nil
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	var r io.Reader = &countingReader{R: rc, N: &fetched}
+	if !inDisk {
+		cr := reg.DiskCache.Tee(desc.Digest, desc.MediaType, r)
+		defer cr.Close()
+		r = cr
+	}
+	if !inIPFS {
+		err = reg.IPFS.Store(ctx, desc.Digest, r, desc.MediaType)
+	} else {
+		_, err = io.Copy(io.Discard, r)
+	}
+	return fetched, err
+}
+
+type countingReader struct {
+	R io.Reader
+	N *int64
+}
+
+func (r *countingReader) Read(b []byte) (n int, err error) {
+	n, err = r.R.Read(b)
+	*r.N += int64(n)
+	return
+}
+
+// Run prewarms the scheduled images periodically until ctx is canceled
+func (p *Prewarmer) Run(ctx context.Context) {
+	schedule := p.Config.Schedule
+	if schedule == nil {
+		return
+	}
+	interval, err := time.ParseDuration(schedule.Interval)
+	if err != nil {
+		log.WithError(err).Error("invalid prewarm interval")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		refs := p.scheduledImages(ctx)
+		log.WithField("images", len(refs)).Debug("prewarming scheduled images")
+		for _, ref := range refs {
+			if ctx.Err() != nil {
+				return
+			}
+			p.prewarm(ctx, ref)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scheduledImages lists the configured images, followed by the most popular ones
+func (p *Prewarmer) scheduledImages(ctx context.Context) []string {
+	schedule := p.Config.Schedule
+
+	var (
+		res  []string
+		seen = make(map[string]struct{})
+	)
+	add := func(refs []string) {
+		for _, ref := range refs {
+			if _, exists := seen[ref]; exists {
+				continue
+			}
+			seen[ref] = struct{}{}
+			res = append(res, ref)
+		}
+	}
+
+	add(schedule.Images)
+	if schedule.Popular != nil {
+		popular, err := queryPopularImages(ctx, http.DefaultClient, schedule.Popular)
+		if err != nil {
+			log.WithError(err).Warn("cannot determine popular images")
+		}
+		add(popular)
+	}
+	return res
+}
+
+// queryPopularImages asks Prometheus for the workspace images used by the most workspaces
+func queryPopularImages(ctx context.Context, client *http.Client, cfg *config.PopularImagesConfig) ([]string, error) {
+	limit := cfg.Limit
+	if limit <= 0 {
+		limit = defaultPopularImagesLimit
+	}
+	query := cfg.Query
+	if query == "" {
+		query = fmt.Sprintf(defaultPopularImagesQuery, limit)
+	}
+
+	u := strings.TrimSuffix(cfg.PrometheusURL, "/") + "/api/v1/query?" + url.Values{"query": []string{query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot query Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.Errorf("cannot query Prometheus: %s", resp.Status)
+	}
+
+	var qr struct {
+		Status string `json:"status"`
+		Data   struct {
+			ResultType string `json:"resultType"`
+			Result     []struct {
+				Metric map[string]string `json:"metric"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&qr)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot decode Prometheus response: %w", err)
+	}
+	if qr.Status != "success" || qr.Data.ResultType != "vector" {
+		return nil, xerrors.Errorf("unexpected Prometheus response: status %s, result type %s", qr.Status, qr.Data.ResultType)
+	}
+
+	var res []string
+	for _, r := range qr.Data.Result {
+		img := r.Metric["image"]
+		if img == "" {
+			continue
+		}
+		res = append(res, img)
+		if len(res) >= limit {
+			break
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/registry-facade/api/config"
+)
+
+func TestQueryPopularImages(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Config        config.PopularImagesConfig
+		Response      string
+		StatusCode    int
+		ExpectedQuery string
+		Expectation   []string
+		Error         bool
+	}{
+		{
+			Name:          "default query",
+			Response:      `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"image":"eu.gcr.io/gitpod/workspace-full:latest"},"value":[1700000000,"42"]},{"metric":{},"value":[1700000000,"3"]},{"metric":{"image":"ubuntu:22.04"},"value":[1700000000,"7"]}]}}`,
+			ExpectedQuery: fmt.Sprintf(defaultPopularImagesQuery, defaultPopularImagesLimit),
+			Expectation:   []string{"eu.gcr.io/gitpod/workspace-full:latest", "ubuntu:22.04"},
+		},
+		{
+			Name:          "custom query with limit",
+			Config:        config.PopularImagesConfig{Query: "my_query", Limit: 1},
+			Response:      `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"image":"a"},"value":[1700000000,"2"]},{"metric":{"image":"b"},"value":[1700000000,"1"]}]}}`,
+			ExpectedQuery: "my_query",
+			Expectation:   []string{"a"},
+		},
+		{
+			Name:       "query failed",
+			Response:   `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			StatusCode: http.StatusBadRequest,
+			Error:      true,
+		},
+		{
+			Name:     "not a vector",
+			Response: `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			Error:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/query" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				if q := r.URL.Query().Get("query"); test.ExpectedQuery != "" && q != test.ExpectedQuery {
+					t.Errorf("unexpected query: %s", q)
+				}
+				if test.StatusCode != 0 {
+					w.WriteHeader(test.StatusCode)
+				}
+				_, _ = w.Write([]byte(test.Response))
+			}))
+			defer srv.Close()
+
+			cfg := test.Config
+			cfg.PrometheusURL = srv.URL + "/"
+			act, err := queryPopularImages(context.Background(), srv.Client(), &cfg)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/docker/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"golang.org/x/xerrors"
+)
+
+// maxReferrersIndexSize limits the size of the referrers index we're willing to download
+const maxReferrersIndexSize = 4 * 1024 * 1024
+
+// ReferrersFetcher finds the artifacts (e.g. signatures or attestations) which refer to a manifest
+type ReferrersFetcher interface {
+	// Referrers lists the manifests of type artifactType which refer to dgst in the repository of ref.
+	// If artifactType is empty, all referrers are returned.
+	Referrers(ctx context.Context, ref string, dgst digest.Digest, artifactType string) ([]ociv1.Descriptor, error)
+}
+
+// NewReferrersFetcher produces a referrers fetcher which uses the OCI referrers API of the registries
+// in hosts. Registries which don't support the referrers API yet are queried using the referrers tag schema.
+func NewReferrersFetcher(hosts docker.RegistryHosts, newResolver ResolverProvider) ReferrersFetcher {
+	return &registryReferrers{
+		Hosts:    hosts,
+		Resolver: newResolver,
+	}
+}
+
+type registryReferrers struct {
+	Hosts    docker.RegistryHosts
+	Resolver ResolverProvider
+}
+
+var errReferrersAPIUnsupported = xerrors.Errorf("registry does not support the referrers API")
+
+func (rr *registryReferrers) Referrers(ctx context.Context, ref string, dgst digest.Digest, artifactType string) ([]ociv1.Descriptor, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse ref %s: %w", ref, err)
+	}
+
+	var idx *ociv1.Index
+	if rr.Hosts != nil {
+		idx, err = rr.fetchFromAPI(ctx, named, dgst, artifactType)
+		if err != nil && !errors.Is(err, errReferrersAPIUnsupported) {
+			return nil, err
+		}
+	}
+	if idx == nil {
+		// see https://github.com/opencontainers/distribution-spec/blob/main/spec.md#referrers-tag-schema
+		_, buf, err := fetchByTag(ctx, rr.Resolver(), named, referrersTag(dgst, ""))
+		if err != nil {
+			return nil, err
+		}
+		if buf == nil {
+			return nil, nil
+		}
+		idx = &ociv1.Index{}
+		err = json.Unmarshal(buf, idx)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot decode referrers of %s: %w", dgst, err)
+		}
+	}
+
+	res := make([]ociv1.Descriptor, 0, len(idx.Manifests))
+	for _, m := range idx.Manifests {
+		if artifactType != "" && m.ArtifactType != artifactType {
+			continue
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+func (rr *registryReferrers) fetchFromAPI(ctx context.Context, named reference.Named, dgst digest.Digest, artifactType string) (*ociv1.Index, error) {
+	domain, repo := reference.Domain(named), reference.Path(named)
+	hosts, err := rr.Hosts(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = docker.WithScope(ctx, fmt.Sprintf("repository:%s:pull", repo))
+	for _, host := range hosts {
+		if !host.Capabilities.Has(docker.HostCapabilityPull) {
+			continue
+		}
+
+		u := url.URL{
+			Scheme: host.Scheme,
+			Host:   host.Host,
+			Path:   fmt.Sprintf("%s/%s/referrers/%s", host.Path, repo, dgst),
+		}
+		if artifactType != "" {
+			u.RawQuery = url.Values{"artifactType": []string{artifactType}}.Encode()
+		}
+
+		resp, err := doRegistryRequest(ctx, host, u.String())
+		if err != nil {
+			return nil, err
+		}
+		idx, err := func() (*ociv1.Index, error) {
+			defer resp.Body.Close()
+
+			switch resp.StatusCode {
+			case http.StatusOK:
+			case http.StatusNotFound, http.StatusMethodNotAllowed:
+				return nil, errReferrersAPIUnsupported
+			default:
+				return nil, xerrors.Errorf("cannot list referrers of %s: %s", dgst, resp.Status)
+			}
+
+			var idx ociv1.Index
+			err := json.NewDecoder(io.LimitReader(resp.Body, maxReferrersIndexSize)).Decode(&idx)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot decode referrers of %s: %w", dgst, err)
+			}
+			return &idx, nil
+		}()
+		if errors.Is(err, errReferrersAPIUnsupported) {
+			continue
+		}
+		return idx, err
+	}
+
+	return nil, errReferrersAPIUnsupported
+}
+
+// doRegistryRequest GETs u from a registry host and authorizes the request if the registry asks for it
+func doRegistryRequest(ctx context.Context, host docker.RegistryHost, u string) (*http.Response, error) {
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range host.Header {
+			req.Header[k] = v
+		}
+		req.Header.Set("Accept", ociv1.MediaTypeImageIndex)
+		if host.Authorizer != nil {
+			err = host.Authorizer.Authorize(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		client := host.Client
+		if client == nil {
+			client = http.DefaultClient
+		}
+		return client.Do(req)
+	}
+
+	resp, err := do()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized || host.Authorizer == nil {
+		return resp, nil
+	}
+
+	resp.Body.Close()
+	err = host.Authorizer.AddResponses(ctx, []*http.Response{resp})
+	if err != nil {
+		return nil, err
+	}
+	return do()
+}
+
+// referrersTag produces the tag under which artifacts referring to dgst are found if a registry
+// doesn't support the referrers API. cosign uses the same schema with a suffix (e.g. .sig) for its artifacts.
+func referrersTag(dgst digest.Digest, suffix string) string {
+	return strings.Replace(dgst.String(), ":", "-", 1) + suffix
+}
+
+// fetchByTag downloads the manifest or index tag points to in the repository of named.
+// If the tag does not exist, fetchByTag returns nil.
+func fetchByTag(ctx context.Context, resolver remotes.Resolver, named reference.Named, tag string) (*ociv1.Descriptor, []byte, error) {
+	ref := named.Name() + ":" + tag
+	_, desc, err := resolver.Resolve(ctx, ref)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot resolve %s: %w", ref, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot fetch %s: %w", ref, err)
+	}
+	defer rc.Close()
+
+	buf, err := io.ReadAll(io.LimitReader(rc, maxReferrersIndexSize))
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot download %s: %w", ref, err)
+	}
+	return &desc, buf, nil
+}
@@ -26,7 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
 	"github.com/docker/distribution"
 	"github.com/docker/distribution/reference"
 	"github.com/docker/distribution/registry/api/errcode"
@@ -35,6 +37,7 @@ import (
 	"github.com/gorilla/mux"
 	httpapi "github.com/ipfs/kubo/client/rpc"
 	ma "github.com/multiformats/go-multiaddr"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/xerrors"
@@ -79,16 +82,29 @@ type Registry struct {
 	LayerSource    LayerSource
 	ConfigModifier ConfigModifier
 	SpecProvider   map[string]ImageSpecProvider
+	Policy         *PolicyEngine
+	LazyPull       *LazyPullConverter
+	DiskCache      *DiskBlobCache
 
 	staticLayerSource *RevisioningLayerSource
+	platform          ociv1.Platform
 	metrics           *metrics
 	srv               *http.Server
 }
 
-// NewRegistry creates a new registry
-func NewRegistry(cfg config.Config, newResolver ResolverProvider, reg prometheus.Registerer) (*Registry, error) {
+// NewRegistry creates a new registry. hosts configures access to the upstream registries for everything
+// containerd's resolver cannot do for us, e.g. listing referrers.
+func NewRegistry(cfg config.Config, newResolver ResolverProvider, hosts docker.RegistryHosts, reg prometheus.Registerer) (*Registry, error) {
 	var mfStore BlobStore
 
+	platform, err := ParsePlatform(cfg.Platform)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("platform", platforms.Format(platform)).Info("serving images for platform")
+
+	upstreamResolver := newResolver
+
 	if cfg.IPFSCache != nil && cfg.IPFSCache.Enabled {
 		if cfg.RedisCache == nil || !cfg.RedisCache.Enabled {
 			return nil, xerrors.Errorf("IPFS cache requires Redis")
@@ -124,7 +140,7 @@ func NewRegistry(cfg config.Config, newResolver ResolverProvider, reg prometheus
 		// TODO(cw): GC the store
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(contextWithPlatform(context.Background(), platform))
 	defer cancel()
 
 	metrics, err := newMetrics(reg, true)
@@ -232,6 +248,34 @@ func NewRegistry(cfg config.Config, newResolver ResolverProvider, reg prometheus
 		log.WithField("config", cfg.IPFSCache).Info("enabling IPFS caching")
 	}
 
+	var policy *PolicyEngine
+	if cfg.Policy != nil {
+		// Signatures are added to images after the fact, hence we must not use the cached resolver here.
+		policy, err = NewPolicyEngine(cfg.Policy, upstreamResolver, NewReferrersFetcher(hosts, upstreamResolver), reg)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create image policy engine: %w", err)
+		}
+		log.Info("enabling image policy")
+	}
+
+	var diskCache *DiskBlobCache
+	if cfg.DiskCache != nil {
+		diskCache, err = NewDiskBlobCache(cfg.DiskCache, reg)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create disk blob cache: %w", err)
+		}
+		log.WithField("config", cfg.DiskCache).Info("enabling disk blob cache")
+	}
+
+	var lazyPull *LazyPullConverter
+	if cfg.LazyPull != nil {
+		lazyPull, err = NewLazyPullConverter(cfg.LazyPull, reg)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create lazy pull converter: %w", err)
+		}
+		log.WithField("config", cfg.LazyPull).Info("enabling lazy pull layer conversion")
+	}
+
 	layerSource := CompositeLayerSource(layerSources)
 	return &Registry{
 		Config:            cfg,
@@ -241,7 +285,11 @@ func NewRegistry(cfg config.Config, newResolver ResolverProvider, reg prometheus
 		SpecProvider:      specProvider,
 		LayerSource:       layerSource,
 		staticLayerSource: staticLayer,
+		platform:          platform,
 		ConfigModifier:    NewConfigModifierFromLayerSource(layerSource),
+		Policy:            policy,
+		LazyPull:          lazyPull,
+		DiskCache:         diskCache,
 		metrics:           metrics,
 	}, nil
 }
@@ -329,7 +377,7 @@ func getRedisClient(cfg *config.RedisCacheConfig) (*redis.Client, error) {
 
 // UpdateStaticLayer updates the static layer a registry-facade adds
 func (reg *Registry) UpdateStaticLayer(ctx context.Context, cfg []config.StaticLayerCfg) error {
-	l, err := buildStaticLayer(ctx, cfg, reg.Resolver)
+	l, err := buildStaticLayer(contextWithPlatform(ctx, reg.platform), cfg, reg.Resolver)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,351 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+const (
+	cosignSignatureArtifactType  = "application/vnd.dev.cosign.artifact.sig.v1+json"
+	cosignSimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignSignatureAnnotation    = "dev.cosignproject.cosign/signature"
+	cosignSignatureTagSuffix     = ".sig"
+	cosignAttestationTagSuffix   = ".att"
+
+	notarySignatureArtifactType = "application/vnd.cncf.notary.signature"
+	notaryJWSMediaType          = "application/jose+json"
+	notaryPayloadContentType    = "application/vnd.cncf.notary.payload.v1+json"
+
+	dsseEnvelopeMediaType         = "application/vnd.dsse.envelope.v1+json"
+	inTotoPayloadType             = "application/vnd.in-toto+json"
+	slsaProvenancePredicatePrefix = "https://slsa.dev/provenance/"
+)
+
+// loadPublicKeys reads PEM encoded public keys from files
+func loadPublicKeys(fns []string) ([]crypto.PublicKey, error) {
+	var res []crypto.PublicKey
+	for _, fn := range fns {
+		fc, err := os.ReadFile(fn)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read public key %s: %w", fn, err)
+		}
+		for {
+			var blk *pem.Block
+			blk, fc = pem.Decode(fc)
+			if blk == nil {
+				break
+			}
+			if blk.Type != "PUBLIC KEY" {
+				continue
+			}
+			key, err := x509.ParsePKIXPublicKey(blk.Bytes)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot parse public key %s: %w", fn, err)
+			}
+			res = append(res, key)
+		}
+	}
+	if len(res) == 0 {
+		return nil, xerrors.Errorf("found no public keys in %s", strings.Join(fns, ", "))
+	}
+	return res, nil
+}
+
+// loadCertPool reads PEM encoded CA certificates from files
+func loadCertPool(fns []string) (*x509.CertPool, error) {
+	res := x509.NewCertPool()
+	for _, fn := range fns {
+		fc, err := os.ReadFile(fn)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read certificate %s: %w", fn, err)
+		}
+		if !res.AppendCertsFromPEM(fc) {
+			return nil, xerrors.Errorf("found no certificates in %s", fn)
+		}
+	}
+	return res, nil
+}
+
+// verifyWithKeys checks if any of the keys produced sig for msg. We follow cosign's defaults,
+// i.e. ECDSA and RSA (PKCS #1 v1.5) signatures are made over the SHA-256 digest of msg.
+func verifyWithKeys(keys []crypto.PublicKey, msg, sig []byte) bool {
+	h := sha256.Sum256(msg)
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, h[:], sig) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, h[:], sig) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, msg, sig) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifyCosignSignature verifies a cosign "simple signing" payload and checks that it was made for dgst
+func verifyCosignSignature(keys []crypto.PublicKey, dgst digest.Digest, payload []byte, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return xerrors.Errorf("invalid signature encoding: %w", err)
+	}
+	if !verifyWithKeys(keys, payload, sig) {
+		return xerrors.Errorf("signature was not made by a trusted key")
+	}
+
+	var p struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+		} `json:"critical"`
+	}
+	err = json.Unmarshal(payload, &p)
+	if err != nil {
+		return xerrors.Errorf("invalid signature payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != dgst.String() {
+		return xerrors.Errorf("signature was made for %s", p.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
+
+// verifyNotarySignature verifies a Notary v2 JWS signature envelope and checks that it was made for dgst
+// by a certificate which chains up to roots.
+func verifyNotarySignature(roots *x509.CertPool, dgst digest.Digest, envelope []byte, now time.Time) error {
+	var env struct {
+		Payload   string `json:"payload"`
+		Protected string `json:"protected"`
+		Header    struct {
+			CertChain [][]byte `json:"x5c"`
+		} `json:"header"`
+		Signature string `json:"signature"`
+	}
+	err := json.Unmarshal(envelope, &env)
+	if err != nil {
+		return xerrors.Errorf("invalid signature envelope: %w", err)
+	}
+	if len(env.Header.CertChain) == 0 {
+		return xerrors.Errorf("signature envelope has no certificate chain")
+	}
+
+	rawProtected, err := base64.RawURLEncoding.DecodeString(env.Protected)
+	if err != nil {
+		return xerrors.Errorf("invalid protected header encoding: %w", err)
+	}
+	var protected struct {
+		Algorithm   string     `json:"alg"`
+		ContentType string     `json:"cty"`
+		Expiry      *time.Time `json:"io.cncf.notary.expiry,omitempty"`
+	}
+	err = json.Unmarshal(rawProtected, &protected)
+	if err != nil {
+		return xerrors.Errorf("invalid protected header: %w", err)
+	}
+	if protected.ContentType != notaryPayloadContentType {
+		return xerrors.Errorf("unsupported payload content type %s", protected.ContentType)
+	}
+	if protected.Expiry != nil && now.After(*protected.Expiry) {
+		return xerrors.Errorf("signature expired at %s", protected.Expiry.Format(time.RFC3339))
+	}
+
+	var certs []*x509.Certificate
+	for _, der := range env.Header.CertChain {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return xerrors.Errorf("invalid certificate chain: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return xerrors.Errorf("signing certificate is not trusted: %w", err)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(env.Signature)
+	if err != nil {
+		return xerrors.Errorf("invalid signature encoding: %w", err)
+	}
+	err = verifyJWS(protected.Algorithm, certs[0].PublicKey, []byte(env.Protected+"."+env.Payload), sig)
+	if err != nil {
+		return err
+	}
+
+	rawPayload, err := base64.RawURLEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return xerrors.Errorf("invalid payload encoding: %w", err)
+	}
+	var payload struct {
+		TargetArtifact ociv1.Descriptor `json:"targetArtifact"`
+	}
+	err = json.Unmarshal(rawPayload, &payload)
+	if err != nil {
+		return xerrors.Errorf("invalid signature payload: %w", err)
+	}
+	if payload.TargetArtifact.Digest != dgst {
+		return xerrors.Errorf("signature was made for %s", payload.TargetArtifact.Digest)
+	}
+	return nil
+}
+
+// verifyJWS checks a JWS signature using the algorithms Notary v2 permits
+func verifyJWS(alg string, key crypto.PublicKey, msg, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return xerrors.Errorf("unsupported signature algorithm %s", alg)
+	}
+	hasher := hash.New()
+	_, _ = hasher.Write(msg)
+	h := hasher.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "PS") {
+			break
+		}
+		err := rsa.VerifyPSS(k, hash, h, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		if err != nil {
+			return xerrors.Errorf("invalid signature: %w", err)
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			break
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return xerrors.Errorf("invalid signature length")
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, h, r, s) {
+			return xerrors.Errorf("invalid signature")
+		}
+		return nil
+	}
+	return xerrors.Errorf("signature algorithm %s does not match the signing key (%T)", alg, key)
+}
+
+// verifyProvenance verifies a DSSE envelope carrying an in-toto SLSA provenance statement about dgst.
+// If builders is not empty, the provenance must have been produced by one of them.
+func verifyProvenance(keys []crypto.PublicKey, builders []string, dgst digest.Digest, envelope []byte) error {
+	var env struct {
+		PayloadType string `json:"payloadType"`
+		Payload     []byte `json:"payload"`
+		Signatures  []struct {
+			Sig []byte `json:"sig"`
+		} `json:"signatures"`
+	}
+	err := json.Unmarshal(envelope, &env)
+	if err != nil {
+		return xerrors.Errorf("invalid attestation envelope: %w", err)
+	}
+	if env.PayloadType != inTotoPayloadType {
+		return xerrors.Errorf("unsupported attestation payload type %s", env.PayloadType)
+	}
+
+	// see https://github.com/secure-systems-lab/dsse/blob/master/protocol.md
+	pae := []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(env.PayloadType), env.PayloadType, len(env.Payload), env.Payload))
+	var signed bool
+	for _, s := range env.Signatures {
+		if verifyWithKeys(keys, pae, s.Sig) {
+			signed = true
+			break
+		}
+	}
+	if !signed {
+		return xerrors.Errorf("attestation was not signed by a trusted key")
+	}
+
+	var stmt struct {
+		Subject []struct {
+			Digest map[string]string `json:"digest"`
+		} `json:"subject"`
+		PredicateType string `json:"predicateType"`
+		Predicate     struct {
+			// SLSA v0.2
+			Builder struct {
+				ID string `json:"id"`
+			} `json:"builder"`
+			// SLSA v1
+			RunDetails struct {
+				Builder struct {
+					ID string `json:"id"`
+				} `json:"builder"`
+			} `json:"runDetails"`
+		} `json:"predicate"`
+	}
+	err = json.Unmarshal(env.Payload, &stmt)
+	if err != nil {
+		return xerrors.Errorf("invalid attestation statement: %w", err)
+	}
+	if !strings.HasPrefix(stmt.PredicateType, slsaProvenancePredicatePrefix) {
+		return xerrors.Errorf("attestation is no SLSA provenance but %s", stmt.PredicateType)
+	}
+
+	var subject bool
+	for _, s := range stmt.Subject {
+		if s.Digest[dgst.Algorithm().String()] == dgst.Encoded() {
+			subject = true
+			break
+		}
+	}
+	if !subject {
+		return xerrors.Errorf("provenance is not about %s", dgst)
+	}
+
+	if len(builders) == 0 {
+		return nil
+	}
+	builder := stmt.Predicate.RunDetails.Builder.ID
+	if builder == "" {
+		builder = stmt.Predicate.Builder.ID
+	}
+	for _, b := range builders {
+		if b == builder {
+			return nil
+		}
+	}
+	return xerrors.Errorf("image was built by untrusted builder %q", builder)
+}
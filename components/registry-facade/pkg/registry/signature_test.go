@@ -0,0 +1,212 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+var testImageDigest = digest.FromString("image")
+
+func TestVerifyCosignSignature(t *testing.T) {
+	trusted, untrusted := newTestKey(t), newTestKey(t)
+	payload := func(dgst digest.Digest) []byte {
+		return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"example.com/image"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, dgst))
+	}
+
+	tests := []struct {
+		Name    string
+		Payload []byte
+		Key     *ecdsa.PrivateKey
+		Error   bool
+	}{
+		{Name: "valid", Payload: payload(testImageDigest), Key: trusted},
+		{Name: "untrusted key", Payload: payload(testImageDigest), Key: untrusted, Error: true},
+		{Name: "other image", Payload: payload(digest.FromString("other")), Key: trusted, Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			h := sha256.Sum256(test.Payload)
+			sig, err := ecdsa.SignASN1(rand.Reader, test.Key, h[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = verifyCosignSignature([]crypto.PublicKey{trusted.Public()}, testImageDigest, test.Payload, base64.StdEncoding.EncodeToString(sig))
+			if (err != nil) != test.Error {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyNotarySignature(t *testing.T) {
+	now := time.Now()
+	ca, caKey := newTestCertificate(t, nil, nil, true)
+	leaf, leafKey := newTestCertificate(t, ca, caKey, false)
+	otherCA, otherCAKey := newTestCertificate(t, nil, nil, true)
+	otherLeaf, otherLeafKey := newTestCertificate(t, otherCA, otherCAKey, false)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	tests := []struct {
+		Name   string
+		Cert   *x509.Certificate
+		Key    *ecdsa.PrivateKey
+		Target digest.Digest
+		Expiry *time.Time
+		Error  bool
+	}{
+		{Name: "valid", Cert: leaf, Key: leafKey, Target: testImageDigest},
+		{Name: "untrusted certificate", Cert: otherLeaf, Key: otherLeafKey, Target: testImageDigest, Error: true},
+		{Name: "key does not match certificate", Cert: leaf, Key: otherLeafKey, Target: testImageDigest, Error: true},
+		{Name: "other image", Cert: leaf, Key: leafKey, Target: digest.FromString("other"), Error: true},
+		{Name: "expired", Cert: leaf, Key: leafKey, Target: testImageDigest, Expiry: func() *time.Time { t := now.Add(-time.Hour); return &t }(), Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			protected := map[string]interface{}{
+				"alg": "ES256",
+				"cty": notaryPayloadContentType,
+			}
+			if test.Expiry != nil {
+				protected["io.cncf.notary.expiry"] = test.Expiry.Format(time.RFC3339)
+			}
+			rawProtected, _ := json.Marshal(protected)
+			rawPayload, _ := json.Marshal(map[string]interface{}{
+				"targetArtifact": ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest, Digest: test.Target, Size: 42},
+			})
+			encProtected, encPayload := base64.RawURLEncoding.EncodeToString(rawProtected), base64.RawURLEncoding.EncodeToString(rawPayload)
+
+			h := sha256.Sum256([]byte(encProtected + "." + encPayload))
+			r, s, err := ecdsa.Sign(rand.Reader, test.Key, h[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig := make([]byte, 64)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:])
+
+			envelope, _ := json.Marshal(map[string]interface{}{
+				"payload":   encPayload,
+				"protected": encProtected,
+				"header": map[string]interface{}{
+					"x5c": [][]byte{test.Cert.Raw},
+				},
+				"signature": base64.RawURLEncoding.EncodeToString(sig),
+			})
+
+			err = verifyNotarySignature(roots, testImageDigest, envelope, now)
+			if (err != nil) != test.Error {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	trusted, untrusted := newTestKey(t), newTestKey(t)
+	statement := func(predicateType string, dgst digest.Digest, builder string) []byte {
+		res, _ := json.Marshal(map[string]interface{}{
+			"_type":         "https://in-toto.io/Statement/v1",
+			"subject":       []interface{}{map[string]interface{}{"name": "example.com/image", "digest": map[string]string{"sha256": dgst.Encoded()}}},
+			"predicateType": predicateType,
+			"predicate": map[string]interface{}{
+				"runDetails": map[string]interface{}{"builder": map[string]string{"id": builder}},
+			},
+		})
+		return res
+	}
+
+	tests := []struct {
+		Name      string
+		Statement []byte
+		Key       *ecdsa.PrivateKey
+		Builders  []string
+		Error     bool
+	}{
+		{Name: "valid", Statement: statement("https://slsa.dev/provenance/v1", testImageDigest, "https://github.com/actions/runner"), Key: trusted},
+		{Name: "trusted builder", Statement: statement("https://slsa.dev/provenance/v1", testImageDigest, "https://github.com/actions/runner"), Key: trusted, Builders: []string{"https://github.com/actions/runner"}},
+		{Name: "untrusted builder", Statement: statement("https://slsa.dev/provenance/v1", testImageDigest, "https://example.com/builder"), Key: trusted, Builders: []string{"https://github.com/actions/runner"}, Error: true},
+		{Name: "untrusted key", Statement: statement("https://slsa.dev/provenance/v1", testImageDigest, ""), Key: untrusted, Error: true},
+		{Name: "other image", Statement: statement("https://slsa.dev/provenance/v1", digest.FromString("other"), ""), Key: trusted, Error: true},
+		{Name: "no provenance", Statement: statement("https://spdx.dev/Document", testImageDigest, ""), Key: trusted, Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			pae := fmt.Sprintf("DSSEv1 %d %s %d %s", len(inTotoPayloadType), inTotoPayloadType, len(test.Statement), test.Statement)
+			h := sha256.Sum256([]byte(pae))
+			sig, err := ecdsa.SignASN1(rand.Reader, test.Key, h[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			envelope, _ := json.Marshal(map[string]interface{}{
+				"payloadType": inTotoPayloadType,
+				"payload":     test.Statement,
+				"signatures":  []interface{}{map[string]interface{}{"sig": sig}},
+			})
+
+			err = verifyProvenance([]crypto.PublicKey{trusted.Public()}, test.Builders, testImageDigest, envelope)
+			if (err != nil) != test.Error {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
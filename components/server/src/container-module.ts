@@ -125,6 +125,7 @@ import { WorkspaceDownloadService } from "./workspace/workspace-download-service
 import { WorkspaceFactory } from "./workspace/workspace-factory";
 import { WorkspaceService } from "./workspace/workspace-service";
 import { WorkspaceStartController } from "./workspace/workspace-start-controller";
+import { NodeDrainRestartController } from "./workspace/node-drain-restart-controller";
 import { WorkspaceStarter } from "./workspace/workspace-starter";
 import { DefaultWorkspaceImageValidator } from "./orgs/default-workspace-image-validator";
 import { ContextAwareAnalyticsWriter } from "./analytics";
@@ -173,6 +174,7 @@ export const productionContainerModule = new ContainerModule(
         bind(WorkspaceFactory).toSelf().inSingletonScope();
         bind(WorkspaceStarter).toSelf().inSingletonScope();
         bind(WorkspaceStartController).toSelf().inSingletonScope();
+        bind(NodeDrainRestartController).toSelf().inSingletonScope();
         bind(ImageSourceProvider).toSelf().inSingletonScope();
 
         bind(ServerFactory).toAutoFactory(GitpodServerImpl);
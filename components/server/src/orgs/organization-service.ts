@@ -474,6 +474,20 @@ export class OrganizationService {
         if (settings.defaultRole && !TeamMemberRole.isValid(settings.defaultRole)) {
             throw new ApplicationError(ErrorCodes.BAD_REQUEST, "Invalid default role");
         }
+        if (settings.machineActivity) {
+            const { cpuMillicores } = settings.machineActivity;
+            if (cpuMillicores !== undefined && (!Number.isInteger(cpuMillicores) || cpuMillicores < 0)) {
+                throw new ApplicationError(
+                    ErrorCodes.BAD_REQUEST,
+                    "machineActivity.cpuMillicores must be a non-negative integer",
+                );
+            }
+            settings.machineActivity = {
+                cpuMillicores,
+                taskProcesses: !!settings.machineActivity.taskProcesses,
+                networkConnections: !!settings.machineActivity.networkConnections,
+            };
+        }
         return this.toSettings(await this.teamDB.setOrgSettings(orgId, settings));
     }
 
@@ -497,6 +511,12 @@ export class OrganizationService {
         if (settings.defaultRole) {
             result.defaultRole = settings.defaultRole;
         }
+        if (settings.machineActivity) {
+            result.machineActivity = settings.machineActivity;
+        }
+        if (settings.recordSSHSessions) {
+            result.recordSSHSessions = settings.recordSSHSessions;
+        }
         return result;
     }
 
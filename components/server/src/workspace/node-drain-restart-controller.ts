@@ -0,0 +1,84 @@
+/**
+ * Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import { inject, injectable } from "inversify";
+import { TraceContext } from "@gitpod/gitpod-protocol/lib/util/tracing";
+import { log } from "@gitpod/gitpod-protocol/lib/util/logging";
+import { DBWithTracing, TracedWorkspaceDB, UserDB, WorkspaceDB } from "@gitpod/gitpod-db/lib";
+import { Job } from "../jobs/runner";
+import { WorkspaceService } from "./workspace-service";
+import { runWithSubjectId } from "../util/request-context";
+import { SubjectId } from "../auth/subject-id";
+
+/**
+ * NodeDrainRestartController restarts workspaces that ws-manager stopped because their node was drained.
+ * Restarting creates a new instance which restores the backup taken while stopping. The drained node is
+ * cordoned, hence the new instance lands on another node.
+ */
+@injectable()
+export class NodeDrainRestartController implements Job {
+    public readonly name: string = "node-drain-restart-controller";
+    public readonly frequencyMs: number = 1000 * 10; // 10s
+
+    // instances that stopped longer ago are not restarted anymore, e.g. because their restart keeps failing
+    private readonly restartWindowMs: number = 1000 * 60 * 10; // 10m
+
+    constructor(
+        @inject(TracedWorkspaceDB) private readonly workspaceDB: DBWithTracing<WorkspaceDB>,
+        @inject(UserDB) private readonly userDB: UserDB,
+        @inject(WorkspaceService) private readonly workspaceService: WorkspaceService,
+    ) {}
+
+    public async run(): Promise<number | undefined> {
+        const span = TraceContext.startSpan("restartDrainedWorkspaces");
+        const ctx = { span };
+
+        try {
+            const since = new Date(Date.now() - this.restartWindowMs).toISOString();
+            const instances = await this.workspaceDB.trace(ctx).findInstancesStoppedSince(since);
+            let restarted = 0;
+            for (const instance of instances) {
+                if (!instance.status.conditions.nodeDrain) {
+                    continue;
+                }
+                try {
+                    const latestInstance = await this.workspaceDB.trace(ctx).findCurrentInstance(instance.workspaceId);
+                    if (latestInstance?.id !== instance.id) {
+                        // the workspace has been started again already
+                        continue;
+                    }
+                    const workspace = await this.workspaceDB.trace(ctx).findById(instance.workspaceId);
+                    if (!workspace) {
+                        throw new Error("cannot find workspace for instance");
+                    }
+                    if (workspace.type !== "regular" || !!workspace.softDeleted) {
+                        continue;
+                    }
+                    const user = await this.userDB.findUserById(workspace.ownerId);
+                    if (!user) {
+                        throw new Error("cannot find owner for workspace");
+                    }
+
+                    await runWithSubjectId(SubjectId.fromUserId(user.id), () =>
+                        this.workspaceService.startWorkspace(ctx, user, workspace.id),
+                    );
+                    restarted++;
+                    log.info(
+                        { instanceId: instance.id, workspaceId: workspace.id, userId: user.id },
+                        "restarted workspace after its node was drained",
+                    );
+                } catch (err) {
+                    log.warn({ instanceId: instance.id }, "error while restarting drained workspace", err);
+                }
+            }
+            return restarted;
+        } catch (err) {
+            TraceContext.setError(ctx, err);
+        } finally {
+            span.finish();
+        }
+    }
+}
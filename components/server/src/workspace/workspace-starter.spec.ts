@@ -8,7 +8,7 @@ import "reflect-metadata";
 
 import { suite, test } from "@testdeck/mocha";
 import * as chai from "chai";
-import { isClusterMaintenanceError, isResourceExhaustedError } from "./workspace-starter";
+import { buildSecretCandidates, isClusterMaintenanceError, isResourceExhaustedError } from "./workspace-starter";
 const expect = chai.expect;
 
 @suite
@@ -44,6 +44,19 @@ class TestWorkspaceStarter {
         const result = isResourceExhaustedError(err);
         expect(result).to.be.true;
     }
+
+    @test
+    public testBuildSecretCandidates() {
+        const envVar = (name: string, censored: boolean) => ({ id: name, projectId: "p1", name, censored });
+
+        const result = buildSecretCandidates([
+            envVar("NPM_TOKEN", false),
+            envVar("DEPLOY_KEY", true),
+            envVar("GITPOD_IMAGE_AUTH", false),
+            envVar("-invalid", false),
+        ]);
+        expect(result.map((e) => e.name)).to.deep.equal(["NPM_TOKEN"]);
+    }
 }
 
 module.exports = new TestWorkspaceStarter();
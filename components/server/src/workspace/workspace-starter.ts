@@ -19,6 +19,7 @@ import {
     DBWithTracing,
     ProjectDB,
     RedisPublisher,
+    TeamDB,
     TracedUserDB,
     TracedWorkspaceDB,
     UserDB,
@@ -42,6 +43,7 @@ import {
     NamedWorkspaceFeatureFlag,
     Permission,
     Project,
+    ProjectEnvVar,
     RefType,
     SnapshotContext,
     StartWorkspaceResult,
@@ -73,6 +75,7 @@ import {
     BuildRegistryAuthTotal,
     BuildRequest,
     BuildResponse,
+    BuildSecret,
     BuildSource,
     BuildSourceDockerfile,
     BuildSourceReference,
@@ -92,6 +95,7 @@ import {
     AdmissionLevel,
     EnvironmentVariable,
     GitSpec,
+    MachineActivity,
     PortSpec,
     PortVisibility,
     StartWorkspaceRequest,
@@ -197,6 +201,16 @@ export function isResourceExhaustedError(err: any): boolean {
     return "code" in err && err.code === grpc.status.RESOURCE_EXHAUSTED;
 }
 
+// BUILD_SECRET_ID matches the build secret ids image-builder accepts
+const BUILD_SECRET_ID = /^[a-zA-Z0-9][a-zA-Z0-9_.-]*$/;
+
+/**
+ * buildSecretCandidates returns the project environment variables which may be passed to image builds as build secrets
+ */
+export function buildSecretCandidates(envVars: ProjectEnvVar[]): ProjectEnvVar[] {
+    return envVars.filter((e) => !e.censored && e.name !== "GITPOD_IMAGE_AUTH" && BUILD_SECRET_ID.test(e.name));
+}
+
 export function isClusterMaintenanceError(err: any): boolean {
     return (
         "code" in err &&
@@ -226,6 +240,7 @@ export class WorkspaceStarter {
         @inject(IAnalyticsWriter) private readonly analytics: IAnalyticsWriter,
         @inject(OneTimeSecretServer) private readonly otsServer: OneTimeSecretServer,
         @inject(ProjectDB) private readonly projectDB: ProjectDB,
+        @inject(TeamDB) private readonly teamDB: TeamDB,
         @inject(BlockedRepositoryDB) private readonly blockedRepositoryDB: BlockedRepositoryDB,
         @inject(EntitlementService) private readonly entitlementService: EntitlementService,
         @inject(RedisMutex) private readonly redisMutex: RedisMutex,
@@ -582,12 +597,14 @@ export class WorkspaceStarter {
 
             // build workspace image
             const additionalAuth = await this.getAdditionalImageAuth(envVars);
+            const buildSecrets = await this.getBuildSecrets(workspace, envVars);
             instance = await this.buildWorkspaceImage(
                 { span },
                 user,
                 workspace,
                 instance,
                 additionalAuth,
+                buildSecrets,
                 forceRebuild,
                 forceRebuild,
                 region,
@@ -718,6 +735,11 @@ export class WorkspaceStarter {
             metadata.setProject(workspace.projectId);
             metadata.setTeam(workspace.organizationId);
         }
+        const orgSettings = await this.teamDB.findOrgSettings(workspace.organizationId);
+        if (orgSettings?.recordSSHSessions) {
+            // ws-proxy records the terminal sessions at the SSH gateway of workspaces with this annotation
+            metadata.getAnnotationsMap().set("gitpod.io/sshRecording", "true");
+        }
 
         return metadata;
     }
@@ -805,6 +827,29 @@ export class WorkspaceStarter {
         return res;
     }
 
+    /**
+     * getBuildSecrets makes the project's environment variables available to the RUN --mount=type=secret instructions
+     * of the workspace image's Dockerfile, with the variable name as secret id. Censored variables are excluded: they are
+     * hidden from all workspaces, and anyone who can start a workspace can change its Dockerfile to read build secrets.
+     */
+    private async getBuildSecrets(workspace: Workspace, envVars: ResolvedEnvVars): Promise<BuildSecret[]> {
+        if (!WorkspaceImageSourceDocker.is(workspace.imageSource)) {
+            return [];
+        }
+        const candidates = buildSecretCandidates(envVars.project);
+        if (candidates.length === 0) {
+            return [];
+        }
+
+        const withValues = await this.projectDB.getProjectEnvironmentVariableValues(candidates);
+        return withValues.map((e) => {
+            const secret = new BuildSecret();
+            secret.setId(e.name);
+            secret.setSecretValue(e.value);
+            return secret;
+        });
+    }
+
     /**
      * failInstanceStart properly fails a workspace instance if something goes wrong before the instance ever reaches
      * workspace manager. In this case we need to make sure we also fulfil the tasks of the bridge (e.g. for prebulds).
@@ -1147,6 +1192,7 @@ export class WorkspaceStarter {
         workspace: Workspace,
         instance: WorkspaceInstance,
         additionalAuth: Map<string, string>,
+        buildSecrets: BuildSecret[],
         ignoreBaseImageresolvedAndRebuildBase: boolean = false,
         forceRebuild: boolean = false,
         region?: WorkspaceRegion,
@@ -1169,6 +1215,8 @@ export class WorkspaceStarter {
             req.setAuth(auth);
             req.setForceRebuild(forceRebuild);
             req.setTriggeredBy(user.id);
+            req.setOrganizationId(workspace.organizationId);
+            req.setSecretsList(buildSecrets);
             if (!ignoreBaseImageresolvedAndRebuildBase && !forceRebuild && workspace.baseImageNameResolved) {
                 req.setBaseImageNameResolved(workspace.baseImageNameResolved);
             }
@@ -1255,6 +1303,7 @@ export class WorkspaceStarter {
                         workspace,
                         instance,
                         additionalAuth,
+                        buildSecrets,
                         true,
                         forceRebuild,
                         region,
@@ -1574,6 +1623,16 @@ export class WorkspaceStarter {
             }
         }
         spec.setAdmission(admissionLevel);
+
+        const orgSettings = await this.teamDB.findOrgSettings(workspace.organizationId);
+        if (orgSettings?.machineActivity) {
+            const machineActivity = new MachineActivity();
+            machineActivity.setCpuMillicores(orgSettings.machineActivity.cpuMillicores ?? 0);
+            machineActivity.setTaskProcesses(!!orgSettings.machineActivity.taskProcesses);
+            machineActivity.setNetworkConnections(!!orgSettings.machineActivity.networkConnections);
+            spec.setMachineActivity(machineActivity);
+        }
+
         const sshKeys = await this.userDB.trace(traceCtx).getSSHPublicKeys(user.id);
         spec.setSshPublicKeysList(sshKeys.map((e) => e.key));
         return spec;
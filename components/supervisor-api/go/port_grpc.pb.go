@@ -37,6 +37,17 @@ type PortServiceClient interface {
 	AutoTunnel(ctx context.Context, in *AutoTunnelRequest, opts ...grpc.CallOption) (*AutoTunnelResponse, error)
 	// RetryAutoExpose retries auto exposing the give port
 	RetryAutoExpose(ctx context.Context, in *RetryAutoExposeRequest, opts ...grpc.CallOption) (*RetryAutoExposeResponse, error)
+	// SetPortInspection enables or disables recording the HTTP requests to a port.
+	SetPortInspection(ctx context.Context, in *SetPortInspectionRequest, opts ...grpc.CallOption) (*SetPortInspectionResponse, error)
+	// ListInspectedPorts lists the ports whose HTTP requests are recorded.
+	ListInspectedPorts(ctx context.Context, in *ListInspectedPortsRequest, opts ...grpc.CallOption) (*ListInspectedPortsResponse, error)
+	// RecordInspectedRequest records an HTTP request which ws-proxy forwarded to an exposed port.
+	// Requests to ports without inspection enabled are dropped.
+	RecordInspectedRequest(ctx context.Context, in *RecordInspectedRequestRequest, opts ...grpc.CallOption) (*RecordInspectedRequestResponse, error)
+	// ListInspectedRequests lists the recently recorded HTTP requests of a port, the newest first.
+	ListInspectedRequests(ctx context.Context, in *ListInspectedRequestsRequest, opts ...grpc.CallOption) (*ListInspectedRequestsResponse, error)
+	// ReplayInspectedRequest sends a recorded HTTP request to the port again and records the result.
+	ReplayInspectedRequest(ctx context.Context, in *ReplayInspectedRequestRequest, opts ...grpc.CallOption) (*ReplayInspectedRequestResponse, error)
 }
 
 type portServiceClient struct {
@@ -114,6 +125,51 @@ func (c *portServiceClient) RetryAutoExpose(ctx context.Context, in *RetryAutoEx
 	return out, nil
 }
 
+func (c *portServiceClient) SetPortInspection(ctx context.Context, in *SetPortInspectionRequest, opts ...grpc.CallOption) (*SetPortInspectionResponse, error) {
+	out := new(SetPortInspectionResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/SetPortInspection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) ListInspectedPorts(ctx context.Context, in *ListInspectedPortsRequest, opts ...grpc.CallOption) (*ListInspectedPortsResponse, error) {
+	out := new(ListInspectedPortsResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/ListInspectedPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) RecordInspectedRequest(ctx context.Context, in *RecordInspectedRequestRequest, opts ...grpc.CallOption) (*RecordInspectedRequestResponse, error) {
+	out := new(RecordInspectedRequestResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/RecordInspectedRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) ListInspectedRequests(ctx context.Context, in *ListInspectedRequestsRequest, opts ...grpc.CallOption) (*ListInspectedRequestsResponse, error) {
+	out := new(ListInspectedRequestsResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/ListInspectedRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) ReplayInspectedRequest(ctx context.Context, in *ReplayInspectedRequestRequest, opts ...grpc.CallOption) (*ReplayInspectedRequestResponse, error) {
+	out := new(ReplayInspectedRequestResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/ReplayInspectedRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PortServiceServer is the server API for PortService service.
 // All implementations must embed UnimplementedPortServiceServer
 // for forward compatibility
@@ -129,6 +185,17 @@ type PortServiceServer interface {
 	AutoTunnel(context.Context, *AutoTunnelRequest) (*AutoTunnelResponse, error)
 	// RetryAutoExpose retries auto exposing the give port
 	RetryAutoExpose(context.Context, *RetryAutoExposeRequest) (*RetryAutoExposeResponse, error)
+	// SetPortInspection enables or disables recording the HTTP requests to a port.
+	SetPortInspection(context.Context, *SetPortInspectionRequest) (*SetPortInspectionResponse, error)
+	// ListInspectedPorts lists the ports whose HTTP requests are recorded.
+	ListInspectedPorts(context.Context, *ListInspectedPortsRequest) (*ListInspectedPortsResponse, error)
+	// RecordInspectedRequest records an HTTP request which ws-proxy forwarded to an exposed port.
+	// Requests to ports without inspection enabled are dropped.
+	RecordInspectedRequest(context.Context, *RecordInspectedRequestRequest) (*RecordInspectedRequestResponse, error)
+	// ListInspectedRequests lists the recently recorded HTTP requests of a port, the newest first.
+	ListInspectedRequests(context.Context, *ListInspectedRequestsRequest) (*ListInspectedRequestsResponse, error)
+	// ReplayInspectedRequest sends a recorded HTTP request to the port again and records the result.
+	ReplayInspectedRequest(context.Context, *ReplayInspectedRequestRequest) (*ReplayInspectedRequestResponse, error)
 	mustEmbedUnimplementedPortServiceServer()
 }
 
@@ -151,6 +218,21 @@ func (UnimplementedPortServiceServer) AutoTunnel(context.Context, *AutoTunnelReq
 func (UnimplementedPortServiceServer) RetryAutoExpose(context.Context, *RetryAutoExposeRequest) (*RetryAutoExposeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryAutoExpose not implemented")
 }
+func (UnimplementedPortServiceServer) SetPortInspection(context.Context, *SetPortInspectionRequest) (*SetPortInspectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPortInspection not implemented")
+}
+func (UnimplementedPortServiceServer) ListInspectedPorts(context.Context, *ListInspectedPortsRequest) (*ListInspectedPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInspectedPorts not implemented")
+}
+func (UnimplementedPortServiceServer) RecordInspectedRequest(context.Context, *RecordInspectedRequestRequest) (*RecordInspectedRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordInspectedRequest not implemented")
+}
+func (UnimplementedPortServiceServer) ListInspectedRequests(context.Context, *ListInspectedRequestsRequest) (*ListInspectedRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInspectedRequests not implemented")
+}
+func (UnimplementedPortServiceServer) ReplayInspectedRequest(context.Context, *ReplayInspectedRequestRequest) (*ReplayInspectedRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayInspectedRequest not implemented")
+}
 func (UnimplementedPortServiceServer) mustEmbedUnimplementedPortServiceServer() {}
 
 // UnsafePortServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -262,6 +344,96 @@ func _PortService_RetryAutoExpose_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortService_SetPortInspection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPortInspectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).SetPortInspection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/SetPortInspection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).SetPortInspection(ctx, req.(*SetPortInspectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_ListInspectedPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInspectedPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).ListInspectedPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/ListInspectedPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).ListInspectedPorts(ctx, req.(*ListInspectedPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_RecordInspectedRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordInspectedRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).RecordInspectedRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/RecordInspectedRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).RecordInspectedRequest(ctx, req.(*RecordInspectedRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_ListInspectedRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInspectedRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).ListInspectedRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/ListInspectedRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).ListInspectedRequests(ctx, req.(*ListInspectedRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_ReplayInspectedRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayInspectedRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).ReplayInspectedRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/ReplayInspectedRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).ReplayInspectedRequest(ctx, req.(*ReplayInspectedRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PortService_ServiceDesc is the grpc.ServiceDesc for PortService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,6 +457,26 @@ var PortService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetryAutoExpose",
 			Handler:    _PortService_RetryAutoExpose_Handler,
 		},
+		{
+			MethodName: "SetPortInspection",
+			Handler:    _PortService_SetPortInspection_Handler,
+		},
+		{
+			MethodName: "ListInspectedPorts",
+			Handler:    _PortService_ListInspectedPorts_Handler,
+		},
+		{
+			MethodName: "RecordInspectedRequest",
+			Handler:    _PortService_RecordInspectedRequest_Handler,
+		},
+		{
+			MethodName: "ListInspectedRequests",
+			Handler:    _PortService_ListInspectedRequests_Handler,
+		},
+		{
+			MethodName: "ReplayInspectedRequest",
+			Handler:    _PortService_ReplayInspectedRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -5425,6 +5425,9123 @@ public final class Port {
 
   }
 
+  public interface HTTPHeaderOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.HTTPHeader)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+
+    /**
+     * <code>repeated string values = 2;</code>
+     * @return A list containing the values.
+     */
+    java.util.List<java.lang.String>
+        getValuesList();
+    /**
+     * <code>repeated string values = 2;</code>
+     * @return The count of values.
+     */
+    int getValuesCount();
+    /**
+     * <code>repeated string values = 2;</code>
+     * @param index The index of the element to return.
+     * @return The values at the given index.
+     */
+    java.lang.String getValues(int index);
+    /**
+     * <code>repeated string values = 2;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the values at the given index.
+     */
+    com.google.protobuf.ByteString
+        getValuesBytes(int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.HTTPHeader}
+   */
+  public static final class HTTPHeader extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.HTTPHeader)
+      HTTPHeaderOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use HTTPHeader.newBuilder() to construct.
+    private HTTPHeader(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private HTTPHeader() {
+      name_ = "";
+      values_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new HTTPHeader();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private HTTPHeader(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                values_ = new com.google.protobuf.LazyStringArrayList();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              values_.add(s);
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          values_ = values_.getUnmodifiableView();
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_HTTPHeader_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_HTTPHeader_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.HTTPHeader.class, io.gitpod.supervisor.api.Port.HTTPHeader.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int VALUES_FIELD_NUMBER = 2;
+    private com.google.protobuf.LazyStringList values_;
+    /**
+     * <code>repeated string values = 2;</code>
+     * @return A list containing the values.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getValuesList() {
+      return values_;
+    }
+    /**
+     * <code>repeated string values = 2;</code>
+     * @return The count of values.
+     */
+    public int getValuesCount() {
+      return values_.size();
+    }
+    /**
+     * <code>repeated string values = 2;</code>
+     * @param index The index of the element to return.
+     * @return The values at the given index.
+     */
+    public java.lang.String getValues(int index) {
+      return values_.get(index);
+    }
+    /**
+     * <code>repeated string values = 2;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the values at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getValuesBytes(int index) {
+      return values_.getByteString(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
+      }
+      for (int i = 0; i < values_.size(); i++) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, values_.getRaw(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < values_.size(); i++) {
+          dataSize += computeStringSizeNoTag(values_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getValuesList().size();
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.HTTPHeader)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.HTTPHeader other = (io.gitpod.supervisor.api.Port.HTTPHeader) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (!getValuesList()
+          .equals(other.getValuesList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      if (getValuesCount() > 0) {
+        hash = (37 * hash) + VALUES_FIELD_NUMBER;
+        hash = (53 * hash) + getValuesList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.HTTPHeader parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.HTTPHeader prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.HTTPHeader}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.HTTPHeader)
+        io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_HTTPHeader_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_HTTPHeader_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.HTTPHeader.class, io.gitpod.supervisor.api.Port.HTTPHeader.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.HTTPHeader.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        name_ = "";
+
+        values_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+        bitField0_ = (bitField0_ & ~0x00000001);
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_HTTPHeader_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.HTTPHeader getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.HTTPHeader.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.HTTPHeader build() {
+        io.gitpod.supervisor.api.Port.HTTPHeader result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.HTTPHeader buildPartial() {
+        io.gitpod.supervisor.api.Port.HTTPHeader result = new io.gitpod.supervisor.api.Port.HTTPHeader(this);
+        int from_bitField0_ = bitField0_;
+        result.name_ = name_;
+        if (((bitField0_ & 0x00000001) != 0)) {
+          values_ = values_.getUnmodifiableView();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        }
+        result.values_ = values_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.HTTPHeader) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.HTTPHeader)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.HTTPHeader other) {
+        if (other == io.gitpod.supervisor.api.Port.HTTPHeader.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        if (!other.values_.isEmpty()) {
+          if (values_.isEmpty()) {
+            values_ = other.values_;
+            bitField0_ = (bitField0_ & ~0x00000001);
+          } else {
+            ensureValuesIsMutable();
+            values_.addAll(other.values_);
+          }
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.HTTPHeader parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.HTTPHeader) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringList values_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+      private void ensureValuesIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          values_ = new com.google.protobuf.LazyStringArrayList(values_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @return A list containing the values.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getValuesList() {
+        return values_.getUnmodifiableView();
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @return The count of values.
+       */
+      public int getValuesCount() {
+        return values_.size();
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @param index The index of the element to return.
+       * @return The values at the given index.
+       */
+      public java.lang.String getValues(int index) {
+        return values_.get(index);
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the values at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getValuesBytes(int index) {
+        return values_.getByteString(index);
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @param index The index to set the value at.
+       * @param value The values to set.
+       * @return This builder for chaining.
+       */
+      public Builder setValues(
+          int index, java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureValuesIsMutable();
+        values_.set(index, value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @param value The values to add.
+       * @return This builder for chaining.
+       */
+      public Builder addValues(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureValuesIsMutable();
+        values_.add(value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @param values The values to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllValues(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureValuesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, values_);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearValues() {
+        values_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated string values = 2;</code>
+       * @param value The bytes of the values to add.
+       * @return This builder for chaining.
+       */
+      public Builder addValuesBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        ensureValuesIsMutable();
+        values_.add(value);
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.HTTPHeader)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.HTTPHeader)
+    private static final io.gitpod.supervisor.api.Port.HTTPHeader DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.HTTPHeader();
+    }
+
+    public static io.gitpod.supervisor.api.Port.HTTPHeader getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<HTTPHeader>
+        PARSER = new com.google.protobuf.AbstractParser<HTTPHeader>() {
+      @java.lang.Override
+      public HTTPHeader parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new HTTPHeader(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<HTTPHeader> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<HTTPHeader> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.HTTPHeader getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface InspectedRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.InspectedRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string id = 1;</code>
+     * @return The id.
+     */
+    java.lang.String getId();
+    /**
+     * <code>string id = 1;</code>
+     * @return The bytes for id.
+     */
+    com.google.protobuf.ByteString
+        getIdBytes();
+
+    /**
+     * <code>uint32 port = 2;</code>
+     * @return The port.
+     */
+    int getPort();
+
+    /**
+     * <pre>
+     * start_time is the time the request was received in milliseconds since the unix epoch
+     * </pre>
+     *
+     * <code>int64 start_time = 3;</code>
+     * @return The startTime.
+     */
+    long getStartTime();
+
+    /**
+     * <code>uint64 duration_ms = 4;</code>
+     * @return The durationMs.
+     */
+    long getDurationMs();
+
+    /**
+     * <code>string method = 5;</code>
+     * @return The method.
+     */
+    java.lang.String getMethod();
+    /**
+     * <code>string method = 5;</code>
+     * @return The bytes for method.
+     */
+    com.google.protobuf.ByteString
+        getMethodBytes();
+
+    /**
+     * <pre>
+     * uri is the path and the query of the request
+     * </pre>
+     *
+     * <code>string uri = 6;</code>
+     * @return The uri.
+     */
+    java.lang.String getUri();
+    /**
+     * <pre>
+     * uri is the path and the query of the request
+     * </pre>
+     *
+     * <code>string uri = 6;</code>
+     * @return The bytes for uri.
+     */
+    com.google.protobuf.ByteString
+        getUriBytes();
+
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader>
+        getRequestHeadersList();
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    io.gitpod.supervisor.api.Port.HTTPHeader getRequestHeaders(int index);
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    int getRequestHeadersCount();
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+        getRequestHeadersOrBuilderList();
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder getRequestHeadersOrBuilder(
+        int index);
+
+    /**
+     * <code>bytes request_body = 8;</code>
+     * @return The requestBody.
+     */
+    com.google.protobuf.ByteString getRequestBody();
+
+    /**
+     * <code>bool request_body_truncated = 9;</code>
+     * @return The requestBodyTruncated.
+     */
+    boolean getRequestBodyTruncated();
+
+    /**
+     * <code>uint32 status_code = 10;</code>
+     * @return The statusCode.
+     */
+    int getStatusCode();
+
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader>
+        getResponseHeadersList();
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    io.gitpod.supervisor.api.Port.HTTPHeader getResponseHeaders(int index);
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    int getResponseHeadersCount();
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+        getResponseHeadersOrBuilderList();
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder getResponseHeadersOrBuilder(
+        int index);
+
+    /**
+     * <code>bytes response_body = 12;</code>
+     * @return The responseBody.
+     */
+    com.google.protobuf.ByteString getResponseBody();
+
+    /**
+     * <code>bool response_body_truncated = 13;</code>
+     * @return The responseBodyTruncated.
+     */
+    boolean getResponseBodyTruncated();
+
+    /**
+     * <pre>
+     * error is set if the request could not be forwarded to the port
+     * </pre>
+     *
+     * <code>string error = 14;</code>
+     * @return The error.
+     */
+    java.lang.String getError();
+    /**
+     * <pre>
+     * error is set if the request could not be forwarded to the port
+     * </pre>
+     *
+     * <code>string error = 14;</code>
+     * @return The bytes for error.
+     */
+    com.google.protobuf.ByteString
+        getErrorBytes();
+
+    /**
+     * <pre>
+     * replay_of is the ID of the request this request replayed
+     * </pre>
+     *
+     * <code>string replay_of = 15;</code>
+     * @return The replayOf.
+     */
+    java.lang.String getReplayOf();
+    /**
+     * <pre>
+     * replay_of is the ID of the request this request replayed
+     * </pre>
+     *
+     * <code>string replay_of = 15;</code>
+     * @return The bytes for replayOf.
+     */
+    com.google.protobuf.ByteString
+        getReplayOfBytes();
+
+    /**
+     * <pre>
+     * tls is true if the port serves HTTPS
+     * </pre>
+     *
+     * <code>bool tls = 16;</code>
+     * @return The tls.
+     */
+    boolean getTls();
+  }
+  /**
+   * <pre>
+   * InspectedRequest is an HTTP request to a port and its response.
+   * Sensitive values are redacted and bodies are truncated.
+   * </pre>
+   *
+   * Protobuf type {@code supervisor.InspectedRequest}
+   */
+  public static final class InspectedRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.InspectedRequest)
+      InspectedRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use InspectedRequest.newBuilder() to construct.
+    private InspectedRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private InspectedRequest() {
+      id_ = "";
+      method_ = "";
+      uri_ = "";
+      requestHeaders_ = java.util.Collections.emptyList();
+      requestBody_ = com.google.protobuf.ByteString.EMPTY;
+      responseHeaders_ = java.util.Collections.emptyList();
+      responseBody_ = com.google.protobuf.ByteString.EMPTY;
+      error_ = "";
+      replayOf_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new InspectedRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private InspectedRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              id_ = s;
+              break;
+            }
+            case 16: {
+
+              port_ = input.readUInt32();
+              break;
+            }
+            case 24: {
+
+              startTime_ = input.readInt64();
+              break;
+            }
+            case 32: {
+
+              durationMs_ = input.readUInt64();
+              break;
+            }
+            case 42: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              method_ = s;
+              break;
+            }
+            case 50: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              uri_ = s;
+              break;
+            }
+            case 58: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                requestHeaders_ = new java.util.ArrayList<io.gitpod.supervisor.api.Port.HTTPHeader>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              requestHeaders_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Port.HTTPHeader.parser(), extensionRegistry));
+              break;
+            }
+            case 66: {
+
+              requestBody_ = input.readBytes();
+              break;
+            }
+            case 72: {
+
+              requestBodyTruncated_ = input.readBool();
+              break;
+            }
+            case 80: {
+
+              statusCode_ = input.readUInt32();
+              break;
+            }
+            case 90: {
+              if (!((mutable_bitField0_ & 0x00000002) != 0)) {
+                responseHeaders_ = new java.util.ArrayList<io.gitpod.supervisor.api.Port.HTTPHeader>();
+                mutable_bitField0_ |= 0x00000002;
+              }
+              responseHeaders_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Port.HTTPHeader.parser(), extensionRegistry));
+              break;
+            }
+            case 98: {
+
+              responseBody_ = input.readBytes();
+              break;
+            }
+            case 104: {
+
+              responseBodyTruncated_ = input.readBool();
+              break;
+            }
+            case 114: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              error_ = s;
+              break;
+            }
+            case 122: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              replayOf_ = s;
+              break;
+            }
+            case 128: {
+
+              tls_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          requestHeaders_ = java.util.Collections.unmodifiableList(requestHeaders_);
+        }
+        if (((mutable_bitField0_ & 0x00000002) != 0)) {
+          responseHeaders_ = java.util.Collections.unmodifiableList(responseHeaders_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_InspectedRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_InspectedRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.InspectedRequest.class, io.gitpod.supervisor.api.Port.InspectedRequest.Builder.class);
+    }
+
+    public static final int ID_FIELD_NUMBER = 1;
+    private volatile java.lang.Object id_;
+    /**
+     * <code>string id = 1;</code>
+     * @return The id.
+     */
+    @java.lang.Override
+    public java.lang.String getId() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        id_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string id = 1;</code>
+     * @return The bytes for id.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getIdBytes() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        id_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int PORT_FIELD_NUMBER = 2;
+    private int port_;
+    /**
+     * <code>uint32 port = 2;</code>
+     * @return The port.
+     */
+    @java.lang.Override
+    public int getPort() {
+      return port_;
+    }
+
+    public static final int START_TIME_FIELD_NUMBER = 3;
+    private long startTime_;
+    /**
+     * <pre>
+     * start_time is the time the request was received in milliseconds since the unix epoch
+     * </pre>
+     *
+     * <code>int64 start_time = 3;</code>
+     * @return The startTime.
+     */
+    @java.lang.Override
+    public long getStartTime() {
+      return startTime_;
+    }
+
+    public static final int DURATION_MS_FIELD_NUMBER = 4;
+    private long durationMs_;
+    /**
+     * <code>uint64 duration_ms = 4;</code>
+     * @return The durationMs.
+     */
+    @java.lang.Override
+    public long getDurationMs() {
+      return durationMs_;
+    }
+
+    public static final int METHOD_FIELD_NUMBER = 5;
+    private volatile java.lang.Object method_;
+    /**
+     * <code>string method = 5;</code>
+     * @return The method.
+     */
+    @java.lang.Override
+    public java.lang.String getMethod() {
+      java.lang.Object ref = method_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        method_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string method = 5;</code>
+     * @return The bytes for method.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getMethodBytes() {
+      java.lang.Object ref = method_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        method_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int URI_FIELD_NUMBER = 6;
+    private volatile java.lang.Object uri_;
+    /**
+     * <pre>
+     * uri is the path and the query of the request
+     * </pre>
+     *
+     * <code>string uri = 6;</code>
+     * @return The uri.
+     */
+    @java.lang.Override
+    public java.lang.String getUri() {
+      java.lang.Object ref = uri_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        uri_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * uri is the path and the query of the request
+     * </pre>
+     *
+     * <code>string uri = 6;</code>
+     * @return The bytes for uri.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getUriBytes() {
+      java.lang.Object ref = uri_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        uri_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int REQUEST_HEADERS_FIELD_NUMBER = 7;
+    private java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> requestHeaders_;
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> getRequestHeadersList() {
+      return requestHeaders_;
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+        getRequestHeadersOrBuilderList() {
+      return requestHeaders_;
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    @java.lang.Override
+    public int getRequestHeadersCount() {
+      return requestHeaders_.size();
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.HTTPHeader getRequestHeaders(int index) {
+      return requestHeaders_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder getRequestHeadersOrBuilder(
+        int index) {
+      return requestHeaders_.get(index);
+    }
+
+    public static final int REQUEST_BODY_FIELD_NUMBER = 8;
+    private com.google.protobuf.ByteString requestBody_;
+    /**
+     * <code>bytes request_body = 8;</code>
+     * @return The requestBody.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getRequestBody() {
+      return requestBody_;
+    }
+
+    public static final int REQUEST_BODY_TRUNCATED_FIELD_NUMBER = 9;
+    private boolean requestBodyTruncated_;
+    /**
+     * <code>bool request_body_truncated = 9;</code>
+     * @return The requestBodyTruncated.
+     */
+    @java.lang.Override
+    public boolean getRequestBodyTruncated() {
+      return requestBodyTruncated_;
+    }
+
+    public static final int STATUS_CODE_FIELD_NUMBER = 10;
+    private int statusCode_;
+    /**
+     * <code>uint32 status_code = 10;</code>
+     * @return The statusCode.
+     */
+    @java.lang.Override
+    public int getStatusCode() {
+      return statusCode_;
+    }
+
+    public static final int RESPONSE_HEADERS_FIELD_NUMBER = 11;
+    private java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> responseHeaders_;
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> getResponseHeadersList() {
+      return responseHeaders_;
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+        getResponseHeadersOrBuilderList() {
+      return responseHeaders_;
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    @java.lang.Override
+    public int getResponseHeadersCount() {
+      return responseHeaders_.size();
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.HTTPHeader getResponseHeaders(int index) {
+      return responseHeaders_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder getResponseHeadersOrBuilder(
+        int index) {
+      return responseHeaders_.get(index);
+    }
+
+    public static final int RESPONSE_BODY_FIELD_NUMBER = 12;
+    private com.google.protobuf.ByteString responseBody_;
+    /**
+     * <code>bytes response_body = 12;</code>
+     * @return The responseBody.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getResponseBody() {
+      return responseBody_;
+    }
+
+    public static final int RESPONSE_BODY_TRUNCATED_FIELD_NUMBER = 13;
+    private boolean responseBodyTruncated_;
+    /**
+     * <code>bool response_body_truncated = 13;</code>
+     * @return The responseBodyTruncated.
+     */
+    @java.lang.Override
+    public boolean getResponseBodyTruncated() {
+      return responseBodyTruncated_;
+    }
+
+    public static final int ERROR_FIELD_NUMBER = 14;
+    private volatile java.lang.Object error_;
+    /**
+     * <pre>
+     * error is set if the request could not be forwarded to the port
+     * </pre>
+     *
+     * <code>string error = 14;</code>
+     * @return The error.
+     */
+    @java.lang.Override
+    public java.lang.String getError() {
+      java.lang.Object ref = error_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        error_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * error is set if the request could not be forwarded to the port
+     * </pre>
+     *
+     * <code>string error = 14;</code>
+     * @return The bytes for error.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getErrorBytes() {
+      java.lang.Object ref = error_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        error_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int REPLAY_OF_FIELD_NUMBER = 15;
+    private volatile java.lang.Object replayOf_;
+    /**
+     * <pre>
+     * replay_of is the ID of the request this request replayed
+     * </pre>
+     *
+     * <code>string replay_of = 15;</code>
+     * @return The replayOf.
+     */
+    @java.lang.Override
+    public java.lang.String getReplayOf() {
+      java.lang.Object ref = replayOf_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        replayOf_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * replay_of is the ID of the request this request replayed
+     * </pre>
+     *
+     * <code>string replay_of = 15;</code>
+     * @return The bytes for replayOf.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getReplayOfBytes() {
+      java.lang.Object ref = replayOf_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        replayOf_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int TLS_FIELD_NUMBER = 16;
+    private boolean tls_;
+    /**
+     * <pre>
+     * tls is true if the port serves HTTPS
+     * </pre>
+     *
+     * <code>bool tls = 16;</code>
+     * @return The tls.
+     */
+    @java.lang.Override
+    public boolean getTls() {
+      return tls_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, id_);
+      }
+      if (port_ != 0) {
+        output.writeUInt32(2, port_);
+      }
+      if (startTime_ != 0L) {
+        output.writeInt64(3, startTime_);
+      }
+      if (durationMs_ != 0L) {
+        output.writeUInt64(4, durationMs_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(method_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 5, method_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(uri_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 6, uri_);
+      }
+      for (int i = 0; i < requestHeaders_.size(); i++) {
+        output.writeMessage(7, requestHeaders_.get(i));
+      }
+      if (!requestBody_.isEmpty()) {
+        output.writeBytes(8, requestBody_);
+      }
+      if (requestBodyTruncated_ != false) {
+        output.writeBool(9, requestBodyTruncated_);
+      }
+      if (statusCode_ != 0) {
+        output.writeUInt32(10, statusCode_);
+      }
+      for (int i = 0; i < responseHeaders_.size(); i++) {
+        output.writeMessage(11, responseHeaders_.get(i));
+      }
+      if (!responseBody_.isEmpty()) {
+        output.writeBytes(12, responseBody_);
+      }
+      if (responseBodyTruncated_ != false) {
+        output.writeBool(13, responseBodyTruncated_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(error_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 14, error_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(replayOf_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 15, replayOf_);
+      }
+      if (tls_ != false) {
+        output.writeBool(16, tls_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, id_);
+      }
+      if (port_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(2, port_);
+      }
+      if (startTime_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(3, startTime_);
+      }
+      if (durationMs_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(4, durationMs_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(method_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(5, method_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(uri_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(6, uri_);
+      }
+      for (int i = 0; i < requestHeaders_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(7, requestHeaders_.get(i));
+      }
+      if (!requestBody_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(8, requestBody_);
+      }
+      if (requestBodyTruncated_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(9, requestBodyTruncated_);
+      }
+      if (statusCode_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(10, statusCode_);
+      }
+      for (int i = 0; i < responseHeaders_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(11, responseHeaders_.get(i));
+      }
+      if (!responseBody_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(12, responseBody_);
+      }
+      if (responseBodyTruncated_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(13, responseBodyTruncated_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(error_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(14, error_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(replayOf_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(15, replayOf_);
+      }
+      if (tls_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(16, tls_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.InspectedRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.InspectedRequest other = (io.gitpod.supervisor.api.Port.InspectedRequest) obj;
+
+      if (!getId()
+          .equals(other.getId())) return false;
+      if (getPort()
+          != other.getPort()) return false;
+      if (getStartTime()
+          != other.getStartTime()) return false;
+      if (getDurationMs()
+          != other.getDurationMs()) return false;
+      if (!getMethod()
+          .equals(other.getMethod())) return false;
+      if (!getUri()
+          .equals(other.getUri())) return false;
+      if (!getRequestHeadersList()
+          .equals(other.getRequestHeadersList())) return false;
+      if (!getRequestBody()
+          .equals(other.getRequestBody())) return false;
+      if (getRequestBodyTruncated()
+          != other.getRequestBodyTruncated()) return false;
+      if (getStatusCode()
+          != other.getStatusCode()) return false;
+      if (!getResponseHeadersList()
+          .equals(other.getResponseHeadersList())) return false;
+      if (!getResponseBody()
+          .equals(other.getResponseBody())) return false;
+      if (getResponseBodyTruncated()
+          != other.getResponseBodyTruncated()) return false;
+      if (!getError()
+          .equals(other.getError())) return false;
+      if (!getReplayOf()
+          .equals(other.getReplayOf())) return false;
+      if (getTls()
+          != other.getTls()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
+      hash = (37 * hash) + PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getPort();
+      hash = (37 * hash) + START_TIME_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getStartTime());
+      hash = (37 * hash) + DURATION_MS_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getDurationMs());
+      hash = (37 * hash) + METHOD_FIELD_NUMBER;
+      hash = (53 * hash) + getMethod().hashCode();
+      hash = (37 * hash) + URI_FIELD_NUMBER;
+      hash = (53 * hash) + getUri().hashCode();
+      if (getRequestHeadersCount() > 0) {
+        hash = (37 * hash) + REQUEST_HEADERS_FIELD_NUMBER;
+        hash = (53 * hash) + getRequestHeadersList().hashCode();
+      }
+      hash = (37 * hash) + REQUEST_BODY_FIELD_NUMBER;
+      hash = (53 * hash) + getRequestBody().hashCode();
+      hash = (37 * hash) + REQUEST_BODY_TRUNCATED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getRequestBodyTruncated());
+      hash = (37 * hash) + STATUS_CODE_FIELD_NUMBER;
+      hash = (53 * hash) + getStatusCode();
+      if (getResponseHeadersCount() > 0) {
+        hash = (37 * hash) + RESPONSE_HEADERS_FIELD_NUMBER;
+        hash = (53 * hash) + getResponseHeadersList().hashCode();
+      }
+      hash = (37 * hash) + RESPONSE_BODY_FIELD_NUMBER;
+      hash = (53 * hash) + getResponseBody().hashCode();
+      hash = (37 * hash) + RESPONSE_BODY_TRUNCATED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getResponseBodyTruncated());
+      hash = (37 * hash) + ERROR_FIELD_NUMBER;
+      hash = (53 * hash) + getError().hashCode();
+      hash = (37 * hash) + REPLAY_OF_FIELD_NUMBER;
+      hash = (53 * hash) + getReplayOf().hashCode();
+      hash = (37 * hash) + TLS_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getTls());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.InspectedRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.InspectedRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * <pre>
+     * InspectedRequest is an HTTP request to a port and its response.
+     * Sensitive values are redacted and bodies are truncated.
+     * </pre>
+     *
+     * Protobuf type {@code supervisor.InspectedRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.InspectedRequest)
+        io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_InspectedRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_InspectedRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.InspectedRequest.class, io.gitpod.supervisor.api.Port.InspectedRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.InspectedRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getRequestHeadersFieldBuilder();
+          getResponseHeadersFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        id_ = "";
+
+        port_ = 0;
+
+        startTime_ = 0L;
+
+        durationMs_ = 0L;
+
+        method_ = "";
+
+        uri_ = "";
+
+        if (requestHeadersBuilder_ == null) {
+          requestHeaders_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          requestHeadersBuilder_.clear();
+        }
+        requestBody_ = com.google.protobuf.ByteString.EMPTY;
+
+        requestBodyTruncated_ = false;
+
+        statusCode_ = 0;
+
+        if (responseHeadersBuilder_ == null) {
+          responseHeaders_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
+        } else {
+          responseHeadersBuilder_.clear();
+        }
+        responseBody_ = com.google.protobuf.ByteString.EMPTY;
+
+        responseBodyTruncated_ = false;
+
+        error_ = "";
+
+        replayOf_ = "";
+
+        tls_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_InspectedRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.InspectedRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.InspectedRequest build() {
+        io.gitpod.supervisor.api.Port.InspectedRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.InspectedRequest buildPartial() {
+        io.gitpod.supervisor.api.Port.InspectedRequest result = new io.gitpod.supervisor.api.Port.InspectedRequest(this);
+        int from_bitField0_ = bitField0_;
+        result.id_ = id_;
+        result.port_ = port_;
+        result.startTime_ = startTime_;
+        result.durationMs_ = durationMs_;
+        result.method_ = method_;
+        result.uri_ = uri_;
+        if (requestHeadersBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            requestHeaders_ = java.util.Collections.unmodifiableList(requestHeaders_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.requestHeaders_ = requestHeaders_;
+        } else {
+          result.requestHeaders_ = requestHeadersBuilder_.build();
+        }
+        result.requestBody_ = requestBody_;
+        result.requestBodyTruncated_ = requestBodyTruncated_;
+        result.statusCode_ = statusCode_;
+        if (responseHeadersBuilder_ == null) {
+          if (((bitField0_ & 0x00000002) != 0)) {
+            responseHeaders_ = java.util.Collections.unmodifiableList(responseHeaders_);
+            bitField0_ = (bitField0_ & ~0x00000002);
+          }
+          result.responseHeaders_ = responseHeaders_;
+        } else {
+          result.responseHeaders_ = responseHeadersBuilder_.build();
+        }
+        result.responseBody_ = responseBody_;
+        result.responseBodyTruncated_ = responseBodyTruncated_;
+        result.error_ = error_;
+        result.replayOf_ = replayOf_;
+        result.tls_ = tls_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.InspectedRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.InspectedRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.InspectedRequest other) {
+        if (other == io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance()) return this;
+        if (!other.getId().isEmpty()) {
+          id_ = other.id_;
+          onChanged();
+        }
+        if (other.getPort() != 0) {
+          setPort(other.getPort());
+        }
+        if (other.getStartTime() != 0L) {
+          setStartTime(other.getStartTime());
+        }
+        if (other.getDurationMs() != 0L) {
+          setDurationMs(other.getDurationMs());
+        }
+        if (!other.getMethod().isEmpty()) {
+          method_ = other.method_;
+          onChanged();
+        }
+        if (!other.getUri().isEmpty()) {
+          uri_ = other.uri_;
+          onChanged();
+        }
+        if (requestHeadersBuilder_ == null) {
+          if (!other.requestHeaders_.isEmpty()) {
+            if (requestHeaders_.isEmpty()) {
+              requestHeaders_ = other.requestHeaders_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureRequestHeadersIsMutable();
+              requestHeaders_.addAll(other.requestHeaders_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.requestHeaders_.isEmpty()) {
+            if (requestHeadersBuilder_.isEmpty()) {
+              requestHeadersBuilder_.dispose();
+              requestHeadersBuilder_ = null;
+              requestHeaders_ = other.requestHeaders_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              requestHeadersBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getRequestHeadersFieldBuilder() : null;
+            } else {
+              requestHeadersBuilder_.addAllMessages(other.requestHeaders_);
+            }
+          }
+        }
+        if (other.getRequestBody() != com.google.protobuf.ByteString.EMPTY) {
+          setRequestBody(other.getRequestBody());
+        }
+        if (other.getRequestBodyTruncated() != false) {
+          setRequestBodyTruncated(other.getRequestBodyTruncated());
+        }
+        if (other.getStatusCode() != 0) {
+          setStatusCode(other.getStatusCode());
+        }
+        if (responseHeadersBuilder_ == null) {
+          if (!other.responseHeaders_.isEmpty()) {
+            if (responseHeaders_.isEmpty()) {
+              responseHeaders_ = other.responseHeaders_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+            } else {
+              ensureResponseHeadersIsMutable();
+              responseHeaders_.addAll(other.responseHeaders_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.responseHeaders_.isEmpty()) {
+            if (responseHeadersBuilder_.isEmpty()) {
+              responseHeadersBuilder_.dispose();
+              responseHeadersBuilder_ = null;
+              responseHeaders_ = other.responseHeaders_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+              responseHeadersBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getResponseHeadersFieldBuilder() : null;
+            } else {
+              responseHeadersBuilder_.addAllMessages(other.responseHeaders_);
+            }
+          }
+        }
+        if (other.getResponseBody() != com.google.protobuf.ByteString.EMPTY) {
+          setResponseBody(other.getResponseBody());
+        }
+        if (other.getResponseBodyTruncated() != false) {
+          setResponseBodyTruncated(other.getResponseBodyTruncated());
+        }
+        if (!other.getError().isEmpty()) {
+          error_ = other.error_;
+          onChanged();
+        }
+        if (!other.getReplayOf().isEmpty()) {
+          replayOf_ = other.replayOf_;
+          onChanged();
+        }
+        if (other.getTls() != false) {
+          setTls(other.getTls());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.InspectedRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.InspectedRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object id_ = "";
+      /**
+       * <code>string id = 1;</code>
+       * @return The id.
+       */
+      public java.lang.String getId() {
+        java.lang.Object ref = id_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          id_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @return The bytes for id.
+       */
+      public com.google.protobuf.ByteString
+          getIdBytes() {
+        java.lang.Object ref = id_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          id_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @param value The id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setId(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearId() {
+
+        id_ = getDefaultInstance().getId();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @param value The bytes for id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setIdBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+
+      private int port_ ;
+      /**
+       * <code>uint32 port = 2;</code>
+       * @return The port.
+       */
+      @java.lang.Override
+      public int getPort() {
+        return port_;
+      }
+      /**
+       * <code>uint32 port = 2;</code>
+       * @param value The port to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPort(int value) {
+
+        port_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 port = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPort() {
+
+        port_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private long startTime_ ;
+      /**
+       * <pre>
+       * start_time is the time the request was received in milliseconds since the unix epoch
+       * </pre>
+       *
+       * <code>int64 start_time = 3;</code>
+       * @return The startTime.
+       */
+      @java.lang.Override
+      public long getStartTime() {
+        return startTime_;
+      }
+      /**
+       * <pre>
+       * start_time is the time the request was received in milliseconds since the unix epoch
+       * </pre>
+       *
+       * <code>int64 start_time = 3;</code>
+       * @param value The startTime to set.
+       * @return This builder for chaining.
+       */
+      public Builder setStartTime(long value) {
+
+        startTime_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * start_time is the time the request was received in milliseconds since the unix epoch
+       * </pre>
+       *
+       * <code>int64 start_time = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearStartTime() {
+
+        startTime_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long durationMs_ ;
+      /**
+       * <code>uint64 duration_ms = 4;</code>
+       * @return The durationMs.
+       */
+      @java.lang.Override
+      public long getDurationMs() {
+        return durationMs_;
+      }
+      /**
+       * <code>uint64 duration_ms = 4;</code>
+       * @param value The durationMs to set.
+       * @return This builder for chaining.
+       */
+      public Builder setDurationMs(long value) {
+
+        durationMs_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint64 duration_ms = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearDurationMs() {
+
+        durationMs_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object method_ = "";
+      /**
+       * <code>string method = 5;</code>
+       * @return The method.
+       */
+      public java.lang.String getMethod() {
+        java.lang.Object ref = method_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          method_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string method = 5;</code>
+       * @return The bytes for method.
+       */
+      public com.google.protobuf.ByteString
+          getMethodBytes() {
+        java.lang.Object ref = method_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          method_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string method = 5;</code>
+       * @param value The method to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMethod(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        method_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string method = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMethod() {
+
+        method_ = getDefaultInstance().getMethod();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string method = 5;</code>
+       * @param value The bytes for method to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMethodBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        method_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object uri_ = "";
+      /**
+       * <pre>
+       * uri is the path and the query of the request
+       * </pre>
+       *
+       * <code>string uri = 6;</code>
+       * @return The uri.
+       */
+      public java.lang.String getUri() {
+        java.lang.Object ref = uri_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          uri_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * uri is the path and the query of the request
+       * </pre>
+       *
+       * <code>string uri = 6;</code>
+       * @return The bytes for uri.
+       */
+      public com.google.protobuf.ByteString
+          getUriBytes() {
+        java.lang.Object ref = uri_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          uri_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * uri is the path and the query of the request
+       * </pre>
+       *
+       * <code>string uri = 6;</code>
+       * @param value The uri to set.
+       * @return This builder for chaining.
+       */
+      public Builder setUri(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        uri_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * uri is the path and the query of the request
+       * </pre>
+       *
+       * <code>string uri = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearUri() {
+
+        uri_ = getDefaultInstance().getUri();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * uri is the path and the query of the request
+       * </pre>
+       *
+       * <code>string uri = 6;</code>
+       * @param value The bytes for uri to set.
+       * @return This builder for chaining.
+       */
+      public Builder setUriBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        uri_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> requestHeaders_ =
+        java.util.Collections.emptyList();
+      private void ensureRequestHeadersIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          requestHeaders_ = new java.util.ArrayList<io.gitpod.supervisor.api.Port.HTTPHeader>(requestHeaders_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.HTTPHeader, io.gitpod.supervisor.api.Port.HTTPHeader.Builder, io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder> requestHeadersBuilder_;
+
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> getRequestHeadersList() {
+        if (requestHeadersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(requestHeaders_);
+        } else {
+          return requestHeadersBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public int getRequestHeadersCount() {
+        if (requestHeadersBuilder_ == null) {
+          return requestHeaders_.size();
+        } else {
+          return requestHeadersBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader getRequestHeaders(int index) {
+        if (requestHeadersBuilder_ == null) {
+          return requestHeaders_.get(index);
+        } else {
+          return requestHeadersBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder setRequestHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader value) {
+        if (requestHeadersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.set(index, value);
+          onChanged();
+        } else {
+          requestHeadersBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder setRequestHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader.Builder builderForValue) {
+        if (requestHeadersBuilder_ == null) {
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          requestHeadersBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder addRequestHeaders(io.gitpod.supervisor.api.Port.HTTPHeader value) {
+        if (requestHeadersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.add(value);
+          onChanged();
+        } else {
+          requestHeadersBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder addRequestHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader value) {
+        if (requestHeadersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.add(index, value);
+          onChanged();
+        } else {
+          requestHeadersBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder addRequestHeaders(
+          io.gitpod.supervisor.api.Port.HTTPHeader.Builder builderForValue) {
+        if (requestHeadersBuilder_ == null) {
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.add(builderForValue.build());
+          onChanged();
+        } else {
+          requestHeadersBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder addRequestHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader.Builder builderForValue) {
+        if (requestHeadersBuilder_ == null) {
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          requestHeadersBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder addAllRequestHeaders(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Port.HTTPHeader> values) {
+        if (requestHeadersBuilder_ == null) {
+          ensureRequestHeadersIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, requestHeaders_);
+          onChanged();
+        } else {
+          requestHeadersBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder clearRequestHeaders() {
+        if (requestHeadersBuilder_ == null) {
+          requestHeaders_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          requestHeadersBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public Builder removeRequestHeaders(int index) {
+        if (requestHeadersBuilder_ == null) {
+          ensureRequestHeadersIsMutable();
+          requestHeaders_.remove(index);
+          onChanged();
+        } else {
+          requestHeadersBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader.Builder getRequestHeadersBuilder(
+          int index) {
+        return getRequestHeadersFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder getRequestHeadersOrBuilder(
+          int index) {
+        if (requestHeadersBuilder_ == null) {
+          return requestHeaders_.get(index);  } else {
+          return requestHeadersBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+           getRequestHeadersOrBuilderList() {
+        if (requestHeadersBuilder_ != null) {
+          return requestHeadersBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(requestHeaders_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader.Builder addRequestHeadersBuilder() {
+        return getRequestHeadersFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Port.HTTPHeader.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader.Builder addRequestHeadersBuilder(
+          int index) {
+        return getRequestHeadersFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Port.HTTPHeader.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader request_headers = 7;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader.Builder>
+           getRequestHeadersBuilderList() {
+        return getRequestHeadersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.HTTPHeader, io.gitpod.supervisor.api.Port.HTTPHeader.Builder, io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+          getRequestHeadersFieldBuilder() {
+        if (requestHeadersBuilder_ == null) {
+          requestHeadersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Port.HTTPHeader, io.gitpod.supervisor.api.Port.HTTPHeader.Builder, io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>(
+                  requestHeaders_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          requestHeaders_ = null;
+        }
+        return requestHeadersBuilder_;
+      }
+
+      private com.google.protobuf.ByteString requestBody_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes request_body = 8;</code>
+       * @return The requestBody.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getRequestBody() {
+        return requestBody_;
+      }
+      /**
+       * <code>bytes request_body = 8;</code>
+       * @param value The requestBody to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRequestBody(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        requestBody_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes request_body = 8;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRequestBody() {
+
+        requestBody_ = getDefaultInstance().getRequestBody();
+        onChanged();
+        return this;
+      }
+
+      private boolean requestBodyTruncated_ ;
+      /**
+       * <code>bool request_body_truncated = 9;</code>
+       * @return The requestBodyTruncated.
+       */
+      @java.lang.Override
+      public boolean getRequestBodyTruncated() {
+        return requestBodyTruncated_;
+      }
+      /**
+       * <code>bool request_body_truncated = 9;</code>
+       * @param value The requestBodyTruncated to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRequestBodyTruncated(boolean value) {
+
+        requestBodyTruncated_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bool request_body_truncated = 9;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRequestBodyTruncated() {
+
+        requestBodyTruncated_ = false;
+        onChanged();
+        return this;
+      }
+
+      private int statusCode_ ;
+      /**
+       * <code>uint32 status_code = 10;</code>
+       * @return The statusCode.
+       */
+      @java.lang.Override
+      public int getStatusCode() {
+        return statusCode_;
+      }
+      /**
+       * <code>uint32 status_code = 10;</code>
+       * @param value The statusCode to set.
+       * @return This builder for chaining.
+       */
+      public Builder setStatusCode(int value) {
+
+        statusCode_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 status_code = 10;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearStatusCode() {
+
+        statusCode_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> responseHeaders_ =
+        java.util.Collections.emptyList();
+      private void ensureResponseHeadersIsMutable() {
+        if (!((bitField0_ & 0x00000002) != 0)) {
+          responseHeaders_ = new java.util.ArrayList<io.gitpod.supervisor.api.Port.HTTPHeader>(responseHeaders_);
+          bitField0_ |= 0x00000002;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.HTTPHeader, io.gitpod.supervisor.api.Port.HTTPHeader.Builder, io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder> responseHeadersBuilder_;
+
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader> getResponseHeadersList() {
+        if (responseHeadersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(responseHeaders_);
+        } else {
+          return responseHeadersBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public int getResponseHeadersCount() {
+        if (responseHeadersBuilder_ == null) {
+          return responseHeaders_.size();
+        } else {
+          return responseHeadersBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader getResponseHeaders(int index) {
+        if (responseHeadersBuilder_ == null) {
+          return responseHeaders_.get(index);
+        } else {
+          return responseHeadersBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder setResponseHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader value) {
+        if (responseHeadersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.set(index, value);
+          onChanged();
+        } else {
+          responseHeadersBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder setResponseHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader.Builder builderForValue) {
+        if (responseHeadersBuilder_ == null) {
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          responseHeadersBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder addResponseHeaders(io.gitpod.supervisor.api.Port.HTTPHeader value) {
+        if (responseHeadersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.add(value);
+          onChanged();
+        } else {
+          responseHeadersBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder addResponseHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader value) {
+        if (responseHeadersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.add(index, value);
+          onChanged();
+        } else {
+          responseHeadersBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder addResponseHeaders(
+          io.gitpod.supervisor.api.Port.HTTPHeader.Builder builderForValue) {
+        if (responseHeadersBuilder_ == null) {
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.add(builderForValue.build());
+          onChanged();
+        } else {
+          responseHeadersBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder addResponseHeaders(
+          int index, io.gitpod.supervisor.api.Port.HTTPHeader.Builder builderForValue) {
+        if (responseHeadersBuilder_ == null) {
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          responseHeadersBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder addAllResponseHeaders(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Port.HTTPHeader> values) {
+        if (responseHeadersBuilder_ == null) {
+          ensureResponseHeadersIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, responseHeaders_);
+          onChanged();
+        } else {
+          responseHeadersBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder clearResponseHeaders() {
+        if (responseHeadersBuilder_ == null) {
+          responseHeaders_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
+          onChanged();
+        } else {
+          responseHeadersBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public Builder removeResponseHeaders(int index) {
+        if (responseHeadersBuilder_ == null) {
+          ensureResponseHeadersIsMutable();
+          responseHeaders_.remove(index);
+          onChanged();
+        } else {
+          responseHeadersBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader.Builder getResponseHeadersBuilder(
+          int index) {
+        return getResponseHeadersFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder getResponseHeadersOrBuilder(
+          int index) {
+        if (responseHeadersBuilder_ == null) {
+          return responseHeaders_.get(index);  } else {
+          return responseHeadersBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+           getResponseHeadersOrBuilderList() {
+        if (responseHeadersBuilder_ != null) {
+          return responseHeadersBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(responseHeaders_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader.Builder addResponseHeadersBuilder() {
+        return getResponseHeadersFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Port.HTTPHeader.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public io.gitpod.supervisor.api.Port.HTTPHeader.Builder addResponseHeadersBuilder(
+          int index) {
+        return getResponseHeadersFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Port.HTTPHeader.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.HTTPHeader response_headers = 11;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Port.HTTPHeader.Builder>
+           getResponseHeadersBuilderList() {
+        return getResponseHeadersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.HTTPHeader, io.gitpod.supervisor.api.Port.HTTPHeader.Builder, io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>
+          getResponseHeadersFieldBuilder() {
+        if (responseHeadersBuilder_ == null) {
+          responseHeadersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Port.HTTPHeader, io.gitpod.supervisor.api.Port.HTTPHeader.Builder, io.gitpod.supervisor.api.Port.HTTPHeaderOrBuilder>(
+                  responseHeaders_,
+                  ((bitField0_ & 0x00000002) != 0),
+                  getParentForChildren(),
+                  isClean());
+          responseHeaders_ = null;
+        }
+        return responseHeadersBuilder_;
+      }
+
+      private com.google.protobuf.ByteString responseBody_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes response_body = 12;</code>
+       * @return The responseBody.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getResponseBody() {
+        return responseBody_;
+      }
+      /**
+       * <code>bytes response_body = 12;</code>
+       * @param value The responseBody to set.
+       * @return This builder for chaining.
+       */
+      public Builder setResponseBody(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        responseBody_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes response_body = 12;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearResponseBody() {
+
+        responseBody_ = getDefaultInstance().getResponseBody();
+        onChanged();
+        return this;
+      }
+
+      private boolean responseBodyTruncated_ ;
+      /**
+       * <code>bool response_body_truncated = 13;</code>
+       * @return The responseBodyTruncated.
+       */
+      @java.lang.Override
+      public boolean getResponseBodyTruncated() {
+        return responseBodyTruncated_;
+      }
+      /**
+       * <code>bool response_body_truncated = 13;</code>
+       * @param value The responseBodyTruncated to set.
+       * @return This builder for chaining.
+       */
+      public Builder setResponseBodyTruncated(boolean value) {
+
+        responseBodyTruncated_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bool response_body_truncated = 13;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearResponseBodyTruncated() {
+
+        responseBodyTruncated_ = false;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object error_ = "";
+      /**
+       * <pre>
+       * error is set if the request could not be forwarded to the port
+       * </pre>
+       *
+       * <code>string error = 14;</code>
+       * @return The error.
+       */
+      public java.lang.String getError() {
+        java.lang.Object ref = error_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          error_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * error is set if the request could not be forwarded to the port
+       * </pre>
+       *
+       * <code>string error = 14;</code>
+       * @return The bytes for error.
+       */
+      public com.google.protobuf.ByteString
+          getErrorBytes() {
+        java.lang.Object ref = error_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          error_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * error is set if the request could not be forwarded to the port
+       * </pre>
+       *
+       * <code>string error = 14;</code>
+       * @param value The error to set.
+       * @return This builder for chaining.
+       */
+      public Builder setError(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        error_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * error is set if the request could not be forwarded to the port
+       * </pre>
+       *
+       * <code>string error = 14;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearError() {
+
+        error_ = getDefaultInstance().getError();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * error is set if the request could not be forwarded to the port
+       * </pre>
+       *
+       * <code>string error = 14;</code>
+       * @param value The bytes for error to set.
+       * @return This builder for chaining.
+       */
+      public Builder setErrorBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        error_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object replayOf_ = "";
+      /**
+       * <pre>
+       * replay_of is the ID of the request this request replayed
+       * </pre>
+       *
+       * <code>string replay_of = 15;</code>
+       * @return The replayOf.
+       */
+      public java.lang.String getReplayOf() {
+        java.lang.Object ref = replayOf_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          replayOf_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * replay_of is the ID of the request this request replayed
+       * </pre>
+       *
+       * <code>string replay_of = 15;</code>
+       * @return The bytes for replayOf.
+       */
+      public com.google.protobuf.ByteString
+          getReplayOfBytes() {
+        java.lang.Object ref = replayOf_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          replayOf_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * replay_of is the ID of the request this request replayed
+       * </pre>
+       *
+       * <code>string replay_of = 15;</code>
+       * @param value The replayOf to set.
+       * @return This builder for chaining.
+       */
+      public Builder setReplayOf(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        replayOf_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * replay_of is the ID of the request this request replayed
+       * </pre>
+       *
+       * <code>string replay_of = 15;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearReplayOf() {
+
+        replayOf_ = getDefaultInstance().getReplayOf();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * replay_of is the ID of the request this request replayed
+       * </pre>
+       *
+       * <code>string replay_of = 15;</code>
+       * @param value The bytes for replayOf to set.
+       * @return This builder for chaining.
+       */
+      public Builder setReplayOfBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        replayOf_ = value;
+        onChanged();
+        return this;
+      }
+
+      private boolean tls_ ;
+      /**
+       * <pre>
+       * tls is true if the port serves HTTPS
+       * </pre>
+       *
+       * <code>bool tls = 16;</code>
+       * @return The tls.
+       */
+      @java.lang.Override
+      public boolean getTls() {
+        return tls_;
+      }
+      /**
+       * <pre>
+       * tls is true if the port serves HTTPS
+       * </pre>
+       *
+       * <code>bool tls = 16;</code>
+       * @param value The tls to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTls(boolean value) {
+
+        tls_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * tls is true if the port serves HTTPS
+       * </pre>
+       *
+       * <code>bool tls = 16;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTls() {
+
+        tls_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.InspectedRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.InspectedRequest)
+    private static final io.gitpod.supervisor.api.Port.InspectedRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.InspectedRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Port.InspectedRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<InspectedRequest>
+        PARSER = new com.google.protobuf.AbstractParser<InspectedRequest>() {
+      @java.lang.Override
+      public InspectedRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new InspectedRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<InspectedRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<InspectedRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SetPortInspectionRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SetPortInspectionRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>uint32 port = 1;</code>
+     * @return The port.
+     */
+    int getPort();
+
+    /**
+     * <code>bool enabled = 2;</code>
+     * @return The enabled.
+     */
+    boolean getEnabled();
+  }
+  /**
+   * Protobuf type {@code supervisor.SetPortInspectionRequest}
+   */
+  public static final class SetPortInspectionRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SetPortInspectionRequest)
+      SetPortInspectionRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SetPortInspectionRequest.newBuilder() to construct.
+    private SetPortInspectionRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SetPortInspectionRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SetPortInspectionRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SetPortInspectionRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              port_ = input.readUInt32();
+              break;
+            }
+            case 16: {
+
+              enabled_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.SetPortInspectionRequest.class, io.gitpod.supervisor.api.Port.SetPortInspectionRequest.Builder.class);
+    }
+
+    public static final int PORT_FIELD_NUMBER = 1;
+    private int port_;
+    /**
+     * <code>uint32 port = 1;</code>
+     * @return The port.
+     */
+    @java.lang.Override
+    public int getPort() {
+      return port_;
+    }
+
+    public static final int ENABLED_FIELD_NUMBER = 2;
+    private boolean enabled_;
+    /**
+     * <code>bool enabled = 2;</code>
+     * @return The enabled.
+     */
+    @java.lang.Override
+    public boolean getEnabled() {
+      return enabled_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (port_ != 0) {
+        output.writeUInt32(1, port_);
+      }
+      if (enabled_ != false) {
+        output.writeBool(2, enabled_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (port_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(1, port_);
+      }
+      if (enabled_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(2, enabled_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.SetPortInspectionRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.SetPortInspectionRequest other = (io.gitpod.supervisor.api.Port.SetPortInspectionRequest) obj;
+
+      if (getPort()
+          != other.getPort()) return false;
+      if (getEnabled()
+          != other.getEnabled()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getPort();
+      hash = (37 * hash) + ENABLED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getEnabled());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.SetPortInspectionRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SetPortInspectionRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SetPortInspectionRequest)
+        io.gitpod.supervisor.api.Port.SetPortInspectionRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.SetPortInspectionRequest.class, io.gitpod.supervisor.api.Port.SetPortInspectionRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.SetPortInspectionRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        port_ = 0;
+
+        enabled_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.SetPortInspectionRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.SetPortInspectionRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.SetPortInspectionRequest build() {
+        io.gitpod.supervisor.api.Port.SetPortInspectionRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.SetPortInspectionRequest buildPartial() {
+        io.gitpod.supervisor.api.Port.SetPortInspectionRequest result = new io.gitpod.supervisor.api.Port.SetPortInspectionRequest(this);
+        result.port_ = port_;
+        result.enabled_ = enabled_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.SetPortInspectionRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.SetPortInspectionRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.SetPortInspectionRequest other) {
+        if (other == io.gitpod.supervisor.api.Port.SetPortInspectionRequest.getDefaultInstance()) return this;
+        if (other.getPort() != 0) {
+          setPort(other.getPort());
+        }
+        if (other.getEnabled() != false) {
+          setEnabled(other.getEnabled());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.SetPortInspectionRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.SetPortInspectionRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private int port_ ;
+      /**
+       * <code>uint32 port = 1;</code>
+       * @return The port.
+       */
+      @java.lang.Override
+      public int getPort() {
+        return port_;
+      }
+      /**
+       * <code>uint32 port = 1;</code>
+       * @param value The port to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPort(int value) {
+
+        port_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 port = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPort() {
+
+        port_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private boolean enabled_ ;
+      /**
+       * <code>bool enabled = 2;</code>
+       * @return The enabled.
+       */
+      @java.lang.Override
+      public boolean getEnabled() {
+        return enabled_;
+      }
+      /**
+       * <code>bool enabled = 2;</code>
+       * @param value The enabled to set.
+       * @return This builder for chaining.
+       */
+      public Builder setEnabled(boolean value) {
+
+        enabled_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bool enabled = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearEnabled() {
+
+        enabled_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SetPortInspectionRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SetPortInspectionRequest)
+    private static final io.gitpod.supervisor.api.Port.SetPortInspectionRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.SetPortInspectionRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SetPortInspectionRequest>
+        PARSER = new com.google.protobuf.AbstractParser<SetPortInspectionRequest>() {
+      @java.lang.Override
+      public SetPortInspectionRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SetPortInspectionRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SetPortInspectionRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SetPortInspectionRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.SetPortInspectionRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SetPortInspectionResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SetPortInspectionResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code supervisor.SetPortInspectionResponse}
+   */
+  public static final class SetPortInspectionResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SetPortInspectionResponse)
+      SetPortInspectionResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SetPortInspectionResponse.newBuilder() to construct.
+    private SetPortInspectionResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SetPortInspectionResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SetPortInspectionResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SetPortInspectionResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.SetPortInspectionResponse.class, io.gitpod.supervisor.api.Port.SetPortInspectionResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.SetPortInspectionResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.SetPortInspectionResponse other = (io.gitpod.supervisor.api.Port.SetPortInspectionResponse) obj;
+
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.SetPortInspectionResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SetPortInspectionResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SetPortInspectionResponse)
+        io.gitpod.supervisor.api.Port.SetPortInspectionResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.SetPortInspectionResponse.class, io.gitpod.supervisor.api.Port.SetPortInspectionResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.SetPortInspectionResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_SetPortInspectionResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.SetPortInspectionResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.SetPortInspectionResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.SetPortInspectionResponse build() {
+        io.gitpod.supervisor.api.Port.SetPortInspectionResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.SetPortInspectionResponse buildPartial() {
+        io.gitpod.supervisor.api.Port.SetPortInspectionResponse result = new io.gitpod.supervisor.api.Port.SetPortInspectionResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.SetPortInspectionResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.SetPortInspectionResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.SetPortInspectionResponse other) {
+        if (other == io.gitpod.supervisor.api.Port.SetPortInspectionResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.SetPortInspectionResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.SetPortInspectionResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SetPortInspectionResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SetPortInspectionResponse)
+    private static final io.gitpod.supervisor.api.Port.SetPortInspectionResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.SetPortInspectionResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Port.SetPortInspectionResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SetPortInspectionResponse>
+        PARSER = new com.google.protobuf.AbstractParser<SetPortInspectionResponse>() {
+      @java.lang.Override
+      public SetPortInspectionResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SetPortInspectionResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SetPortInspectionResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SetPortInspectionResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.SetPortInspectionResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListInspectedPortsRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListInspectedPortsRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code supervisor.ListInspectedPortsRequest}
+   */
+  public static final class ListInspectedPortsRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListInspectedPortsRequest)
+      ListInspectedPortsRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListInspectedPortsRequest.newBuilder() to construct.
+    private ListInspectedPortsRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListInspectedPortsRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListInspectedPortsRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListInspectedPortsRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.class, io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.ListInspectedPortsRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.ListInspectedPortsRequest other = (io.gitpod.supervisor.api.Port.ListInspectedPortsRequest) obj;
+
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.ListInspectedPortsRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListInspectedPortsRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListInspectedPortsRequest)
+        io.gitpod.supervisor.api.Port.ListInspectedPortsRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.class, io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedPortsRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedPortsRequest build() {
+        io.gitpod.supervisor.api.Port.ListInspectedPortsRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedPortsRequest buildPartial() {
+        io.gitpod.supervisor.api.Port.ListInspectedPortsRequest result = new io.gitpod.supervisor.api.Port.ListInspectedPortsRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.ListInspectedPortsRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.ListInspectedPortsRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.ListInspectedPortsRequest other) {
+        if (other == io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.ListInspectedPortsRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.ListInspectedPortsRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListInspectedPortsRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListInspectedPortsRequest)
+    private static final io.gitpod.supervisor.api.Port.ListInspectedPortsRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.ListInspectedPortsRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListInspectedPortsRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ListInspectedPortsRequest>() {
+      @java.lang.Override
+      public ListInspectedPortsRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListInspectedPortsRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListInspectedPortsRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListInspectedPortsRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.ListInspectedPortsRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListInspectedPortsResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListInspectedPortsResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated uint32 ports = 1;</code>
+     * @return A list containing the ports.
+     */
+    java.util.List<java.lang.Integer> getPortsList();
+    /**
+     * <code>repeated uint32 ports = 1;</code>
+     * @return The count of ports.
+     */
+    int getPortsCount();
+    /**
+     * <code>repeated uint32 ports = 1;</code>
+     * @param index The index of the element to return.
+     * @return The ports at the given index.
+     */
+    int getPorts(int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.ListInspectedPortsResponse}
+   */
+  public static final class ListInspectedPortsResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListInspectedPortsResponse)
+      ListInspectedPortsResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListInspectedPortsResponse.newBuilder() to construct.
+    private ListInspectedPortsResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListInspectedPortsResponse() {
+      ports_ = emptyIntList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListInspectedPortsResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListInspectedPortsResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                ports_ = newIntList();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              ports_.addInt(input.readUInt32());
+              break;
+            }
+            case 10: {
+              int length = input.readRawVarint32();
+              int limit = input.pushLimit(length);
+              if (!((mutable_bitField0_ & 0x00000001) != 0) && input.getBytesUntilLimit() > 0) {
+                ports_ = newIntList();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              while (input.getBytesUntilLimit() > 0) {
+                ports_.addInt(input.readUInt32());
+              }
+              input.popLimit(limit);
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          ports_.makeImmutable(); // C
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.class, io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.Builder.class);
+    }
+
+    public static final int PORTS_FIELD_NUMBER = 1;
+    private com.google.protobuf.Internal.IntList ports_;
+    /**
+     * <code>repeated uint32 ports = 1;</code>
+     * @return A list containing the ports.
+     */
+    @java.lang.Override
+    public java.util.List<java.lang.Integer>
+        getPortsList() {
+      return ports_;
+    }
+    /**
+     * <code>repeated uint32 ports = 1;</code>
+     * @return The count of ports.
+     */
+    public int getPortsCount() {
+      return ports_.size();
+    }
+    /**
+     * <code>repeated uint32 ports = 1;</code>
+     * @param index The index of the element to return.
+     * @return The ports at the given index.
+     */
+    public int getPorts(int index) {
+      return ports_.getInt(index);
+    }
+    private int portsMemoizedSerializedSize = -1;
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getSerializedSize();
+      if (getPortsList().size() > 0) {
+        output.writeUInt32NoTag(10);
+        output.writeUInt32NoTag(portsMemoizedSerializedSize);
+      }
+      for (int i = 0; i < ports_.size(); i++) {
+        output.writeUInt32NoTag(ports_.getInt(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      {
+        int dataSize = 0;
+        for (int i = 0; i < ports_.size(); i++) {
+          dataSize += com.google.protobuf.CodedOutputStream
+            .computeUInt32SizeNoTag(ports_.getInt(i));
+        }
+        size += dataSize;
+        if (!getPortsList().isEmpty()) {
+          size += 1;
+          size += com.google.protobuf.CodedOutputStream
+              .computeInt32SizeNoTag(dataSize);
+        }
+        portsMemoizedSerializedSize = dataSize;
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.ListInspectedPortsResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.ListInspectedPortsResponse other = (io.gitpod.supervisor.api.Port.ListInspectedPortsResponse) obj;
+
+      if (!getPortsList()
+          .equals(other.getPortsList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getPortsCount() > 0) {
+        hash = (37 * hash) + PORTS_FIELD_NUMBER;
+        hash = (53 * hash) + getPortsList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.ListInspectedPortsResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListInspectedPortsResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListInspectedPortsResponse)
+        io.gitpod.supervisor.api.Port.ListInspectedPortsResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.class, io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        ports_ = emptyIntList();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedPortsResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedPortsResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedPortsResponse build() {
+        io.gitpod.supervisor.api.Port.ListInspectedPortsResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedPortsResponse buildPartial() {
+        io.gitpod.supervisor.api.Port.ListInspectedPortsResponse result = new io.gitpod.supervisor.api.Port.ListInspectedPortsResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (((bitField0_ & 0x00000001) != 0)) {
+          ports_.makeImmutable();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        }
+        result.ports_ = ports_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.ListInspectedPortsResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.ListInspectedPortsResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.ListInspectedPortsResponse other) {
+        if (other == io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.getDefaultInstance()) return this;
+        if (!other.ports_.isEmpty()) {
+          if (ports_.isEmpty()) {
+            ports_ = other.ports_;
+            bitField0_ = (bitField0_ & ~0x00000001);
+          } else {
+            ensurePortsIsMutable();
+            ports_.addAll(other.ports_);
+          }
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.ListInspectedPortsResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.ListInspectedPortsResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private com.google.protobuf.Internal.IntList ports_ = emptyIntList();
+      private void ensurePortsIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          ports_ = mutableCopy(ports_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @return A list containing the ports.
+       */
+      public java.util.List<java.lang.Integer>
+          getPortsList() {
+        return ((bitField0_ & 0x00000001) != 0) ?
+                 java.util.Collections.unmodifiableList(ports_) : ports_;
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @return The count of ports.
+       */
+      public int getPortsCount() {
+        return ports_.size();
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @param index The index of the element to return.
+       * @return The ports at the given index.
+       */
+      public int getPorts(int index) {
+        return ports_.getInt(index);
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @param index The index to set the value at.
+       * @param value The ports to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPorts(
+          int index, int value) {
+        ensurePortsIsMutable();
+        ports_.setInt(index, value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @param value The ports to add.
+       * @return This builder for chaining.
+       */
+      public Builder addPorts(int value) {
+        ensurePortsIsMutable();
+        ports_.addInt(value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @param values The ports to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllPorts(
+          java.lang.Iterable<? extends java.lang.Integer> values) {
+        ensurePortsIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, ports_);
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>repeated uint32 ports = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPorts() {
+        ports_ = emptyIntList();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListInspectedPortsResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListInspectedPortsResponse)
+    private static final io.gitpod.supervisor.api.Port.ListInspectedPortsResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.ListInspectedPortsResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedPortsResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListInspectedPortsResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ListInspectedPortsResponse>() {
+      @java.lang.Override
+      public ListInspectedPortsResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListInspectedPortsResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListInspectedPortsResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListInspectedPortsResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.ListInspectedPortsResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface RecordInspectedRequestRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.RecordInspectedRequestRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return Whether the request field is set.
+     */
+    boolean hasRequest();
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return The request.
+     */
+    io.gitpod.supervisor.api.Port.InspectedRequest getRequest();
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     */
+    io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestOrBuilder();
+  }
+  /**
+   * Protobuf type {@code supervisor.RecordInspectedRequestRequest}
+   */
+  public static final class RecordInspectedRequestRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.RecordInspectedRequestRequest)
+      RecordInspectedRequestRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use RecordInspectedRequestRequest.newBuilder() to construct.
+    private RecordInspectedRequestRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private RecordInspectedRequestRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new RecordInspectedRequestRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private RecordInspectedRequestRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              io.gitpod.supervisor.api.Port.InspectedRequest.Builder subBuilder = null;
+              if (request_ != null) {
+                subBuilder = request_.toBuilder();
+              }
+              request_ = input.readMessage(io.gitpod.supervisor.api.Port.InspectedRequest.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(request_);
+                request_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.class, io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.Builder.class);
+    }
+
+    public static final int REQUEST_FIELD_NUMBER = 1;
+    private io.gitpod.supervisor.api.Port.InspectedRequest request_;
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return Whether the request field is set.
+     */
+    @java.lang.Override
+    public boolean hasRequest() {
+      return request_ != null;
+    }
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return The request.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequest getRequest() {
+      return request_ == null ? io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance() : request_;
+    }
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestOrBuilder() {
+      return getRequest();
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (request_ != null) {
+        output.writeMessage(1, getRequest());
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (request_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getRequest());
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest other = (io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest) obj;
+
+      if (hasRequest() != other.hasRequest()) return false;
+      if (hasRequest()) {
+        if (!getRequest()
+            .equals(other.getRequest())) return false;
+      }
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasRequest()) {
+        hash = (37 * hash) + REQUEST_FIELD_NUMBER;
+        hash = (53 * hash) + getRequest().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.RecordInspectedRequestRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.RecordInspectedRequestRequest)
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.class, io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (requestBuilder_ == null) {
+          request_ = null;
+        } else {
+          request_ = null;
+          requestBuilder_ = null;
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest build() {
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest buildPartial() {
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest result = new io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest(this);
+        if (requestBuilder_ == null) {
+          result.request_ = request_;
+        } else {
+          result.request_ = requestBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest other) {
+        if (other == io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.getDefaultInstance()) return this;
+        if (other.hasRequest()) {
+          mergeRequest(other.getRequest());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private io.gitpod.supervisor.api.Port.InspectedRequest request_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder> requestBuilder_;
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       * @return Whether the request field is set.
+       */
+      public boolean hasRequest() {
+        return requestBuilder_ != null || request_ != null;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       * @return The request.
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest getRequest() {
+        if (requestBuilder_ == null) {
+          return request_ == null ? io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance() : request_;
+        } else {
+          return requestBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder setRequest(io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          request_ = value;
+          onChanged();
+        } else {
+          requestBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder setRequest(
+          io.gitpod.supervisor.api.Port.InspectedRequest.Builder builderForValue) {
+        if (requestBuilder_ == null) {
+          request_ = builderForValue.build();
+          onChanged();
+        } else {
+          requestBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder mergeRequest(io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestBuilder_ == null) {
+          if (request_ != null) {
+            request_ =
+              io.gitpod.supervisor.api.Port.InspectedRequest.newBuilder(request_).mergeFrom(value).buildPartial();
+          } else {
+            request_ = value;
+          }
+          onChanged();
+        } else {
+          requestBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder clearRequest() {
+        if (requestBuilder_ == null) {
+          request_ = null;
+          onChanged();
+        } else {
+          request_ = null;
+          requestBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest.Builder getRequestBuilder() {
+
+        onChanged();
+        return getRequestFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestOrBuilder() {
+        if (requestBuilder_ != null) {
+          return requestBuilder_.getMessageOrBuilder();
+        } else {
+          return request_ == null ?
+              io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance() : request_;
+        }
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>
+          getRequestFieldBuilder() {
+        if (requestBuilder_ == null) {
+          requestBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>(
+                  getRequest(),
+                  getParentForChildren(),
+                  isClean());
+          request_ = null;
+        }
+        return requestBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.RecordInspectedRequestRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.RecordInspectedRequestRequest)
+    private static final io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<RecordInspectedRequestRequest>
+        PARSER = new com.google.protobuf.AbstractParser<RecordInspectedRequestRequest>() {
+      @java.lang.Override
+      public RecordInspectedRequestRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new RecordInspectedRequestRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<RecordInspectedRequestRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<RecordInspectedRequestRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface RecordInspectedRequestResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.RecordInspectedRequestResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code supervisor.RecordInspectedRequestResponse}
+   */
+  public static final class RecordInspectedRequestResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.RecordInspectedRequestResponse)
+      RecordInspectedRequestResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use RecordInspectedRequestResponse.newBuilder() to construct.
+    private RecordInspectedRequestResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private RecordInspectedRequestResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new RecordInspectedRequestResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private RecordInspectedRequestResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.class, io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse other = (io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse) obj;
+
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.RecordInspectedRequestResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.RecordInspectedRequestResponse)
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.class, io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_RecordInspectedRequestResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse build() {
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse buildPartial() {
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse result = new io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse other) {
+        if (other == io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.RecordInspectedRequestResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.RecordInspectedRequestResponse)
+    private static final io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<RecordInspectedRequestResponse>
+        PARSER = new com.google.protobuf.AbstractParser<RecordInspectedRequestResponse>() {
+      @java.lang.Override
+      public RecordInspectedRequestResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new RecordInspectedRequestResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<RecordInspectedRequestResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<RecordInspectedRequestResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListInspectedRequestsRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListInspectedRequestsRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>uint32 port = 1;</code>
+     * @return The port.
+     */
+    int getPort();
+  }
+  /**
+   * Protobuf type {@code supervisor.ListInspectedRequestsRequest}
+   */
+  public static final class ListInspectedRequestsRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListInspectedRequestsRequest)
+      ListInspectedRequestsRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListInspectedRequestsRequest.newBuilder() to construct.
+    private ListInspectedRequestsRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListInspectedRequestsRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListInspectedRequestsRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListInspectedRequestsRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              port_ = input.readUInt32();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.class, io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.Builder.class);
+    }
+
+    public static final int PORT_FIELD_NUMBER = 1;
+    private int port_;
+    /**
+     * <code>uint32 port = 1;</code>
+     * @return The port.
+     */
+    @java.lang.Override
+    public int getPort() {
+      return port_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (port_ != 0) {
+        output.writeUInt32(1, port_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (port_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(1, port_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest other = (io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest) obj;
+
+      if (getPort()
+          != other.getPort()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getPort();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListInspectedRequestsRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListInspectedRequestsRequest)
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.class, io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        port_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest build() {
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest buildPartial() {
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest result = new io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest(this);
+        result.port_ = port_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest other) {
+        if (other == io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.getDefaultInstance()) return this;
+        if (other.getPort() != 0) {
+          setPort(other.getPort());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private int port_ ;
+      /**
+       * <code>uint32 port = 1;</code>
+       * @return The port.
+       */
+      @java.lang.Override
+      public int getPort() {
+        return port_;
+      }
+      /**
+       * <code>uint32 port = 1;</code>
+       * @param value The port to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPort(int value) {
+
+        port_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 port = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPort() {
+
+        port_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListInspectedRequestsRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListInspectedRequestsRequest)
+    private static final io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListInspectedRequestsRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ListInspectedRequestsRequest>() {
+      @java.lang.Override
+      public ListInspectedRequestsRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListInspectedRequestsRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListInspectedRequestsRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListInspectedRequestsRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListInspectedRequestsResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListInspectedRequestsResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Port.InspectedRequest>
+        getRequestsList();
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    io.gitpod.supervisor.api.Port.InspectedRequest getRequests(int index);
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    int getRequestsCount();
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>
+        getRequestsOrBuilderList();
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestsOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.ListInspectedRequestsResponse}
+   */
+  public static final class ListInspectedRequestsResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListInspectedRequestsResponse)
+      ListInspectedRequestsResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListInspectedRequestsResponse.newBuilder() to construct.
+    private ListInspectedRequestsResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListInspectedRequestsResponse() {
+      requests_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListInspectedRequestsResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListInspectedRequestsResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                requests_ = new java.util.ArrayList<io.gitpod.supervisor.api.Port.InspectedRequest>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              requests_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Port.InspectedRequest.parser(), extensionRegistry));
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          requests_ = java.util.Collections.unmodifiableList(requests_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.class, io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.Builder.class);
+    }
+
+    public static final int REQUESTS_FIELD_NUMBER = 1;
+    private java.util.List<io.gitpod.supervisor.api.Port.InspectedRequest> requests_;
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Port.InspectedRequest> getRequestsList() {
+      return requests_;
+    }
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>
+        getRequestsOrBuilderList() {
+      return requests_;
+    }
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    @java.lang.Override
+    public int getRequestsCount() {
+      return requests_.size();
+    }
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequest getRequests(int index) {
+      return requests_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestsOrBuilder(
+        int index) {
+      return requests_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < requests_.size(); i++) {
+        output.writeMessage(1, requests_.get(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < requests_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, requests_.get(i));
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse other = (io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse) obj;
+
+      if (!getRequestsList()
+          .equals(other.getRequestsList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getRequestsCount() > 0) {
+        hash = (37 * hash) + REQUESTS_FIELD_NUMBER;
+        hash = (53 * hash) + getRequestsList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListInspectedRequestsResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListInspectedRequestsResponse)
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.class, io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getRequestsFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (requestsBuilder_ == null) {
+          requests_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          requestsBuilder_.clear();
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ListInspectedRequestsResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse build() {
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse buildPartial() {
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse result = new io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (requestsBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            requests_ = java.util.Collections.unmodifiableList(requests_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.requests_ = requests_;
+        } else {
+          result.requests_ = requestsBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse other) {
+        if (other == io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.getDefaultInstance()) return this;
+        if (requestsBuilder_ == null) {
+          if (!other.requests_.isEmpty()) {
+            if (requests_.isEmpty()) {
+              requests_ = other.requests_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureRequestsIsMutable();
+              requests_.addAll(other.requests_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.requests_.isEmpty()) {
+            if (requestsBuilder_.isEmpty()) {
+              requestsBuilder_.dispose();
+              requestsBuilder_ = null;
+              requests_ = other.requests_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              requestsBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getRequestsFieldBuilder() : null;
+            } else {
+              requestsBuilder_.addAllMessages(other.requests_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<io.gitpod.supervisor.api.Port.InspectedRequest> requests_ =
+        java.util.Collections.emptyList();
+      private void ensureRequestsIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          requests_ = new java.util.ArrayList<io.gitpod.supervisor.api.Port.InspectedRequest>(requests_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder> requestsBuilder_;
+
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Port.InspectedRequest> getRequestsList() {
+        if (requestsBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(requests_);
+        } else {
+          return requestsBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public int getRequestsCount() {
+        if (requestsBuilder_ == null) {
+          return requests_.size();
+        } else {
+          return requestsBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest getRequests(int index) {
+        if (requestsBuilder_ == null) {
+          return requests_.get(index);
+        } else {
+          return requestsBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder setRequests(
+          int index, io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRequestsIsMutable();
+          requests_.set(index, value);
+          onChanged();
+        } else {
+          requestsBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder setRequests(
+          int index, io.gitpod.supervisor.api.Port.InspectedRequest.Builder builderForValue) {
+        if (requestsBuilder_ == null) {
+          ensureRequestsIsMutable();
+          requests_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          requestsBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder addRequests(io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRequestsIsMutable();
+          requests_.add(value);
+          onChanged();
+        } else {
+          requestsBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder addRequests(
+          int index, io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureRequestsIsMutable();
+          requests_.add(index, value);
+          onChanged();
+        } else {
+          requestsBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder addRequests(
+          io.gitpod.supervisor.api.Port.InspectedRequest.Builder builderForValue) {
+        if (requestsBuilder_ == null) {
+          ensureRequestsIsMutable();
+          requests_.add(builderForValue.build());
+          onChanged();
+        } else {
+          requestsBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder addRequests(
+          int index, io.gitpod.supervisor.api.Port.InspectedRequest.Builder builderForValue) {
+        if (requestsBuilder_ == null) {
+          ensureRequestsIsMutable();
+          requests_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          requestsBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder addAllRequests(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Port.InspectedRequest> values) {
+        if (requestsBuilder_ == null) {
+          ensureRequestsIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, requests_);
+          onChanged();
+        } else {
+          requestsBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder clearRequests() {
+        if (requestsBuilder_ == null) {
+          requests_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          requestsBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public Builder removeRequests(int index) {
+        if (requestsBuilder_ == null) {
+          ensureRequestsIsMutable();
+          requests_.remove(index);
+          onChanged();
+        } else {
+          requestsBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest.Builder getRequestsBuilder(
+          int index) {
+        return getRequestsFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestsOrBuilder(
+          int index) {
+        if (requestsBuilder_ == null) {
+          return requests_.get(index);  } else {
+          return requestsBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>
+           getRequestsOrBuilderList() {
+        if (requestsBuilder_ != null) {
+          return requestsBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(requests_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest.Builder addRequestsBuilder() {
+        return getRequestsFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest.Builder addRequestsBuilder(
+          int index) {
+        return getRequestsFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.InspectedRequest requests = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Port.InspectedRequest.Builder>
+           getRequestsBuilderList() {
+        return getRequestsFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>
+          getRequestsFieldBuilder() {
+        if (requestsBuilder_ == null) {
+          requestsBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>(
+                  requests_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          requests_ = null;
+        }
+        return requestsBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListInspectedRequestsResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListInspectedRequestsResponse)
+    private static final io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListInspectedRequestsResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ListInspectedRequestsResponse>() {
+      @java.lang.Override
+      public ListInspectedRequestsResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListInspectedRequestsResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListInspectedRequestsResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListInspectedRequestsResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ReplayInspectedRequestRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ReplayInspectedRequestRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>uint32 port = 1;</code>
+     * @return The port.
+     */
+    int getPort();
+
+    /**
+     * <code>string id = 2;</code>
+     * @return The id.
+     */
+    java.lang.String getId();
+    /**
+     * <code>string id = 2;</code>
+     * @return The bytes for id.
+     */
+    com.google.protobuf.ByteString
+        getIdBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.ReplayInspectedRequestRequest}
+   */
+  public static final class ReplayInspectedRequestRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ReplayInspectedRequestRequest)
+      ReplayInspectedRequestRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ReplayInspectedRequestRequest.newBuilder() to construct.
+    private ReplayInspectedRequestRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ReplayInspectedRequestRequest() {
+      id_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ReplayInspectedRequestRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ReplayInspectedRequestRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              port_ = input.readUInt32();
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              id_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.class, io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.Builder.class);
+    }
+
+    public static final int PORT_FIELD_NUMBER = 1;
+    private int port_;
+    /**
+     * <code>uint32 port = 1;</code>
+     * @return The port.
+     */
+    @java.lang.Override
+    public int getPort() {
+      return port_;
+    }
+
+    public static final int ID_FIELD_NUMBER = 2;
+    private volatile java.lang.Object id_;
+    /**
+     * <code>string id = 2;</code>
+     * @return The id.
+     */
+    @java.lang.Override
+    public java.lang.String getId() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        id_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string id = 2;</code>
+     * @return The bytes for id.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getIdBytes() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        id_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (port_ != 0) {
+        output.writeUInt32(1, port_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, id_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (port_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(1, port_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, id_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest other = (io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest) obj;
+
+      if (getPort()
+          != other.getPort()) return false;
+      if (!getId()
+          .equals(other.getId())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PORT_FIELD_NUMBER;
+      hash = (53 * hash) + getPort();
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ReplayInspectedRequestRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ReplayInspectedRequestRequest)
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.class, io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        port_ = 0;
+
+        id_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest build() {
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest buildPartial() {
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest result = new io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest(this);
+        result.port_ = port_;
+        result.id_ = id_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest other) {
+        if (other == io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.getDefaultInstance()) return this;
+        if (other.getPort() != 0) {
+          setPort(other.getPort());
+        }
+        if (!other.getId().isEmpty()) {
+          id_ = other.id_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private int port_ ;
+      /**
+       * <code>uint32 port = 1;</code>
+       * @return The port.
+       */
+      @java.lang.Override
+      public int getPort() {
+        return port_;
+      }
+      /**
+       * <code>uint32 port = 1;</code>
+       * @param value The port to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPort(int value) {
+
+        port_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 port = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPort() {
+
+        port_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object id_ = "";
+      /**
+       * <code>string id = 2;</code>
+       * @return The id.
+       */
+      public java.lang.String getId() {
+        java.lang.Object ref = id_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          id_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string id = 2;</code>
+       * @return The bytes for id.
+       */
+      public com.google.protobuf.ByteString
+          getIdBytes() {
+        java.lang.Object ref = id_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          id_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string id = 2;</code>
+       * @param value The id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setId(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearId() {
+
+        id_ = getDefaultInstance().getId();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 2;</code>
+       * @param value The bytes for id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setIdBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ReplayInspectedRequestRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ReplayInspectedRequestRequest)
+    private static final io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ReplayInspectedRequestRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ReplayInspectedRequestRequest>() {
+      @java.lang.Override
+      public ReplayInspectedRequestRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ReplayInspectedRequestRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ReplayInspectedRequestRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ReplayInspectedRequestRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ReplayInspectedRequestResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ReplayInspectedRequestResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return Whether the request field is set.
+     */
+    boolean hasRequest();
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return The request.
+     */
+    io.gitpod.supervisor.api.Port.InspectedRequest getRequest();
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     */
+    io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestOrBuilder();
+  }
+  /**
+   * Protobuf type {@code supervisor.ReplayInspectedRequestResponse}
+   */
+  public static final class ReplayInspectedRequestResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ReplayInspectedRequestResponse)
+      ReplayInspectedRequestResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ReplayInspectedRequestResponse.newBuilder() to construct.
+    private ReplayInspectedRequestResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ReplayInspectedRequestResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ReplayInspectedRequestResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ReplayInspectedRequestResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              io.gitpod.supervisor.api.Port.InspectedRequest.Builder subBuilder = null;
+              if (request_ != null) {
+                subBuilder = request_.toBuilder();
+              }
+              request_ = input.readMessage(io.gitpod.supervisor.api.Port.InspectedRequest.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(request_);
+                request_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.class, io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.Builder.class);
+    }
+
+    public static final int REQUEST_FIELD_NUMBER = 1;
+    private io.gitpod.supervisor.api.Port.InspectedRequest request_;
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return Whether the request field is set.
+     */
+    @java.lang.Override
+    public boolean hasRequest() {
+      return request_ != null;
+    }
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     * @return The request.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequest getRequest() {
+      return request_ == null ? io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance() : request_;
+    }
+    /**
+     * <code>.supervisor.InspectedRequest request = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestOrBuilder() {
+      return getRequest();
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (request_ != null) {
+        output.writeMessage(1, getRequest());
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (request_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getRequest());
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse other = (io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse) obj;
+
+      if (hasRequest() != other.hasRequest()) return false;
+      if (hasRequest()) {
+        if (!getRequest()
+            .equals(other.getRequest())) return false;
+      }
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasRequest()) {
+        hash = (37 * hash) + REQUEST_FIELD_NUMBER;
+        hash = (53 * hash) + getRequest().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ReplayInspectedRequestResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ReplayInspectedRequestResponse)
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.class, io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (requestBuilder_ == null) {
+          request_ = null;
+        } else {
+          request_ = null;
+          requestBuilder_ = null;
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Port.internal_static_supervisor_ReplayInspectedRequestResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse build() {
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse buildPartial() {
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse result = new io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse(this);
+        if (requestBuilder_ == null) {
+          result.request_ = request_;
+        } else {
+          result.request_ = requestBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse other) {
+        if (other == io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.getDefaultInstance()) return this;
+        if (other.hasRequest()) {
+          mergeRequest(other.getRequest());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private io.gitpod.supervisor.api.Port.InspectedRequest request_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder> requestBuilder_;
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       * @return Whether the request field is set.
+       */
+      public boolean hasRequest() {
+        return requestBuilder_ != null || request_ != null;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       * @return The request.
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest getRequest() {
+        if (requestBuilder_ == null) {
+          return request_ == null ? io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance() : request_;
+        } else {
+          return requestBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder setRequest(io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          request_ = value;
+          onChanged();
+        } else {
+          requestBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder setRequest(
+          io.gitpod.supervisor.api.Port.InspectedRequest.Builder builderForValue) {
+        if (requestBuilder_ == null) {
+          request_ = builderForValue.build();
+          onChanged();
+        } else {
+          requestBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder mergeRequest(io.gitpod.supervisor.api.Port.InspectedRequest value) {
+        if (requestBuilder_ == null) {
+          if (request_ != null) {
+            request_ =
+              io.gitpod.supervisor.api.Port.InspectedRequest.newBuilder(request_).mergeFrom(value).buildPartial();
+          } else {
+            request_ = value;
+          }
+          onChanged();
+        } else {
+          requestBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public Builder clearRequest() {
+        if (requestBuilder_ == null) {
+          request_ = null;
+          onChanged();
+        } else {
+          request_ = null;
+          requestBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequest.Builder getRequestBuilder() {
+
+        onChanged();
+        return getRequestFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder getRequestOrBuilder() {
+        if (requestBuilder_ != null) {
+          return requestBuilder_.getMessageOrBuilder();
+        } else {
+          return request_ == null ?
+              io.gitpod.supervisor.api.Port.InspectedRequest.getDefaultInstance() : request_;
+        }
+      }
+      /**
+       * <code>.supervisor.InspectedRequest request = 1;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>
+          getRequestFieldBuilder() {
+        if (requestBuilder_ == null) {
+          requestBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Port.InspectedRequest, io.gitpod.supervisor.api.Port.InspectedRequest.Builder, io.gitpod.supervisor.api.Port.InspectedRequestOrBuilder>(
+                  getRequest(),
+                  getParentForChildren(),
+                  isClean());
+          request_ = null;
+        }
+        return requestBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ReplayInspectedRequestResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ReplayInspectedRequestResponse)
+    private static final io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ReplayInspectedRequestResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ReplayInspectedRequestResponse>() {
+      @java.lang.Override
+      public ReplayInspectedRequestResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ReplayInspectedRequestResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ReplayInspectedRequestResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ReplayInspectedRequestResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_supervisor_TunnelPortRequest_descriptor;
   private static final
@@ -5475,6 +14592,66 @@ public final class Port {
   private static final
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_supervisor_RetryAutoExposeResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_HTTPHeader_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_HTTPHeader_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_InspectedRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_InspectedRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SetPortInspectionRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SetPortInspectionRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SetPortInspectionResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SetPortInspectionResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListInspectedPortsRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListInspectedPortsRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListInspectedPortsResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListInspectedPortsResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_RecordInspectedRequestRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_RecordInspectedRequestRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_RecordInspectedRequestResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_RecordInspectedRequestResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListInspectedRequestsRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListInspectedRequestsRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListInspectedRequestsResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListInspectedRequestsResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ReplayInspectedRequestRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ReplayInspectedRequestRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ReplayInspectedRequestResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ReplayInspectedRequestResponse_fieldAccessorTable;
 
   public static com.google.protobuf.Descriptors.FileDescriptor
       getDescriptor() {
@@ -5497,25 +14674,65 @@ public final class Port {
       "$\n\021AutoTunnelRequest\022\017\n\007enabled\030\001 \001(\010\"\024\n" +
       "\022AutoTunnelResponse\"&\n\026RetryAutoExposeRe" +
       "quest\022\014\n\004port\030\001 \001(\r\"\031\n\027RetryAutoExposeRe" +
-      "sponse*2\n\017TunnelVisiblity\022\010\n\004none\020\000\022\010\n\004h" +
-      "ost\020\001\022\013\n\007network\020\0022\310\004\n\013PortService\022j\n\006Tu" +
-      "nnel\022\035.supervisor.TunnelPortRequest\032\036.su" +
-      "pervisor.TunnelPortResponse\"!\202\323\344\223\002\033\"\026/v1" +
-      "/port/tunnel/{port}:\001*\022n\n\013CloseTunnel\022\036." +
-      "supervisor.CloseTunnelRequest\032\037.supervis" +
-      "or.CloseTunnelResponse\"\036\202\323\344\223\002\030*\026/v1/port" +
-      "/tunnel/{port}\022^\n\017EstablishTunnel\022\".supe" +
-      "rvisor.EstablishTunnelRequest\032#.supervis" +
-      "or.EstablishTunnelResponse(\0010\001\022s\n\nAutoTu" +
-      "nnel\022\035.supervisor.AutoTunnelRequest\032\036.su" +
-      "pervisor.AutoTunnelResponse\"&\202\323\344\223\002 \"\036/v1" +
-      "/port/tunnel/auto/{enabled}\022\207\001\n\017RetryAut" +
-      "oExpose\022\".supervisor.RetryAutoExposeRequ" +
-      "est\032#.supervisor.RetryAutoExposeResponse" +
-      "\"+\202\323\344\223\002%\"#/v1/port/ports/exposed/retry/{" +
-      "port}BF\n\030io.gitpod.supervisor.apiZ*githu" +
-      "b.com/gitpod-io/gitpod/supervisor/apib\006p" +
-      "roto3"
+      "sponse\"*\n\nHTTPHeader\022\014\n\004name\030\001 \001(\t\022\016\n\006va" +
+      "lues\030\002 \003(\t\"\207\003\n\020InspectedRequest\022\n\n\002id\030\001 " +
+      "\001(\t\022\014\n\004port\030\002 \001(\r\022\022\n\nstart_time\030\003 \001(\003\022\023\n" +
+      "\013duration_ms\030\004 \001(\004\022\016\n\006method\030\005 \001(\t\022\013\n\003ur" +
+      "i\030\006 \001(\t\022/\n\017request_headers\030\007 \003(\0132\026.super" +
+      "visor.HTTPHeader\022\024\n\014request_body\030\010 \001(\014\022\036" +
+      "\n\026request_body_truncated\030\t \001(\010\022\023\n\013status" +
+      "_code\030\n \001(\r\0220\n\020response_headers\030\013 \003(\0132\026." +
+      "supervisor.HTTPHeader\022\025\n\rresponse_body\030\014" +
+      " \001(\014\022\037\n\027response_body_truncated\030\r \001(\010\022\r\n" +
+      "\005error\030\016 \001(\t\022\021\n\treplay_of\030\017 \001(\t\022\013\n\003tls\030\020" +
+      " \001(\010\"9\n\030SetPortInspectionRequest\022\014\n\004port" +
+      "\030\001 \001(\r\022\017\n\007enabled\030\002 \001(\010\"\033\n\031SetPortInspec" +
+      "tionResponse\"\033\n\031ListInspectedPortsReques" +
+      "t\"+\n\032ListInspectedPortsResponse\022\r\n\005ports" +
+      "\030\001 \003(\r\"N\n\035RecordInspectedRequestRequest\022" +
+      "-\n\007request\030\001 \001(\0132\034.supervisor.InspectedR" +
+      "equest\" \n\036RecordInspectedRequestResponse" +
+      "\",\n\034ListInspectedRequestsRequest\022\014\n\004port" +
+      "\030\001 \001(\r\"O\n\035ListInspectedRequestsResponse\022" +
+      ".\n\010requests\030\001 \003(\0132\034.supervisor.Inspected" +
+      "Request\"9\n\035ReplayInspectedRequestRequest" +
+      "\022\014\n\004port\030\001 \001(\r\022\n\n\002id\030\002 \001(\t\"O\n\036ReplayInsp" +
+      "ectedRequestResponse\022-\n\007request\030\001 \001(\0132\034." +
+      "supervisor.InspectedRequest*2\n\017TunnelVis" +
+      "iblity\022\010\n\004none\020\000\022\010\n\004host\020\001\022\013\n\007network\020\0022" +
+      "\265\t\n\013PortService\022j\n\006Tunnel\022\035.supervisor.T" +
+      "unnelPortRequest\032\036.supervisor.TunnelPort" +
+      "Response\"!\202\323\344\223\002\033\"\026/v1/port/tunnel/{port}" +
+      ":\001*\022n\n\013CloseTunnel\022\036.supervisor.CloseTun" +
+      "nelRequest\032\037.supervisor.CloseTunnelRespo" +
+      "nse\"\036\202\323\344\223\002\030*\026/v1/port/tunnel/{port}\022^\n\017E" +
+      "stablishTunnel\022\".supervisor.EstablishTun" +
+      "nelRequest\032#.supervisor.EstablishTunnelR" +
+      "esponse(\0010\001\022s\n\nAutoTunnel\022\035.supervisor.A" +
+      "utoTunnelRequest\032\036.supervisor.AutoTunnel" +
+      "Response\"&\202\323\344\223\002 \"\036/v1/port/tunnel/auto/{" +
+      "enabled}\022\207\001\n\017RetryAutoExpose\022\".superviso" +
+      "r.RetryAutoExposeRequest\032#.supervisor.Re" +
+      "tryAutoExposeResponse\"+\202\323\344\223\002%\"#/v1/port/" +
+      "ports/exposed/retry/{port}\022b\n\021SetPortIns" +
+      "pection\022$.supervisor.SetPortInspectionRe" +
+      "quest\032%.supervisor.SetPortInspectionResp" +
+      "onse\"\000\022e\n\022ListInspectedPorts\022%.superviso" +
+      "r.ListInspectedPortsRequest\032&.supervisor" +
+      ".ListInspectedPortsResponse\"\000\022q\n\026RecordI" +
+      "nspectedRequest\022).supervisor.RecordInspe" +
+      "ctedRequestRequest\032*.supervisor.RecordIn" +
+      "spectedRequestResponse\"\000\022\215\001\n\025ListInspect" +
+      "edRequests\022(.supervisor.ListInspectedReq" +
+      "uestsRequest\032).supervisor.ListInspectedR" +
+      "equestsResponse\"\037\202\323\344\223\002\031\022\027/v1/port/inspec" +
+      "t/{port}\022\234\001\n\026ReplayInspectedRequest\022).su" +
+      "pervisor.ReplayInspectedRequestRequest\032*" +
+      ".supervisor.ReplayInspectedRequestRespon" +
+      "se\"+\202\323\344\223\002%\"#/v1/port/inspect/{port}/repl" +
+      "ay/{id}BF\n\030io.gitpod.supervisor.apiZ*git" +
+      "hub.com/gitpod-io/gitpod/supervisor/apib" +
+      "\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -5582,6 +14799,78 @@ public final class Port {
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_RetryAutoExposeResponse_descriptor,
         new java.lang.String[] { });
+    internal_static_supervisor_HTTPHeader_descriptor =
+      getDescriptor().getMessageTypes().get(10);
+    internal_static_supervisor_HTTPHeader_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_HTTPHeader_descriptor,
+        new java.lang.String[] { "Name", "Values", });
+    internal_static_supervisor_InspectedRequest_descriptor =
+      getDescriptor().getMessageTypes().get(11);
+    internal_static_supervisor_InspectedRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_InspectedRequest_descriptor,
+        new java.lang.String[] { "Id", "Port", "StartTime", "DurationMs", "Method", "Uri", "RequestHeaders", "RequestBody", "RequestBodyTruncated", "StatusCode", "ResponseHeaders", "ResponseBody", "ResponseBodyTruncated", "Error", "ReplayOf", "Tls", });
+    internal_static_supervisor_SetPortInspectionRequest_descriptor =
+      getDescriptor().getMessageTypes().get(12);
+    internal_static_supervisor_SetPortInspectionRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SetPortInspectionRequest_descriptor,
+        new java.lang.String[] { "Port", "Enabled", });
+    internal_static_supervisor_SetPortInspectionResponse_descriptor =
+      getDescriptor().getMessageTypes().get(13);
+    internal_static_supervisor_SetPortInspectionResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SetPortInspectionResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_supervisor_ListInspectedPortsRequest_descriptor =
+      getDescriptor().getMessageTypes().get(14);
+    internal_static_supervisor_ListInspectedPortsRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListInspectedPortsRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_supervisor_ListInspectedPortsResponse_descriptor =
+      getDescriptor().getMessageTypes().get(15);
+    internal_static_supervisor_ListInspectedPortsResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListInspectedPortsResponse_descriptor,
+        new java.lang.String[] { "Ports", });
+    internal_static_supervisor_RecordInspectedRequestRequest_descriptor =
+      getDescriptor().getMessageTypes().get(16);
+    internal_static_supervisor_RecordInspectedRequestRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_RecordInspectedRequestRequest_descriptor,
+        new java.lang.String[] { "Request", });
+    internal_static_supervisor_RecordInspectedRequestResponse_descriptor =
+      getDescriptor().getMessageTypes().get(17);
+    internal_static_supervisor_RecordInspectedRequestResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_RecordInspectedRequestResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_supervisor_ListInspectedRequestsRequest_descriptor =
+      getDescriptor().getMessageTypes().get(18);
+    internal_static_supervisor_ListInspectedRequestsRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListInspectedRequestsRequest_descriptor,
+        new java.lang.String[] { "Port", });
+    internal_static_supervisor_ListInspectedRequestsResponse_descriptor =
+      getDescriptor().getMessageTypes().get(19);
+    internal_static_supervisor_ListInspectedRequestsResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListInspectedRequestsResponse_descriptor,
+        new java.lang.String[] { "Requests", });
+    internal_static_supervisor_ReplayInspectedRequestRequest_descriptor =
+      getDescriptor().getMessageTypes().get(20);
+    internal_static_supervisor_ReplayInspectedRequestRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ReplayInspectedRequestRequest_descriptor,
+        new java.lang.String[] { "Port", "Id", });
+    internal_static_supervisor_ReplayInspectedRequestResponse_descriptor =
+      getDescriptor().getMessageTypes().get(21);
+    internal_static_supervisor_ReplayInspectedRequestResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ReplayInspectedRequestResponse_descriptor,
+        new java.lang.String[] { "Request", });
     com.google.protobuf.ExtensionRegistry registry =
         com.google.protobuf.ExtensionRegistry.newInstance();
     registry.add(com.google.api.AnnotationsProto.http);
@@ -174,6 +174,161 @@ public final class PortServiceGrpc {
     return getRetryAutoExposeMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.SetPortInspectionRequest,
+      io.gitpod.supervisor.api.Port.SetPortInspectionResponse> getSetPortInspectionMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "SetPortInspection",
+      requestType = io.gitpod.supervisor.api.Port.SetPortInspectionRequest.class,
+      responseType = io.gitpod.supervisor.api.Port.SetPortInspectionResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.SetPortInspectionRequest,
+      io.gitpod.supervisor.api.Port.SetPortInspectionResponse> getSetPortInspectionMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.SetPortInspectionRequest, io.gitpod.supervisor.api.Port.SetPortInspectionResponse> getSetPortInspectionMethod;
+    if ((getSetPortInspectionMethod = PortServiceGrpc.getSetPortInspectionMethod) == null) {
+      synchronized (PortServiceGrpc.class) {
+        if ((getSetPortInspectionMethod = PortServiceGrpc.getSetPortInspectionMethod) == null) {
+          PortServiceGrpc.getSetPortInspectionMethod = getSetPortInspectionMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Port.SetPortInspectionRequest, io.gitpod.supervisor.api.Port.SetPortInspectionResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "SetPortInspection"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.SetPortInspectionRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.SetPortInspectionResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new PortServiceMethodDescriptorSupplier("SetPortInspection"))
+              .build();
+        }
+      }
+    }
+    return getSetPortInspectionMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ListInspectedPortsRequest,
+      io.gitpod.supervisor.api.Port.ListInspectedPortsResponse> getListInspectedPortsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ListInspectedPorts",
+      requestType = io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.class,
+      responseType = io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ListInspectedPortsRequest,
+      io.gitpod.supervisor.api.Port.ListInspectedPortsResponse> getListInspectedPortsMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ListInspectedPortsRequest, io.gitpod.supervisor.api.Port.ListInspectedPortsResponse> getListInspectedPortsMethod;
+    if ((getListInspectedPortsMethod = PortServiceGrpc.getListInspectedPortsMethod) == null) {
+      synchronized (PortServiceGrpc.class) {
+        if ((getListInspectedPortsMethod = PortServiceGrpc.getListInspectedPortsMethod) == null) {
+          PortServiceGrpc.getListInspectedPortsMethod = getListInspectedPortsMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Port.ListInspectedPortsRequest, io.gitpod.supervisor.api.Port.ListInspectedPortsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ListInspectedPorts"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.ListInspectedPortsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.ListInspectedPortsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new PortServiceMethodDescriptorSupplier("ListInspectedPorts"))
+              .build();
+        }
+      }
+    }
+    return getListInspectedPortsMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest,
+      io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse> getRecordInspectedRequestMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "RecordInspectedRequest",
+      requestType = io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.class,
+      responseType = io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest,
+      io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse> getRecordInspectedRequestMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest, io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse> getRecordInspectedRequestMethod;
+    if ((getRecordInspectedRequestMethod = PortServiceGrpc.getRecordInspectedRequestMethod) == null) {
+      synchronized (PortServiceGrpc.class) {
+        if ((getRecordInspectedRequestMethod = PortServiceGrpc.getRecordInspectedRequestMethod) == null) {
+          PortServiceGrpc.getRecordInspectedRequestMethod = getRecordInspectedRequestMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest, io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "RecordInspectedRequest"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new PortServiceMethodDescriptorSupplier("RecordInspectedRequest"))
+              .build();
+        }
+      }
+    }
+    return getRecordInspectedRequestMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest,
+      io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse> getListInspectedRequestsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ListInspectedRequests",
+      requestType = io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.class,
+      responseType = io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest,
+      io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse> getListInspectedRequestsMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest, io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse> getListInspectedRequestsMethod;
+    if ((getListInspectedRequestsMethod = PortServiceGrpc.getListInspectedRequestsMethod) == null) {
+      synchronized (PortServiceGrpc.class) {
+        if ((getListInspectedRequestsMethod = PortServiceGrpc.getListInspectedRequestsMethod) == null) {
+          PortServiceGrpc.getListInspectedRequestsMethod = getListInspectedRequestsMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest, io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ListInspectedRequests"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new PortServiceMethodDescriptorSupplier("ListInspectedRequests"))
+              .build();
+        }
+      }
+    }
+    return getListInspectedRequestsMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest,
+      io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse> getReplayInspectedRequestMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ReplayInspectedRequest",
+      requestType = io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.class,
+      responseType = io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest,
+      io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse> getReplayInspectedRequestMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest, io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse> getReplayInspectedRequestMethod;
+    if ((getReplayInspectedRequestMethod = PortServiceGrpc.getReplayInspectedRequestMethod) == null) {
+      synchronized (PortServiceGrpc.class) {
+        if ((getReplayInspectedRequestMethod = PortServiceGrpc.getReplayInspectedRequestMethod) == null) {
+          PortServiceGrpc.getReplayInspectedRequestMethod = getReplayInspectedRequestMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest, io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ReplayInspectedRequest"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new PortServiceMethodDescriptorSupplier("ReplayInspectedRequest"))
+              .build();
+        }
+      }
+    }
+    return getReplayInspectedRequestMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -273,6 +428,57 @@ public final class PortServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getRetryAutoExposeMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * SetPortInspection enables or disables recording the HTTP requests to a port.
+     * </pre>
+     */
+    public void setPortInspection(io.gitpod.supervisor.api.Port.SetPortInspectionRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.SetPortInspectionResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getSetPortInspectionMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedPorts lists the ports whose HTTP requests are recorded.
+     * </pre>
+     */
+    public void listInspectedPorts(io.gitpod.supervisor.api.Port.ListInspectedPortsRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ListInspectedPortsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListInspectedPortsMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * RecordInspectedRequest records an HTTP request which ws-proxy forwarded to an exposed port.
+     * Requests to ports without inspection enabled are dropped.
+     * </pre>
+     */
+    public void recordInspectedRequest(io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getRecordInspectedRequestMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedRequests lists the recently recorded HTTP requests of a port, the newest first.
+     * </pre>
+     */
+    public void listInspectedRequests(io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListInspectedRequestsMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ReplayInspectedRequest sends a recorded HTTP request to the port again and records the result.
+     * </pre>
+     */
+    public void replayInspectedRequest(io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getReplayInspectedRequestMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -310,6 +516,41 @@ public final class PortServiceGrpc {
                 io.gitpod.supervisor.api.Port.RetryAutoExposeRequest,
                 io.gitpod.supervisor.api.Port.RetryAutoExposeResponse>(
                   this, METHODID_RETRY_AUTO_EXPOSE)))
+          .addMethod(
+            getSetPortInspectionMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Port.SetPortInspectionRequest,
+                io.gitpod.supervisor.api.Port.SetPortInspectionResponse>(
+                  this, METHODID_SET_PORT_INSPECTION)))
+          .addMethod(
+            getListInspectedPortsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Port.ListInspectedPortsRequest,
+                io.gitpod.supervisor.api.Port.ListInspectedPortsResponse>(
+                  this, METHODID_LIST_INSPECTED_PORTS)))
+          .addMethod(
+            getRecordInspectedRequestMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest,
+                io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse>(
+                  this, METHODID_RECORD_INSPECTED_REQUEST)))
+          .addMethod(
+            getListInspectedRequestsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest,
+                io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse>(
+                  this, METHODID_LIST_INSPECTED_REQUESTS)))
+          .addMethod(
+            getReplayInspectedRequestMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest,
+                io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse>(
+                  this, METHODID_REPLAY_INSPECTED_REQUEST)))
           .build();
     }
   }
@@ -383,6 +624,62 @@ public final class PortServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getRetryAutoExposeMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * SetPortInspection enables or disables recording the HTTP requests to a port.
+     * </pre>
+     */
+    public void setPortInspection(io.gitpod.supervisor.api.Port.SetPortInspectionRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.SetPortInspectionResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getSetPortInspectionMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedPorts lists the ports whose HTTP requests are recorded.
+     * </pre>
+     */
+    public void listInspectedPorts(io.gitpod.supervisor.api.Port.ListInspectedPortsRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ListInspectedPortsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListInspectedPortsMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * RecordInspectedRequest records an HTTP request which ws-proxy forwarded to an exposed port.
+     * Requests to ports without inspection enabled are dropped.
+     * </pre>
+     */
+    public void recordInspectedRequest(io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getRecordInspectedRequestMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedRequests lists the recently recorded HTTP requests of a port, the newest first.
+     * </pre>
+     */
+    public void listInspectedRequests(io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListInspectedRequestsMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ReplayInspectedRequest sends a recorded HTTP request to the port again and records the result.
+     * </pre>
+     */
+    public void replayInspectedRequest(io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getReplayInspectedRequestMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -439,6 +736,57 @@ public final class PortServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getRetryAutoExposeMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * SetPortInspection enables or disables recording the HTTP requests to a port.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Port.SetPortInspectionResponse setPortInspection(io.gitpod.supervisor.api.Port.SetPortInspectionRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getSetPortInspectionMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedPorts lists the ports whose HTTP requests are recorded.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Port.ListInspectedPortsResponse listInspectedPorts(io.gitpod.supervisor.api.Port.ListInspectedPortsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListInspectedPortsMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * RecordInspectedRequest records an HTTP request which ws-proxy forwarded to an exposed port.
+     * Requests to ports without inspection enabled are dropped.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse recordInspectedRequest(io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getRecordInspectedRequestMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedRequests lists the recently recorded HTTP requests of a port, the newest first.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse listInspectedRequests(io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListInspectedRequestsMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ReplayInspectedRequest sends a recorded HTTP request to the port again and records the result.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse replayInspectedRequest(io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getReplayInspectedRequestMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -499,13 +847,74 @@ public final class PortServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getRetryAutoExposeMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * SetPortInspection enables or disables recording the HTTP requests to a port.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Port.SetPortInspectionResponse> setPortInspection(
+        io.gitpod.supervisor.api.Port.SetPortInspectionRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getSetPortInspectionMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedPorts lists the ports whose HTTP requests are recorded.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Port.ListInspectedPortsResponse> listInspectedPorts(
+        io.gitpod.supervisor.api.Port.ListInspectedPortsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListInspectedPortsMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * RecordInspectedRequest records an HTTP request which ws-proxy forwarded to an exposed port.
+     * Requests to ports without inspection enabled are dropped.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse> recordInspectedRequest(
+        io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getRecordInspectedRequestMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ListInspectedRequests lists the recently recorded HTTP requests of a port, the newest first.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse> listInspectedRequests(
+        io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListInspectedRequestsMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ReplayInspectedRequest sends a recorded HTTP request to the port again and records the result.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse> replayInspectedRequest(
+        io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getReplayInspectedRequestMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_TUNNEL = 0;
   private static final int METHODID_CLOSE_TUNNEL = 1;
   private static final int METHODID_AUTO_TUNNEL = 2;
   private static final int METHODID_RETRY_AUTO_EXPOSE = 3;
-  private static final int METHODID_ESTABLISH_TUNNEL = 4;
+  private static final int METHODID_SET_PORT_INSPECTION = 4;
+  private static final int METHODID_LIST_INSPECTED_PORTS = 5;
+  private static final int METHODID_RECORD_INSPECTED_REQUEST = 6;
+  private static final int METHODID_LIST_INSPECTED_REQUESTS = 7;
+  private static final int METHODID_REPLAY_INSPECTED_REQUEST = 8;
+  private static final int METHODID_ESTABLISH_TUNNEL = 9;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -540,6 +949,26 @@ public final class PortServiceGrpc {
           serviceImpl.retryAutoExpose((io.gitpod.supervisor.api.Port.RetryAutoExposeRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.RetryAutoExposeResponse>) responseObserver);
           break;
+        case METHODID_SET_PORT_INSPECTION:
+          serviceImpl.setPortInspection((io.gitpod.supervisor.api.Port.SetPortInspectionRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.SetPortInspectionResponse>) responseObserver);
+          break;
+        case METHODID_LIST_INSPECTED_PORTS:
+          serviceImpl.listInspectedPorts((io.gitpod.supervisor.api.Port.ListInspectedPortsRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ListInspectedPortsResponse>) responseObserver);
+          break;
+        case METHODID_RECORD_INSPECTED_REQUEST:
+          serviceImpl.recordInspectedRequest((io.gitpod.supervisor.api.Port.RecordInspectedRequestRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.RecordInspectedRequestResponse>) responseObserver);
+          break;
+        case METHODID_LIST_INSPECTED_REQUESTS:
+          serviceImpl.listInspectedRequests((io.gitpod.supervisor.api.Port.ListInspectedRequestsRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ListInspectedRequestsResponse>) responseObserver);
+          break;
+        case METHODID_REPLAY_INSPECTED_REQUEST:
+          serviceImpl.replayInspectedRequest((io.gitpod.supervisor.api.Port.ReplayInspectedRequestRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Port.ReplayInspectedRequestResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -609,6 +1038,11 @@ public final class PortServiceGrpc {
               .addMethod(getEstablishTunnelMethod())
               .addMethod(getAutoTunnelMethod())
               .addMethod(getRetryAutoExposeMethod())
+              .addMethod(getSetPortInspectionMethod())
+              .addMethod(getListInspectedPortsMethod())
+              .addMethod(getRecordInspectedRequestMethod())
+              .addMethod(getListInspectedRequestsMethod())
+              .addMethod(getReplayInspectedRequestMethod())
               .build();
         }
       }
@@ -0,0 +1,331 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gitpod-io/gitpod/components/scrubber"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// maxInspectedRequests is the number of requests we keep per port
+	maxInspectedRequests = 50
+	// maxInspectedBodySize is the number of bytes we keep of request and response bodies
+	maxInspectedBodySize = 64 * 1024
+)
+
+var (
+	// ErrInspectedRequestNotFound is returned when replaying a request which isn't recorded (anymore)
+	ErrInspectedRequestNotFound = errors.New("request not found")
+	// ErrInspectedRequestTruncated is returned when replaying a request whose body was truncated
+	ErrInspectedRequestTruncated = errors.New("request body was truncated")
+)
+
+// sensitiveHeaders are redacted entirely, as the scrubber wouldn't recognise their values
+var sensitiveHeaders = map[string]struct{}{
+	"authorization":       {},
+	"proxy-authorization": {},
+	"cookie":              {},
+	"set-cookie":          {},
+}
+
+// hopHeaders are not replayed, as they belong to the connection the request was received on
+var hopHeaders = map[string]struct{}{
+	"connection":          {},
+	"content-length":      {},
+	"keep-alive":          {},
+	"proxy-connection":    {},
+	"te":                  {},
+	"trailer":             {},
+	"transfer-encoding":   {},
+	"upgrade":             {},
+	"proxy-authenticate":  {},
+	"proxy-authorization": {},
+}
+
+// RequestInspector keeps the most recent HTTP requests to each port which users
+// enabled inspection for, so that they can inspect and replay them, e.g. when debugging webhooks.
+type RequestInspector struct {
+	size   int
+	client *http.Client
+
+	mu       sync.RWMutex
+	enabled  map[uint32]struct{}
+	requests map[uint32][]*api.InspectedRequest
+}
+
+// NewRequestInspector creates a new request inspector
+func NewRequestInspector() *RequestInspector {
+	return &RequestInspector{
+		size: maxInspectedRequests,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+			// we want to see the redirect, not follow it
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		enabled:  make(map[uint32]struct{}),
+		requests: make(map[uint32][]*api.InspectedRequest),
+	}
+}
+
+// SetEnabled enables or disables recording the requests to a port.
+// Disabling the inspection of a port drops its recorded requests.
+func (ri *RequestInspector) SetEnabled(port uint32, enabled bool) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	if enabled {
+		ri.enabled[port] = struct{}{}
+		return
+	}
+	delete(ri.enabled, port)
+	delete(ri.requests, port)
+}
+
+// Ports returns the ports whose requests are recorded
+func (ri *RequestInspector) Ports() []uint32 {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+
+	res := make([]uint32, 0, len(ri.enabled))
+	for port := range ri.enabled {
+		res = append(res, port)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+func (ri *RequestInspector) isEnabled(port uint32) bool {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+
+	_, enabled := ri.enabled[port]
+	return enabled
+}
+
+// Record scrubs a request and adds it to the history of its port.
+// If the history is full, the oldest request is dropped.
+// Requests to ports without inspection enabled are dropped.
+func (ri *RequestInspector) Record(req *api.InspectedRequest) {
+	if !ri.isEnabled(req.Port) {
+		return
+	}
+	req.RequestBody, req.RequestBodyTruncated = truncateBody(req.RequestBody, req.RequestBodyTruncated)
+	req.ResponseBody, req.ResponseBodyTruncated = truncateBody(req.ResponseBody, req.ResponseBodyTruncated)
+	scrubInspectedRequest(req)
+
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	if _, enabled := ri.enabled[req.Port]; !enabled {
+		// inspection was disabled while we scrubbed the request
+		return
+	}
+	history := append(ri.requests[req.Port], req)
+	if len(history) > ri.size {
+		history = append([]*api.InspectedRequest(nil), history[len(history)-ri.size:]...)
+	}
+	ri.requests[req.Port] = history
+}
+
+// List returns the recorded requests of a port, the newest first
+func (ri *RequestInspector) List(port uint32) []*api.InspectedRequest {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+
+	history := ri.requests[port]
+	res := make([]*api.InspectedRequest, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		res = append(res, history[i])
+	}
+	return res
+}
+
+func (ri *RequestInspector) get(port uint32, id string) *api.InspectedRequest {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+
+	for _, req := range ri.requests[port] {
+		if req.Id == id {
+			return req
+		}
+	}
+	return nil
+}
+
+// Replay sends a recorded request to the port again, and records and returns the result.
+// Note that redacted values are replayed redacted.
+func (ri *RequestInspector) Replay(ctx context.Context, port uint32, id string) (*api.InspectedRequest, error) {
+	orig := ri.get(port, id)
+	if orig == nil {
+		return nil, ErrInspectedRequestNotFound
+	}
+	if orig.RequestBodyTruncated {
+		return nil, ErrInspectedRequestTruncated
+	}
+
+	scheme := "http"
+	if orig.Tls {
+		scheme = "https"
+	}
+	req, err := http.NewRequestWithContext(ctx, orig.Method, fmt.Sprintf("%s://localhost:%d%s", scheme, port, orig.Uri), bytes.NewReader(orig.RequestBody))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create request: %w", err)
+	}
+	for _, h := range orig.RequestHeaders {
+		name := strings.ToLower(h.Name)
+		if name == "host" {
+			if len(h.Values) > 0 {
+				req.Host = h.Values[0]
+			}
+			continue
+		}
+		if _, hop := hopHeaders[name]; hop {
+			continue
+		}
+		for _, v := range h.Values {
+			req.Header.Add(h.Name, v)
+		}
+	}
+
+	res := &api.InspectedRequest{
+		Id:             uuid.New().String(),
+		Port:           port,
+		Method:         orig.Method,
+		Uri:            orig.Uri,
+		RequestHeaders: proto.Clone(orig).(*api.InspectedRequest).RequestHeaders,
+		RequestBody:    orig.RequestBody,
+		ReplayOf:       orig.Id,
+		Tls:            orig.Tls,
+	}
+	start := time.Now()
+	res.StartTime = start.UnixMilli()
+
+	resp, err := ri.client.Do(req)
+	if err != nil {
+		res.Error = err.Error()
+	} else {
+		defer resp.Body.Close()
+
+		res.StatusCode = uint32(resp.StatusCode)
+		res.ResponseHeaders = inspectedHeaders(resp.Header)
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxInspectedBodySize+1))
+		if err != nil {
+			res.Error = err.Error()
+		}
+		res.ResponseBody, res.ResponseBodyTruncated = truncateBody(body, false)
+	}
+	res.DurationMs = uint64(time.Since(start).Milliseconds())
+
+	ri.Record(res)
+	return res, nil
+}
+
+// inspectedHeaders converts HTTP headers for an inspected request
+func inspectedHeaders(header http.Header) []*api.HTTPHeader {
+	res := make([]*api.HTTPHeader, 0, len(header))
+	for name, values := range header {
+		res = append(res, &api.HTTPHeader{Name: name, Values: append([]string(nil), values...)})
+	}
+	return res
+}
+
+func truncateBody(body []byte, truncated bool) ([]byte, bool) {
+	if len(body) > maxInspectedBodySize {
+		return body[:maxInspectedBodySize], true
+	}
+	return body, truncated
+}
+
+func scrubInspectedRequest(req *api.InspectedRequest) {
+	if u, err := url.ParseRequestURI(req.Uri); err == nil && u.RawQuery != "" {
+		u.RawQuery = scrubForm(u.Query()).Encode()
+		req.Uri = u.RequestURI()
+	}
+	scrubHeaders(req.RequestHeaders)
+	scrubHeaders(req.ResponseHeaders)
+	req.RequestBody = scrubBody(contentType(req.RequestHeaders), req.RequestBody)
+	req.ResponseBody = scrubBody(contentType(req.ResponseHeaders), req.ResponseBody)
+}
+
+func scrubHeaders(headers []*api.HTTPHeader) {
+	for _, h := range headers {
+		_, sensitive := sensitiveHeaders[strings.ToLower(h.Name)]
+		for i, v := range h.Values {
+			if sensitive {
+				h.Values[i] = scrubber.SanitiseRedact(v)
+			} else {
+				h.Values[i] = scrubber.Default.Value(scrubber.Default.KeyValue(h.Name, v))
+			}
+		}
+	}
+}
+
+func scrubForm(form url.Values) url.Values {
+	for key, values := range form {
+		for i, v := range values {
+			values[i] = scrubber.Default.Value(scrubber.Default.KeyValue(key, v))
+		}
+	}
+	return form
+}
+
+func contentType(headers []*api.HTTPHeader) string {
+	for _, h := range headers {
+		if strings.EqualFold(h.Name, "Content-Type") && len(h.Values) > 0 {
+			mediaType, _, _ := mime.ParseMediaType(h.Values[0])
+			return mediaType
+		}
+	}
+	return ""
+}
+
+// scrubBody scrubs textual bodies, binary bodies are kept as they are
+func scrubBody(mediaType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		if res, err := scrubber.Default.JSON(json.RawMessage(body)); err == nil {
+			return res
+		}
+		// truncated JSON isn't valid anymore
+		return []byte(scrubber.Default.Value(string(body)))
+	case mediaType == "application/x-www-form-urlencoded":
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			return []byte(scrubForm(form).Encode())
+		}
+		return []byte(scrubber.Default.Value(string(body)))
+	case strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "xml"):
+		return []byte(scrubber.Default.Value(string(body)))
+	default:
+		return body
+	}
+}
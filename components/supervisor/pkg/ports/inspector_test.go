@@ -0,0 +1,167 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestRequestInspectorHistory(t *testing.T) {
+	ri := NewRequestInspector()
+	ri.size = 3
+	ri.SetEnabled(3000, true)
+	ri.SetEnabled(8080, true)
+	for i := 0; i < 5; i++ {
+		ri.Record(&api.InspectedRequest{Id: strconv.Itoa(i), Port: 3000})
+	}
+	ri.Record(&api.InspectedRequest{Id: "other", Port: 8080})
+
+	var act []string
+	for _, req := range ri.List(3000) {
+		act = append(act, req.Id)
+	}
+	if diff := cmp.Diff([]string{"4", "3", "2"}, act); diff != "" {
+		t.Errorf("unexpected history (-want +got):\n%s", diff)
+	}
+	if l := len(ri.List(4000)); l != 0 {
+		t.Errorf("expected no requests for unknown port, got %d", l)
+	}
+}
+
+func TestRequestInspectorEnablement(t *testing.T) {
+	ri := NewRequestInspector()
+	ri.Record(&api.InspectedRequest{Id: "dropped", Port: 3000})
+	if l := len(ri.List(3000)); l != 0 {
+		t.Errorf("expected requests to ports without inspection to be dropped, got %d", l)
+	}
+
+	ri.SetEnabled(8080, true)
+	ri.SetEnabled(3000, true)
+	ri.Record(&api.InspectedRequest{Id: "recorded", Port: 3000})
+	if diff := cmp.Diff([]uint32{3000, 8080}, ri.Ports()); diff != "" {
+		t.Errorf("unexpected inspected ports (-want +got):\n%s", diff)
+	}
+	if l := len(ri.List(3000)); l != 1 {
+		t.Errorf("expected 1 request, got %d", l)
+	}
+
+	ri.SetEnabled(3000, false)
+	if diff := cmp.Diff([]uint32{8080}, ri.Ports()); diff != "" {
+		t.Errorf("unexpected inspected ports (-want +got):\n%s", diff)
+	}
+	if l := len(ri.List(3000)); l != 0 {
+		t.Errorf("expected disabling the inspection to drop the requests, got %d", l)
+	}
+}
+
+func TestScrubInspectedRequest(t *testing.T) {
+	req := &api.InspectedRequest{
+		Port:   3000,
+		Method: http.MethodPost,
+		Uri:    "/hook?token=abc&page=1",
+		RequestHeaders: []*api.HTTPHeader{
+			{Name: "Authorization", Values: []string{"Bearer abc"}},
+			{Name: "Content-Type", Values: []string{"application/json; charset=utf-8"}},
+			{Name: "X-Hook-Secret", Values: []string{"abc"}},
+		},
+		RequestBody: []byte(`{"password":"abc","name":"foo"}`),
+		ResponseHeaders: []*api.HTTPHeader{
+			{Name: "Content-Type", Values: []string{"application/x-www-form-urlencoded"}},
+		},
+		ResponseBody: []byte("jwt=abc&page=1"),
+	}
+	scrubInspectedRequest(req)
+
+	expectation := &api.InspectedRequest{
+		Port:   3000,
+		Method: http.MethodPost,
+		Uri:    "/hook?page=1&token=%5Bredacted%5D",
+		RequestHeaders: []*api.HTTPHeader{
+			{Name: "Authorization", Values: []string{"[redacted]"}},
+			{Name: "Content-Type", Values: []string{"application/json; charset=utf-8"}},
+			{Name: "X-Hook-Secret", Values: []string{"[redacted]"}},
+		},
+		RequestBody: []byte(`{"name":"foo","password":"[redacted]"}`),
+		ResponseHeaders: []*api.HTTPHeader{
+			{Name: "Content-Type", Values: []string{"application/x-www-form-urlencoded"}},
+		},
+		ResponseBody: []byte("jwt=%5Bredacted%5D&page=1"),
+	}
+	if diff := cmp.Diff(expectation, req, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected scrubbed request (-want +got):\n%s", diff)
+	}
+}
+
+func TestRequestInspectorReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s %s %s %s", r.Method, r.URL.RequestURI(), r.Header.Get("X-Event"), body)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.ParseUint(u.Port(), 10, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ri := NewRequestInspector()
+	ri.SetEnabled(uint32(port), true)
+	ri.Record(&api.InspectedRequest{
+		Id:     "hook",
+		Port:   uint32(port),
+		Method: http.MethodPost,
+		Uri:    "/hook?page=1",
+		RequestHeaders: []*api.HTTPHeader{
+			{Name: "X-Event", Values: []string{"push"}},
+			{Name: "Content-Length", Values: []string{"1000"}},
+		},
+		RequestBody: []byte("payload"),
+	})
+	ri.Record(&api.InspectedRequest{
+		Id:                   "truncated",
+		Port:                 uint32(port),
+		Method:               http.MethodPost,
+		Uri:                  "/hook",
+		RequestBodyTruncated: true,
+	})
+
+	res, err := ri.Replay(context.Background(), uint32(port), "hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ReplayOf != "hook" || res.StatusCode != http.StatusOK || res.Error != "" {
+		t.Errorf("unexpected replay result: %v", res)
+	}
+	if diff := cmp.Diff("POST /hook?page=1 push payload", string(res.ResponseBody)); diff != "" {
+		t.Errorf("unexpected replay response (-want +got):\n%s", diff)
+	}
+	if latest := ri.List(uint32(port))[0]; latest.Id != res.Id {
+		t.Errorf("replay was not recorded")
+	}
+
+	_, err = ri.Replay(context.Background(), uint32(port), "truncated")
+	if err != ErrInspectedRequestTruncated {
+		t.Errorf("expected replaying a truncated request to fail, got %v", err)
+	}
+	_, err = ri.Replay(context.Background(), uint32(port), "unknown")
+	if err != ErrInspectedRequestNotFound {
+		t.Errorf("expected replaying an unknown request to fail, got %v", err)
+	}
+}
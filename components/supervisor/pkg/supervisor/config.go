@@ -91,6 +91,10 @@ type StaticConfig struct {
 
 	// SSHPort is the port we run the SSH server on
 	SSHPort int `json:"sshPort"`
+
+	// DiskUsageThresholds are the percentages of the workspace storage quota at which
+	// the user is notified about the disk usage. Defaults to 80% and 95%.
+	DiskUsageThresholds []int `json:"diskUsageThresholds,omitempty"`
 }
 
 // Validate validates this configuration.
@@ -107,10 +111,25 @@ func (c StaticConfig) Validate() error {
 	if !(0 < c.SSHPort && c.SSHPort <= math.MaxUint16) {
 		return xerrors.Errorf("sshPort must be between 0 and %d", math.MaxUint16)
 	}
+	for _, t := range c.DiskUsageThresholds {
+		if !(0 < t && t <= 100) {
+			return xerrors.Errorf("diskUsageThresholds must be between 1 and 100")
+		}
+	}
 
 	return nil
 }
 
+// GetDiskUsageThresholds returns the sorted disk usage notification thresholds in percent.
+func (c StaticConfig) GetDiskUsageThresholds() []int {
+	if len(c.DiskUsageThresholds) == 0 {
+		return []int{80, 95}
+	}
+	res := slices.Clone(c.DiskUsageThresholds)
+	slices.Sort(res)
+	return res
+}
+
 // ReadinessProbeType determines the IDE readiness probe type.
 type ReadinessProbeType string
 
@@ -15,7 +15,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/serverapi"
 )
 
 const (
@@ -256,3 +259,50 @@ func isActionAllowed(action string, req *api.NotifyRequest) bool {
 	}
 	return false
 }
+
+// notifyStatusMessages shows the status messages of the running workspace to the user, e.g. that
+// the workspace will be stopped because its node is drained.
+func notifyStatusMessages(ctx context.Context, gitpodService serverapi.APIInterface, notifications *NotificationService) {
+	updates, err := gitpodService.WorkspaceUpdates(ctx)
+	if err != nil {
+		log.WithError(err).Error("cannot get workspace updates for status messages")
+		return
+	}
+
+	var last string
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case update := <-updates:
+			if update == nil {
+				return
+			}
+			msg, ok := statusMessageToNotify(last, update)
+			if !ok {
+				continue
+			}
+			last = msg
+			if msg == "" {
+				continue
+			}
+
+			_, err := notifications.Notify(ctx, &api.NotifyRequest{
+				Level:   api.NotifyRequest_WARNING,
+				Message: msg,
+			})
+			if err != nil && ctx.Err() == nil {
+				log.WithError(err).Warn("cannot notify about workspace status message")
+			}
+		}
+	}
+}
+
+// statusMessageToNotify returns the status message of a running workspace if it differs from the last one
+func statusMessageToNotify(last string, update *gitpod.WorkspaceInstance) (msg string, changed bool) {
+	if update.Status == nil || update.Status.Phase != v1.WorkspaceInstanceStatus_PHASE_RUNNING.String() {
+		return "", false
+	}
+	msg = update.Status.Message
+	return msg, msg != last
+}
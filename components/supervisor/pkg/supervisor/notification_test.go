@@ -11,8 +11,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc"
 
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 )
 
@@ -263,3 +266,52 @@ func Test(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestStatusMessageToNotify(t *testing.T) {
+	running := v1.WorkspaceInstanceStatus_PHASE_RUNNING.String()
+	tests := []struct {
+		Name        string
+		Last        string
+		Update      *gitpod.WorkspaceInstance
+		Expectation string
+		Changed     bool
+	}{
+		{
+			Name:   "no status",
+			Update: &gitpod.WorkspaceInstance{},
+		},
+		{
+			Name:   "not running",
+			Update: &gitpod.WorkspaceInstance{Status: &gitpod.WorkspaceInstanceStatus{Phase: v1.WorkspaceInstanceStatus_PHASE_STOPPING.String(), Message: "stopping"}},
+		},
+		{
+			Name:        "new message",
+			Update:      &gitpod.WorkspaceInstance{Status: &gitpod.WorkspaceInstanceStatus{Phase: running, Message: "node is drained"}},
+			Expectation: "node is drained",
+			Changed:     true,
+		},
+		{
+			Name:        "same message",
+			Last:        "node is drained",
+			Update:      &gitpod.WorkspaceInstance{Status: &gitpod.WorkspaceInstanceStatus{Phase: running, Message: "node is drained"}},
+			Expectation: "node is drained",
+		},
+		{
+			Name:    "message cleared",
+			Last:    "node is drained",
+			Update:  &gitpod.WorkspaceInstance{Status: &gitpod.WorkspaceInstanceStatus{Phase: running}},
+			Changed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			msg, changed := statusMessageToNotify(test.Last, test.Update)
+			if diff := cmp.Diff(test.Expectation, msg); diff != "" {
+				t.Errorf("unexpected message (-want +got):\n%s", diff)
+			}
+			if changed != test.Changed {
+				t.Errorf("unexpected changed: want %v, got %v", test.Changed, changed)
+			}
+		})
+	}
+}
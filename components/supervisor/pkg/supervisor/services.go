@@ -922,6 +922,7 @@ func (state *InMemoryContentState) ContentSource() (src csapi.WorkspaceInitSourc
 
 type portService struct {
 	portsManager *ports.Manager
+	inspector    *ports.RequestInspector
 
 	api.UnimplementedPortServiceServer
 }
@@ -1032,9 +1033,53 @@ func (s *portService) RetryAutoExpose(ctx context.Context, req *api.RetryAutoExp
 	return &api.RetryAutoExposeResponse{}, nil
 }
 
+// SetPortInspection enables or disables recording the HTTP requests to a port.
+func (s *portService) SetPortInspection(ctx context.Context, req *api.SetPortInspectionRequest) (*api.SetPortInspectionResponse, error) {
+	if req.Port == 0 {
+		return nil, status.Error(codes.InvalidArgument, "port is required")
+	}
+	s.inspector.SetEnabled(req.Port, req.Enabled)
+	return &api.SetPortInspectionResponse{}, nil
+}
+
+// ListInspectedPorts lists the ports whose HTTP requests are recorded.
+func (s *portService) ListInspectedPorts(ctx context.Context, req *api.ListInspectedPortsRequest) (*api.ListInspectedPortsResponse, error) {
+	return &api.ListInspectedPortsResponse{Ports: s.inspector.Ports()}, nil
+}
+
+// RecordInspectedRequest records an HTTP request which ws-proxy forwarded to a port.
+// Requests to ports without inspection enabled are dropped.
+func (s *portService) RecordInspectedRequest(ctx context.Context, req *api.RecordInspectedRequestRequest) (*api.RecordInspectedRequestResponse, error) {
+	if req.Request == nil || req.Request.Port == 0 {
+		return nil, status.Error(codes.InvalidArgument, "request and port are required")
+	}
+	s.inspector.Record(req.Request)
+	return &api.RecordInspectedRequestResponse{}, nil
+}
+
+// ListInspectedRequests lists the recently recorded HTTP requests of a port.
+func (s *portService) ListInspectedRequests(ctx context.Context, req *api.ListInspectedRequestsRequest) (*api.ListInspectedRequestsResponse, error) {
+	return &api.ListInspectedRequestsResponse{Requests: s.inspector.List(req.Port)}, nil
+}
+
+// ReplayInspectedRequest sends a recorded HTTP request to the port again.
+func (s *portService) ReplayInspectedRequest(ctx context.Context, req *api.ReplayInspectedRequestRequest) (*api.ReplayInspectedRequestResponse, error) {
+	res, err := s.inspector.Replay(ctx, req.Port, req.Id)
+	if errors.Is(err, ports.ErrInspectedRequestNotFound) {
+		return nil, status.Errorf(codes.NotFound, "request %s of port %d not found", req.Id, req.Port)
+	}
+	if errors.Is(err, ports.ErrInspectedRequestTruncated) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot replay request %s: %v", req.Id, err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ReplayInspectedRequestResponse{Request: res}, nil
+}
+
 // ResourcesStatus provides workspace resources status information.
 func (s *statusService) ResourcesStatus(ctx context.Context, in *api.ResourcesStatuRequest) (*api.ResourcesStatusResponse, error) {
-	return s.topService.data, nil
+	return s.topService.Data(), nil
 }
 
 type taskService struct {
@@ -52,6 +52,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/experiments"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/pprof"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/executor"
 	"github.com/gitpod-io/gitpod/content-service/pkg/git"
@@ -159,6 +160,7 @@ var childProcEnvvars []string
 
 // Run serves as main entrypoint to the supervisor.
 func Run(options ...RunOption) {
+	startTime := time.Now()
 	exitCode := 0
 	defer handleExit(&exitCode)
 	defer func() {
@@ -214,6 +216,12 @@ func Run(options ...RunOption) {
 	telemetry := analytics.NewFromEnvironment()
 	defer telemetry.Close()
 
+	if !opts.RunGP {
+		if closer := tracing.Init("supervisor"); closer != nil {
+			defer closer.Close()
+		}
+	}
+
 	tokenService := NewInMemoryTokenService()
 
 	if !opts.RunGP {
@@ -287,6 +295,9 @@ func Run(options ...RunOption) {
 	if !cfg.isHeadless() && !opts.RunGP && !cfg.isDebugWorkspace() {
 		go trackReadiness(ctx, telemetry, cfg, cstate, ideReady, desktopIdeReady)
 	}
+	if !opts.RunGP {
+		go traceStartup(ctx, cfg, startTime, cstate, ideReady, desktopIdeReady)
+	}
 	tokenService.provider[KindGit] = []tokenProvider{NewGitTokenProvider(gitpodService, cfg.WorkspaceConfig, notificationService)}
 
 	gitpodConfigService := config.NewConfigService(cfg.RepoRoot+"/.gitpod.yml", cstate.ContentReady())
@@ -308,9 +319,13 @@ func Run(options ...RunOption) {
 		internalPorts...,
 	)
 
-	topService := NewTopService()
+	topService := NewTopService(cfg.GetDiskUsageThresholds())
 	if !opts.RunGP {
 		topService.Observe(ctx)
+		go notifyDiskUsage(ctx, cfg.GetDiskUsageThresholds(), topService, notificationService)
+	}
+	if !cfg.isHeadless() && !opts.RunGP {
+		go notifyStatusMessages(ctx, gitpodService, notificationService)
 	}
 
 	if !cfg.isHeadless() && !opts.RunGP {
@@ -390,7 +405,7 @@ func Run(options ...RunOption) {
 		notificationService,
 		NewInfoService(cfg, cstate, gitpodService),
 		&ControlService{portsManager: portMgmt},
-		&portService{portsManager: portMgmt},
+		&portService{portsManager: portMgmt, inspector: ports.NewRequestInspector()},
 		&taskService{
 			wg:              taskServiceWg,
 			tasksManager:    taskManager,
@@ -1285,6 +1300,9 @@ func isBlacklistedEnvvar(name string) bool {
 	prefixBlacklist := []string{
 		"THEIA_SUPERVISOR_",
 		"GITPOD_TOKENS",
+		// The workspace startup trace context is meant for supervisor only, not for user processes.
+		"TRACEPARENT",
+		"TRACESTATE",
 		// The following vars are meant to filter out the kubernetes-injected env vars that we do not know how to turn of (yet)
 		"KUBERNETES_SERVICE",
 		"KUBERNETES_PORT",
@@ -1478,7 +1496,7 @@ func startAPIEndpoint(
 		_, err = io.Copy(conn2, conn)
 		if err != nil {
 			var usedCpu, usedMemory int64
-			data := topService.data
+			data := topService.Data()
 			if data != nil && data.Cpu != nil {
 				usedCpu = data.Cpu.Used
 			}
@@ -1787,7 +1805,7 @@ func analysePerfChanges(ctx context.Context, wscfg *Config, w analytics.Writer,
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			data := topService.data
+			data := topService.Data()
 			if data == nil {
 				continue
 			}
@@ -1923,6 +1941,39 @@ func trackReadiness(ctx context.Context, w analytics.Writer, cfg *Config, cstate
 	}
 }
 
+// traceStartup adds the supervisor's view of the workspace startup to the startup trace
+// ws-manager passed on using the TRACEPARENT environment variable.
+func traceStartup(ctx context.Context, cfg *Config, start time.Time, cstate *InMemoryContentState, ideReady *ideReadyState, desktopIdeReady *ideReadyState) {
+	if os.Getenv(tracing.TraceParentEnvVar) == "" {
+		return
+	}
+
+	attrs := tracing.OWIAttributes(log.OWI(cfg.OwnerId, cfg.WorkspaceID, cfg.WorkspaceInstanceID))
+	ctx, span := tracing.StartSpan(tracing.ExtractEnv(ctx), "supervisor.startup", attrs...)
+	defer span.End()
+
+	var wg sync.WaitGroup
+	waitFor := func(name string, ready <-chan struct{}) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case <-ready:
+				tracing.RecordSpan(ctx, name, start, time.Now(), nil, attrs...)
+			case <-ctx.Done():
+			}
+		}()
+	}
+	waitFor("supervisor.content_ready", cstate.ContentReady())
+	if !cfg.isHeadless() {
+		waitFor("supervisor.ide_ready", ideReady.Wait())
+		if cfg.GetDesktopIDE() != nil {
+			waitFor("supervisor.desktop_ide_ready", desktopIdeReady.Wait())
+		}
+	}
+	wg.Wait()
+}
+
 func runAsGitpodUser(cmd *exec.Cmd) *exec.Cmd {
 	if cmd.SysProcAttr == nil {
 		cmd.SysProcAttr = &syscall.SysProcAttr{}
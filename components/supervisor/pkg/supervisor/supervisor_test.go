@@ -148,6 +148,7 @@ func TestIsBlacklistedEnvvar(t *testing.T) {
 		{Name: "deprecated theia envvars", Input: "THEIA_SUPERVISOR_FOOBAR", Expectation: true},
 		{Name: "gitpod tokens", Input: "GITPOD_TOKENS", Expectation: true},
 		{Name: "gitpod tokens child", Input: "GITPOD_TOKENS_GITHUB", Expectation: true},
+		{Name: "startup trace context", Input: "TRACEPARENT", Expectation: true},
 		{Name: "kubernetes services", Input: "KUBERNETES_SERVICE_FOOBAR", Expectation: true},
 		{Name: "kubernetes service ports", Input: "KUBERNETES_PORT_FOOBAR", Expectation: true},
 		{Name: "something with spaces", Input: "   I_DO_NOT_UNDERSTAND", Expectation: true},
@@ -18,7 +18,12 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/process"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	"github.com/gitpod-io/gitpod/supervisor/api"
@@ -276,9 +281,13 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 		}
 		taskLog := log.WithField("command", t.command)
 		taskLog.Info("starting a task terminal...")
-		openRequest := &api.OpenTerminalRequest{}
+		openRequest := &api.OpenTerminalRequest{
+			Env: map[string]string{
+				// marks the task processes so that ws-daemon can count them as machine activity
+				process.TaskIDEnvVar: t.Id,
+			},
+		}
 		if t.config.Env != nil {
-			openRequest.Env = make(map[string]string, len(*t.config.Env))
 			for key, value := range *t.config.Env {
 				// Required check because a string is considered valid JSON (e.g. "hello")
 				// We don't want to marshall basic strings otherwise we get a double quoted environment variable
@@ -324,6 +333,7 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 		})
 
 		taskWatchWg := &sync.WaitGroup{}
+		taskStart := time.Now()
 
 		go func(t *task, term *terminal.Term) {
 			state, err := term.Wait()
@@ -331,25 +341,28 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 			taskWatchWg.Wait()
 			taskLog.Info("watch() has finished, setting task state to closed")
 
+			var result taskSuccess
 			if term.ForceSuccess {
 				// Simulate state.Success()
-				t.successChan <- taskSuccessful
+				result = taskSuccessful
 			} else if state != nil {
 				if state.Success() {
-					t.successChan <- taskSuccessful
+					result = taskSuccessful
 				} else {
-					t.successChan <- taskFailed(state.String())
+					result = taskFailed(state.String())
 				}
 			} else if err != nil {
-				t.successChan <- taskSuccessful
+				result = taskSuccessful
 			} else {
 				msg := "cannot wait for task"
 				if err != nil {
 					msg = err.Error()
 				}
 
-				t.successChan <- taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
+				result = taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
 			}
+			traceTask(t, taskStart, result)
+			t.successChan <- result
 			tm.setTaskState(t, api.TaskState_closed)
 		}(t, term)
 
@@ -378,6 +391,24 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 	successChan <- success
 }
 
+// traceTask adds the execution of a task to the workspace startup trace ws-manager passed on
+// using the TRACEPARENT environment variable.
+func traceTask(t *task, start time.Time, result taskSuccess) {
+	if os.Getenv(tracing.TraceParentEnvVar) == "" {
+		return
+	}
+
+	var err error
+	if result.Failed() {
+		err = xerrors.New(string(result))
+	}
+	attrs := []attribute.KeyValue{attribute.String("task.id", t.Id)}
+	if t.config.Name != nil {
+		attrs = append(attrs, attribute.String("task.name", *t.config.Name))
+	}
+	tracing.RecordSpan(tracing.ExtractEnv(context.Background()), "supervisor.task", start, time.Now(), err, attrs...)
+}
+
 func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csapi.WorkspaceInitSource, storeLocation string) string {
 	commands := getCommands(task, isPrebuild, contentSource, storeLocation)
 	command := composeCommand(composeCommandOptions{
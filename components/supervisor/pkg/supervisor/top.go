@@ -6,7 +6,9 @@ package supervisor
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -25,19 +27,31 @@ import (
 )
 
 type TopService struct {
+	mu        sync.RWMutex
 	data      *api.ResourcesStatusResponse
 	ready     chan struct{}
 	readyOnce sync.Once
 	top       func(ctx context.Context) (*api.ResourcesStatusResponse, error)
+
+	// diskUsageThresholds are the sorted disk usage thresholds in percent which determine the disk severity
+	diskUsageThresholds []int
 }
 
-func NewTopService() *TopService {
+func NewTopService(diskUsageThresholds []int) *TopService {
 	log.Debug("gitpod top service: initialized")
 	return &TopService{
-		top: Top,
+		top:                 Top,
+		diskUsageThresholds: diskUsageThresholds,
 	}
 }
 
+// Data returns the last observed resource status, or nil if none was observed yet
+func (t *TopService) Data() *api.ResourcesStatusResponse {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.data
+}
+
 // Observe starts observing the resource status
 func (t *TopService) Observe(ctx context.Context) {
 	var (
@@ -54,7 +68,12 @@ func (t *TopService) Observe(ctx context.Context) {
 			data, err := t.top(ctx)
 			if err == nil {
 				delay = minReconnectionDelay
+				if data.Disk != nil {
+					data.Disk.Severity = calcDiskSeverity(diskUsagePercentage(data.Disk.Used, data.Disk.Limit), t.diskUsageThresholds)
+				}
+				t.mu.Lock()
 				t.data = data
+				t.mu.Unlock()
 
 				t.readyOnce.Do(func() {
 					close(t.ready)
@@ -87,6 +106,21 @@ func calcSeverity(value int64) api.ResourceStatusSeverity {
 	}
 }
 
+// calcDiskSeverity determines the disk severity from the sorted disk usage thresholds: reaching the highest
+// threshold is dangerous, reaching any other one is a warning.
+func calcDiskSeverity(percentage int64, thresholds []int) api.ResourceStatusSeverity {
+	switch {
+	case len(thresholds) == 0:
+		return calcSeverity(percentage)
+	case percentage >= int64(thresholds[len(thresholds)-1]):
+		return api.ResourceStatusSeverity_danger
+	case percentage >= int64(thresholds[0]):
+		return api.ResourceStatusSeverity_warning
+	default:
+		return api.ResourceStatusSeverity_normal
+	}
+}
+
 // Top provides workspace resources status information.
 func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 	const socketFN = "/.supervisor/info.sock"
@@ -127,6 +161,14 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		cpuPercentage := int64((float64(resp.Resources.Cpu.Used) / float64(resp.Resources.Cpu.Limit)) * 100)
 		memoryPercentage := int64((float64(resp.Resources.Memory.Used) / float64(resp.Resources.Memory.Limit)) * 100)
 
+		var disk *api.ResourceStatus
+		if d := resp.Resources.Disk; d != nil && d.Limit > 0 {
+			disk = &api.ResourceStatus{
+				Limit: d.Limit,
+				Used:  d.Used,
+			}
+		}
+
 		return &api.ResourcesStatusResponse{
 			Memory: &api.ResourceStatus{
 				Limit:    resp.Resources.Memory.Limit,
@@ -138,10 +180,95 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 				Used:     resp.Resources.Cpu.Used,
 				Severity: calcSeverity(cpuPercentage),
 			},
+			Disk: disk,
 		}, nil
 	}
 }
 
+func diskUsagePercentage(used, limit int64) int64 {
+	if limit <= 0 {
+		return 0
+	}
+	return int64((float64(used) / float64(limit)) * 100)
+}
+
+// diskUsageAnalyzer tracks which disk usage threshold the user was last notified about.
+type diskUsageAnalyzer struct {
+	// thresholds are the sorted notification thresholds in percent
+	thresholds []int
+	// notified is the number of thresholds the user has been notified about
+	notified int
+}
+
+// analyze returns the threshold which was newly crossed by the given usage, if any.
+// Once usage drops below a threshold, crossing it again results in another notification.
+func (a *diskUsageAnalyzer) analyze(percentage int64) (threshold int, crossed bool) {
+	var reached int
+	for _, t := range a.thresholds {
+		if percentage < int64(t) {
+			break
+		}
+		reached++
+	}
+
+	crossed = reached > a.notified
+	a.notified = reached
+	if !crossed {
+		return 0, false
+	}
+	return a.thresholds[reached-1], true
+}
+
+// notifyDiskUsage notifies the user whenever the workspace disk usage crosses one of the configured thresholds.
+func notifyDiskUsage(ctx context.Context, thresholds []int, topService *TopService, notifications *NotificationService) {
+	analyzer := &diskUsageAnalyzer{thresholds: thresholds}
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			data := topService.Data()
+			if data == nil || data.Disk == nil {
+				continue
+			}
+
+			percentage := diskUsagePercentage(data.Disk.Used, data.Disk.Limit)
+			threshold, crossed := analyzer.analyze(percentage)
+			if !crossed {
+				continue
+			}
+
+			level := api.NotifyRequest_WARNING
+			if threshold == thresholds[len(thresholds)-1] {
+				level = api.NotifyRequest_ERROR
+			}
+			log.WithField("used", data.Disk.Used).WithField("limit", data.Disk.Limit).WithField("threshold", threshold).Info("workspace disk usage crossed threshold")
+			_, err := notifications.Notify(ctx, &api.NotifyRequest{
+				Level: level,
+				Message: fmt.Sprintf("Your workspace uses %d%% of its %s storage quota. Writes will fail once the quota is exhausted. Run `gp du` to find the largest directories.",
+					percentage, formatQuota(data.Disk.Limit)),
+			})
+			if err != nil && ctx.Err() == nil {
+				log.WithError(err).Warn("cannot notify about disk usage")
+			}
+		}
+	}
+}
+
+// formatQuota formats a storage quota in bytes using binary units, e.g. 30Gi or 512Mi
+func formatQuota(bytes int64) string {
+	units := []string{"Gi", "Mi", "Ki"}
+	for i, unit := range units {
+		size := int64(1) << (10 * (len(units) - i))
+		if bytes >= size {
+			return strconv.FormatFloat(math.Round(float64(bytes)/float64(size)*10)/10, 'f', -1, 64) + unit
+		}
+	}
+	return strconv.FormatInt(bytes, 10) + "B"
+}
+
 func resolveMemoryStatus() (*api.ResourceStatus, error) {
 	memory := cgroups.NewMemoryController("/sys/fs/cgroup")
 
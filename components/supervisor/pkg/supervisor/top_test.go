@@ -22,18 +22,18 @@ func TestTopServiceHappyPath(t *testing.T) {
 	}
 	ctx := context.Background()
 
-	topService := NewTopService()
+	topService := NewTopService(nil)
 	topService.Observe(ctx)
 
 	<-topService.ready
 
-	if topService.data == nil {
+	if topService.Data() == nil {
 		t.Errorf("topService data should not be nil")
 	}
-	if topService.data.Memory == nil {
+	if topService.Data().Memory == nil {
 		t.Errorf("Memory should not be nil")
 	}
-	if topService.data.Cpu == nil {
+	if topService.Data().Cpu == nil {
 		t.Errorf("CPU should not be nil")
 	}
 }
@@ -43,7 +43,7 @@ func TestTopServiceWithUpstreamFailure(t *testing.T) {
 
 	var isFirstRun = true
 
-	topService := NewTopService()
+	topService := NewTopService(nil)
 	topService.top = func(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		if isFirstRun {
 			isFirstRun = false
@@ -64,31 +64,122 @@ func TestTopServiceWithUpstreamFailure(t *testing.T) {
 	topService.Observe(ctx)
 	<-topService.ready
 
-	if topService.data.Memory.Used != 1 {
+	if topService.Data().Memory.Used != 1 {
 		t.Errorf("Used Memory should be 1")
 	}
-	if topService.data.Memory.Limit != 10 {
+	if topService.Data().Memory.Limit != 10 {
 		t.Errorf("Total Memory should be 10")
 	}
-	if topService.data.Cpu.Used != 2 {
+	if topService.Data().Cpu.Used != 2 {
 		t.Errorf("Used Cpu should be 2")
 	}
-	if topService.data.Cpu.Limit != 5 {
+	if topService.Data().Cpu.Limit != 5 {
 		t.Errorf("Total Cpu should be 5")
 	}
 
 	time.Sleep(2 * time.Second)
 
-	if topService.data.Memory.Used != 1 {
+	if topService.Data().Memory.Used != 1 {
 		t.Errorf("Used Memory should be 1")
 	}
-	if topService.data.Memory.Limit != 10 {
+	if topService.Data().Memory.Limit != 10 {
 		t.Errorf("Total Memory should be 10")
 	}
-	if topService.data.Cpu.Used != 2 {
+	if topService.Data().Cpu.Used != 2 {
 		t.Errorf("Used Cpu should be 2")
 	}
-	if topService.data.Cpu.Limit != 5 {
+	if topService.Data().Cpu.Limit != 5 {
 		t.Errorf("Total Cpu should be 5")
 	}
 }
+
+func TestDiskUsageAnalyzer(t *testing.T) {
+	type step struct {
+		Percentage int64
+		Threshold  int
+		Crossed    bool
+	}
+	tests := []struct {
+		Name  string
+		Steps []step
+	}{
+		{
+			Name: "below thresholds",
+			Steps: []step{
+				{Percentage: 10},
+				{Percentage: 79},
+			},
+		},
+		{
+			Name: "crossing thresholds once",
+			Steps: []step{
+				{Percentage: 80, Threshold: 80, Crossed: true},
+				{Percentage: 85},
+				{Percentage: 96, Threshold: 95, Crossed: true},
+				{Percentage: 100},
+			},
+		},
+		{
+			Name: "skipping thresholds",
+			Steps: []step{
+				{Percentage: 97, Threshold: 95, Crossed: true},
+				{Percentage: 90},
+			},
+		},
+		{
+			Name: "renotify after dropping below",
+			Steps: []step{
+				{Percentage: 81, Threshold: 80, Crossed: true},
+				{Percentage: 50},
+				{Percentage: 82, Threshold: 80, Crossed: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			analyzer := &diskUsageAnalyzer{thresholds: []int{80, 95}}
+			for i, s := range test.Steps {
+				threshold, crossed := analyzer.analyze(s.Percentage)
+				if threshold != s.Threshold || crossed != s.Crossed {
+					t.Errorf("step %d (%d%%): got (%d, %v), expected (%d, %v)", i, s.Percentage, threshold, crossed, s.Threshold, s.Crossed)
+				}
+			}
+		})
+	}
+}
+
+func TestCalcDiskSeverity(t *testing.T) {
+	tests := []struct {
+		Percentage  int64
+		Thresholds  []int
+		Expectation api.ResourceStatusSeverity
+	}{
+		{Percentage: 50, Thresholds: []int{60, 70, 90}, Expectation: api.ResourceStatusSeverity_normal},
+		{Percentage: 60, Thresholds: []int{60, 70, 90}, Expectation: api.ResourceStatusSeverity_warning},
+		{Percentage: 89, Thresholds: []int{60, 70, 90}, Expectation: api.ResourceStatusSeverity_warning},
+		{Percentage: 90, Thresholds: []int{60, 70, 90}, Expectation: api.ResourceStatusSeverity_danger},
+		{Percentage: 85, Expectation: api.ResourceStatusSeverity_warning},
+	}
+	for _, test := range tests {
+		act := calcDiskSeverity(test.Percentage, test.Thresholds)
+		if act != test.Expectation {
+			t.Errorf("calcDiskSeverity(%d, %v): got %v, expected %v", test.Percentage, test.Thresholds, act, test.Expectation)
+		}
+	}
+}
+
+func TestFormatQuota(t *testing.T) {
+	tests := map[int64]string{
+		30 * 1024 * 1024 * 1024: "30Gi",
+		1536 * 1024 * 1024:      "1.5Gi",
+		512 * 1024 * 1024:       "512Mi",
+		2048:                    "2Ki",
+		100:                     "100B",
+	}
+	for bytes, expectation := range tests {
+		if act := formatQuota(bytes); act != expectation {
+			t.Errorf("formatQuota(%d): got %s, expected %s", bytes, act, expectation)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package activity
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cgroups_v2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// Config configures the machine activity reporting
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Interval is the period over which the CPU usage of a workspace is averaged
+	Interval util.Duration `json:"interval"`
+	// ProcLocation is the location of the node's proc filesystem, which is used to find task processes
+	// and their network connections. Defaults to /proc.
+	ProcLocation string `json:"procLocation,omitempty"`
+}
+
+// NewMachineActivityReporter creates a dispatch listener which reports sustained compute, running task
+// processes and their network connections in workspaces as machine activity on the workspace resource.
+func NewMachineActivityReporter(cfg Config, cgroupBasePath, namespace string, clnt client.Client, prom prometheus.Registerer) (*MachineActivityReporter, error) {
+	if time.Duration(cfg.Interval) <= 0 {
+		return nil, xerrors.Errorf("machine activity interval must be positive")
+	}
+	if cfg.ProcLocation == "" {
+		cfg.ProcLocation = "/proc"
+	}
+
+	r := &MachineActivityReporter{
+		Config:         cfg,
+		CGroupBasePath: cgroupBasePath,
+		Namespace:      namespace,
+		Client:         clnt,
+
+		reportedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "machine_activity_reported_total",
+			Help: "Number of times machine activity was reported for a workspace",
+		}, []string{"success"}),
+	}
+	err := prom.Register(r.reportedTotal)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MachineActivityReporter samples the workload of workspaces and marks them as active
+// if it matches the machine activity configured on the workspace.
+type MachineActivityReporter struct {
+	Config         Config
+	CGroupBasePath string
+	Namespace      string
+	Client         client.Client
+
+	reportedTotal *prometheus.CounterVec
+}
+
+var _ dispatch.Listener = &MachineActivityReporter{}
+
+// WorkspaceAdded starts sampling the CPU usage of a workspace until the workspace is gone
+func (r *MachineActivityReporter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return xerrors.Errorf("no dispatch available")
+	}
+
+	cgroupPath, err := disp.Runtime.ContainerCGroupPath(ctx, ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot get cgroup path for container %s: %w", ws.ContainerID, err)
+	}
+
+	cpu := cgroups_v2.NewCpuControllerWithMount(r.CGroupBasePath, cgroupPath)
+	go r.observe(ctx, ws, cpu, filepath.Join(r.CGroupBasePath, cgroupPath))
+
+	return nil
+}
+
+func (r *MachineActivityReporter) observe(ctx context.Context, ws *dispatch.Workspace, cpu *cgroups_v2.Cpu, cgroupPath string) {
+	interval := time.Duration(r.Config.Interval)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	var last *cpuSample
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		stat, err := cpu.Stat()
+		if err != nil {
+			log.WithError(err).WithFields(ws.OWI()).Debug("cannot read cpu stats")
+			continue
+		}
+		sample := &cpuSample{UsageUsec: stat.UsageTotal, Time: time.Now()}
+		prev := last
+		last = sample
+		if prev == nil {
+			continue
+		}
+
+		err = r.report(ctx, ws, averageMillicores(*prev, *sample), cgroupPath)
+		if err != nil && ctx.Err() == nil {
+			log.WithError(err).WithFields(ws.OWI()).Warn("cannot report machine activity")
+		}
+	}
+}
+
+func (r *MachineActivityReporter) report(ctx context.Context, ws *dispatch.Workspace, millicores int64, cgroupPath string) error {
+	var (
+		reported bool
+		name     = types.NamespacedName{Namespace: r.Namespace, Name: ws.InstanceID}
+	)
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var obj workspacev1.Workspace
+		err := r.Client.Get(ctx, name, &obj)
+		if err != nil {
+			return err
+		}
+		spec := obj.Spec.Timeout.MachineActivity
+		if !isMachineActive(spec, r.sampleWorkload(ws, spec, millicores, cgroupPath)) {
+			return nil
+		}
+
+		now := metav1.Now()
+		obj.Status.LastMachineActivity = &now
+		reported = true
+		return r.Client.Status().Update(ctx, &obj)
+	})
+	if !reported {
+		return err
+	}
+	if err != nil {
+		r.reportedTotal.WithLabelValues("false").Inc()
+		return err
+	}
+	r.reportedTotal.WithLabelValues("true").Inc()
+	return nil
+}
+
+type cpuSample struct {
+	UsageUsec uint64
+	Time      time.Time
+}
+
+// averageMillicores computes the average CPU usage in millicores between two samples
+func averageMillicores(prev, cur cpuSample) int64 {
+	dt := cur.Time.Sub(prev.Time).Microseconds()
+	if dt <= 0 || cur.UsageUsec < prev.UsageUsec {
+		return 0
+	}
+	return int64(cur.UsageUsec-prev.UsageUsec) * 1000 / dt
+}
+
+// workload is the workload observed in a workspace during one interval
+type workload struct {
+	Millicores int64
+	// TaskProcesses is the number of processes running in task terminals, not counting the terminal shells
+	TaskProcesses int
+	// Connections is the number of established TCP connections of task processes, not counting those to localhost
+	Connections int
+}
+
+// sampleWorkload determines the workload of a workspace. It only looks at the task processes if the spec requires it.
+func (r *MachineActivityReporter) sampleWorkload(ws *dispatch.Workspace, spec *workspacev1.MachineActivitySpec, millicores int64, cgroupPath string) workload {
+	res := workload{Millicores: millicores}
+	if spec == nil || (!spec.TaskProcesses && !spec.NetworkConnections) {
+		return res
+	}
+
+	pids, err := cgroupProcesses(cgroupPath)
+	if err != nil {
+		log.WithError(err).WithFields(ws.OWI()).Debug("cannot list workspace processes")
+		return res
+	}
+	shells, tasks := taskProcesses(r.Config.ProcLocation, pids)
+	res.TaskProcesses = len(tasks)
+	if spec.NetworkConnections {
+		res.Connections, err = establishedConnections(r.Config.ProcLocation, append(shells, tasks...))
+		if err != nil {
+			log.WithError(err).WithFields(ws.OWI()).Debug("cannot count network connections")
+		}
+	}
+	return res
+}
+
+// isMachineActive returns true if machine activity is enabled for the workspace and
+// the workload matches one of the configured signals.
+func isMachineActive(spec *workspacev1.MachineActivitySpec, w workload) bool {
+	if spec == nil {
+		return false
+	}
+	switch {
+	case spec.CPUMillicores > 0 && w.Millicores >= spec.CPUMillicores:
+		return true
+	case spec.TaskProcesses && w.TaskProcesses > 0:
+		return true
+	case spec.NetworkConnections && w.Connections > 0:
+		return true
+	default:
+		return false
+	}
+}
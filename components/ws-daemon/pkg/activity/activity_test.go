@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package activity
+
+import (
+	"testing"
+	"time"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestAverageMillicores(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		Name        string
+		Prev        cpuSample
+		Cur         cpuSample
+		Expectation int64
+	}{
+		{"idle", cpuSample{UsageUsec: 100, Time: now}, cpuSample{UsageUsec: 100, Time: now.Add(time.Second)}, 0},
+		{"half a core", cpuSample{UsageUsec: 0, Time: now}, cpuSample{UsageUsec: 5_000_000, Time: now.Add(10 * time.Second)}, 500},
+		{"two cores", cpuSample{UsageUsec: 1_000_000, Time: now}, cpuSample{UsageUsec: 3_000_000, Time: now.Add(time.Second)}, 2000},
+		{"counter reset", cpuSample{UsageUsec: 1_000_000, Time: now}, cpuSample{UsageUsec: 0, Time: now.Add(time.Second)}, 0},
+		{"no time passed", cpuSample{UsageUsec: 0, Time: now}, cpuSample{UsageUsec: 1_000_000, Time: now}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := averageMillicores(test.Prev, test.Cur)
+			if act != test.Expectation {
+				t.Errorf("unexpected averageMillicores: %d, expected %d", act, test.Expectation)
+			}
+		})
+	}
+}
+
+func TestIsMachineActive(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Spec        *workspacev1.MachineActivitySpec
+		Workload    workload
+		Expectation bool
+	}{
+		{"disabled", nil, workload{Millicores: 4000, TaskProcesses: 1, Connections: 1}, false},
+		{"below threshold", &workspacev1.MachineActivitySpec{CPUMillicores: 500}, workload{Millicores: 499}, false},
+		{"at threshold", &workspacev1.MachineActivitySpec{CPUMillicores: 500}, workload{Millicores: 500}, true},
+		{"above threshold", &workspacev1.MachineActivitySpec{CPUMillicores: 500}, workload{Millicores: 2000}, true},
+		{"cpu not enabled", &workspacev1.MachineActivitySpec{TaskProcesses: true}, workload{Millicores: 2000}, false},
+		{"task processes", &workspacev1.MachineActivitySpec{TaskProcesses: true}, workload{TaskProcesses: 2}, true},
+		{"task processes not enabled", &workspacev1.MachineActivitySpec{CPUMillicores: 500}, workload{TaskProcesses: 2}, false},
+		{"network connections", &workspacev1.MachineActivitySpec{NetworkConnections: true}, workload{TaskProcesses: 1, Connections: 1}, true},
+		{"no network connections", &workspacev1.MachineActivitySpec{NetworkConnections: true}, workload{TaskProcesses: 1}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := isMachineActive(test.Spec, test.Workload)
+			if act != test.Expectation {
+				t.Errorf("unexpected isMachineActive: %v, expected %v", act, test.Expectation)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package activity
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/process"
+)
+
+// tcpEstablished is the state of established connections in /proc/net/tcp
+const tcpEstablished = "01"
+
+// cgroupProcesses lists the PIDs of all processes in a cgroup and its children
+func cgroupProcesses(cgroupPath string) ([]int, error) {
+	var res []int
+	err := filepath.WalkDir(cgroupPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// cgroups of processes which exited in the meantime are removed while we walk
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || d.Name() != "cgroup.procs" {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Fields(string(content)) {
+			pid, err := strconv.Atoi(line)
+			if err != nil {
+				return xerrors.Errorf("invalid pid %q in %s", line, path)
+			}
+			res = append(res, pid)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// taskProcesses finds the processes which supervisor started in task terminals. Shells are the terminal
+// shells themselves, tasks all processes started from them. Processes which exit while we look are skipped.
+func taskProcesses(procLocation string, pids []int) (shells, tasks []int) {
+	marked := make(map[int]struct{}, len(pids))
+	for _, pid := range pids {
+		environ, err := os.ReadFile(filepath.Join(procLocation, strconv.Itoa(pid), "environ"))
+		if err != nil {
+			continue
+		}
+		if hasTaskMarker(environ) {
+			marked[pid] = struct{}{}
+		}
+	}
+
+	for pid := range marked {
+		ppid, err := parentPID(procLocation, pid)
+		if err != nil {
+			continue
+		}
+		if _, ok := marked[ppid]; ok {
+			tasks = append(tasks, pid)
+		} else {
+			shells = append(shells, pid)
+		}
+	}
+	return shells, tasks
+}
+
+func hasTaskMarker(environ []byte) bool {
+	prefix := []byte(process.TaskIDEnvVar + "=")
+	for _, env := range bytes.Split(environ, []byte{0}) {
+		if bytes.HasPrefix(env, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parentPID reads the parent PID of a process from /proc/<pid>/stat
+func parentPID(procLocation string, pid int) (int, error) {
+	stat, err := os.ReadFile(filepath.Join(procLocation, strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return 0, err
+	}
+	// the command name is in parentheses and may contain spaces, hence we parse the fields after it
+	idx := bytes.LastIndexByte(stat, ')')
+	if idx < 0 {
+		return 0, xerrors.Errorf("cannot parse stat of process %d", pid)
+	}
+	fields := strings.Fields(string(stat[idx+1:]))
+	if len(fields) < 2 {
+		return 0, xerrors.Errorf("cannot parse stat of process %d", pid)
+	}
+	return strconv.Atoi(fields[1])
+}
+
+// establishedConnections counts the established TCP connections owned by the given processes, ignoring
+// connections to localhost. All processes must share the network namespace of the first one.
+func establishedConnections(procLocation string, pids []int) (int, error) {
+	if len(pids) == 0 {
+		return 0, nil
+	}
+
+	inodes := make(map[string]struct{})
+	for _, pid := range pids {
+		fds, err := os.ReadDir(filepath.Join(procLocation, strconv.Itoa(pid), "fd"))
+		if err != nil {
+			continue
+		}
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(procLocation, strconv.Itoa(pid), "fd", fd.Name()))
+			if err != nil {
+				continue
+			}
+			if inode, ok := strings.CutPrefix(target, "socket:["); ok {
+				inodes[strings.TrimSuffix(inode, "]")] = struct{}{}
+			}
+		}
+	}
+	if len(inodes) == 0 {
+		return 0, nil
+	}
+
+	var res int
+	for _, fn := range []string{"tcp", "tcp6"} {
+		f, err := os.Open(filepath.Join(procLocation, strconv.Itoa(pids[0]), "net", fn))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		n, err := countEstablished(f, inodes)
+		f.Close()
+		if err != nil {
+			return 0, xerrors.Errorf("cannot parse %s: %w", fn, err)
+		}
+		res += n
+	}
+	return res, nil
+}
+
+// countEstablished counts the established non-loopback connections in a /proc/net/tcp(6) table whose socket
+// is one of the given inodes
+func countEstablished(table io.Reader, inodes map[string]struct{}) (int, error) {
+	var res int
+	scanner := bufio.NewScanner(table)
+	// skip the header
+	scanner.Scan()
+	for scanner.Scan() {
+		// sl local_address rem_address st tx_queue:rx_queue tr:tm->when retrnsmt uid timeout inode ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[3] != tcpEstablished {
+			continue
+		}
+		if _, ok := inodes[fields[9]]; !ok {
+			continue
+		}
+		remote, err := parseProcNetAddr(fields[2])
+		if err != nil {
+			return 0, err
+		}
+		if remote.IsLoopback() {
+			continue
+		}
+		res++
+	}
+	return res, scanner.Err()
+}
+
+// parseProcNetAddr parses the IP of an address in /proc/net/tcp(6), e.g. 0100007F:0050. The address is
+// stored as 32-bit words in host byte order, i.e. little endian on the platforms we run on.
+func parseProcNetAddr(addr string) (net.IP, error) {
+	host, _, ok := strings.Cut(addr, ":")
+	if !ok {
+		return nil, xerrors.Errorf("invalid address %q", addr)
+	}
+	raw, err := hex.DecodeString(host)
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return nil, xerrors.Errorf("invalid address %q", addr)
+	}
+	for i := 0; i < len(raw); i += 4 {
+		raw[i], raw[i+1], raw[i+2], raw[i+3] = raw[i+3], raw[i+2], raw[i+1], raw[i]
+	}
+	return net.IP(raw), nil
+}
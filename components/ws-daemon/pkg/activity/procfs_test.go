@@ -0,0 +1,130 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package activity
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTaskProcesses(t *testing.T) {
+	procs := map[int]struct {
+		PPID    int
+		Environ []string
+	}{
+		// supervisor
+		1: {PPID: 0, Environ: []string{"HOME=/home/gitpod"}},
+		// task terminal shell
+		10: {PPID: 1, Environ: []string{"HOME=/home/gitpod", "GITPOD_TASK_ID=0"}},
+		// build running in the task terminal
+		11: {PPID: 10, Environ: []string{"GITPOD_TASK_ID=0", "HOME=/home/gitpod"}},
+		12: {PPID: 11, Environ: []string{"GITPOD_TASK_ID=0"}},
+		// user terminal
+		20: {PPID: 1, Environ: []string{"GITPOD_TASK_IDS=0"}},
+		21: {PPID: 20, Environ: nil},
+	}
+
+	procLocation := t.TempDir()
+	var pids []int
+	for pid, p := range procs {
+		dir := filepath.Join(procLocation, strconv.Itoa(pid))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		environ := strings.Join(p.Environ, "\x00")
+		if err := os.WriteFile(filepath.Join(dir, "environ"), []byte(environ), 0644); err != nil {
+			t.Fatal(err)
+		}
+		stat := strconv.Itoa(pid) + " (some (cmd)) S " + strconv.Itoa(p.PPID) + " 1 1 0 -1"
+		if err := os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0644); err != nil {
+			t.Fatal(err)
+		}
+		pids = append(pids, pid)
+	}
+	// processes which are gone are ignored
+	pids = append(pids, 99)
+
+	shells, tasks := taskProcesses(procLocation, pids)
+	sort.Ints(shells)
+	sort.Ints(tasks)
+	if diff := cmp.Diff([]int{10}, shells); diff != "" {
+		t.Errorf("unexpected shells (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{11, 12}, tasks); diff != "" {
+		t.Errorf("unexpected tasks (-want +got):\n%s", diff)
+	}
+}
+
+func TestCgroupProcesses(t *testing.T) {
+	base := t.TempDir()
+	for path, content := range map[string]string{
+		"cgroup.procs":                "1\n",
+		"workspace/cgroup.procs":      "",
+		"workspace/user/cgroup.procs": "10\n11\n",
+		"workspace/user/cpu.max":      "max 100000\n",
+	} {
+		fn := filepath.Join(base, path)
+		if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fn, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	act, err := cgroupProcesses(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Ints(act)
+	if diff := cmp.Diff([]int{1, 10, 11}, act); diff != "" {
+		t.Errorf("unexpected processes (-want +got):\n%s", diff)
+	}
+}
+
+func TestCountEstablished(t *testing.T) {
+	const table = `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:5DAF 00000000:0000 0A 00000000:00000000 00:00000000 00000000 33333        0 100 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:A1B2 0100007F:5DAF 01 00000000:00000000 00:00000000 00000000 33333        0 101 1 0000000000000000 20 4 30 10 -1
+   2: 0500000A:C350 22D8B85D:01BB 01 00000000:00000000 00:00000000 00000000 33333        0 102 1 0000000000000000 20 4 30 10 -1
+   3: 0500000A:C351 22D8B85D:01BB 01 00000000:00000000 00:00000000 00000000 33333        0 103 1 0000000000000000 20 4 30 10 -1
+   4: 0500000A:C352 22D8B85D:01BB 06 00000000:00000000 00:00000000 00000000 33333        0 104 1 0000000000000000 20 4 30 10 -1
+   5: 0000000000000000FFFF00000100007F:C353 0000000000000000FFFF00000100007F:1F90 01 00000000:00000000 00:00000000 00000000 33333        0 105 1 0000000000000000 20 4 30 10 -1
+`
+	inodes := map[string]struct{}{"100": {}, "101": {}, "102": {}, "104": {}, "105": {}}
+	act, err := countEstablished(strings.NewReader(table), inodes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only 102 is established, owned by a task process and not connected to localhost
+	if act != 1 {
+		t.Errorf("unexpected number of established connections: %d, expected 1", act)
+	}
+}
+
+func TestParseProcNetAddr(t *testing.T) {
+	tests := map[string]string{
+		"0100007F:5DAF":                         "127.0.0.1",
+		"22D8B85D:01BB":                         "93.184.216.34",
+		"00000000000000000000000001000000:0016": "::1",
+		"0000000000000000FFFF00000100007F:0016": "127.0.0.1",
+	}
+	for addr, expectation := range tests {
+		act, err := parseProcNetAddr(addr)
+		if err != nil {
+			t.Errorf("cannot parse %s: %v", addr, err)
+			continue
+		}
+		if act.String() != expectation {
+			t.Errorf("parseProcNetAddr(%s): got %s, expected %s", addr, act, expectation)
+		}
+	}
+}
@@ -186,6 +186,7 @@ func (wsc *WorkspaceController) handleWorkspaceInit(ctx context.Context, ws *wor
 			Headless:     ws.IsHeadless(),
 			StorageQuota: ws.Spec.StorageQuota,
 		})
+		tracing.RecordSpan(tracing.ExtractAnnotations(ctx, ws.Annotations), "workspace.content_init", initStart, time.Now(), initErr, tracing.OWIAttributes(ws.OWI())...)
 
 		err = retry.RetryOnConflict(retryParams, func() error {
 			if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
@@ -7,6 +7,7 @@ package daemon
 import (
 	"context"
 
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/activity"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
@@ -30,6 +31,7 @@ type Config struct {
 	OOMScores           cgroup.OOMScoreAdjConfig  `json:"oomScores"`
 	DiskSpaceGuard      diskguard.Config          `json:"disk"`
 	WorkspaceController WorkspaceControllerConfig `json:"workspaceController"`
+	MachineActivity     activity.Config           `json:"machineActivity"`
 
 	RegistryFacadeHost string `json:"registryFacadeHost,omitempty"`
 }
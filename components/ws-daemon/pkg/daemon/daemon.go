@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/activity"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
@@ -230,6 +231,14 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
+	if config.MachineActivity.Enabled {
+		machineActivity, err := activity.NewMachineActivityReporter(config.MachineActivity, config.CPULimit.CGroupBasePath, config.Runtime.KubernetesNamespace, mgr.GetClient(), wrappedReg)
+		if err != nil {
+			return nil, err
+		}
+		listener = append(listener, machineActivity)
+	}
+
 	housekeeping := controller.NewHousekeeping(contentCfg.WorkingArea, 5*time.Minute)
 	go housekeeping.Start(context.Background())
 
@@ -40,6 +40,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
 	nsi "github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 )
 
 //
@@ -85,7 +86,7 @@ var (
 )
 
 // ServeWorkspace establishes the IWS server for a workspace
-func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string) func(ctx context.Context, ws *session.Workspace) error {
+func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string, xfs *quota.XFS) func(ctx context.Context, ws *session.Workspace) error {
 	return func(ctx context.Context, ws *session.Workspace) (err error) {
 		span, _ := opentracing.StartSpanFromContext(ctx, "iws.ServeWorkspace")
 		defer tracing.FinishSpan(span, &err)
@@ -100,6 +101,7 @@ func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMount
 			FSShift:          fsshift,
 			CGroupMountPoint: cgroupMountPoint,
 			WorkspaceCIDR:    workspaceCIDR,
+			XFS:              xfs,
 		}
 		err = iws.Start()
 		if err != nil {
@@ -143,6 +145,10 @@ type InWorkspaceServiceServer struct {
 
 	WorkspaceCIDR string
 
+	// XFS is used to report the disk usage of the workspace. It is nil if the
+	// filesystem does not support project quota.
+	XFS *quota.XFS
+
 	srv  *grpc.Server
 	sckt io.Closer
 
@@ -988,11 +994,33 @@ func (wbs *InWorkspaceServiceServer) WorkspaceInfo(ctx context.Context, req *api
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	resources.Disk, err = getDiskResourceInfo(wbs.XFS, wbs.Session)
+	if err != nil {
+		// disk usage is best effort and must not prevent reporting CPU and memory
+		log.WithError(err).WithFields(wbs.Session.OWI()).Warn("could not get disk usage")
+	}
+
 	return &api.WorkspaceInfoResponse{
 		Resources: resources,
 	}, nil
 }
 
+func getDiskResourceInfo(xfs *quota.XFS, ws *session.Workspace) (*api.Disk, error) {
+	if xfs == nil || ws.XFSProjectID == 0 {
+		return nil, nil
+	}
+
+	used, limit, err := xfs.GetUsage(ws.XFSProjectID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get xfs project usage: %w", err)
+	}
+
+	return &api.Disk{
+		Used:  int64(used),
+		Limit: int64(limit),
+	}, nil
+}
+
 func getWorkspaceResourceInfo(mountPoint, cgroupPath string) (*api.Resources, error) {
 	cpu, err := getCpuResourceInfoV2(mountPoint, cgroupPath)
 	if err != nil {
@@ -159,6 +159,35 @@ func (xfs *XFS) RemoveQuota(projectID int) error {
 	return nil
 }
 
+// GetUsage returns the number of bytes used by a project and its limit. The limit is the hard
+// limit if one is set, otherwise the soft limit. A limit of zero means the project is unlimited.
+func (xfs *XFS) GetUsage(projectID int) (used, limit Size, err error) {
+	out, err := xfs.exec(xfs.Dir, fmt.Sprintf("quota -p -N -b %d", projectID))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// xfs_quota reports in 1KiB blocks: <device> <used> <soft> <hard> <warn/grace> ...
+	fields := strings.Fields(out)
+	if len(fields) < 4 {
+		return 0, 0, fmt.Errorf("cannot parse xfs_quota output: %q", out)
+	}
+	var blocks [3]int64
+	for i := range blocks {
+		blocks[i], err = strconv.ParseInt(fields[i+1], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("cannot parse xfs_quota output: %q", out)
+		}
+	}
+
+	used = Size(blocks[0]) * Kilobyte
+	limit = Size(blocks[2]) * Kilobyte
+	if limit == 0 {
+		limit = Size(blocks[1]) * Kilobyte
+	}
+	return used, limit, nil
+}
+
 // GetProjectUseCount returns the number of projectIDs in use
 func (xfs *XFS) GetProjectUseCount() int {
 	xfs.mu.Lock()
@@ -191,3 +191,78 @@ func TestSetQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUsage(t *testing.T) {
+	type Expectation struct {
+		Used  Size
+		Limit Size
+		Execs []string
+		Error string
+	}
+	tests := []struct {
+		Name        string
+		Output      string
+		ExecErr     error
+		Expectation Expectation
+	}{
+		{
+			Name:   "hard limit",
+			Output: "/dev/sdb  1024  0  5242880  00 [--------] /var/gitpod/workspaces\n",
+			Expectation: Expectation{
+				Used:  1024 * Kilobyte,
+				Limit: 5 * Gigabyte,
+				Execs: []string{"quota -p -N -b 1000"},
+			},
+		},
+		{
+			Name:   "soft limit",
+			Output: "/dev/sdb  2048  5242880  0  00 [--------] /var/gitpod/workspaces\n",
+			Expectation: Expectation{
+				Used:  2 * Megabyte,
+				Limit: 5 * Gigabyte,
+				Execs: []string{"quota -p -N -b 1000"},
+			},
+		},
+		{
+			Name:   "unparseable output",
+			Output: "foobar",
+			Expectation: Expectation{
+				Execs: []string{"quota -p -N -b 1000"},
+				Error: `cannot parse xfs_quota output: "foobar"`,
+			},
+		},
+		{
+			Name:    "exec failure",
+			ExecErr: fmt.Errorf("exec failed"),
+			Expectation: Expectation{
+				Execs: []string{"quota -p -N -b 1000"},
+				Error: "exec failed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				act Expectation
+				err error
+			)
+			xfs := &XFS{
+				exec: func(dir, command string) (output string, err error) {
+					act.Execs = append(act.Execs, command)
+					return test.Output, test.ExecErr
+				},
+				Dir: "/",
+			}
+
+			act.Used, act.Limit, err = xfs.GetUsage(1000)
+			if err != nil {
+				act.Error = err.Error()
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected GetUsage (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -142,6 +142,9 @@ type Configuration struct {
 
 	// SSHGatewayCAPublicKey is a CA public key
 	SSHGatewayCAPublicKey string
+
+	// Audit configures the publication of workspace lifecycle events for auditing purposes
+	Audit *AuditConfiguration `json:"audit,omitempty"`
 }
 
 type WorkspaceClass struct {
@@ -152,6 +155,21 @@ type WorkspaceClass struct {
 
 	// CreditsPerMinute is the cost per minute for this workspace class in credits
 	CreditsPerMinute float32 `json:"creditsPerMinute"`
+
+	// MachineActivity configures when workload inside workspaces of this class counts as activity.
+	// If not set, workspaces time out based on user activity only.
+	MachineActivity *MachineActivityConfiguration `json:"machineActivity,omitempty"`
+}
+
+// MachineActivityConfiguration configures when ws-daemon considers a workspace active
+// even though its user is not, e.g. because a build is running in a closed browser tab.
+type MachineActivityConfiguration struct {
+	// CPUMillicores is the average CPU usage above which a workspace is considered active. Zero disables this signal.
+	CPUMillicores int64 `json:"cpuMillicores,omitempty"`
+	// TaskProcesses considers a workspace active while processes run in one of its task terminals
+	TaskProcesses bool `json:"taskProcesses,omitempty"`
+	// NetworkConnections considers a workspace active while its task processes have established TCP connections
+	NetworkConnections bool `json:"networkConnections,omitempty"`
 }
 
 // WorkspaceTimeoutConfiguration configures the timeout behaviour of workspaces
@@ -175,6 +193,8 @@ type WorkspaceTimeoutConfiguration struct {
 	Stopping util.Duration `json:"stopping"`
 	// Interrupted is the time a workspace may be interrupted (since it last saw activity or since it was created if it never saw any)
 	Interrupted util.Duration `json:"interrupted"`
+	// NodeDrain is the time a workspace keeps running after its node was marked for draining before it gets stopped
+	NodeDrain util.Duration `json:"nodeDrain,omitempty"`
 }
 
 // InitProbeConfiguration configures the behaviour of the workspace ready probe
@@ -203,6 +223,40 @@ type WorkspacePodTemplateConfiguration struct {
 	ImagebuildPath string `json:"imagebuildPath,omitempty"`
 }
 
+// AuditConfiguration configures where workspace lifecycle events are published to.
+// Events are published as CloudEvents to all configured sinks.
+type AuditConfiguration struct {
+	// Source is the CloudEvents source of all events, e.g. the URI of the workspace cluster
+	Source string `json:"source"`
+	// QueueSize is the number of events which are buffered for delivery
+	QueueSize int `json:"queueSize,omitempty"`
+	// EnqueueTimeout is how long publishing an event waits for room in a full queue before the event is dropped
+	EnqueueTimeout util.Duration `json:"enqueueTimeout,omitempty"`
+
+	Webhook *AuditWebhookConfiguration `json:"webhook,omitempty"`
+	File    *AuditFileConfiguration    `json:"file,omitempty"`
+	Redis   *AuditRedisConfiguration   `json:"redis,omitempty"`
+}
+
+// AuditWebhookConfiguration configures an HTTP endpoint events are POSTed to
+type AuditWebhookConfiguration struct {
+	URL     string        `json:"url"`
+	Timeout util.Duration `json:"timeout,omitempty"`
+}
+
+// AuditFileConfiguration configures a file events are appended to as JSON lines
+type AuditFileConfiguration struct {
+	Path string `json:"path"`
+}
+
+// AuditRedisConfiguration configures a Redis stream events are added to
+type AuditRedisConfiguration struct {
+	Address string `json:"address"`
+	Stream  string `json:"stream"`
+	// MaxLen caps the length of the stream. Zero means unbounded.
+	MaxLen int64 `json:"maxLen,omitempty"`
+}
+
 // WorkspaceDaemonConfiguration configures our connection to the workspace sync daemons runnin on the nodes
 type WorkspaceDaemonConfiguration struct {
 	// Port is the port on the node on which the ws-daemon is listening
@@ -268,6 +322,43 @@ func (c *Configuration) Validate() error {
 		if err != nil {
 			return xerrors.Errorf("workspace class %s: %w", name, err)
 		}
+
+		if ma := class.MachineActivity; ma != nil {
+			if ma.CPUMillicores < 0 {
+				return xerrors.Errorf("workspace class %s: machineActivity.cpuMillicores must not be negative", name)
+			}
+			if ma.CPUMillicores == 0 && !ma.TaskProcesses && !ma.NetworkConnections {
+				return xerrors.Errorf("workspace class %s: machineActivity enables no activity signal", name)
+			}
+		}
+	}
+
+	if a := c.Audit; a != nil {
+		err = ozzo.ValidateStruct(a,
+			ozzo.Field(&a.Source, ozzo.Required),
+			ozzo.Field(&a.QueueSize, ozzo.Min(0)),
+		)
+		if err != nil {
+			return xerrors.Errorf("audit: %w", err)
+		}
+		if a.Webhook != nil {
+			err = ozzo.ValidateStruct(a.Webhook, ozzo.Field(&a.Webhook.URL, ozzo.Required, is.URL))
+			if err != nil {
+				return xerrors.Errorf("audit webhook: %w", err)
+			}
+		}
+		if a.File != nil && a.File.Path == "" {
+			return xerrors.Errorf("audit file: path is required")
+		}
+		if a.Redis != nil {
+			err = ozzo.ValidateStruct(a.Redis,
+				ozzo.Field(&a.Redis.Address, ozzo.Required),
+				ozzo.Field(&a.Redis.Stream, ozzo.Required),
+			)
+			if err != nil {
+				return xerrors.Errorf("audit redis: %w", err)
+			}
+		}
 	}
 
 	return err
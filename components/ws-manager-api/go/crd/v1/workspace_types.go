@@ -116,6 +116,24 @@ type TimeoutSpec struct {
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h)?)+$"
 	MaximumLifetime *metav1.Duration `json:"maximumLifetime,omitempty"`
+	// MachineActivity configures if workload running in the workspace, e.g. a long-running build,
+	// counts as activity. If not set, only user activity prevents the workspace from timing out.
+	// +kubebuilder:validation:Optional
+	MachineActivity *MachineActivitySpec `json:"machineActivity,omitempty"`
+}
+
+type MachineActivitySpec struct {
+	// CPUMillicores is the average CPU usage in millicores above which ws-daemon considers the workspace active.
+	// If not set, CPU usage does not count as activity.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	CPUMillicores int64 `json:"cpuMillicores,omitempty"`
+	// TaskProcesses makes processes running in a task terminal, e.g. a build or a dev server, count as activity
+	// +kubebuilder:validation:Optional
+	TaskProcesses bool `json:"taskProcesses,omitempty"`
+	// NetworkConnections makes established TCP connections of task processes count as activity
+	// +kubebuilder:validation:Optional
+	NetworkConnections bool `json:"networkConnections,omitempty"`
 }
 
 type AdmissionSpec struct {
@@ -193,6 +211,10 @@ type WorkspaceStatus struct {
 	Storage StorageStatus `json:"storage,omitempty"`
 
 	LastActivity *metav1.Time `json:"lastActivity,omitempty"`
+
+	// LastMachineActivity is the last time ws-daemon observed workload in the workspace which
+	// exceeded the machine activity thresholds. Only set if machine activity is configured.
+	LastMachineActivity *metav1.Time `json:"lastMachineActivity,omitempty"`
 }
 
 func (s *WorkspaceStatus) SetCondition(cond metav1.Condition) {
@@ -205,7 +227,7 @@ type StorageStatus struct {
 	MountPath      string `json:"mountPath"`
 }
 
-// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;ThroughputAdjusted
+// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;NodeDrain;ThroughputAdjusted
 type WorkspaceCondition string
 
 const (
@@ -254,6 +276,11 @@ const (
 	// NodeDisappeared is true if the workspace's node disappeared before the workspace was stopped
 	WorkspaceConditionNodeDisappeared WorkspaceCondition = "NodeDisappeared"
 
+	// NodeDrain is true if the workspace's node is being drained. The workspace will be stopped
+	// once the drain grace period has passed, and restarted on another node by the server.
+	// The condition message contains the node name.
+	WorkspaceConditionNodeDrain WorkspaceCondition = "NodeDrain"
+
 	VolumeAttachRequest WorkspaceCondition = "VolumeAttachRequest"
 	// VolumeAttached is true if the workspace's volume has been attached to the node
 	VolumeAttached WorkspaceCondition = "VolumeAttached"
@@ -329,6 +356,16 @@ func NewWorkspaceConditionStoppedByRequest(message string) metav1.Condition {
 	}
 }
 
+// StoppedByNodeDrainReason is the reason of the StoppedByRequest condition if the node drain stopped the workspace.
+// A StopWorkspace request replaces the condition, hence workspaces stopped by their users during a drain don't carry it.
+const StoppedByNodeDrainReason = "NodeDrain"
+
+func NewWorkspaceConditionStoppedByNodeDrain(message string) metav1.Condition {
+	cond := NewWorkspaceConditionStoppedByRequest(message)
+	cond.Reason = StoppedByNodeDrainReason
+	return cond
+}
+
 func NewWorkspaceConditionAborted(reason string) metav1.Condition {
 	return metav1.Condition{
 		Type:               string(WorkspaceConditionAborted),
@@ -391,6 +428,16 @@ func NewWorkspaceConditionNodeDisappeared() metav1.Condition {
 	}
 }
 
+func NewWorkspaceConditionNodeDrain(nodeName string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionNodeDrain),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "NodeDrain",
+		Message:            nodeName,
+	}
+}
+
 func NewWorkspaceConditionContainerRunning(status metav1.ConditionStatus) metav1.Condition {
 	return metav1.Condition{
 		Type:               string(WorkspaceConditionContainerRunning),
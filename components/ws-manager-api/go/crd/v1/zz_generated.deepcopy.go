@@ -95,6 +95,21 @@ func (in *IDEImages) DeepCopy() *IDEImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineActivitySpec) DeepCopyInto(out *MachineActivitySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineActivitySpec.
+func (in *MachineActivitySpec) DeepCopy() *MachineActivitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineActivitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ownership) DeepCopyInto(out *Ownership) {
 	*out = *in
@@ -247,6 +262,11 @@ func (in *TimeoutSpec) DeepCopyInto(out *TimeoutSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MachineActivity != nil {
+		in, out := &in.MachineActivity, &out.MachineActivity
+		*out = new(MachineActivitySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutSpec.
@@ -448,6 +468,10 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 		in, out := &in.LastActivity, &out.LastActivity
 		*out = (*in).DeepCopy()
 	}
+	if in.LastMachineActivity != nil {
+		in, out := &in.LastMachineActivity, &out.LastMachineActivity
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
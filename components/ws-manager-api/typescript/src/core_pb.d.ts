@@ -856,6 +856,8 @@ export class WorkspaceConditions extends jspb.Message {
     setVolumeSnapshot(value?: VolumeSnapshotInfo): WorkspaceConditions;
     getAborted(): WorkspaceConditionBool;
     setAborted(value: WorkspaceConditionBool): WorkspaceConditions;
+    getNodeDrain(): WorkspaceConditionBool;
+    setNodeDrain(value: WorkspaceConditionBool): WorkspaceConditions;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceConditions.AsObject;
@@ -881,6 +883,7 @@ export namespace WorkspaceConditions {
         stoppedByRequest: WorkspaceConditionBool,
         volumeSnapshot?: VolumeSnapshotInfo.AsObject,
         aborted: WorkspaceConditionBool,
+        nodeDrain: WorkspaceConditionBool,
     }
 }
 
@@ -1034,6 +1037,11 @@ export class StartWorkspaceSpec extends jspb.Message {
     getMaximumLifetime(): string;
     setMaximumLifetime(value: string): StartWorkspaceSpec;
 
+    hasMachineActivity(): boolean;
+    clearMachineActivity(): void;
+    getMachineActivity(): MachineActivity | undefined;
+    setMachineActivity(value?: MachineActivity): StartWorkspaceSpec;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StartWorkspaceSpec.AsObject;
     static toObject(includeInstance: boolean, msg: StartWorkspaceSpec): StartWorkspaceSpec.AsObject;
@@ -1062,6 +1070,33 @@ export namespace StartWorkspaceSpec {
         ideImageLayersList: Array<string>,
         closedTimeout: string,
         maximumLifetime: string,
+        machineActivity?: MachineActivity.AsObject,
+    }
+}
+
+export class MachineActivity extends jspb.Message {
+    getCpuMillicores(): number;
+    setCpuMillicores(value: number): MachineActivity;
+    getTaskProcesses(): boolean;
+    setTaskProcesses(value: boolean): MachineActivity;
+    getNetworkConnections(): boolean;
+    setNetworkConnections(value: boolean): MachineActivity;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): MachineActivity.AsObject;
+    static toObject(includeInstance: boolean, msg: MachineActivity): MachineActivity.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: MachineActivity, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): MachineActivity;
+    static deserializeBinaryFromReader(message: MachineActivity, reader: jspb.BinaryReader): MachineActivity;
+}
+
+export namespace MachineActivity {
+    export type AsObject = {
+        cpuMillicores: number,
+        taskProcesses: boolean,
+        networkConnections: boolean,
     }
 }
 
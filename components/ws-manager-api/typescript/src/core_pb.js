@@ -45,6 +45,7 @@ goog.exportSymbol('proto.wsman.GetWorkspacesRequest', null, global);
 goog.exportSymbol('proto.wsman.GetWorkspacesResponse', null, global);
 goog.exportSymbol('proto.wsman.GitSpec', null, global);
 goog.exportSymbol('proto.wsman.IDEImage', null, global);
+goog.exportSymbol('proto.wsman.MachineActivity', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveRequest', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveResponse', null, global);
 goog.exportSymbol('proto.wsman.MetadataFilter', null, global);
@@ -856,6 +857,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.StartWorkspaceSpec.displayName = 'proto.wsman.StartWorkspaceSpec';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.MachineActivity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.MachineActivity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.MachineActivity.displayName = 'proto.wsman.MachineActivity';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -6808,7 +6830,8 @@ proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
     headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 10, ""),
     stoppedByRequest: jspb.Message.getFieldWithDefault(msg, 11, 0),
     volumeSnapshot: (f = msg.getVolumeSnapshot()) && proto.wsman.VolumeSnapshotInfo.toObject(includeInstance, f),
-    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0)
+    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    nodeDrain: jspb.Message.getFieldWithDefault(msg, 14, 0)
   };
 
   if (includeInstance) {
@@ -6895,6 +6918,10 @@ proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, read
       var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
       msg.setAborted(value);
       break;
+    case 14:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setNodeDrain(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7010,6 +7037,13 @@ proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writ
       f
     );
   }
+  f = message.getNodeDrain();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      14,
+      f
+    );
+  }
 };
 
 
@@ -7267,6 +7301,24 @@ proto.wsman.WorkspaceConditions.prototype.setAborted = function(value) {
 };
 
 
+/**
+ * optional WorkspaceConditionBool node_drain = 14;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getNodeDrain = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setNodeDrain = function(value) {
+  return jspb.Message.setProto3EnumField(this, 14, value);
+};
+
+
 
 
 
@@ -8014,7 +8066,8 @@ proto.wsman.StartWorkspaceSpec.toObject = function(includeInstance, msg) {
     proto.wsman.EnvironmentVariable.toObject, includeInstance),
     ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f,
     closedTimeout: jspb.Message.getFieldWithDefault(msg, 18, ""),
-    maximumLifetime: jspb.Message.getFieldWithDefault(msg, 19, "")
+    maximumLifetime: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    machineActivity: (f = msg.getMachineActivity()) && proto.wsman.MachineActivity.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -8123,6 +8176,11 @@ proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readString());
       msg.setMaximumLifetime(value);
       break;
+    case 20:
+      var value = new proto.wsman.MachineActivity;
+      reader.readMessage(value,proto.wsman.MachineActivity.deserializeBinaryFromReader);
+      msg.setMachineActivity(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8270,6 +8328,14 @@ proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getMachineActivity();
+  if (f != null) {
+    writer.writeMessage(
+      20,
+      f,
+      proto.wsman.MachineActivity.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -8735,6 +8801,233 @@ proto.wsman.StartWorkspaceSpec.prototype.setMaximumLifetime = function(value) {
 };
 
 
+/**
+ * optional MachineActivity machine_activity = 20;
+ * @return {?proto.wsman.MachineActivity}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getMachineActivity = function() {
+  return /** @type{?proto.wsman.MachineActivity} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.MachineActivity, 20));
+};
+
+
+/**
+ * @param {?proto.wsman.MachineActivity|undefined} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setMachineActivity = function(value) {
+  return jspb.Message.setWrapperField(this, 20, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearMachineActivity = function() {
+  return this.setMachineActivity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.hasMachineActivity = function() {
+  return jspb.Message.getField(this, 20) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.MachineActivity.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.MachineActivity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.MachineActivity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MachineActivity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuMillicores: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    taskProcesses: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    networkConnections: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.MachineActivity}
+ */
+proto.wsman.MachineActivity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.MachineActivity;
+  return proto.wsman.MachineActivity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.MachineActivity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.MachineActivity}
+ */
+proto.wsman.MachineActivity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuMillicores(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTaskProcesses(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNetworkConnections(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.MachineActivity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.MachineActivity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.MachineActivity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MachineActivity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuMillicores();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getTaskProcesses();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getNetworkConnections();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 cpu_millicores = 1;
+ * @return {number}
+ */
+proto.wsman.MachineActivity.prototype.getCpuMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.MachineActivity} returns this
+ */
+proto.wsman.MachineActivity.prototype.setCpuMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bool task_processes = 2;
+ * @return {boolean}
+ */
+proto.wsman.MachineActivity.prototype.getTaskProcesses = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.MachineActivity} returns this
+ */
+proto.wsman.MachineActivity.prototype.setTaskProcesses = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool network_connections = 3;
+ * @return {boolean}
+ */
+proto.wsman.MachineActivity.prototype.getNetworkConnections = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.MachineActivity} returns this
+ */
+proto.wsman.MachineActivity.prototype.setNetworkConnections = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
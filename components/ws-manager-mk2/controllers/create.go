@@ -622,6 +622,10 @@ func createWorkspaceEnvironment(sctx *startWorkspaceContext) ([]corev1.EnvVar, e
 		result = append(result, corev1.EnvVar{Name: "GITPOD_HEADLESS", Value: "true"})
 	}
 
+	// pass the startup trace context on to supervisor
+	result = append(result, corev1.EnvVar{Name: tracing.TraceParentEnvVar, Value: sctx.Workspace.Annotations[tracing.TraceParentAnnotation]})
+	result = append(result, corev1.EnvVar{Name: tracing.TraceStateEnvVar, Value: sctx.Workspace.Annotations[tracing.TraceStateAnnotation]})
+
 	// remove empty env vars
 	cleanResult := make([]corev1.EnvVar, 0)
 	for _, v := range result {
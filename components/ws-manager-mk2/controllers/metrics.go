@@ -36,6 +36,7 @@ const (
 	workspaceRestoresFailureTotal string = "workspace_restores_failure_total"
 	workspaceNodeUtilization      string = "workspace_node_utilization"
 	workspaceActivityTotal        string = "workspace_activity_total"
+	workspaceImageTotal           string = "workspace_image_total"
 )
 
 type StopReason string
@@ -48,6 +49,7 @@ const (
 	StopReasonTimeout      = "timeout"
 	StopReasonTabClosed    = "tab-closed"
 	StopReasonRegular      = "regular-stop"
+	StopReasonNodeDrain    = "node-drain"
 )
 
 type controllerMetrics struct {
@@ -70,6 +72,8 @@ type controllerMetrics struct {
 
 	workspaceActivityTotal *workspaceActivityVec
 
+	workspaceImages *workspaceImageVec
+
 	// used to prevent recording metrics multiple times
 	cache *lru.Cache
 }
@@ -150,6 +154,7 @@ func newControllerMetrics(r *WorkspaceReconciler) (*controllerMetrics, error) {
 		timeoutSettings:          newTimeoutSettingsVec(r),
 		workspaceNodeUtilization: newNodeUtilizationVec(r),
 		workspaceActivityTotal:   newWorkspaceActivityVec(r),
+		workspaceImages:          newWorkspaceImageVec(r),
 		cache:                    cache,
 	}, nil
 }
@@ -217,6 +222,8 @@ func (m *controllerMetrics) countWorkspaceStop(log *logr.Logger, ws *workspacev1
 		}
 	} else if ws.IsConditionTrue(workspacev1.WorkspaceConditionAborted) {
 		reason = StopReasonAborted
+	} else if ws.IsConditionTrue(workspacev1.WorkspaceConditionNodeDrain) {
+		reason = StopReasonNodeDrain
 	} else if ws.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) {
 		reason = StopReasonTimeout
 	} else if ws.IsConditionTrue(workspacev1.WorkspaceConditionClosed) {
@@ -337,6 +344,7 @@ func (m *controllerMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.timeoutSettings.Describe(ch)
 	m.workspaceNodeUtilization.Describe(ch)
 	m.workspaceActivityTotal.Describe(ch)
+	m.workspaceImages.Describe(ch)
 }
 
 // Collect implements Collector.
@@ -357,6 +365,7 @@ func (m *controllerMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.timeoutSettings.Collect(ch)
 	m.workspaceNodeUtilization.Collect(ch)
 	m.workspaceActivityTotal.Collect(ch)
+	m.workspaceImages.Collect(ch)
 }
 
 // phaseTotalVec returns a gauge vector counting the workspaces per phase
@@ -695,3 +704,63 @@ func (wav *workspaceActivityVec) getWorkspaceActivityCounts() (active, notActive
 
 	return
 }
+
+// workspaceImageVec counts the workspaces per workspace image. registry-facade uses it to find the
+// images worth prewarming on nodes. The number of series is bounded by the number of workspaces.
+type workspaceImageVec struct {
+	name       string
+	desc       *prometheus.Desc
+	reconciler *WorkspaceReconciler
+}
+
+func newWorkspaceImageVec(r *WorkspaceReconciler) *workspaceImageVec {
+	name := prometheus.BuildFQName(metricsNamespace, metricsWorkspaceSubsystem, workspaceImageTotal)
+	desc := prometheus.NewDesc(
+		name,
+		"Current number of workspaces per workspace image",
+		[]string{"image", "type"},
+		prometheus.Labels(map[string]string{}),
+	)
+	return &workspaceImageVec{
+		name:       name,
+		desc:       desc,
+		reconciler: r,
+	}
+}
+
+// Describe implements Collector. It will send exactly one Desc to the provided channel.
+func (wiv *workspaceImageVec) Describe(ch chan<- *prometheus.Desc) {
+	ch <- wiv.desc
+}
+
+// Collect implements Collector.
+func (wiv *workspaceImageVec) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), kubernetesOperationTimeout)
+	defer cancel()
+
+	var workspaces workspacev1.WorkspaceList
+	err := wiv.reconciler.List(ctx, &workspaces, client.InNamespace(wiv.reconciler.Config.Namespace))
+	if err != nil {
+		return
+	}
+
+	counts := make(map[string]int)
+	for _, ws := range workspaces.Items {
+		if ws.Spec.Image.Workspace.Ref == nil || *ws.Spec.Image.Workspace.Ref == "" {
+			continue
+		}
+		counts[*ws.Spec.Image.Workspace.Ref+"::"+string(ws.Spec.Type)]++
+	}
+
+	for key, count := range counts {
+		idx := strings.LastIndex(key, "::")
+		image, tpe := key[:idx], key[idx+2:]
+
+		metric, err := prometheus.NewConstMetric(wiv.desc, prometheus.GaugeValue, float64(count), image, tpe)
+		if err != nil {
+			continue
+		}
+
+		ch <- metric
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	nodeDrainDefaultGracePeriod = 5 * time.Minute
+	nodeDrainRequeue            = 10 * time.Second
+
+	// nodeDrainStopGracePeriod matches the grace period of regular stop requests
+	nodeDrainStopGracePeriod = 30 * time.Second
+
+	nodeDrainWorkspaces     string = "node_drain_workspaces"
+	nodeDrainStoppedTotal   string = "node_drain_workspaces_stopped_total"
+	nodeDrainCompletedTotal string = "node_drain_completed_total"
+)
+
+func NewNodeDrainReconciler(c client.Client, recorder record.EventRecorder, cfg config.Configuration, maintenance maintenance.Maintenance, reg prometheus.Registerer) (*NodeDrainReconciler, error) {
+	gracePeriod := time.Duration(cfg.Timeouts.NodeDrain)
+	if gracePeriod == 0 {
+		gracePeriod = nodeDrainDefaultGracePeriod
+	}
+
+	r := &NodeDrainReconciler{
+		Client:      c,
+		Config:      cfg,
+		gracePeriod: gracePeriod,
+		recorder:    recorder,
+		maintenance: maintenance,
+		completed:   make(map[string]struct{}),
+
+		drainingWorkspaces: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      nodeDrainWorkspaces,
+			Help:      "Number of workspaces which still need to be moved off a draining node",
+		}, []string{"node"}),
+		stoppedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      nodeDrainStoppedTotal,
+			Help:      "Total number of workspaces stopped because their node was drained",
+		}),
+		completedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      nodeDrainCompletedTotal,
+			Help:      "Total number of node drains which completed",
+		}),
+	}
+
+	err := reg.Register(r.drainingWorkspaces)
+	if err != nil {
+		return nil, err
+	}
+	err = reg.Register(r.stoppedTotal)
+	if err != nil {
+		return nil, err
+	}
+	err = reg.Register(r.completedTotal)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NodeDrainReconciler moves workspaces off nodes which carry the node drain annotation.
+// It cordons the node, announces the drain on all workspaces running on it, and stops them
+// once the drain grace period has passed. The announcement becomes the status message of the
+// workspace, which supervisor shows to the user. Stopping a workspace makes ws-daemon back up
+// its content. The NodeDrain condition is reported to ws-manager-bridge, and the server restarts
+// the workspace from that backup once it has stopped. The new instance lands on another node,
+// as the drained node is cordoned.
+type NodeDrainReconciler struct {
+	client.Client
+
+	Config      config.Configuration
+	gracePeriod time.Duration
+	recorder    record.EventRecorder
+	maintenance maintenance.Maintenance
+
+	drainingWorkspaces *prometheus.GaugeVec
+	stoppedTotal       prometheus.Counter
+	completedTotal     prometheus.Counter
+
+	// completed contains the nodes whose drain has completed, so that we report completion only once
+	mu        sync.Mutex
+	completed map[string]struct{}
+}
+
+//+kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces/status,verbs=get;update;patch
+
+func (r *NodeDrainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("node", req.Name)
+
+	var node corev1.Node
+	if err := r.Get(ctx, req.NamespacedName, &node); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forget(req.Name)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !hasNodeDrainAnnotation(&node) {
+		r.forget(node.Name)
+		return ctrl.Result{}, nil
+	}
+
+	if r.maintenance.IsEnabled(ctx) {
+		// Don't stop workspaces in maintenance mode, but make sure to continue the drain afterwards.
+		return ctrl.Result{RequeueAfter: maintenanceRequeue}, nil
+	}
+
+	if !node.Spec.Unschedulable {
+		log.Info("cordoning node for drain")
+		if err := r.cordon(ctx, node.Name); err != nil {
+			return errorResultLogConflict(log, err)
+		}
+	}
+
+	var workspaces workspacev1.WorkspaceList
+	if err := r.List(ctx, &workspaces, client.InNamespace(r.Config.Namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	var (
+		remaining int
+		now       = time.Now()
+		requeue   = r.gracePeriod
+	)
+	for i := range workspaces.Items {
+		ws := &workspaces.Items[i]
+		if !isOnNode(ws, node.Name) {
+			continue
+		}
+		remaining++
+
+		action, wait := nodeDrainActionFor(ws, now, r.gracePeriod)
+		switch action {
+		case nodeDrainAnnounce:
+			err := r.updateWorkspaceStatus(ctx, ws, func(ws *workspacev1.Workspace) {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionNodeDrain(node.Name))
+			})
+			if err != nil {
+				return errorResultLogConflict(log, err)
+			}
+			r.recorder.Eventf(ws, corev1.EventTypeWarning, "NodeDrain", "Node %s is being drained, workspace will be stopped in %s", node.Name, r.gracePeriod)
+		case nodeDrainStop:
+			err := r.updateWorkspaceStatus(ctx, ws, func(ws *workspacev1.Workspace) {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStoppedByNodeDrain(nodeDrainStopGracePeriod.String()))
+			})
+			if err != nil {
+				return errorResultLogConflict(log, err)
+			}
+			r.recorder.Eventf(ws, corev1.EventTypeNormal, "NodeDrain", "Stopping workspace to move it off node %s", node.Name)
+			r.stoppedTotal.Inc()
+		}
+
+		if wait > 0 && wait < requeue {
+			requeue = wait
+		}
+	}
+
+	r.drainingWorkspaces.WithLabelValues(node.Name).Set(float64(remaining))
+	if remaining == 0 {
+		r.mu.Lock()
+		if _, done := r.completed[node.Name]; !done {
+			r.completed[node.Name] = struct{}{}
+			r.completedTotal.Inc()
+			log.Info("node drain complete")
+		}
+		r.mu.Unlock()
+		return ctrl.Result{}, nil
+	}
+
+	if requeue < nodeDrainRequeue {
+		// Workspaces which are already stopping need a bit of time to finalize their content.
+		requeue = nodeDrainRequeue
+	}
+	return ctrl.Result{RequeueAfter: requeue}, nil
+}
+
+func (r *NodeDrainReconciler) forget(nodeName string) {
+	r.drainingWorkspaces.DeleteLabelValues(nodeName)
+
+	r.mu.Lock()
+	delete(r.completed, nodeName)
+	r.mu.Unlock()
+}
+
+func (r *NodeDrainReconciler) cordon(ctx context.Context, nodeName string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var node corev1.Node
+		if err := r.Get(ctx, types.NamespacedName{Name: nodeName}, &node); err != nil {
+			return err
+		}
+
+		node.Spec.Unschedulable = true
+		return r.Update(ctx, &node)
+	})
+}
+
+func (r *NodeDrainReconciler) updateWorkspaceStatus(ctx context.Context, ws *workspacev1.Workspace, mod func(ws *workspacev1.Workspace)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		err := r.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)
+		if err != nil {
+			return err
+		}
+
+		mod(ws)
+		return r.Status().Update(ctx, ws)
+	})
+}
+
+func isOnNode(ws *workspacev1.Workspace, nodeName string) bool {
+	return ws.Status.Runtime != nil && ws.Status.Runtime.NodeName == nodeName
+}
+
+type nodeDrainAction int
+
+const (
+	nodeDrainNone nodeDrainAction = iota
+	nodeDrainAnnounce
+	nodeDrainStop
+)
+
+// nodeDrainActionFor determines what needs to happen to a workspace on a draining node,
+// and how long to wait until the next action is due.
+func nodeDrainActionFor(ws *workspacev1.Workspace, now time.Time, gracePeriod time.Duration) (action nodeDrainAction, wait time.Duration) {
+	if ws.Status.Phase == workspacev1.WorkspacePhaseStopping ||
+		ws.Status.Phase == workspacev1.WorkspacePhaseStopped ||
+		ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) {
+		// The workspace is on its way out already, we only wait for it to be gone.
+		return nodeDrainNone, 0
+	}
+
+	if !ws.IsConditionTrue(workspacev1.WorkspaceConditionNodeDrain) {
+		return nodeDrainAnnounce, gracePeriod
+	}
+	cond := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionNodeDrain))
+
+	deadline := cond.LastTransitionTime.Add(gracePeriod)
+	if now.Before(deadline) && ws.Status.Phase == workspacev1.WorkspacePhaseRunning {
+		return nodeDrainNone, deadline.Sub(now)
+	}
+
+	// Workspaces which aren't running yet have no user work to save, hence can be stopped right away.
+	return nodeDrainStop, 0
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeDrainReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("nodedrain").
+		For(&corev1.Node{}).
+		WithEventFilter(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return hasNodeDrainAnnotation(e.Object)
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				// Also reconcile when the annotation was removed, to clean up the drain metrics.
+				return hasNodeDrainAnnotation(e.ObjectOld) || hasNodeDrainAnnotation(e.ObjectNew)
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return hasNodeDrainAnnotation(e.Object)
+			},
+			GenericFunc: func(e event.GenericEvent) bool {
+				return hasNodeDrainAnnotation(e.Object)
+			},
+		}).
+		Complete(r)
+}
+
+func hasNodeDrainAnnotation(obj client.Object) bool {
+	if obj == nil {
+		return false
+	}
+
+	_, ok := obj.GetAnnotations()[wsk8s.NodeDrainAnnotation]
+	return ok
+}
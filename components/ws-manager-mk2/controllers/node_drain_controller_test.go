@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"time"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("NodeDrainController", func() {
+	var (
+		conf       = newTestConfig()
+		r          *NodeDrainReconciler
+		fakeClient client.Client
+		nodeName   string
+	)
+	BeforeEach(func() {
+		var err error
+		fakeClient = fake.NewClientBuilder().WithStatusSubresource(&workspacev1.Workspace{}).WithScheme(k8sClient.Scheme()).Build()
+		r, err = NewNodeDrainReconciler(fakeClient, record.NewFakeRecorder(100), conf, &fakeMaintenance{enabled: false}, prometheus.NewRegistry())
+		Expect(err).ToNot(HaveOccurred())
+
+		nodeName = uuid.NewString()
+		Expect(fakeClient.Create(ctx, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName,
+				Annotations: map[string]string{
+					wsk8s.NodeDrainAnnotation: time.Now().Format(time.RFC3339),
+				},
+			},
+		})).To(Succeed())
+	})
+
+	reconcileNode := func() reconcile.Result {
+		GinkgoHelper()
+		res, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: nodeName}})
+		Expect(err).ToNot(HaveOccurred())
+		return res
+	}
+
+	It("should cordon the node and stop workspaces after the grace period", func() {
+		ws := newWorkspace(uuid.NewString(), "default")
+		Expect(fakeClient.Create(ctx, ws)).To(Succeed())
+		updateObjWithRetries(fakeClient, ws, true, func(ws *workspacev1.Workspace) {
+			ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+			ws.Status.Runtime = &workspacev1.WorkspaceRuntimeStatus{NodeName: nodeName}
+		})
+
+		By("announcing the drain")
+		res := reconcileNode()
+		Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+
+		var node corev1.Node
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: nodeName}, &node)).To(Succeed())
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)).To(Succeed())
+		Expect(ws.IsConditionTrue(workspacev1.WorkspaceConditionNodeDrain)).To(BeTrue())
+		Expect(ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest)).To(BeFalse())
+
+		By("stopping the workspace once the grace period has passed")
+		updateObjWithRetries(fakeClient, ws, true, func(ws *workspacev1.Workspace) {
+			for i, c := range ws.Status.Conditions {
+				if c.Type == string(workspacev1.WorkspaceConditionNodeDrain) {
+					ws.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * r.gracePeriod))
+				}
+			}
+		})
+		reconcileNode()
+
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)).To(Succeed())
+		Expect(ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest)).To(BeTrue())
+		cond := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStoppedByRequest))
+		Expect(cond.Reason).To(Equal(workspacev1.StoppedByNodeDrainReason))
+	})
+
+	It("should complete once no workspaces are left on the node", func() {
+		ws := newWorkspace(uuid.NewString(), "default")
+		Expect(fakeClient.Create(ctx, ws)).To(Succeed())
+		updateObjWithRetries(fakeClient, ws, true, func(ws *workspacev1.Workspace) {
+			ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+			ws.Status.Runtime = &workspacev1.WorkspaceRuntimeStatus{NodeName: "other-node"}
+		})
+
+		res := reconcileNode()
+		Expect(res.RequeueAfter).To(BeZero())
+
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)).To(Succeed())
+		Expect(ws.IsConditionTrue(workspacev1.WorkspaceConditionNodeDrain)).To(BeFalse())
+	})
+})
@@ -162,6 +162,7 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 
 	start := ws.ObjectMeta.CreationTimestamp.Time
 	lastActivity := activity.Last(ws)
+	lastActivityIncludingMachine := activity.LastIncludingMachine(ws)
 	isClosed := ws.IsConditionTrue(workspacev1.WorkspaceConditionClosed)
 
 	switch phase {
@@ -198,19 +199,23 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 		} else if lastActivity == nil {
 			// The workspace is up and running, but the user has never produced any activity
 			return decide(start, timeouts.TotalStartup, activityNone)
-		} else if isClosed {
-			reason := func() string {
-				afterClosed := timeouts.AfterClose
-				if customClosedTimeout := ws.Spec.Timeout.ClosedTimeout; customClosedTimeout != nil {
-					afterClosed = util.Duration(customClosedTimeout.Duration)
-					if afterClosed == 0 {
-						return ""
+		} else {
+			// Workload in the workspace, e.g. a build running in a closed browser tab, counts as activity if enabled
+			lastActivity = lastActivityIncludingMachine
+			if isClosed {
+				reason := func() string {
+					afterClosed := timeouts.AfterClose
+					if customClosedTimeout := ws.Spec.Timeout.ClosedTimeout; customClosedTimeout != nil {
+						afterClosed = util.Duration(customClosedTimeout.Duration)
+						if afterClosed == 0 {
+							return ""
+						}
 					}
+					return decide(*lastActivity, afterClosed, activityClosed)
+				}()
+				if reason != "" {
+					return reason
 				}
-				return decide(*lastActivity, afterClosed, activityClosed)
-			}()
-			if reason != "" {
-				return reason
 			}
 		}
 		return decide(*lastActivity, timeout, activity)
@@ -129,6 +129,52 @@ var _ = Describe("TimeoutController", func() {
 				lastActivityAgo: pointer.Duration(10 * time.Minute),
 				expectTimeout:   true,
 			}),
+			Entry("shouldn't timeout closed workspace with recent machine activity", testCase{
+				phase: workspacev1.WorkspacePhaseRunning,
+				update: func(ws *workspacev1.Workspace) {
+					ws.Spec.Timeout.MachineActivity = &workspacev1.MachineActivitySpec{CPUMillicores: 500}
+				},
+				updateStatus: func(ws *workspacev1.Workspace) {
+					ws.Status.Conditions = wsk8s.AddUniqueCondition(ws.Status.Conditions, metav1.Condition{
+						Type:               string(workspacev1.WorkspaceConditionClosed),
+						LastTransitionTime: metav1.Now(),
+						Status:             metav1.ConditionTrue,
+					})
+					lastMachineActivity := metav1.NewTime(now.Add(-1 * time.Minute))
+					ws.Status.LastMachineActivity = &lastMachineActivity
+				},
+				age:             5 * time.Hour,
+				lastActivityAgo: pointer.Duration(10 * time.Minute),
+				expectTimeout:   false,
+			}),
+			Entry("should timeout closed workspace with machine activity if not enabled", testCase{
+				phase: workspacev1.WorkspacePhaseRunning,
+				updateStatus: func(ws *workspacev1.Workspace) {
+					ws.Status.Conditions = wsk8s.AddUniqueCondition(ws.Status.Conditions, metav1.Condition{
+						Type:               string(workspacev1.WorkspaceConditionClosed),
+						LastTransitionTime: metav1.Now(),
+						Status:             metav1.ConditionTrue,
+					})
+					lastMachineActivity := metav1.NewTime(now.Add(-1 * time.Minute))
+					ws.Status.LastMachineActivity = &lastMachineActivity
+				},
+				age:             5 * time.Hour,
+				lastActivityAgo: pointer.Duration(10 * time.Minute),
+				expectTimeout:   true,
+			}),
+			Entry("should timeout workspace with outdated machine activity", testCase{
+				phase: workspacev1.WorkspacePhaseRunning,
+				update: func(ws *workspacev1.Workspace) {
+					ws.Spec.Timeout.MachineActivity = &workspacev1.MachineActivitySpec{CPUMillicores: 500}
+				},
+				updateStatus: func(ws *workspacev1.Workspace) {
+					lastMachineActivity := metav1.NewTime(now.Add(-3 * time.Hour))
+					ws.Status.LastMachineActivity = &lastMachineActivity
+				},
+				age:             10 * time.Hour,
+				lastActivityAgo: pointer.Duration(2 * time.Hour),
+				expectTimeout:   true,
+			}),
 			Entry("should timeout headless workspace", testCase{
 				phase: workspacev1.WorkspacePhaseRunning,
 				update: func(ws *workspacev1.Workspace) {
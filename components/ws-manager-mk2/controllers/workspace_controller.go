@@ -347,6 +347,7 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 		lastState.pendingStartTime = time.Now()
 	} else if !lastState.pendingStartTime.IsZero() && workspace.Status.Phase != workspacev1.WorkspacePhasePending {
 		r.metrics.recordWorkspacePendingTime(&log, workspace, lastState.pendingStartTime)
+		recordStartupSpan(workspace, "workspace.pending", lastState.pendingStartTime)
 		lastState.pendingStartTime = time.Time{}
 	}
 
@@ -354,6 +355,8 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 		lastState.creatingStartTime = time.Now()
 	} else if !lastState.creatingStartTime.IsZero() && workspace.Status.Phase != workspacev1.WorkspacePhaseCreating {
 		r.metrics.recordWorkspaceCreatingTime(&log, workspace, lastState.creatingStartTime)
+		// workspaces are in the creating phase while the node pulls their images
+		recordStartupSpan(workspace, "workspace.image_pull", lastState.creatingStartTime)
 		lastState.creatingStartTime = time.Time{}
 	}
 
@@ -375,6 +378,7 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 
 	if !lastState.recordedStartTime && workspace.Status.Phase == workspacev1.WorkspacePhaseRunning {
 		r.metrics.recordWorkspaceStartupTime(&log, workspace)
+		recordStartupSpan(workspace, "workspace.startup", workspace.CreationTimestamp.Time)
 		lastState.recordedStartTime = true
 	}
 
@@ -406,6 +410,17 @@ func isStartFailure(ws *workspacev1.Workspace) bool {
 	return !everReady && !isAborted && !isStoppedByRequest
 }
 
+// recordStartupSpan adds a span covering a workspace phase to the startup trace of the workspace,
+// if the workspace carries one.
+func recordStartupSpan(ws *workspacev1.Workspace, name string, start time.Time) {
+	if _, ok := ws.Annotations[tracing.TraceParentAnnotation]; !ok {
+		return
+	}
+
+	ctx := tracing.ExtractAnnotations(context.Background(), ws.Annotations)
+	tracing.RecordSpan(ctx, name, start, time.Now(), nil, tracing.OWIAttributes(ws.OWI())...)
+}
+
 func (r *WorkspaceReconciler) emitPhaseEvents(ctx context.Context, ws *workspacev1.Workspace, old *workspacev1.WorkspaceStatus) {
 	if ws.Status.Phase == workspacev1.WorkspacePhaseInitializing && old.Phase != workspacev1.WorkspacePhaseInitializing {
 		r.Recorder.Event(ws, corev1.EventTypeNormal, "Initializing", "")
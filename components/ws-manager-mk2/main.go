@@ -44,6 +44,7 @@ import (
 	imgbldr "github.com/gitpod-io/gitpod/image-builder/api"
 	regapi "github.com/gitpod-io/gitpod/registry-facade/api"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/controllers"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/audit"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
 	imgproxy "github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/proxy"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/service"
@@ -218,6 +219,34 @@ func main() {
 		os.Exit(1)
 	}
 
+	nodeDrainReconciler, err := controllers.NewNodeDrainReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), cfg.Manager, maintenanceReconciler, metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to create node drain controller", "controller", "NodeDrain")
+		os.Exit(1)
+	}
+
+	if err = nodeDrainReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup node drain controller with manager", "controller", "NodeDrain")
+		os.Exit(1)
+	}
+
+	if cfg.Manager.Audit != nil {
+		sinks, err := audit.NewSinks(cfg.Manager.Audit)
+		if err != nil {
+			setupLog.Error(err, "unable to create audit sinks")
+			os.Exit(1)
+		}
+		publisher, err := audit.NewPublisher(cfg.Manager.Audit, sinks, mgr.GetCache(), mgr.GetClient(), metrics.Registry)
+		if err != nil {
+			setupLog.Error(err, "unable to create audit publisher")
+			os.Exit(1)
+		}
+		if err = mgr.Add(publisher); err != nil {
+			setupLog.Error(err, "unable to add audit publisher to manager")
+			os.Exit(1)
+		}
+	}
+
 	if err = maintenanceReconciler.SetupWithManager(mgrCtx, mgr); err != nil {
 		setupLog.Error(err, "unable to setup maintenance controller with manager", "controller", "Maintenance")
 		os.Exit(1)
@@ -10,6 +10,7 @@ import (
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 )
 
+// Last returns the time of the last user activity, or nil if the user was never active.
 func Last(ws *workspacev1.Workspace) *time.Time {
 	lastActivity := ws.Status.LastActivity
 	if lastActivity != nil {
@@ -18,3 +19,18 @@ func Last(ws *workspacev1.Workspace) *time.Time {
 
 	return nil
 }
+
+// LastIncludingMachine returns the time of the last user or machine activity, whichever is more recent.
+// Machine activity is only taken into account if it is enabled for the workspace.
+func LastIncludingMachine(ws *workspacev1.Workspace) *time.Time {
+	last := Last(ws)
+	if ws.Spec.Timeout.MachineActivity == nil || ws.Status.LastMachineActivity == nil {
+		return last
+	}
+
+	machine := ws.Status.LastMachineActivity.Time
+	if last == nil || machine.After(*last) {
+		return &machine
+	}
+	return last
+}
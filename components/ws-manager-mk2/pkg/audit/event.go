@@ -0,0 +1,199 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package audit
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	contentTypeJSON        = "application/json"
+
+	TypePhaseChanged      = "io.gitpod.workspace.phase.changed"
+	TypeTimedOut          = "io.gitpod.workspace.timedout"
+	TypeContentReady      = "io.gitpod.workspace.content.ready"
+	TypeContentFailed     = "io.gitpod.workspace.content.failed"
+	TypeBackupCompleted   = "io.gitpod.workspace.backup.completed"
+	TypeBackupFailed      = "io.gitpod.workspace.backup.failed"
+	TypeSnapshotCompleted = "io.gitpod.workspace.snapshot.completed"
+	TypeSnapshotFailed    = "io.gitpod.workspace.snapshot.failed"
+	TypeAdmissionChanged  = "io.gitpod.workspace.admission.changed"
+	TypePortExposed       = "io.gitpod.workspace.port.exposed"
+	TypePortUnexposed     = "io.gitpod.workspace.port.unexposed"
+)
+
+// Event is a CloudEvent (v1.0) in structured JSON mode
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            EventData `json:"data"`
+}
+
+// EventData describes the workspace an event happened to and what happened.
+// Free-form fields are scrubbed before an event is published.
+type EventData struct {
+	InstanceID     string `json:"instanceId" scrub:"ignore"`
+	WorkspaceID    string `json:"workspaceId" scrub:"ignore"`
+	OwnerID        string `json:"ownerId" scrub:"ignore"`
+	OrganizationID string `json:"organizationId,omitempty" scrub:"ignore"`
+	WorkspaceType  string `json:"workspaceType,omitempty" scrub:"ignore"`
+	WorkspaceClass string `json:"workspaceClass,omitempty" scrub:"ignore"`
+
+	Phase             string    `json:"phase,omitempty" scrub:"ignore"`
+	PreviousPhase     string    `json:"previousPhase,omitempty" scrub:"ignore"`
+	Admission         string    `json:"admission,omitempty" scrub:"ignore"`
+	PreviousAdmission string    `json:"previousAdmission,omitempty" scrub:"ignore"`
+	Port              *PortData `json:"port,omitempty"`
+	Snapshot          string    `json:"snapshot,omitempty"`
+	Message           string    `json:"message,omitempty"`
+}
+
+// PortData describes an exposed workspace port
+type PortData struct {
+	Port       uint32 `json:"port"`
+	Visibility string `json:"visibility" scrub:"ignore"`
+	Protocol   string `json:"protocol" scrub:"ignore"`
+}
+
+func newEvent(tpe string, ws *workspacev1.Workspace) Event {
+	return Event{
+		Type:    tpe,
+		Subject: ws.Name,
+		Data: EventData{
+			InstanceID:     ws.Name,
+			WorkspaceID:    ws.Spec.Ownership.WorkspaceID,
+			OwnerID:        ws.Spec.Ownership.Owner,
+			OrganizationID: ws.Labels[wsk8s.TeamLabel],
+			WorkspaceType:  string(ws.Spec.Type),
+			WorkspaceClass: ws.Spec.Class,
+		},
+	}
+}
+
+// WorkspaceEvents computes the lifecycle events between two versions of a workspace
+func WorkspaceEvents(old, new *workspacev1.Workspace) []Event {
+	var res []Event
+
+	if new.Status.Phase != "" && new.Status.Phase != old.Status.Phase {
+		ev := newEvent(TypePhaseChanged, new)
+		ev.Data.Phase = string(new.Status.Phase)
+		ev.Data.PreviousPhase = string(old.Status.Phase)
+		res = append(res, ev)
+	}
+
+	for _, c := range []struct {
+		Condition workspacev1.WorkspaceCondition
+		Type      string
+	}{
+		{workspacev1.WorkspaceConditionTimeout, TypeTimedOut},
+		{workspacev1.WorkspaceConditionBackupComplete, TypeBackupCompleted},
+		{workspacev1.WorkspaceConditionBackupFailure, TypeBackupFailed},
+	} {
+		if old.IsConditionTrue(c.Condition) || !new.IsConditionTrue(c.Condition) {
+			continue
+		}
+		ev := newEvent(c.Type, new)
+		if cond := wsk8s.GetCondition(new.Status.Conditions, string(c.Condition)); cond != nil {
+			ev.Data.Message = cond.Message
+		}
+		res = append(res, ev)
+	}
+
+	if old.Spec.Admission.Level != new.Spec.Admission.Level {
+		ev := newEvent(TypeAdmissionChanged, new)
+		ev.Data.Admission = string(new.Spec.Admission.Level)
+		ev.Data.PreviousAdmission = string(old.Spec.Admission.Level)
+		res = append(res, ev)
+	}
+
+	res = append(res, contentEvents(old, new)...)
+	res = append(res, portEvents(old, new)...)
+
+	return res
+}
+
+// contentEvents reports the content initialization ws-daemon performs on the node. ws-daemon's other
+// actions are covered by the backup conditions it sets.
+func contentEvents(old, new *workspacev1.Workspace) []Event {
+	cond := wsk8s.GetCondition(new.Status.Conditions, string(workspacev1.WorkspaceConditionContentReady))
+	if cond == nil {
+		return nil
+	}
+	if prev := wsk8s.GetCondition(old.Status.Conditions, string(workspacev1.WorkspaceConditionContentReady)); prev != nil && prev.Status == cond.Status && prev.Reason == cond.Reason {
+		return nil
+	}
+
+	switch {
+	case cond.Status == metav1.ConditionTrue:
+		return []Event{newEvent(TypeContentReady, new)}
+	case cond.Reason == workspacev1.ReasonInitializationFailure:
+		ev := newEvent(TypeContentFailed, new)
+		ev.Data.Message = cond.Message
+		return []Event{ev}
+	default:
+		return nil
+	}
+}
+
+func portEvents(old, new *workspacev1.Workspace) []Event {
+	oldPorts := make(map[uint32]workspacev1.PortSpec, len(old.Spec.Ports))
+	for _, p := range old.Spec.Ports {
+		oldPorts[p.Port] = p
+	}
+	newPorts := make(map[uint32]workspacev1.PortSpec, len(new.Spec.Ports))
+	for _, p := range new.Spec.Ports {
+		newPorts[p.Port] = p
+	}
+
+	var res []Event
+	for _, p := range new.Spec.Ports {
+		if o, ok := oldPorts[p.Port]; ok && o.Equal(p) {
+			continue
+		}
+		// Changing the visibility or protocol of a port re-exposes it.
+		ev := newEvent(TypePortExposed, new)
+		ev.Data.Port = &PortData{Port: p.Port, Visibility: string(p.Visibility), Protocol: string(p.Protocol)}
+		res = append(res, ev)
+	}
+
+	for _, p := range old.Spec.Ports {
+		if _, ok := newPorts[p.Port]; ok {
+			continue
+		}
+		ev := newEvent(TypePortUnexposed, new)
+		ev.Data.Port = &PortData{Port: p.Port, Visibility: string(p.Visibility), Protocol: string(p.Protocol)}
+		res = append(res, ev)
+	}
+
+	return res
+}
+
+// SnapshotEvents computes the lifecycle events between two versions of a snapshot of workspace ws
+func SnapshotEvents(old, new *workspacev1.Snapshot, ws *workspacev1.Workspace) []Event {
+	if old.Status.Completed || !new.Status.Completed {
+		return nil
+	}
+
+	if new.Status.Error != "" {
+		ev := newEvent(TypeSnapshotFailed, ws)
+		ev.Data.Message = new.Status.Error
+		return []Event{ev}
+	}
+
+	ev := newEvent(TypeSnapshotCompleted, ws)
+	ev.Data.Snapshot = new.Status.URL
+	return []Event{ev}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gitpod-io/gitpod/components/scrubber"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	defaultQueueSize      = 1000
+	defaultEnqueueTimeout = 5 * time.Second
+	sendTimeout           = 10 * time.Second
+)
+
+// NewSinks creates the sinks configured in cfg
+func NewSinks(cfg *config.AuditConfiguration) (map[string]Sink, error) {
+	sinks := make(map[string]Sink)
+	if cfg.Webhook != nil {
+		sinks["webhook"] = NewWebhookSink(cfg.Webhook.URL, time.Duration(cfg.Webhook.Timeout))
+	}
+	if cfg.File != nil {
+		s, err := NewFileSink(cfg.File.Path)
+		if err != nil {
+			return nil, err
+		}
+		sinks["file"] = s
+	}
+	if cfg.Redis != nil {
+		sinks["redis"] = NewRedisSink(cfg.Redis.Address, cfg.Redis.Stream, cfg.Redis.MaxLen)
+	}
+	return sinks, nil
+}
+
+// NewPublisher creates a publisher which sends workspace lifecycle events to all sinks.
+// The publisher observes workspaces and snapshots through the informers and runs as
+// manager.Runnable, i.e. only on the leader.
+func NewPublisher(cfg *config.AuditConfiguration, sinks map[string]Sink, informers cache.Informers, reader client.Reader, reg prometheus.Registerer) (*Publisher, error) {
+	queueSize := cfg.QueueSize
+	if queueSize == 0 {
+		queueSize = defaultQueueSize
+	}
+	enqueueTimeout := time.Duration(cfg.EnqueueTimeout)
+	if enqueueTimeout == 0 {
+		enqueueTimeout = defaultEnqueueTimeout
+	}
+
+	p := &Publisher{
+		Source:    cfg.Source,
+		Informers: informers,
+		Reader:    reader,
+		sinks:     sinks,
+		events:    make(chan Event, queueSize),
+
+		enqueueTimeout: enqueueTimeout,
+
+		sentTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      "audit_events_sent_total",
+			Help:      "Total number of audit events sent to a sink",
+		}, []string{"sink", "success"}),
+		droppedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      "audit_events_dropped_total",
+			Help:      "Total number of audit events dropped because the queue stayed full or the event could not be scrubbed",
+		}),
+	}
+
+	err := reg.Register(p.sentTotal)
+	if err != nil {
+		return nil, err
+	}
+	err = reg.Register(p.droppedTotal)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Publisher publishes workspace lifecycle events as CloudEvents
+type Publisher struct {
+	Source    string
+	Informers cache.Informers
+	Reader    client.Reader
+
+	sinks  map[string]Sink
+	events chan Event
+
+	enqueueTimeout time.Duration
+
+	sentTotal    *prometheus.CounterVec
+	droppedTotal prometheus.Counter
+}
+
+// Publish queues an event for delivery. The event envelope is completed and the event data scrubbed.
+// If the queue is full, Publish waits for room up to the enqueue timeout and drops the event afterwards.
+// Publish is called from the informer event handlers, which don't block the controllers.
+func (p *Publisher) Publish(ev Event) {
+	ev.SpecVersion = cloudEventsSpecVersion
+	ev.ID = uuid.NewString()
+	ev.Source = p.Source
+	ev.DataContentType = contentTypeJSON
+	if ev.Time.IsZero() {
+		ev.Time = time.Now().UTC()
+	}
+	if err := scrubber.Default.Struct(&ev.Data); err != nil {
+		log.Log.Error(err, "cannot scrub audit event, dropping it", "type", ev.Type, "instanceId", ev.Data.InstanceID)
+		p.droppedTotal.Inc()
+		return
+	}
+	// Struct only scrubs fields based on their name, but messages can contain anything.
+	ev.Data.Message = scrubber.Default.Value(ev.Data.Message)
+
+	select {
+	case p.events <- ev:
+		return
+	default:
+	}
+
+	timer := time.NewTimer(p.enqueueTimeout)
+	defer timer.Stop()
+	select {
+	case p.events <- ev:
+	case <-timer.C:
+		log.Log.Error(nil, "audit event queue stayed full, dropping event", "type", ev.Type, "instanceId", ev.Data.InstanceID)
+		p.droppedTotal.Inc()
+	}
+}
+
+// Start observes workspaces and snapshots and delivers their lifecycle events until ctx is canceled.
+func (p *Publisher) Start(ctx context.Context) error {
+	wsInformer, err := p.Informers.GetInformer(ctx, &workspacev1.Workspace{})
+	if err != nil {
+		return fmt.Errorf("cannot get workspace informer: %w", err)
+	}
+	_, err = wsInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			old, ok1 := oldObj.(*workspacev1.Workspace)
+			new, ok2 := newObj.(*workspacev1.Workspace)
+			if !ok1 || !ok2 {
+				return
+			}
+			for _, ev := range WorkspaceEvents(old, new) {
+				p.Publish(ev)
+			}
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot watch workspaces: %w", err)
+	}
+
+	snapshotInformer, err := p.Informers.GetInformer(ctx, &workspacev1.Snapshot{})
+	if err != nil {
+		return fmt.Errorf("cannot get snapshot informer: %w", err)
+	}
+	_, err = snapshotInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			old, ok1 := oldObj.(*workspacev1.Snapshot)
+			new, ok2 := newObj.(*workspacev1.Snapshot)
+			if !ok1 || !ok2 {
+				return
+			}
+			p.publishSnapshotEvents(ctx, old, new)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot watch snapshots: %w", err)
+	}
+
+	p.run(ctx)
+	return nil
+}
+
+func (p *Publisher) publishSnapshotEvents(ctx context.Context, old, new *workspacev1.Snapshot) {
+	if old.Status.Completed || !new.Status.Completed {
+		return
+	}
+
+	var ws workspacev1.Workspace
+	err := p.Reader.Get(ctx, types.NamespacedName{Namespace: new.Namespace, Name: new.Spec.WorkspaceID}, &ws)
+	if err != nil {
+		// The workspace might be gone already, we still want to record the snapshot.
+		ws.Name = new.Spec.WorkspaceID
+	}
+	for _, ev := range SnapshotEvents(old, new, &ws) {
+		p.Publish(ev)
+	}
+}
+
+func (p *Publisher) run(ctx context.Context) {
+	defer func() {
+		for name, s := range p.sinks {
+			if err := s.Close(); err != nil {
+				log.Log.Error(err, "cannot close audit sink", "sink", name)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-p.events:
+			p.send(ctx, &ev)
+		}
+	}
+}
+
+func (p *Publisher) send(ctx context.Context, ev *Event) {
+	for name, s := range p.sinks {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := s.Send(sendCtx, ev)
+		cancel()
+
+		if err != nil {
+			log.Log.Error(err, "cannot send audit event", "sink", name, "type", ev.Type, "instanceId", ev.Data.InstanceID)
+		}
+		p.sentTotal.WithLabelValues(name, fmt.Sprint(err == nil)).Inc()
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Sink receives published events
+type Sink interface {
+	// Send delivers a single event. The publisher never calls Send concurrently.
+	Send(ctx context.Context, ev *Event) error
+	io.Closer
+}
+
+const defaultWebhookTimeout = 5 * time.Second
+
+// NewWebhookSink creates a sink which POSTs events to an HTTP endpoint
+// using the CloudEvents structured content mode.
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	if timeout == 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &WebhookSink{
+		URL:    url,
+		Client: &http.Client{Timeout: timeout},
+	}
+}
+
+// WebhookSink POSTs events to an HTTP endpoint
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+func (s *WebhookSink) Send(ctx context.Context, ev *Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=UTF-8")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *WebhookSink) Close() error {
+	s.Client.CloseIdleConnections()
+	return nil
+}
+
+// NewFileSink creates a sink which appends events as JSON lines to a file
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open audit log %s: %w", path, err)
+	}
+	return &FileSink{f: f}, nil
+}
+
+// FileSink appends events as JSON lines to a file
+type FileSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func (s *FileSink) Send(ctx context.Context, ev *Event) error {
+	line, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.f.Write(line)
+	return err
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
+
+// NewRedisSink creates a sink which adds events to a Redis stream
+func NewRedisSink(address, stream string, maxLen int64) *RedisSink {
+	return &RedisSink{
+		Client: redis.NewClient(&redis.Options{Addr: address}),
+		Stream: stream,
+		MaxLen: maxLen,
+	}
+}
+
+// RedisSink adds events to a Redis stream. Each stream entry carries the event type
+// and the JSON serialised event.
+type RedisSink struct {
+	Client redis.UniversalClient
+	Stream string
+	MaxLen int64
+}
+
+func (s *RedisSink) Send(ctx context.Context, ev *Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.Stream,
+		MaxLen: s.MaxLen,
+		Approx: s.MaxLen > 0,
+		Values: map[string]interface{}{
+			"type":  ev.Type,
+			"event": payload,
+		},
+	}).Err()
+}
+
+func (s *RedisSink) Close() error {
+	return s.Client.Close()
+}
@@ -49,6 +49,10 @@ func (p ImageBuilder) ListBuilds(ctx context.Context, req *api.ListBuildsRequest
 	return p.D.ListBuilds(ctx, req)
 }
 
+func (p ImageBuilder) GetBuildSBOM(ctx context.Context, req *api.GetBuildSBOMRequest) (*api.GetBuildSBOMResponse, error) {
+	return p.D.GetBuildSBOM(ctx, req)
+}
+
 type ProtoMessage interface {
 	proto.Message
 	comparable
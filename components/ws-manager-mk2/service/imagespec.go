@@ -7,6 +7,7 @@ package service
 import (
 	"context"
 
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	regapi "github.com/gitpod-io/gitpod/registry-facade/api"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"google.golang.org/grpc/codes"
@@ -37,10 +38,11 @@ func (is *WorkspaceImageSpecProvider) GetImageSpec(ctx context.Context, req *reg
 
 	return &regapi.GetImageSpecResponse{
 		Spec: &regapi.ImageSpec{
-			BaseRef:       pointer.StringDeref(ws.Spec.Image.Workspace.Ref, ""),
-			IdeRef:        ws.Spec.Image.IDE.Web,
-			IdeLayerRef:   ws.Spec.Image.IDE.Refs,
-			SupervisorRef: ws.Spec.Image.IDE.Supervisor,
+			BaseRef:        pointer.StringDeref(ws.Spec.Image.Workspace.Ref, ""),
+			IdeRef:         ws.Spec.Image.IDE.Web,
+			IdeLayerRef:    ws.Spec.Image.IDE.Refs,
+			SupervisorRef:  ws.Spec.Image.IDE.Supervisor,
+			OrganizationId: ws.Labels[wsk8s.TeamLabel],
 		},
 	}, nil
 }
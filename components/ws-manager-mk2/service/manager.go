@@ -108,6 +108,11 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 	tracing.ApplyOWI(span, owi)
 	defer tracing.FinishSpan(span, &err)
 
+	// The workspace startup trace begins here. Its context is stored on the workspace resource,
+	// so that the controllers, ws-daemon and supervisor can add their spans to it.
+	ctx, startupSpan := tracing.StartSpan(ctx, "StartWorkspace", tracing.OWIAttributes(owi)...)
+	defer startupSpan.End()
+
 	if wsm.maintenance.IsEnabled(ctx) {
 		return &wsmanapi.StartWorkspaceResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
 	}
@@ -197,6 +202,29 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 
+	var machineActivity *workspacev1.MachineActivitySpec
+	if class.MachineActivity != nil {
+		machineActivity = &workspacev1.MachineActivitySpec{
+			CPUMillicores:      class.MachineActivity.CPUMillicores,
+			TaskProcesses:      class.MachineActivity.TaskProcesses,
+			NetworkConnections: class.MachineActivity.NetworkConnections,
+		}
+	}
+	// the machine activity configured for the organization of the workspace takes precedence over the class
+	if ma := req.Spec.MachineActivity; ma != nil {
+		if ma.CpuMillicores < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "machine activity cpu millicores must not be negative")
+		}
+		machineActivity = nil
+		if ma.CpuMillicores > 0 || ma.TaskProcesses || ma.NetworkConnections {
+			machineActivity = &workspacev1.MachineActivitySpec{
+				CPUMillicores:      ma.CpuMillicores,
+				TaskProcesses:      ma.TaskProcesses,
+				NetworkConnections: ma.NetworkConnections,
+			}
+		}
+	}
+
 	annotations := make(map[string]string)
 	for k, v := range req.Metadata.Annotations {
 		annotations[k] = v
@@ -213,6 +241,8 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		}
 	}
 
+	tracing.InjectAnnotations(ctx, annotations)
+
 	var sshGatewayCAPublicKey string
 	for _, feature := range req.Spec.FeatureFlags {
 		switch feature {
@@ -276,6 +306,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 				Time:            timeout,
 				ClosedTimeout:   closedTimeout,
 				MaximumLifetime: maximumLifetime,
+				MachineActivity: machineActivity,
 			},
 			Admission: workspacev1.AdmissionSpec{
 				Level: admissionLevel,
@@ -286,6 +317,9 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			SSHGatewayCAPublicKey: sshGatewayCAPublicKey,
 		},
 	}
+	if team := req.Metadata.GetTeam(); team != "" {
+		ws.Labels[wsk8s.TeamLabel] = team
+	}
 	controllerutil.AddFinalizer(&ws, workspacev1.GitpodFinalizerName)
 
 	exists, err := wsm.workspaceExists(ctx, req.Metadata.MetaId)
@@ -1140,7 +1174,9 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 			StoppedByRequest:    convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStoppedByRequest)),
 			FinalBackupComplete: convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionBackupComplete)),
 			Aborted:             convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionAborted)),
+			NodeDrain:           stoppedByNodeDrain(ws),
 		},
+		Message: statusMessage(ws),
 		Runtime: runtime,
 		Auth: &wsmanapi.WorkspaceAuthentication{
 			Admission:  admissionLevel,
@@ -1152,6 +1188,25 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 	return res
 }
 
+// statusMessage tells the user about upcoming changes to their running workspace. Supervisor shows it as a notification.
+func statusMessage(ws *workspacev1.Workspace) string {
+	if ws.Status.Phase == workspacev1.WorkspacePhaseRunning &&
+		ws.IsConditionTrue(workspacev1.WorkspaceConditionNodeDrain) &&
+		!ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) {
+		return "The node this workspace runs on is being drained. The workspace will be stopped in a few minutes and restarted on another node from its backup."
+	}
+	return ""
+}
+
+// stoppedByNodeDrain reports whether the node drain stopped the workspace, which makes the server restart it on another node.
+func stoppedByNodeDrain(ws *workspacev1.Workspace) wsmanapi.WorkspaceConditionBool {
+	c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStoppedByRequest))
+	if c == nil || c.Status != metav1.ConditionTrue || c.Reason != workspacev1.StoppedByNodeDrainReason {
+		return wsmanapi.WorkspaceConditionBool_FALSE
+	}
+	return wsmanapi.WorkspaceConditionBool_TRUE
+}
+
 func getConditionMessageIfTrue(conds []metav1.Condition, tpe string) string {
 	for _, c := range conds {
 		if c.Type == tpe && c.Status == metav1.ConditionTrue {
@@ -10,8 +10,10 @@ import (
 
 	"github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestDescribeCluster(t *testing.T) {
@@ -93,3 +95,48 @@ func TestDescribeCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestStoppedByNodeDrain(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Conditions []metav1.Condition
+		Expected   api.WorkspaceConditionBool
+	}{
+		{
+			Name:     "running",
+			Expected: api.WorkspaceConditionBool_FALSE,
+		},
+		{
+			Name: "announced drain",
+			Conditions: []metav1.Condition{
+				workspacev1.NewWorkspaceConditionNodeDrain("node"),
+			},
+			Expected: api.WorkspaceConditionBool_FALSE,
+		},
+		{
+			Name: "stopped by node drain",
+			Conditions: []metav1.Condition{
+				workspacev1.NewWorkspaceConditionNodeDrain("node"),
+				workspacev1.NewWorkspaceConditionStoppedByNodeDrain("30s"),
+			},
+			Expected: api.WorkspaceConditionBool_TRUE,
+		},
+		{
+			Name: "stopped by user during drain",
+			Conditions: []metav1.Condition{
+				workspacev1.NewWorkspaceConditionNodeDrain("node"),
+				workspacev1.NewWorkspaceConditionStoppedByRequest("30s"),
+			},
+			Expected: api.WorkspaceConditionBool_FALSE,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &workspacev1.Workspace{Status: workspacev1.WorkspaceStatus{Conditions: test.Conditions}}
+			if act := stoppedByNodeDrain(ws); act != test.Expected {
+				t.Errorf("unexpected result: expected %v, got %v", test.Expected, act)
+			}
+		})
+	}
+}
@@ -83,6 +83,7 @@ var runCmd = &cobra.Command{
 		if err != nil {
 			log.WithError(err).Fatal("cannot create CRD-based info provider")
 		}
+		crdInfoProv.Namespace = cfg.Namespace
 		if err = crdInfoProv.SetupWithManager(mgr); err != nil {
 			log.WithError(err).Fatal(err, "unable to create CRD-based info provider", "controller", "Workspace")
 		}
@@ -157,6 +158,16 @@ var runCmd = &cobra.Command{
 			readCAKeyFile()
 		}
 
+		var userCA *sshproxy.UserCertificateAuthority
+		if cfg.Proxy.SSHGatewayUserCAKeyFile != "" {
+			b, err := os.ReadFile(cfg.Proxy.SSHGatewayUserCAKeyFile)
+			if err != nil {
+				log.WithError(err).Error("cannot read SSH Gateway user CA keys")
+			} else if userCA, err = sshproxy.ParseUserCertificateAuthority(b, time.Duration(cfg.Proxy.SSHGatewayUserCertMaxValidity)); err != nil {
+				log.WithError(err).Error("cannot parse SSH Gateway user CA keys")
+			}
+		}
+
 		var signers []ssh.Signer
 		var sshGatewayServer *sshproxy.Server
 		flist, err := os.ReadDir("/mnt/host-key")
@@ -176,7 +187,31 @@ var runCmd = &cobra.Command{
 				signers = append(signers, hostSigner)
 			}
 			if len(signers) > 0 {
-				sshGatewayServer = sshproxy.New(signers, infoprov, heartbeat, caKey)
+				sshGatewayServer = sshproxy.New(signers, infoprov, heartbeat, caKey, userCA)
+				if audit := cfg.Proxy.SSHGatewayAudit; audit != nil {
+					var sinks sshproxy.AuditSinks
+					if audit.Log {
+						sinks = append(sinks, sshproxy.LogAuditSink{})
+					}
+					if audit.LogFile != "" {
+						sink, err := sshproxy.NewFileAuditSink(audit.LogFile)
+						if err != nil {
+							log.WithError(err).Fatal("cannot open SSH audit log")
+						}
+						sinks = append(sinks, sink)
+					}
+					if len(sinks) > 0 {
+						sshGatewayServer.Audit = sinks
+					}
+					if audit.RecordingLocation != "" {
+						sshGatewayServer.Recording = &sshproxy.RecordingConfig{
+							Location:      audit.RecordingLocation,
+							Organizations: audit.RecordedOrganizations,
+							Retention:     time.Duration(audit.RecordingRetention),
+						}
+						go sshGatewayServer.Recording.PruneRecordings(context.Background(), time.Hour)
+					}
+				}
 				l, err := net.Listen("tcp", ":2200")
 				if err != nil {
 					panic(err)
@@ -190,7 +225,7 @@ var runCmd = &cobra.Command{
 
 		go func() {
 			log.Infof("startint proxying on %s", cfg.Ingress.HTTPAddress)
-			proxy.NewWorkspaceProxy(cfg.Ingress, cfg.Proxy, proxy.HostBasedRouter(cfg.Ingress.Header, cfg.Proxy.GitpodInstallation.WorkspaceHostSuffix, cfg.Proxy.GitpodInstallation.WorkspaceHostSuffixRegex), infoprov, sshGatewayServer).MustServe(ctrlCtx)
+			proxy.NewWorkspaceProxy(cfg.Ingress, cfg.Proxy, cfg.Proxy.GitpodInstallation.Router(cfg.Ingress.Header), infoprov, sshGatewayServer).MustServe(ctrlCtx)
 		}()
 
 		log.Info("🚪 ws-proxy is up and running")
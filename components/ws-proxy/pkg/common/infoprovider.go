@@ -60,9 +60,15 @@ type WorkspaceInfo struct {
 	Auth      *wsapi.WorkspaceAuthentication
 	StartedAt time.Time
 
-	OwnerUserId   string
-	SSHPublicKeys []string
-	IsRunning     bool
+	OwnerUserId    string
+	OrganizationID string
+	WorkspaceClass string
+	SSHPublicKeys  []string
+	IsRunning      bool
+	// SSHRecording is true if terminal sessions at the SSH gateway are recorded for this workspace
+	SSHRecording bool
+	// RevokedPortShares are the IDs of port share links which were revoked before they expired
+	RevokedPortShares []string
 
 	IsEnabledSSHCA bool
 	IsManagedByMk2 bool
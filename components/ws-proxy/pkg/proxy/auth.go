@@ -20,11 +20,7 @@ import (
 // WorkspaceAuthHandler rejects requests which are not authenticated or authorized to access a workspace.
 func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.MiddlewareFunc {
 	return func(h http.Handler) http.Handler {
-		cookiePrefix := domain
-		for _, c := range []string{" ", "-", "."} {
-			cookiePrefix = strings.ReplaceAll(cookiePrefix, c, "_")
-		}
-		cookiePrefix = "_" + cookiePrefix + "_ws_"
+		cookiePrefix := ownerCookiePrefix(domain)
 
 		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 			var (
@@ -83,30 +79,41 @@ func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.
 				// port seems to be private - subject it to the same access policy as the workspace itself
 			}
 
-			tkn := req.Header.Get("x-gitpod-owner-token")
-			if tkn == "" {
-				cn := fmt.Sprintf("%s%s_owner_", cookiePrefix, ws.InstanceID)
-				c, err := req.Cookie(cn)
-				if err != nil {
-					log.WithField("cookieName", cn).Debug("no owner cookie present")
-					resp.WriteHeader(http.StatusUnauthorized)
+			if status := checkOwnerToken(req, cookiePrefix, ws); status != http.StatusOK {
+				resp.WriteHeader(status)
 
-					return
-				}
+				return
+			}
+
+			h.ServeHTTP(resp, req)
+		})
+	}
+}
+
+// WorkspaceOwnerAuthHandler rejects requests which don't carry the owner token of a workspace,
+// regardless of whether the workspace or its ports are shared.
+func WorkspaceOwnerAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		cookiePrefix := ownerCookiePrefix(domain)
+
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			wsID := mux.Vars(req)[common.WorkspaceIDIdentifier]
+			if wsID == "" {
+				getLog(req.Context()).Warn("workspace request without workspace ID")
+				resp.WriteHeader(http.StatusForbidden)
 
-				tkn = c.Value
+				return
 			}
-			tkn, err := url.QueryUnescape(tkn)
-			if err != nil {
-				log.WithError(err).Warn("cannot decode owner token")
-				resp.WriteHeader(http.StatusBadRequest)
+
+			ws := info.WorkspaceInfo(wsID)
+			if ws == nil {
+				resp.WriteHeader(http.StatusNotFound)
 
 				return
 			}
 
-			if tkn != ws.Auth.OwnerToken {
-				log.Warn("owner token mismatch")
-				resp.WriteHeader(http.StatusForbidden)
+			if status := checkOwnerToken(req, cookiePrefix, ws); status != http.StatusOK {
+				resp.WriteHeader(status)
 
 				return
 			}
@@ -115,3 +122,39 @@ func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.
 		})
 	}
 }
+
+func ownerCookiePrefix(domain string) string {
+	cookiePrefix := domain
+	for _, c := range []string{" ", "-", "."} {
+		cookiePrefix = strings.ReplaceAll(cookiePrefix, c, "_")
+	}
+	return "_" + cookiePrefix + "_ws_"
+}
+
+// checkOwnerToken compares the owner token of a request, sent as header or cookie, with the one of the workspace.
+// It returns http.StatusOK if they match and the status to fail the request with otherwise.
+func checkOwnerToken(req *http.Request, cookiePrefix string, ws *common.WorkspaceInfo) int {
+	log := getLog(req.Context())
+	tkn := req.Header.Get("x-gitpod-owner-token")
+	if tkn == "" {
+		cn := fmt.Sprintf("%s%s_owner_", cookiePrefix, ws.InstanceID)
+		c, err := req.Cookie(cn)
+		if err != nil {
+			log.WithField("cookieName", cn).Debug("no owner cookie present")
+			return http.StatusUnauthorized
+		}
+
+		tkn = c.Value
+	}
+	tkn, err := url.QueryUnescape(tkn)
+	if err != nil {
+		log.WithError(err).Warn("cannot decode owner token")
+		return http.StatusBadRequest
+	}
+
+	if ws.Auth == nil || tkn != ws.Auth.OwnerToken {
+		log.Warn("owner token mismatch")
+		return http.StatusForbidden
+	}
+	return http.StatusOK
+}
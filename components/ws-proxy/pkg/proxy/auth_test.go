@@ -253,6 +253,57 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 	}
 }
 
+func TestWorkspaceOwnerAuthHandler(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.PanicLevel)
+
+	const (
+		domain      = "test-domain.com"
+		workspaceID = "workspac-65f4-43c9-bf46-3541b89dca85"
+		instanceID  = "instance-fce1-4ff6-9364-cf6dff0c4ecf"
+		ownerToken  = "owner-token"
+	)
+	infos := map[string]*common.WorkspaceInfo{
+		workspaceID: {
+			WorkspaceID: workspaceID,
+			InstanceID:  instanceID,
+			Auth: &api.WorkspaceAuthentication{
+				Admission:  api.AdmissionLevel_ADMIT_EVERYONE,
+				OwnerToken: ownerToken,
+			},
+		},
+	}
+	tests := []struct {
+		Name        string
+		OwnerCookie string
+		WorkspaceID string
+		Expected    int
+	}{
+		{Name: "shared workspace without owner token", WorkspaceID: workspaceID, Expected: http.StatusUnauthorized},
+		{Name: "shared workspace with wrong owner token", WorkspaceID: workspaceID, OwnerCookie: "foobar", Expected: http.StatusForbidden},
+		{Name: "shared workspace with owner token", WorkspaceID: workspaceID, OwnerCookie: ownerToken, Expected: http.StatusOK},
+		{Name: "workspace not found", WorkspaceID: "other-workspace", OwnerCookie: ownerToken, Expected: http.StatusNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			handler := WorkspaceOwnerAuthHandler(domain, &fixedInfoProvider{Infos: infos})(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+				resp.WriteHeader(http.StatusOK)
+			}))
+
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/_ports/share", domain), nil)
+			if test.OwnerCookie != "" {
+				setOwnerTokenCookie(req, domain, instanceID, test.OwnerCookie)
+			}
+			req = mux.SetURLVars(req, map[string]string{common.WorkspaceIDIdentifier: test.WorkspaceID})
+
+			handler.ServeHTTP(rr, req)
+			if rr.Code != test.Expected {
+				t.Errorf("unexpected status code: want %d, got %d", test.Expected, rr.Code)
+			}
+		})
+	}
+}
+
 func setOwnerTokenCookie(r *http.Request, domain, instanceID, token string) {
 	c := ownerTokenCookie(domain, instanceID, token)
 	r.AddCookie(c)
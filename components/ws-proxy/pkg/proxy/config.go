@@ -29,6 +29,34 @@ type Config struct {
 
 	BuiltinPages        BuiltinPagesConfig `json:"builtinPages"`
 	SSHGatewayCAKeyFile string             `json:"sshCAKeyFile"`
+	// SSHGatewayUserCAKeyFile contains the public keys of the CAs whose user certificates the SSH gateway accepts
+	SSHGatewayUserCAKeyFile string `json:"sshUserCAKeyFile,omitempty"`
+	// SSHGatewayUserCertMaxValidity is the longest validity period of user certificates the SSH gateway accepts
+	SSHGatewayUserCertMaxValidity util.Duration `json:"sshUserCertMaxValidity,omitempty"`
+	// SSHGatewayAudit configures the audit log of SSH sessions at the gateway
+	SSHGatewayAudit *SSHGatewayAuditConfig `json:"sshAudit,omitempty"`
+	// PortShare enables shareable, expiring links to workspace ports
+	PortShare *PortShareConfig `json:"portShare,omitempty"`
+	// PortInspector enables capturing requests to workspace ports for inspection
+	PortInspector *PortInspectorConfig `json:"portInspector,omitempty"`
+	// PortRateLimit limits the requests to and the bandwidth of public workspace ports
+	PortRateLimit *PortRateLimitConfig `json:"portRateLimit,omitempty"`
+}
+
+// SSHGatewayAuditConfig configures the audit log and the recording of SSH sessions at the gateway
+type SSHGatewayAuditConfig struct {
+	// Log writes audit events to the log of ws-proxy
+	Log bool `json:"log,omitempty"`
+	// LogFile is the file audit events are appended to as JSON lines
+	LogFile string `json:"logFile,omitempty"`
+	// RecordingLocation is the directory terminal sessions are recorded to in the asciicast format, as <workspace-id>/<instance-id>-<start>-<channel>.cast.
+	// Recordings in the container's filesystem are lost when ws-proxy restarts, hence this should be on a persistent volume.
+	RecordingLocation string `json:"recordingLocation,omitempty"`
+	// RecordedOrganizations are the IDs of organizations whose terminal sessions are recorded, in addition
+	// to those which enabled the recording in their organization settings
+	RecordedOrganizations []string `json:"recordedOrganizations,omitempty"`
+	// RecordingRetention is how long recordings are kept before they're deleted. Defaults to 30 days.
+	RecordingRetention util.Duration `json:"recordingRetention,omitempty"`
 }
 
 // Validate validates the configuration to catch issues during startup and not at runtime.
@@ -41,6 +69,9 @@ func (c *Config) Validate() error {
 		c.BlobServer,
 		c.GitpodInstallation,
 		c.WorkspacePodConfig,
+		c.PortShare,
+		c.PortInspector,
+		c.PortRateLimit,
 	} {
 		err := v.Validate()
 		if err != nil {
@@ -110,6 +141,32 @@ type GitpodInstallation struct {
 	HostName                 string `json:"hostName"`
 	WorkspaceHostSuffix      string `json:"workspaceHostSuffix"`
 	WorkspaceHostSuffixRegex string `json:"workspaceHostSuffixRegex"`
+	// WorkspaceRouting is either "host" (the default) to serve workspaces from their own subdomains, e.g. 3000-<id>.ws.<domain>,
+	// or "path" to serve all workspaces from WorkspaceHost, e.g. ws.<domain>/ws/<id>/port/3000/.
+	WorkspaceRouting WorkspaceRouting `json:"workspaceRouting,omitempty"`
+	// WorkspaceHost is the host workspaces are served from if WorkspaceRouting is "path"
+	WorkspaceHost string `json:"workspaceHost,omitempty"`
+	// InsecureSharedWorkspaceOrigin must be set to use the "path" WorkspaceRouting, see PathBasedRouter.
+	InsecureSharedWorkspaceOrigin bool `json:"insecureSharedWorkspaceOrigin,omitempty"`
+}
+
+// WorkspaceRouting determines how ws-proxy resolves workspaces from requests
+type WorkspaceRouting string
+
+const (
+	// WorkspaceRoutingHost routes requests by their host, see HostBasedRouter
+	WorkspaceRoutingHost WorkspaceRouting = "host"
+	// WorkspaceRoutingPath routes requests by their path, see PathBasedRouter
+	WorkspaceRoutingPath WorkspaceRouting = "path"
+)
+
+// Router returns the WorkspaceRouter for the configured workspace routing.
+// header is the header requests carry their original host in.
+func (c *GitpodInstallation) Router(header string) WorkspaceRouter {
+	if c.WorkspaceRouting == WorkspaceRoutingPath {
+		return PathBasedRouter(header, c.WorkspaceHost)
+	}
+	return HostBasedRouter(header, c.WorkspaceHostSuffix, c.WorkspaceHostSuffixRegex)
 }
 
 // Validate validates the configuration to catch issues during startup and not at runtime.
@@ -118,10 +175,18 @@ func (c *GitpodInstallation) Validate() error {
 		return xerrors.Errorf("GitpodInstallation not configured")
 	}
 
+	workspaceHostField := validation.Field(&c.WorkspaceHostSuffix, validation.Required)
+	if c.WorkspaceRouting == WorkspaceRoutingPath {
+		if !c.InsecureSharedWorkspaceOrigin {
+			return xerrors.Errorf("path based workspace routing serves all workspaces and ports from a single origin and requires insecureSharedWorkspaceOrigin")
+		}
+		workspaceHostField = validation.Field(&c.WorkspaceHost, validation.Required)
+	}
 	return validation.ValidateStruct(c,
 		validation.Field(&c.Scheme, validation.Required),
 		validation.Field(&c.HostName, validation.Required), // TODO IP ONLY: Check if there is any dependency. If yes, remove it.
-		validation.Field(&c.WorkspaceHostSuffix, validation.Required),
+		validation.Field(&c.WorkspaceRouting, validation.In(WorkspaceRoutingHost, WorkspaceRoutingPath)),
+		workspaceHostField,
 	)
 }
 
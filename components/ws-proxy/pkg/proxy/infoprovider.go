@@ -6,13 +6,17 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"net/url"
 	"sort"
+	"time"
 
 	"golang.org/x/xerrors"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -51,6 +55,8 @@ func getPortStr(urlStr string) string {
 type CRDWorkspaceInfoProvider struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// Namespace is the namespace of the workspace resources, which we record revoked port share links on
+	Namespace string
 
 	store cache.ThreadSafeStore
 }
@@ -155,11 +161,17 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 		Auth:            &wsapi.WorkspaceAuthentication{Admission: admission, OwnerToken: ws.Status.OwnerToken},
 		StartedAt:       ws.CreationTimestamp.Time,
 		OwnerUserId:     ws.Spec.Ownership.Owner,
+		OrganizationID:  ws.Spec.Ownership.Team,
+		WorkspaceClass:  ws.Spec.Class,
 		SSHPublicKeys:   ws.Spec.SshPublicKeys,
 		IsRunning:       ws.Status.Phase == workspacev1.WorkspacePhaseRunning,
+		SSHRecording:    ws.Annotations[wsk8s.WorkspaceSSHRecordingAnnotation] == "true",
 		IsEnabledSSHCA:  ws.Spec.SSHGatewayCAPublicKey != "",
 		IsManagedByMk2:  managedByMk2,
 	}
+	for id := range revokedPortShares(ws.Annotations, time.Now()) {
+		wsinfo.RevokedPortShares = append(wsinfo.RevokedPortShares, id)
+	}
 
 	r.store.Update(req.Name, wsinfo)
 	log.WithField("workspace", req.Name).WithField("details", wsinfo).Debug("adding/updating workspace details")
@@ -167,6 +179,56 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// RevokePortShare records a revoked port share link on the workspace resource, so that all ws-proxy replicas reject it.
+// The revocation is forgotten after forgetAt, once the link would have expired anyway.
+func (r *CRDWorkspaceInfoProvider) RevokePortShare(ctx context.Context, workspaceID, shareID string, forgetAt time.Time) error {
+	info := r.WorkspaceInfo(workspaceID)
+	if info == nil {
+		return xerrors.Errorf("workspace %s not found", workspaceID)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var ws workspacev1.Workspace
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: info.InstanceID}, &ws)
+		if err != nil {
+			return err
+		}
+
+		revoked := revokedPortShares(ws.Annotations, time.Now())
+		revoked[shareID] = forgetAt.UTC().Truncate(time.Second)
+		b, err := json.Marshal(revoked)
+		if err != nil {
+			return err
+		}
+		if ws.Annotations == nil {
+			ws.Annotations = make(map[string]string)
+		}
+		ws.Annotations[wsk8s.WorkspaceRevokedPortSharesAnnotation] = string(b)
+		return r.Client.Update(ctx, &ws)
+	})
+}
+
+// revokedPortShares returns the port share revocations of a workspace which must not be forgotten yet
+func revokedPortShares(annotations map[string]string, now time.Time) map[string]time.Time {
+	res := make(map[string]time.Time)
+	v, ok := annotations[wsk8s.WorkspaceRevokedPortSharesAnnotation]
+	if !ok {
+		return res
+	}
+	var revoked map[string]time.Time
+	err := json.Unmarshal([]byte(v), &revoked)
+	if err != nil {
+		log.WithError(err).Warn("cannot parse revoked port shares")
+		return res
+	}
+	for id, forgetAt := range revoked {
+		if now.Before(forgetAt) {
+			res[id] = forgetAt
+		}
+	}
+	return res
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *CRDWorkspaceInfoProvider) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -191,6 +253,21 @@ func (c CompositeInfoProvider) WorkspaceInfo(workspaceID string) *common.Workspa
 	return nil
 }
 
+// RevokePortShare revokes a port share link using the first info provider which knows the workspace
+func (c CompositeInfoProvider) RevokePortShare(ctx context.Context, workspaceID, shareID string, forgetAt time.Time) error {
+	for _, ip := range c {
+		if ip.WorkspaceInfo(workspaceID) == nil {
+			continue
+		}
+		revoker, ok := ip.(PortShareRevoker)
+		if !ok {
+			break
+		}
+		return revoker.RevokePortShare(ctx, workspaceID, shareID, forgetAt)
+	}
+	return xerrors.Errorf("cannot revoke port share links of workspace %s", workspaceID)
+}
+
 type fixedInfoProvider struct {
 	Infos map[string]*common.WorkspaceInfo
 }
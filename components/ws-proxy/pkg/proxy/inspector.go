@@ -0,0 +1,390 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// inspectorSendTimeout is the time we give supervisor to record a request
+	inspectorSendTimeout = 5 * time.Second
+	// inspectorPortsTTL is the time for which we cache the ports users enabled the inspection for
+	inspectorPortsTTL = 10 * time.Second
+	// inspectorIdleTimeout is the time after which we forget workspaces without captured requests,
+	// and close their connections to supervisor
+	inspectorIdleTimeout = 1 * time.Minute
+)
+
+// PortInspectorConfig configures the capturing of HTTP requests to workspace ports,
+// which users can inspect and replay using `gp ports inspect`.
+// Requests are only captured for ports users enabled the inspection for.
+type PortInspectorConfig struct {
+	// MaxBodySize is the number of bytes we capture of request and response bodies
+	MaxBodySize int64 `json:"maxBodySize"`
+	// QueueSize is the number of captured requests per workspace waiting to be sent to supervisor.
+	// Requests captured while the queue is full are dropped.
+	QueueSize int `json:"queueSize"`
+	// Concurrency is the number of calls to supervisors we make at the same time
+	Concurrency int `json:"concurrency"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime.
+func (c *PortInspectorConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.MaxBodySize < 0 {
+		return xerrors.Errorf("port inspector max body size must not be negative")
+	}
+	if c.QueueSize <= 0 {
+		return xerrors.Errorf("port inspector queue size must be positive")
+	}
+	if c.Concurrency <= 0 {
+		return xerrors.Errorf("port inspector concurrency must be positive")
+	}
+	return nil
+}
+
+// PortInspector captures HTTP requests to workspace ports and their responses, and sends them
+// to the supervisor of the workspace. Supervisor scrubs them and keeps the most recent ones.
+type PortInspector struct {
+	Config *PortInspectorConfig
+
+	supervisorPort string
+	// calls limits the number of concurrent calls to supervisors
+	calls chan struct{}
+
+	mu         sync.Mutex
+	workspaces map[string]*inspectedWorkspace
+}
+
+// inspectedWorkspace is the state of a workspace we capture requests for.
+// All fields are guarded by the port inspector's mutex.
+type inspectedWorkspace struct {
+	IP       string
+	LastUsed time.Time
+
+	conn *grpc.ClientConn
+
+	ports         map[uint32]struct{}
+	portsFetched  time.Time
+	fetchingPorts bool
+
+	queue   []*supervisor.InspectedRequest
+	sending bool
+}
+
+// NewPortInspector creates a new port inspector which sends captured requests in the background
+func NewPortInspector(cfg *PortInspectorConfig, supervisorPort uint16) *PortInspector {
+	pi := &PortInspector{
+		Config:         cfg,
+		supervisorPort: strconv.Itoa(int(supervisorPort)),
+		calls:          make(chan struct{}, cfg.Concurrency),
+		workspaces:     make(map[string]*inspectedWorkspace),
+	}
+	go pi.forgetIdleWorkspaces()
+	return pi
+}
+
+func (pi *PortInspector) forgetIdleWorkspaces() {
+	ticker := time.NewTicker(inspectorIdleTimeout)
+	defer ticker.Stop()
+	for range ticker.C {
+		pi.mu.Lock()
+		for ip, ws := range pi.workspaces {
+			if time.Since(ws.LastUsed) < inspectorIdleTimeout || ws.sending || ws.fetchingPorts {
+				continue
+			}
+			if ws.conn != nil {
+				ws.conn.Close()
+			}
+			delete(pi.workspaces, ip)
+		}
+		pi.mu.Unlock()
+	}
+}
+
+// workspace returns the state of a workspace. Callers must hold the mutex.
+func (pi *PortInspector) workspace(ip string) *inspectedWorkspace {
+	ws, ok := pi.workspaces[ip]
+	if !ok {
+		ws = &inspectedWorkspace{IP: ip}
+		pi.workspaces[ip] = ws
+	}
+	ws.LastUsed = time.Now()
+	return ws
+}
+
+// inspected returns true if users enabled the inspection of a port. The enabled ports
+// are fetched from supervisor in the background, so that we never delay a request.
+func (pi *PortInspector) inspected(ip string, port uint32) bool {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+
+	ws := pi.workspace(ip)
+	if time.Since(ws.portsFetched) > inspectorPortsTTL && !ws.fetchingPorts {
+		ws.fetchingPorts = true
+		go pi.fetchPorts(ws)
+	}
+	_, ok := ws.ports[port]
+	return ok
+}
+
+func (pi *PortInspector) fetchPorts(ws *inspectedWorkspace) {
+	var ports map[uint32]struct{}
+	err := pi.call(ws, func(ctx context.Context, client supervisor.PortServiceClient) error {
+		resp, err := client.ListInspectedPorts(ctx, &supervisor.ListInspectedPortsRequest{})
+		if err != nil {
+			return err
+		}
+		ports = make(map[uint32]struct{}, len(resp.Ports))
+		for _, port := range resp.Ports {
+			ports[port] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).WithField("workspaceIP", ws.IP).Debug("cannot fetch inspected ports from supervisor")
+	}
+
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	// we don't retry failed calls before the ports expire, e.g. for workspaces whose supervisor doesn't support the inspection
+	ws.ports = ports
+	ws.portsFetched = time.Now()
+	ws.fetchingPorts = false
+}
+
+// enqueue queues a captured request to be sent to the supervisor of the workspace.
+// Requests are sent in the order they were captured, one at a time per workspace.
+func (pi *PortInspector) enqueue(ip string, req *supervisor.InspectedRequest) {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+
+	ws := pi.workspace(ip)
+	if len(ws.queue) >= pi.Config.QueueSize {
+		// we rather drop requests than slow down the proxy
+		return
+	}
+	ws.queue = append(ws.queue, req)
+	if !ws.sending {
+		ws.sending = true
+		go pi.send(ws)
+	}
+}
+
+func (pi *PortInspector) send(ws *inspectedWorkspace) {
+	for {
+		pi.mu.Lock()
+		if len(ws.queue) == 0 {
+			ws.sending = false
+			pi.mu.Unlock()
+			return
+		}
+		req := ws.queue[0]
+		ws.queue[0] = nil
+		ws.queue = ws.queue[1:]
+		pi.mu.Unlock()
+
+		err := pi.call(ws, func(ctx context.Context, client supervisor.PortServiceClient) error {
+			_, err := client.RecordInspectedRequest(ctx, &supervisor.RecordInspectedRequestRequest{Request: req})
+			return err
+		})
+		if err != nil {
+			log.WithError(err).WithField("workspaceIP", ws.IP).Debug("cannot send inspected request to supervisor")
+		}
+	}
+}
+
+// call calls the supervisor of a workspace once a call slot is free
+func (pi *PortInspector) call(ws *inspectedWorkspace, f func(ctx context.Context, client supervisor.PortServiceClient) error) error {
+	pi.calls <- struct{}{}
+	defer func() { <-pi.calls }()
+
+	pi.mu.Lock()
+	conn := ws.conn
+	if conn == nil {
+		c, err := grpc.Dial(net.JoinHostPort(ws.IP, pi.supervisorPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			pi.mu.Unlock()
+			return xerrors.Errorf("failed connecting to supervisor: %w", err)
+		}
+		ws.conn = c
+		conn = c
+	}
+	pi.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), inspectorSendTimeout)
+	defer cancel()
+	err := f(ctx, supervisor.NewPortServiceClient(conn))
+	if err != nil {
+		// the workspace might be gone, and its IP reused by another one
+		pi.mu.Lock()
+		if ws.conn == conn {
+			ws.conn = nil
+		}
+		pi.mu.Unlock()
+		conn.Close()
+		return err
+	}
+	return nil
+}
+
+// capture starts capturing a request which is forwarded to target.
+// It returns nil if the request is not captured.
+func (pi *PortInspector) capture(req *http.Request, target *url.URL) *requestCapture {
+	if pi == nil || getWorkspaceCoords(req).Debug {
+		return nil
+	}
+	port, err := strconv.ParseUint(target.Port(), 10, 32)
+	if err != nil {
+		return nil
+	}
+	if !pi.inspected(target.Hostname(), uint32(port)) {
+		return nil
+	}
+
+	headers := inspectedHeaders(req.Header)
+	headers = append(headers, &supervisor.HTTPHeader{Name: "Host", Values: []string{req.Host}})
+	c := &requestCapture{
+		inspector:   pi,
+		workspaceIP: target.Hostname(),
+		start:       time.Now(),
+		req: &supervisor.InspectedRequest{
+			Id:             uuid.New().String(),
+			Port:           uint32(port),
+			Method:         req.Method,
+			Uri:            req.URL.RequestURI(),
+			RequestHeaders: headers,
+			Tls:            target.Scheme == "https",
+		},
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		c.requestBody = &bodyCapture{ReadCloser: req.Body, limit: pi.Config.MaxBodySize}
+		req.Body = c.requestBody
+	} else {
+		c.requestBody = &bodyCapture{eof: true}
+	}
+	return c
+}
+
+// requestCapture captures a single request and its response
+type requestCapture struct {
+	inspector   *PortInspector
+	workspaceIP string
+	start       time.Time
+	req         *supervisor.InspectedRequest
+	requestBody *bodyCapture
+
+	once sync.Once
+}
+
+// response captures the response, which is sent once its body was read
+func (c *requestCapture) response(resp *http.Response) {
+	c.req.StatusCode = uint32(resp.StatusCode)
+	c.req.ResponseHeaders = inspectedHeaders(resp.Header)
+	if resp.StatusCode == http.StatusSwitchingProtocols {
+		// the body is the upgraded connection, which we don't capture
+		c.done(nil, nil)
+		return
+	}
+
+	body := &bodyCapture{ReadCloser: resp.Body, limit: c.inspector.Config.MaxBodySize}
+	body.onClose = func() {
+		c.done(body, nil)
+	}
+	resp.Body = body
+}
+
+// fail captures that the request could not be forwarded
+func (c *requestCapture) fail(err error) {
+	c.done(nil, err)
+}
+
+func (c *requestCapture) done(responseBody *bodyCapture, err error) {
+	c.once.Do(func() {
+		c.req.DurationMs = uint64(time.Since(c.start).Milliseconds())
+		c.req.StartTime = c.start.UnixMilli()
+		c.req.RequestBody, c.req.RequestBodyTruncated = c.requestBody.Bytes()
+		if responseBody != nil {
+			c.req.ResponseBody, c.req.ResponseBodyTruncated = responseBody.Bytes()
+		}
+		if err != nil {
+			c.req.Error = err.Error()
+		}
+		c.inspector.enqueue(c.workspaceIP, c.req)
+	})
+}
+
+func inspectedHeaders(header http.Header) []*supervisor.HTTPHeader {
+	res := make([]*supervisor.HTTPHeader, 0, len(header))
+	for name, values := range header {
+		res = append(res, &supervisor.HTTPHeader{Name: name, Values: append([]string(nil), values...)})
+	}
+	return res
+}
+
+// bodyCapture captures the beginning of a body while it's read
+type bodyCapture struct {
+	io.ReadCloser
+	limit   int64
+	onClose func()
+
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	truncated bool
+	eof       bool
+	closeOnce sync.Once
+}
+
+func (b *bodyCapture) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	b.mu.Lock()
+	if rest := b.limit - int64(b.buf.Len()); int64(n) > rest {
+		b.buf.Write(p[:rest])
+		b.truncated = true
+	} else {
+		b.buf.Write(p[:n])
+	}
+	if err == io.EOF {
+		b.eof = true
+	}
+	b.mu.Unlock()
+
+	return n, err
+}
+
+func (b *bodyCapture) Close() error {
+	err := b.ReadCloser.Close()
+	if b.onClose != nil {
+		b.closeOnce.Do(b.onClose)
+	}
+	return err
+}
+
+// Bytes returns the captured body, and whether it's incomplete
+func (b *bodyCapture) Bytes() ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.buf.Bytes()...), b.truncated || !b.eof
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+type fakePortService struct {
+	supervisor.UnimplementedPortServiceServer
+
+	ports    []uint32
+	recorded chan *supervisor.InspectedRequest
+}
+
+func (s *fakePortService) ListInspectedPorts(ctx context.Context, req *supervisor.ListInspectedPortsRequest) (*supervisor.ListInspectedPortsResponse, error) {
+	return &supervisor.ListInspectedPortsResponse{Ports: s.ports}, nil
+}
+
+func (s *fakePortService) RecordInspectedRequest(ctx context.Context, req *supervisor.RecordInspectedRequestRequest) (*supervisor.RecordInspectedRequestResponse, error) {
+	s.recorded <- req.Request
+	return &supervisor.RecordInspectedRequestResponse{}, nil
+}
+
+func (s *fakePortService) next(t *testing.T) *supervisor.InspectedRequest {
+	select {
+	case req := <-s.recorded:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not sent to supervisor")
+		return nil
+	}
+}
+
+func TestPortInspectorCapture(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := &fakePortService{ports: []uint32{3000}, recorded: make(chan *supervisor.InspectedRequest, 10)}
+	srv := grpc.NewServer()
+	supervisor.RegisterPortServiceServer(srv, svc)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	pi := NewPortInspector(&PortInspectorConfig{MaxBodySize: 8, QueueSize: 10, Concurrency: 1}, uint16(lis.Addr().(*net.TCPAddr).Port))
+	target, _ := url.Parse("https://127.0.0.1:3000")
+	ignore := protocmp.IgnoreFields(&supervisor.InspectedRequest{}, "id", "start_time", "duration_ms")
+
+	// the inspected ports are fetched in the background
+	for deadline := time.Now().Add(5 * time.Second); !pi.inspected(target.Hostname(), 3000); {
+		if time.Now().After(deadline) {
+			t.Fatal("inspected ports were not fetched from supervisor")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/hook?page=1", strings.NewReader("payload"))
+	capture := pi.capture(req, target)
+	_, _ = io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/plain"}},
+		Body:       io.NopCloser(strings.NewReader("a long response")),
+	}
+	capture.response(resp)
+	_, _ = io.ReadAll(resp.Body)
+	select {
+	case <-svc.recorded:
+		t.Fatal("request was sent before the response body was closed")
+	default:
+	}
+	_ = resp.Body.Close()
+
+	act := svc.next(t)
+	expectation := &supervisor.InspectedRequest{
+		Port:                  3000,
+		Method:                http.MethodPost,
+		Uri:                   "/hook?page=1",
+		RequestHeaders:        []*supervisor.HTTPHeader{{Name: "Host", Values: []string{"example.com"}}},
+		RequestBody:           []byte("payload"),
+		StatusCode:            http.StatusOK,
+		ResponseHeaders:       []*supervisor.HTTPHeader{{Name: "Content-Type", Values: []string{"text/plain"}}},
+		ResponseBody:          []byte("a long r"),
+		ResponseBodyTruncated: true,
+		Tls:                   true,
+	}
+	if diff := cmp.Diff(expectation, act, protocmp.Transform(), ignore); diff != "" {
+		t.Errorf("unexpected inspected request (-want +got):\n%s", diff)
+	}
+
+	req = httptest.NewRequest(http.MethodPut, "/upload", strings.NewReader("payload"))
+	pi.capture(req, target).fail(errors.New("connection refused"))
+	act = svc.next(t)
+	expectation = &supervisor.InspectedRequest{
+		Port:                 3000,
+		Method:               http.MethodPut,
+		Uri:                  "/upload",
+		RequestHeaders:       []*supervisor.HTTPHeader{{Name: "Host", Values: []string{"example.com"}}},
+		RequestBody:          []byte{},
+		RequestBodyTruncated: true,
+		Error:                "connection refused",
+		Tls:                  true,
+	}
+	if diff := cmp.Diff(expectation, act, protocmp.Transform(), ignore); diff != "" {
+		t.Errorf("unexpected failed request (-want +got):\n%s", diff)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	other, _ := url.Parse("http://127.0.0.1:8080")
+	if pi.capture(req, other) != nil {
+		t.Error("requests to ports without inspection must not be captured")
+	}
+
+	req = mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{common.DebugWorkspaceIdentifier: "true"})
+	if pi.capture(req, target) != nil {
+		t.Error("requests to debug workspaces must not be captured")
+	}
+	if (*PortInspector)(nil).capture(req, target) != nil {
+		t.Error("requests must not be captured without inspector")
+	}
+}
@@ -28,6 +28,7 @@ type proxyPassConfig struct {
 	ErrorHandler    errorHandler
 	Transport       http.RoundTripper
 	UseTargetHost   bool
+	PortInspector   *PortInspector
 }
 
 func (ppc *proxyPassConfig) appendResponseHandler(handler responseHandler) {
@@ -130,6 +131,7 @@ func proxyPass(config *RouteHandlerConfig, infoProvider common.WorkspaceInfoProv
 		}
 
 		originalURL := *req.URL
+		capture := h.PortInspector.capture(req, targetURL)
 
 		// TODO(cw): we should cache the proxy for some time for each target URL
 
@@ -154,10 +156,17 @@ func proxyPass(config *RouteHandlerConfig, infoProvider common.WorkspaceInfoProv
 				}
 			}
 
+			if capture != nil {
+				capture.response(resp)
+			}
 			return nil
 		}
 
 		proxy.ErrorHandler = func(rw http.ResponseWriter, req *http.Request, err error) {
+			if capture != nil {
+				capture.fail(err)
+			}
+
 			if h.ErrorHandler != nil {
 				req.URL = &originalURL
 				h.ErrorHandler(w, req, err)
@@ -251,6 +260,13 @@ func withXFrameOptionsFilter() proxyPassOpt {
 	}
 }
 
+// withPortInspector captures the proxied requests, so that users can inspect them.
+func withPortInspector(pi *PortInspector) proxyPassOpt {
+	return func(cfg *proxyPassConfig) {
+		cfg.PortInspector = pi
+	}
+}
+
 func withUseTargetHost() proxyPassOpt {
 	return func(cfg *proxyPassConfig) {
 		cfg.UseTargetHost = true
@@ -0,0 +1,447 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+const (
+	// portShareTokenParam is the query parameter share links carry their token in
+	portShareTokenParam = "gitpod_share"
+	// portShareCookieName is the cookie we keep the share token in once a link was opened
+	portShareCookieName = "_gitpod_port_share"
+
+	defaultPortShareTTL    = 1 * time.Hour
+	defaultPortShareMaxTTL = 24 * time.Hour
+	portShareKeyIDName     = "kid"
+)
+
+var (
+	errPortShareInvalid  = errors.New("share link is invalid or has expired")
+	errPortShareUsedUp   = errors.New("share link has been used too often")
+	errPortShareReadOnly = errors.New("share link only allows read-only requests")
+	errPortShareRevoked  = errors.New("share link has been revoked")
+)
+
+// PortShareConfig configures shareable, expiring links to workspace ports
+type PortShareConfig struct {
+	// Signing is the key we sign share tokens with
+	Signing PortShareKey `json:"signing"`
+	// Validating are previous signing keys whose tokens we still accept
+	Validating []PortShareKey `json:"validating,omitempty"`
+	// MaxTTL is the longest a share link may be valid. Defaults to 24 hours.
+	MaxTTL util.Duration `json:"maxTTL,omitempty"`
+}
+
+// PortShareKey is an RSA private key in PKCS8 PEM format
+type PortShareKey struct {
+	ID             string `json:"id"`
+	PrivateKeyPath string `json:"privateKeyPath"`
+}
+
+// Validate validates the port share config
+func (c *PortShareConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Signing.ID == "" || c.Signing.PrivateKeyPath == "" {
+		return xerrors.Errorf("port share signing key is mandatory")
+	}
+	if c.MaxTTL < 0 {
+		return xerrors.Errorf("port share max TTL must not be negative")
+	}
+	return nil
+}
+
+// PortShareClaims are the claims of a port share token
+type PortShareClaims struct {
+	jwt.RegisteredClaims
+
+	WorkspaceID string `json:"wsid"`
+	// InstanceID binds the token to the workspace instance. Revocations are recorded on the instance,
+	// hence a link must not outlive it: restarting a workspace invalidates all of its share links.
+	InstanceID string `json:"instanceId"`
+	Port       uint32 `json:"port"`
+	ReadOnly   bool   `json:"readOnly,omitempty"`
+	MaxUses    int    `json:"maxUses,omitempty"`
+}
+
+// PortShares issues and verifies share tokens for workspace ports
+type PortShares struct {
+	signingKeyID string
+	keys         map[string]*rsa.PrivateKey
+	maxTTL       time.Duration
+
+	mu sync.Mutex
+	// uses counts how often share links were opened, by token ID. Each ws-proxy replica counts on its own.
+	uses map[string]*portShareUses
+}
+
+type portShareUses struct {
+	Count     int
+	ExpiresAt time.Time
+}
+
+// NewPortShares reads the keys of a port share config
+func NewPortShares(cfg *PortShareConfig) (*PortShares, error) {
+	res := &PortShares{
+		signingKeyID: cfg.Signing.ID,
+		keys:         make(map[string]*rsa.PrivateKey),
+		maxTTL:       time.Duration(cfg.MaxTTL),
+		uses:         make(map[string]*portShareUses),
+	}
+	if res.maxTTL == 0 {
+		res.maxTTL = defaultPortShareMaxTTL
+	}
+	for _, k := range append([]PortShareKey{cfg.Signing}, cfg.Validating...) {
+		if _, exists := res.keys[k.ID]; exists {
+			return nil, xerrors.Errorf("duplicate port share key %s", k.ID)
+		}
+		key, err := readRSAPrivateKey(k.PrivateKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		res.keys[k.ID] = key
+	}
+	return res, nil
+}
+
+func readRSAPrivateKey(fn string) (*rsa.PrivateKey, error) {
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read private key from %s: %w", fn, err)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, xerrors.Errorf("%s does not contain a PEM encoded key", fn)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse private key from %s: %w", fn, err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, xerrors.Errorf("%s does not contain an RSA private key", fn)
+	}
+	return key, nil
+}
+
+// PortShareRequest asks for a share link
+type PortShareRequest struct {
+	Port     uint32 `json:"port"`
+	TTL      string `json:"ttl,omitempty"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+	MaxUses  int    `json:"maxUses,omitempty"`
+}
+
+// PortShareResponse carries the token of a share link. Clients add it to the port's URL using the TokenParam query parameter.
+// The ID revokes the link.
+type PortShareResponse struct {
+	ID         string    `json:"id"`
+	Token      string    `json:"token"`
+	TokenParam string    `json:"tokenParam"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// Issue creates a share token for a port of the current workspace instance
+func (p *PortShares) Issue(ws *common.WorkspaceInfo, req *PortShareRequest) (*PortShareResponse, error) {
+	ttl := defaultPortShareTTL
+	if req.TTL != "" {
+		var err error
+		ttl, err = time.ParseDuration(req.TTL)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid TTL: %w", err)
+		}
+	}
+	if ttl <= 0 {
+		return nil, xerrors.Errorf("TTL must be positive")
+	}
+	if ttl > p.maxTTL {
+		return nil, xerrors.Errorf("TTL must not exceed %s", p.maxTTL)
+	}
+	if req.MaxUses < 0 {
+		return nil, xerrors.Errorf("max uses must not be negative")
+	}
+
+	var (
+		now       = time.Now()
+		expiresAt = now.Add(ttl)
+		id        = uuid.NewString()
+	)
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &PortShareClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		WorkspaceID: ws.WorkspaceID,
+		InstanceID:  ws.InstanceID,
+		Port:        req.Port,
+		ReadOnly:    req.ReadOnly,
+		MaxUses:     req.MaxUses,
+	})
+	token.Header[portShareKeyIDName] = p.signingKeyID
+	signed, err := token.SignedString(p.keys[p.signingKeyID])
+	if err != nil {
+		return nil, xerrors.Errorf("cannot sign port share token: %w", err)
+	}
+	return &PortShareResponse{
+		ID:         id,
+		Token:      signed,
+		TokenParam: portShareTokenParam,
+		ExpiresAt:  expiresAt.Truncate(time.Second),
+	}, nil
+}
+
+// Verify checks that a share token is valid for the workspace instance and port and hasn't been revoked
+func (p *PortShares) Verify(token string, ws *common.WorkspaceInfo, port uint32) (*PortShareClaims, error) {
+	var claims PortShareClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header[portShareKeyIDName].(string)
+		key, ok := p.keys[kid]
+		if !ok {
+			return nil, xerrors.Errorf("unknown key ID %q", kid)
+		}
+		return key.Public(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+	if err != nil {
+		return nil, errPortShareInvalid
+	}
+	// share links must expire
+	if claims.ExpiresAt == nil {
+		return nil, errPortShareInvalid
+	}
+	if ws == nil || claims.WorkspaceID != ws.WorkspaceID || claims.InstanceID != ws.InstanceID || claims.Port != port {
+		return nil, errPortShareInvalid
+	}
+	for _, id := range ws.RevokedPortShares {
+		if id == claims.ID {
+			return nil, errPortShareRevoked
+		}
+	}
+	return &claims, nil
+}
+
+// Redeem counts the opening of a share link and fails if the link was opened too often
+func (p *PortShares) Redeem(claims *PortShareClaims) error {
+	if claims.MaxUses == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for id, u := range p.uses {
+		if now.After(u.ExpiresAt) {
+			delete(p.uses, id)
+		}
+	}
+	u, ok := p.uses[claims.ID]
+	if !ok {
+		u = &portShareUses{ExpiresAt: claims.ExpiresAt.Time}
+		p.uses[claims.ID] = u
+	}
+	if u.Count >= claims.MaxUses {
+		return errPortShareUsedUp
+	}
+	u.Count++
+	return nil
+}
+
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// portShareAuthHandler grants access to workspace ports for requests with a valid share token, and subjects all
+// other requests to the regular workspace authentication.
+func portShareAuthHandler(shares *PortShares, info common.WorkspaceInfoProvider, auth mux.MiddlewareFunc) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		authenticated := auth(h)
+		if shares == nil {
+			return authenticated
+		}
+
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			var (
+				log  = getLog(req.Context())
+				vars = mux.Vars(req)
+				wsID = vars[common.WorkspaceIDIdentifier]
+			)
+			port, err := strconv.ParseUint(vars[common.WorkspacePortIdentifier], 10, 16)
+			if err != nil || wsID == "" {
+				authenticated.ServeHTTP(resp, req)
+				return
+			}
+
+			ws := info.WorkspaceInfo(wsID)
+			if token := req.URL.Query().Get(portShareTokenParam); token != "" {
+				claims, err := shares.Verify(token, ws, uint32(port))
+				if err == nil {
+					err = shares.Redeem(claims)
+				}
+				if err != nil {
+					log.WithError(err).WithField("port", port).Debug("rejected port share link")
+					http.Error(resp, err.Error(), http.StatusForbidden)
+					return
+				}
+
+				http.SetCookie(resp, &http.Cookie{
+					Name:     portShareCookieName,
+					Value:    token,
+					Path:     "/",
+					Expires:  claims.ExpiresAt.Time,
+					HttpOnly: true,
+					Secure:   true,
+					SameSite: http.SameSiteLaxMode,
+				})
+				// we don't want the token to end up in the browser history or with the application
+				q := req.URL.Query()
+				q.Del(portShareTokenParam)
+				redirect := *req.URL
+				redirect.RawQuery = q.Encode()
+				http.Redirect(resp, req, redirect.RequestURI(), http.StatusSeeOther)
+				return
+			}
+
+			c, err := req.Cookie(portShareCookieName)
+			if err != nil {
+				authenticated.ServeHTTP(resp, req)
+				return
+			}
+			claims, err := shares.Verify(c.Value, ws, uint32(port))
+			if err != nil {
+				// the link has expired or was revoked - the owner can still access the port
+				authenticated.ServeHTTP(resp, req)
+				return
+			}
+			if claims.ReadOnly && !isReadOnlyMethod(req.Method) {
+				http.Error(resp, errPortShareReadOnly.Error(), http.StatusForbidden)
+				return
+			}
+			removeCookie(req, portShareCookieName)
+			h.ServeHTTP(resp, req.WithContext(context.WithValue(req.Context(), portShareContextKey{}, true)))
+		})
+	}
+}
+
+type portShareContextKey struct{}
+
+// isPortShareRequest reports whether portShareAuthHandler admitted a request through a share link
+func isPortShareRequest(req *http.Request) bool {
+	shared, _ := req.Context().Value(portShareContextKey{}).(bool)
+	return shared
+}
+
+// removeCookie removes a cookie from a request, so that it isn't forwarded to the workspace
+func removeCookie(req *http.Request, name string) {
+	cookies := req.Cookies()
+	req.Header.Del("Cookie")
+	for _, c := range cookies {
+		if c.Name == name {
+			continue
+		}
+		req.AddCookie(c)
+	}
+}
+
+// PortShareRevoker records revoked share links, so that all ws-proxy replicas reject them.
+// The revocation may be forgotten after forgetAt.
+type PortShareRevoker interface {
+	RevokePortShare(ctx context.Context, workspaceID, shareID string, forgetAt time.Time) error
+}
+
+// HandlePortShareRoute issues share links for the ports of a workspace. Only the owner of a workspace may share its ports,
+// even if the workspace is shared with everyone.
+func (ir *ideRoutes) HandlePortShareRoute(route *mux.Route, shares *PortShares) {
+	r := route.Subrouter()
+	r.Use(logRouteHandlerHandler("HandlePortShareRoute"))
+	r.Use(ir.workspaceMustExistHandler)
+	r.Use(ir.Config.WorkspaceOwnerAuthHandler)
+
+	r.Path("").Methods(http.MethodPost).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req PortShareRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("cannot parse request: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		coords := getWorkspaceCoords(r)
+		ws := ir.InfoProvider.WorkspaceInfo(coords.ID)
+		if ws == nil {
+			http.Error(w, "workspace not found", http.StatusNotFound)
+			return
+		}
+		var exposed bool
+		for _, p := range ws.Ports {
+			if p.Port == req.Port {
+				exposed = true
+				break
+			}
+		}
+		if !exposed {
+			http.Error(w, fmt.Sprintf("port %d is not exposed", req.Port), http.StatusNotFound)
+			return
+		}
+
+		resp, err := shares.Issue(ws, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		getLog(r.Context()).WithField("port", req.Port).WithField("shareId", resp.ID).WithField("expiresAt", resp.ExpiresAt).Info("issued port share link")
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	r.Path("/{shareId}").Methods(http.MethodDelete).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revoker, ok := ir.InfoProvider.(PortShareRevoker)
+		if !ok {
+			http.Error(w, "share links cannot be revoked", http.StatusNotImplemented)
+			return
+		}
+
+		coords := getWorkspaceCoords(r)
+		shareID := mux.Vars(r)["shareId"]
+		// tokens are valid for at most the max TTL, so we don't need to remember revocations for longer
+		err := revoker.RevokePortShare(r.Context(), coords.ID, shareID, time.Now().Add(shares.maxTTL))
+		if err != nil {
+			getLog(r.Context()).WithError(err).WithField("shareId", shareID).Error("cannot revoke port share link")
+			http.Error(w, "cannot revoke share link", http.StatusInternalServerError)
+			return
+		}
+		getLog(r.Context()).WithField("shareId", shareID).Info("revoked port share link")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+func newTestPortShares(t *testing.T, maxTTL time.Duration) *PortShares {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := filepath.Join(t.TempDir(), "key.pem")
+	err = os.WriteFile(fn, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shares, err := NewPortShares(&PortShareConfig{
+		Signing: PortShareKey{ID: "0001", PrivateKeyPath: fn},
+		MaxTTL:  util.Duration(maxTTL),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shares
+}
+
+func TestPortShares(t *testing.T) {
+	shares := newTestPortShares(t, 2*time.Hour)
+
+	ws1 := &common.WorkspaceInfo{WorkspaceID: "ws1", InstanceID: "inst1"}
+	_, err := shares.Issue(ws1, &PortShareRequest{Port: 3000, TTL: "3h"})
+	if err == nil {
+		t.Error("expected TTL beyond the maximum to fail")
+	}
+
+	resp, err := shares.Issue(ws1, &PortShareRequest{Port: 3000, TTL: "2h", MaxUses: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := time.Until(resp.ExpiresAt); exp < 119*time.Minute || exp > 2*time.Hour {
+		t.Errorf("unexpected expiry: %s", resp.ExpiresAt)
+	}
+
+	claims, err := shares.Verify(resp.Token, ws1, 3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.ID != resp.ID {
+		t.Errorf("share token ID %s differs from the issued ID %s", claims.ID, resp.ID)
+	}
+	for _, c := range []struct {
+		WorkspaceID string
+		Port        uint32
+		Token       string
+	}{
+		{WorkspaceID: "ws2", Port: 3000, Token: resp.Token},
+		{WorkspaceID: "ws1", Port: 3001, Token: resp.Token},
+		{WorkspaceID: "ws1", Port: 3000, Token: resp.Token + "x"},
+	} {
+		_, err := shares.Verify(c.Token, &common.WorkspaceInfo{WorkspaceID: c.WorkspaceID, InstanceID: "inst1"}, c.Port)
+		if err != errPortShareInvalid {
+			t.Errorf("expected share token to be invalid for %s:%d, got %v", c.WorkspaceID, c.Port, err)
+		}
+	}
+
+	if _, err := shares.Verify(resp.Token, nil, 3000); err != errPortShareInvalid {
+		t.Errorf("expected share token for an unknown workspace to be invalid, got %v", err)
+	}
+	revoked := &common.WorkspaceInfo{WorkspaceID: "ws1", InstanceID: "inst1", RevokedPortShares: []string{resp.ID}}
+	if _, err := shares.Verify(resp.Token, revoked, 3000); err != errPortShareRevoked {
+		t.Errorf("expected revoked share token to be rejected, got %v", err)
+	}
+	// the revocation lives on the instance, which is gone after a restart. The token must not become valid again.
+	restarted := &common.WorkspaceInfo{WorkspaceID: "ws1", InstanceID: "inst2"}
+	if _, err := shares.Verify(resp.Token, restarted, 3000); err != errPortShareInvalid {
+		t.Errorf("expected share token to be invalid after a restart, got %v", err)
+	}
+
+	other := newTestPortShares(t, 0)
+	if _, err := other.Verify(resp.Token, ws1, 3000); err != errPortShareInvalid {
+		t.Errorf("expected share token signed with an unknown key to be invalid, got %v", err)
+	}
+	if _, err := other.Issue(ws1, &PortShareRequest{Port: 3000, TTL: "25h"}); err == nil {
+		t.Error("expected TTL beyond the default maximum to fail")
+	}
+
+	var redeemed []error
+	for i := 0; i < 3; i++ {
+		redeemed = append(redeemed, shares.Redeem(claims))
+	}
+	if diff := cmp.Diff([]error{nil, nil, errPortShareUsedUp}, redeemed, cmp.Comparer(func(a, b error) bool { return a == b })); diff != "" {
+		t.Errorf("unexpected redeem results (-want +got):\n%s", diff)
+	}
+}
+
+func TestPortShareAuthHandler(t *testing.T) {
+	shares := newTestPortShares(t, 0)
+	ws1 := &common.WorkspaceInfo{WorkspaceID: "ws1", InstanceID: "inst1"}
+	readOnly, err := shares.Issue(ws1, &PortShareRequest{Port: 3000, ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	denyAll := func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+	}
+	revoked, err := shares.Issue(ws1, &PortShareRequest{Port: 3000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &fixedInfoProvider{Infos: map[string]*common.WorkspaceInfo{
+		"ws1": {WorkspaceID: "ws1", InstanceID: "inst1", RevokedPortShares: []string{revoked.ID}},
+	}}
+	handler := portShareAuthHandler(shares, info, denyAll)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie(portShareCookieName); err == nil {
+			t.Error("share cookie was forwarded to the workspace")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	type Expectation struct {
+		Status   int
+		Location string
+		Cookie   bool
+	}
+	tests := []struct {
+		Name        string
+		Method      string
+		URL         string
+		Cookie      string
+		Port        string
+		Expectation Expectation
+	}{
+		{
+			Name:        "no share token",
+			URL:         "/",
+			Expectation: Expectation{Status: http.StatusUnauthorized},
+		},
+		{
+			Name:        "share link",
+			URL:         "/app?" + portShareTokenParam + "=" + readOnly.Token + "&foo=bar",
+			Expectation: Expectation{Status: http.StatusSeeOther, Location: "/app?foo=bar", Cookie: true},
+		},
+		{
+			Name:        "share link for other port",
+			URL:         "/?" + portShareTokenParam + "=" + readOnly.Token,
+			Port:        "8080",
+			Expectation: Expectation{Status: http.StatusForbidden},
+		},
+		{
+			Name:        "share cookie",
+			URL:         "/app",
+			Cookie:      readOnly.Token,
+			Expectation: Expectation{Status: http.StatusOK},
+		},
+		{
+			Name:        "read-only share cookie",
+			Method:      http.MethodPost,
+			URL:         "/app",
+			Cookie:      readOnly.Token,
+			Expectation: Expectation{Status: http.StatusForbidden},
+		},
+		{
+			Name:        "revoked share link",
+			URL:         "/app?" + portShareTokenParam + "=" + revoked.Token,
+			Expectation: Expectation{Status: http.StatusForbidden},
+		},
+		{
+			Name:        "revoked share cookie",
+			URL:         "/app",
+			Cookie:      revoked.Token,
+			Expectation: Expectation{Status: http.StatusUnauthorized},
+		},
+		{
+			Name:        "invalid share cookie",
+			URL:         "/app",
+			Cookie:      "foobar",
+			Expectation: Expectation{Status: http.StatusUnauthorized},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			method := test.Method
+			if method == "" {
+				method = http.MethodGet
+			}
+			port := test.Port
+			if port == "" {
+				port = "3000"
+			}
+			req := httptest.NewRequest(method, test.URL, nil)
+			if test.Cookie != "" {
+				req.AddCookie(&http.Cookie{Name: portShareCookieName, Value: test.Cookie})
+			}
+			req = mux.SetURLVars(req, map[string]string{
+				common.WorkspaceIDIdentifier:   "ws1",
+				common.WorkspacePortIdentifier: port,
+			})
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			var cookie bool
+			for _, c := range rec.Result().Cookies() {
+				if c.Name == portShareCookieName && c.Value == readOnly.Token {
+					cookie = true
+				}
+			}
+			act := Expectation{
+				Status:   rec.Code,
+				Location: rec.Header().Get("Location"),
+				Cookie:   cookie,
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("revoked share link after restart", func(t *testing.T) {
+		// the new instance doesn't carry the revocations of the old one
+		info.Infos["ws1"] = &common.WorkspaceInfo{WorkspaceID: "ws1", InstanceID: "inst2"}
+		req := httptest.NewRequest(http.MethodGet, "/app?"+portShareTokenParam+"="+revoked.Token, nil)
+		req = mux.SetURLVars(req, map[string]string{
+			common.WorkspaceIDIdentifier:   "ws1",
+			common.WorkspacePortIdentifier: "3000",
+		})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+}
+
+func TestRevokedPortShares(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	annotations := map[string]string{
+		wsk8s.WorkspaceRevokedPortSharesAnnotation: `{"current":"2024-05-01T13:00:00Z","forgotten":"2024-05-01T11:00:00Z"}`,
+	}
+	act := revokedPortShares(annotations, now)
+	if diff := cmp.Diff(map[string]time.Time{"current": now.Add(time.Hour)}, act); diff != "" {
+		t.Errorf("unexpected revoked port shares (-want +got):\n%s", diff)
+	}
+
+	if act := revokedPortShares(map[string]string{wsk8s.WorkspaceRevokedPortSharesAnnotation: "foobar"}, now); len(act) != 0 {
+		t.Errorf("expected no revoked port shares from an invalid annotation, got %v", act)
+	}
+}
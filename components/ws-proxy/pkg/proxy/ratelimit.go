@@ -0,0 +1,497 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"golang.org/x/xerrors"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+const (
+	// rateLimiterIdleTimeout is the time after which we forget the limiters of unused ports, workspaces and clients
+	rateLimiterIdleTimeout = 5 * time.Minute
+	// defaultClientIPHeader is the header our ingress proxy adds the client IP to
+	defaultClientIPHeader = "X-Forwarded-For"
+)
+
+const (
+	rateLimitScopePort      = "port"
+	rateLimitScopeWorkspace = "workspace"
+	rateLimitScopeClientIP  = "client_ip"
+)
+
+var (
+	PortRateLimitedRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gitpod_ws_proxy_port_rate_limited_requests_total",
+		Help: "Total number of requests to public or shared workspace ports rejected because a rate limit was exceeded",
+	}, []string{"limit"})
+
+	PortRateLimitBlockedWorkspacesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gitpod_ws_proxy_port_rate_limit_blocked_workspaces_total",
+		Help: "Total number of times the public ports of a workspace were blocked because it kept exceeding its rate limits",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		PortRateLimitedRequestsTotal,
+		PortRateLimitBlockedWorkspacesTotal,
+	)
+}
+
+// PortRateLimitConfig limits the requests to and the bandwidth of public workspace ports,
+// and of private ports accessed through share links
+type PortRateLimitConfig struct {
+	// Default are the limits of workspaces whose class has no limits of its own
+	Default PortRateLimits `json:"default"`
+	// Classes are the limits by workspace class
+	Classes map[string]PortRateLimits `json:"classes,omitempty"`
+	// ClientIPHeader is the header the ingress proxy adds the client IP to. Defaults to X-Forwarded-For.
+	ClientIPHeader string `json:"clientIPHeader,omitempty"`
+	// Block blocks the public ports of workspaces which keep exceeding their limits
+	Block *PortRateLimitBlockConfig `json:"block,omitempty"`
+}
+
+// PortRateLimits are the limits of a workspace
+type PortRateLimits struct {
+	// Port limits each public port of a workspace
+	Port RateLimit `json:"port"`
+	// Workspace limits all public ports of a workspace together
+	Workspace RateLimit `json:"workspace"`
+	// ClientIP limits each client IP accessing the public ports of a workspace
+	ClientIP RateLimit `json:"clientIP"`
+}
+
+// RateLimit limits requests and bandwidth. Zero values mean unlimited.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	// RequestBurst is the number of requests which may exceed the rate momentarily
+	RequestBurst int `json:"requestBurst,omitempty"`
+	// BytesPerSecond is the sustained bandwidth of request and response bodies
+	BytesPerSecond int `json:"bytesPerSecond,omitempty"`
+}
+
+// PortRateLimitBlockConfig configures when the public ports of a workspace get blocked
+type PortRateLimitBlockConfig struct {
+	// Violations is the number of rejected requests within Window after which the public ports of a workspace are blocked
+	Violations int `json:"violations"`
+	// Window is the period in which violations are counted
+	Window util.Duration `json:"window"`
+	// Duration is how long the public ports of a workspace remain blocked
+	Duration util.Duration `json:"duration"`
+}
+
+// Validate validates the port rate limit config
+func (c *PortRateLimitConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for class, l := range c.Classes {
+		err := l.validate()
+		if err != nil {
+			return xerrors.Errorf("invalid port rate limits of workspace class %s: %w", class, err)
+		}
+	}
+	err := c.Default.validate()
+	if err != nil {
+		return xerrors.Errorf("invalid default port rate limits: %w", err)
+	}
+	if c.Block != nil && (c.Block.Violations <= 0 || c.Block.Window <= 0 || c.Block.Duration <= 0) {
+		return xerrors.Errorf("port rate limit block violations, window and duration must be positive")
+	}
+	return nil
+}
+
+func (l PortRateLimits) validate() error {
+	for _, rl := range []RateLimit{l.Port, l.Workspace, l.ClientIP} {
+		if rl.RequestsPerSecond < 0 || rl.RequestBurst < 0 || rl.BytesPerSecond < 0 {
+			return xerrors.Errorf("rate limits must not be negative")
+		}
+	}
+	return nil
+}
+
+// PortRateLimiter enforces the rate limits of public workspace ports and of private ports accessed through share links.
+// Each ws-proxy replica enforces the limits on its own.
+type PortRateLimiter struct {
+	Config *PortRateLimitConfig
+
+	mu         sync.Mutex
+	limiters   map[string]*rateLimiter
+	violations map[string]*rateLimitViolations
+	lastSweep  time.Time
+	now        func() time.Time
+}
+
+type rateLimiter struct {
+	Requests  *rate.Limiter
+	Bandwidth *rate.Limiter
+	LastUsed  time.Time
+}
+
+type rateLimitViolations struct {
+	Count        int
+	WindowStart  time.Time
+	BlockedUntil time.Time
+}
+
+// NewPortRateLimiter creates a new port rate limiter
+func NewPortRateLimiter(cfg *PortRateLimitConfig) *PortRateLimiter {
+	return &PortRateLimiter{
+		Config:     cfg,
+		limiters:   make(map[string]*rateLimiter),
+		violations: make(map[string]*rateLimitViolations),
+		now:        time.Now,
+	}
+}
+
+func (l *PortRateLimiter) limits(class string) PortRateLimits {
+	if res, ok := l.Config.Classes[class]; ok {
+		return res
+	}
+	return l.Config.Default
+}
+
+// limitersFor returns the limiters of a request by their scope. The caller must hold mu.
+func (l *PortRateLimiter) limitersFor(info *common.WorkspaceInfo, port, clientIP string, now time.Time) map[string]*rateLimiter {
+	if now.Sub(l.lastSweep) > rateLimiterIdleTimeout {
+		for key, rl := range l.limiters {
+			if now.Sub(rl.LastUsed) > rateLimiterIdleTimeout {
+				delete(l.limiters, key)
+			}
+		}
+		for wsID, v := range l.violations {
+			if now.After(v.BlockedUntil) && now.Sub(v.WindowStart) > rateLimiterIdleTimeout {
+				delete(l.violations, wsID)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	limits := l.limits(info.WorkspaceClass)
+	res := make(map[string]*rateLimiter, 3)
+	for scope, s := range map[string]struct {
+		Key   string
+		Limit RateLimit
+	}{
+		rateLimitScopePort:      {Key: info.WorkspaceID + "/" + port, Limit: limits.Port},
+		rateLimitScopeWorkspace: {Key: info.WorkspaceID, Limit: limits.Workspace},
+		rateLimitScopeClientIP:  {Key: info.WorkspaceID + "/" + clientIP, Limit: limits.ClientIP},
+	} {
+		if s.Limit == (RateLimit{}) {
+			continue
+		}
+		key := scope + ":" + s.Key
+		rl, ok := l.limiters[key]
+		if !ok {
+			rl = newRateLimiter(s.Limit)
+			l.limiters[key] = rl
+		}
+		rl.LastUsed = now
+		res[scope] = rl
+	}
+	return res
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	res := &rateLimiter{}
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.RequestBurst
+		if burst <= 0 {
+			burst = int(math.Max(1, math.Ceil(limit.RequestsPerSecond)))
+		}
+		res.Requests = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	if limit.BytesPerSecond > 0 {
+		res.Bandwidth = rate.NewLimiter(rate.Limit(limit.BytesPerSecond), limit.BytesPerSecond)
+	}
+	return res
+}
+
+// allow reports whether a request is within the limits, and otherwise the scope of the exceeded limit.
+// If the request is allowed, it returns the bandwidth limiters the request is subject to.
+func (l *PortRateLimiter) allow(info *common.WorkspaceInfo, port, clientIP string) (scope string, retryAfter time.Duration, bandwidth []*rate.Limiter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if v, ok := l.violations[info.WorkspaceID]; ok && now.Before(v.BlockedUntil) {
+		return rateLimitScopeWorkspace, v.BlockedUntil.Sub(now), nil
+	}
+
+	limiters := l.limitersFor(info, port, clientIP, now)
+	reservations := make([]*rate.Reservation, 0, len(limiters))
+	for s, rl := range limiters {
+		if rl.Requests == nil {
+			continue
+		}
+		r := rl.Requests.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			r.CancelAt(now)
+			for _, prev := range reservations {
+				prev.CancelAt(now)
+			}
+			l.violate(info, now)
+			return s, delay, nil
+		}
+		reservations = append(reservations, r)
+	}
+
+	for _, rl := range limiters {
+		if rl.Bandwidth != nil {
+			bandwidth = append(bandwidth, rl.Bandwidth)
+		}
+	}
+	return "", 0, bandwidth
+}
+
+// violate counts a rejected request of a workspace and blocks its public ports if it keeps exceeding its limits.
+// The caller must hold mu.
+func (l *PortRateLimiter) violate(info *common.WorkspaceInfo, now time.Time) {
+	cfg := l.Config.Block
+	if cfg == nil {
+		return
+	}
+
+	v, ok := l.violations[info.WorkspaceID]
+	if !ok || now.Sub(v.WindowStart) > time.Duration(cfg.Window) {
+		v = &rateLimitViolations{WindowStart: now}
+		l.violations[info.WorkspaceID] = v
+	}
+	v.Count++
+	if v.Count < cfg.Violations {
+		return
+	}
+
+	v.BlockedUntil = now.Add(time.Duration(cfg.Duration))
+	v.Count = 0
+	v.WindowStart = v.BlockedUntil
+	PortRateLimitBlockedWorkspacesTotal.Inc()
+	log.WithFields(log.OWI(info.OwnerUserId, info.WorkspaceID, info.InstanceID)).
+		WithField("workspaceClass", info.WorkspaceClass).
+		WithField("blockedUntil", v.BlockedUntil).
+		Warn("blocking public ports of workspace which keeps exceeding its rate limits")
+}
+
+// clientIP returns the IP of the client which sent a request
+func (l *PortRateLimiter) clientIP(req *http.Request) string {
+	header := l.Config.ClientIPHeader
+	if header == "" {
+		header = defaultClientIPHeader
+	}
+	if values := req.Header.Values(header); len(values) > 0 {
+		// our ingress proxy appends the client IP, everything before that was sent by the client
+		ips := strings.Split(values[len(values)-1], ",")
+		if ip := strings.TrimSpace(ips[len(ips)-1]); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// portRateLimitHandler enforces the rate limits of public workspace ports and of private ports accessed through share links.
+// It must run after portShareAuthHandler.
+func portRateLimitHandler(limiter *PortRateLimiter, infoProvider common.WorkspaceInfoProvider) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		if limiter == nil {
+			return h
+		}
+
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			coords := getWorkspaceCoords(req)
+			if coords.Debug || coords.Port == "" {
+				h.ServeHTTP(resp, req)
+				return
+			}
+			info := infoProvider.WorkspaceInfo(coords.ID)
+			if info == nil || !(isPublicPort(info, coords.Port) || isPortShareRequest(req)) {
+				h.ServeHTTP(resp, req)
+				return
+			}
+
+			scope, retryAfter, bandwidth := limiter.allow(info, coords.Port, limiter.clientIP(req))
+			if scope != "" {
+				PortRateLimitedRequestsTotal.WithLabelValues(scope).Inc()
+				getLog(req.Context()).WithField("limit", scope).Debug("rate limit of port exceeded")
+
+				resp.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				http.Error(resp, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+
+			if len(bandwidth) > 0 {
+				if req.Body != nil && req.Body != http.NoBody {
+					req.Body = &throttledBody{ReadCloser: req.Body, ctx: req.Context(), limiters: bandwidth}
+				}
+				resp = &throttledResponseWriter{ResponseWriter: resp, ctx: req.Context(), limiters: bandwidth}
+			}
+			h.ServeHTTP(resp, req)
+		})
+	}
+}
+
+func isPublicPort(info *common.WorkspaceInfo, port string) bool {
+	if info.Auth != nil && info.Auth.Admission == api.AdmissionLevel_ADMIT_EVERYONE {
+		return true
+	}
+	prt, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return false
+	}
+	for _, p := range info.Ports {
+		if p.Port == uint32(prt) {
+			return p.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC
+		}
+	}
+	return false
+}
+
+// maxBurst caps n at the smallest burst size of the limiters, which is the most they can let pass at once
+func maxBurst(limiters []*rate.Limiter, n int) int {
+	for _, l := range limiters {
+		if b := l.Burst(); n > b {
+			n = b
+		}
+	}
+	return n
+}
+
+// waitBandwidth waits until all limiters allow n bytes to pass, in chunks of at most their burst size.
+// It returns the number of bytes which may pass now.
+func waitBandwidth(ctx context.Context, limiters []*rate.Limiter, n int) (int, error) {
+	n = maxBurst(limiters, n)
+	for _, l := range limiters {
+		err := l.WaitN(ctx, n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// readThrottled reads at most a burst from r and waits until the limiters allow the bytes actually read to pass.
+// Readers often return less than requested, e.g. interactive connections, which must not be charged for the whole of p.
+func readThrottled(ctx context.Context, limiters []*rate.Limiter, r io.Reader, p []byte) (int, error) {
+	if len(p) == 0 {
+		return r.Read(p)
+	}
+	n, err := r.Read(p[:maxBurst(limiters, len(p))])
+	if n > 0 {
+		for _, l := range limiters {
+			werr := l.WaitN(ctx, n)
+			if werr != nil {
+				return n, werr
+			}
+		}
+	}
+	return n, err
+}
+
+// throttledBody limits the bandwidth of a request body
+type throttledBody struct {
+	io.ReadCloser
+	ctx      context.Context
+	limiters []*rate.Limiter
+}
+
+func (b *throttledBody) Read(p []byte) (int, error) {
+	return readThrottled(b.ctx, b.limiters, b.ReadCloser, p)
+}
+
+// writeThrottled writes p to w in chunks the limiters allow to pass
+func writeThrottled(ctx context.Context, limiters []*rate.Limiter, w io.Writer, p []byte) (int, error) {
+	var written int
+	for len(p) > 0 {
+		n, err := waitBandwidth(ctx, limiters, len(p))
+		if err != nil {
+			return written, err
+		}
+		n, err = w.Write(p[:n])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// throttledResponseWriter limits the bandwidth of a response body.
+// Upgraded connections, e.g. websockets, are throttled in both directions.
+type throttledResponseWriter struct {
+	http.ResponseWriter
+	ctx      context.Context
+	limiters []*rate.Limiter
+}
+
+func (w *throttledResponseWriter) Write(p []byte) (int, error) {
+	return writeThrottled(w.ctx, w.limiters, w.ResponseWriter, p)
+}
+
+func (w *throttledResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *throttledResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, xerrors.Errorf("response writer does not support hijacking")
+	}
+	conn, brw, err := h.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tc := &throttledConn{Conn: conn, ctx: w.ctx, limiters: w.limiters}
+	// the reader may hold data the client sent before the connection was hijacked
+	buffered, _ := brw.Reader.Peek(brw.Reader.Buffered())
+	return tc, bufio.NewReadWriter(bufio.NewReader(io.MultiReader(bytes.NewReader(buffered), tc)), bufio.NewWriter(tc)), nil
+}
+
+func (w *throttledResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// throttledConn limits the bandwidth of a hijacked connection
+type throttledConn struct {
+	net.Conn
+	ctx      context.Context
+	limiters []*rate.Limiter
+}
+
+func (c *throttledConn) Read(p []byte) (int, error) {
+	return readThrottled(c.ctx, c.limiters, c.Conn, p)
+}
+
+func (c *throttledConn) Write(p []byte) (int, error) {
+	return writeThrottled(c.ctx, c.limiters, c.Conn, p)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+func TestPortRateLimitHandler(t *testing.T) {
+	infos := []common.WorkspaceInfo{
+		{
+			WorkspaceID: "amaranth-smelt-9ba20cc1",
+			Ports: []*api.PortSpec{
+				{Port: 3000, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC},
+				{Port: 3001, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC},
+				{Port: 8080, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE},
+			},
+		},
+		{
+			WorkspaceID:    "moccasin-ferret-155799b3",
+			WorkspaceClass: "large",
+			Ports: []*api.PortSpec{
+				{Port: 3000, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC},
+			},
+		},
+	}
+	limiter := NewPortRateLimiter(&PortRateLimitConfig{
+		Default: PortRateLimits{
+			Port:     RateLimit{RequestsPerSecond: 1, RequestBurst: 2},
+			ClientIP: RateLimit{RequestsPerSecond: 1, RequestBurst: 2},
+		},
+		Classes: map[string]PortRateLimits{
+			"large": {Port: RateLimit{RequestsPerSecond: 1, RequestBurst: 4}},
+		},
+		Block: &PortRateLimitBlockConfig{
+			Violations: 2,
+			Window:     util.Duration(time.Minute),
+			Duration:   util.Duration(time.Hour),
+		},
+	})
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	handler := portRateLimitHandler(limiter, &fakeWsInfoProvider{infos: infos})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	request := func(workspaceID, port, clientIP string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Forwarded-For", "10.0.0.1, "+clientIP)
+		req = mux.SetURLVars(req, map[string]string{
+			common.WorkspaceIDIdentifier:   workspaceID,
+			common.WorkspacePortIdentifier: port,
+		})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	var act []int
+	for _, r := range []struct{ WorkspaceID, Port, ClientIP string }{
+		// the port limit is exceeded with the third request
+		{"amaranth-smelt-9ba20cc1", "3000", "1.1.1.1"},
+		{"amaranth-smelt-9ba20cc1", "3000", "2.2.2.2"},
+		{"amaranth-smelt-9ba20cc1", "3000", "3.3.3.3"},
+		// private ports are not limited
+		{"amaranth-smelt-9ba20cc1", "8080", "1.1.1.1"},
+		{"amaranth-smelt-9ba20cc1", "8080", "1.1.1.1"},
+		// the client IP limit is exceeded with the third request
+		{"amaranth-smelt-9ba20cc1", "3001", "1.1.1.1"},
+		{"amaranth-smelt-9ba20cc1", "3001", "1.1.1.1"},
+		// the workspace class has higher limits
+		{"moccasin-ferret-155799b3", "3000", "1.1.1.1"},
+		{"moccasin-ferret-155799b3", "3000", "1.1.1.1"},
+		{"moccasin-ferret-155799b3", "3000", "1.1.1.1"},
+	} {
+		act = append(act, request(r.WorkspaceID, r.Port, r.ClientIP))
+	}
+	expectation := []int{
+		http.StatusOK, http.StatusOK, http.StatusTooManyRequests,
+		http.StatusOK, http.StatusOK,
+		http.StatusOK, http.StatusTooManyRequests,
+		http.StatusOK, http.StatusOK, http.StatusOK,
+	}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected status codes (-want +got):\n%s", diff)
+	}
+
+	// the workspace exceeded its limits twice and is blocked now
+	now = now.Add(time.Minute)
+	if code := request("amaranth-smelt-9ba20cc1", "3000", "4.4.4.4"); code != http.StatusTooManyRequests {
+		t.Errorf("expected blocked workspace to be rejected, got %d", code)
+	}
+	if code := request("moccasin-ferret-155799b3", "3000", "4.4.4.4"); code != http.StatusOK {
+		t.Errorf("expected other workspace to be accepted, got %d", code)
+	}
+	now = now.Add(time.Hour)
+	if code := request("amaranth-smelt-9ba20cc1", "3000", "4.4.4.4"); code != http.StatusOK {
+		t.Errorf("expected workspace to be unblocked, got %d", code)
+	}
+}
+
+func TestPortRateLimitHandlerSharedPorts(t *testing.T) {
+	infos := []common.WorkspaceInfo{
+		{
+			WorkspaceID: "amaranth-smelt-9ba20cc1",
+			Ports: []*api.PortSpec{
+				{Port: 8080, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE},
+			},
+		},
+	}
+	limiter := NewPortRateLimiter(&PortRateLimitConfig{
+		Default: PortRateLimits{Port: RateLimit{RequestsPerSecond: 1, RequestBurst: 1}},
+	})
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	handler := portRateLimitHandler(limiter, &fakeWsInfoProvider{infos: infos})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	request := func(shared bool) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req = mux.SetURLVars(req, map[string]string{
+			common.WorkspaceIDIdentifier:   "amaranth-smelt-9ba20cc1",
+			common.WorkspacePortIdentifier: "8080",
+		})
+		if shared {
+			req = req.WithContext(context.WithValue(req.Context(), portShareContextKey{}, true))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// the owner is not limited, requests through share links are
+	act := []int{request(false), request(false), request(true), request(true)}
+	expectation := []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusTooManyRequests}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected status codes (-want +got):\n%s", diff)
+	}
+}
+
+func TestThrottledResponseWriterHijack(t *testing.T) {
+	const burst = 100
+	limiter := rate.NewLimiter(rate.Every(time.Hour), burst)
+	response := "HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := &throttledResponseWriter{ResponseWriter: w, ctx: r.Context(), limiters: []*rate.Limiter{limiter}}
+		conn, brw, err := tw.Hijack()
+		if err != nil {
+			t.Errorf("cannot hijack connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		_, _ = brw.WriteString(response)
+		_ = brw.Flush()
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if tokens := limiter.Tokens(); tokens > burst-float64(len(response))+1 {
+		t.Errorf("hijacked connection was not throttled: %f tokens left", tokens)
+	}
+}
+
+// shortReader returns at most size bytes per read, like interactive connections do
+type shortReader struct {
+	io.Reader
+	size int
+}
+
+func (r *shortReader) Read(p []byte) (int, error) {
+	if len(p) > r.size {
+		p = p[:r.size]
+	}
+	return r.Reader.Read(p)
+}
+
+func TestThrottledBodyShortReads(t *testing.T) {
+	const burst = 100
+	limiter := rate.NewLimiter(rate.Every(time.Hour), burst)
+	body := &throttledBody{
+		ReadCloser: io.NopCloser(&shortReader{Reader: strings.NewReader("hello world"), size: 5}),
+		ctx:        context.Background(),
+		limiters:   []*rate.Limiter{limiter},
+	}
+
+	var read int
+	buf := make([]byte, 4096)
+	for {
+		n, err := body.Read(buf)
+		read += n
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if read != len("hello world") {
+		t.Errorf("expected to read %d bytes, got %d", len("hello world"), read)
+	}
+	// with an hourly rate no tokens come back during the test, hence all that's missing was charged
+	if charged := burst - limiter.Tokens(); charged > float64(read)+1 {
+		t.Errorf("charged %f tokens for %d bytes read", charged, read)
+	}
+}
+
+func TestThrottledConnShortReads(t *testing.T) {
+	const burst = 100
+	limiter := rate.NewLimiter(rate.Every(time.Hour), burst)
+	client, server := net.Pipe()
+	defer client.Close()
+	conn := &throttledConn{Conn: server, ctx: context.Background(), limiters: []*rate.Limiter{limiter}}
+	defer conn.Close()
+
+	go func() {
+		_, _ = client.Write([]byte("ls\n"))
+	}()
+
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act := string(buf[:n]); act != "ls\n" {
+		t.Errorf("unexpected read: %q", act)
+	}
+	if charged := burst - limiter.Tokens(); charged > float64(n)+1 {
+		t.Errorf("charged %f tokens for %d bytes read", charged, n)
+	}
+}
@@ -47,6 +47,14 @@ type RouteHandlerConfig struct {
 	DefaultTransport     http.RoundTripper
 	CorsHandler          mux.MiddlewareFunc
 	WorkspaceAuthHandler mux.MiddlewareFunc
+	// WorkspaceOwnerAuthHandler rejects requests without the owner token, even if the workspace is shared
+	WorkspaceOwnerAuthHandler mux.MiddlewareFunc
+	// PortShares issues and verifies share links for workspace ports. Ports can't be shared if it's nil.
+	PortShares *PortShares
+	// PortInspector captures requests to workspace ports. Requests aren't captured if it's nil.
+	PortInspector *PortInspector
+	// PortRateLimiter limits requests to public workspace ports. Requests aren't limited if it's nil.
+	PortRateLimiter *PortRateLimiter
 }
 
 // RouteHandlerConfigOpt modifies the router handler config.
@@ -56,6 +64,7 @@ type RouteHandlerConfigOpt func(*Config, *RouteHandlerConfig)
 func WithDefaultAuth(infoprov common.WorkspaceInfoProvider) RouteHandlerConfigOpt {
 	return func(config *Config, c *RouteHandlerConfig) {
 		c.WorkspaceAuthHandler = WorkspaceAuthHandler(config.GitpodInstallation.HostName, infoprov)
+		c.WorkspaceOwnerAuthHandler = WorkspaceOwnerAuthHandler(config.GitpodInstallation.HostName, infoprov)
 	}
 }
 
@@ -71,6 +80,20 @@ func NewRouteHandlerConfig(config *Config, opts ...RouteHandlerConfigOpt) (*Rout
 		DefaultTransport:     createDefaultTransport(config.TransportConfig),
 		CorsHandler:          corsHandler,
 		WorkspaceAuthHandler: func(h http.Handler) http.Handler { return h },
+
+		WorkspaceOwnerAuthHandler: func(h http.Handler) http.Handler { return h },
+	}
+	if config.PortShare != nil {
+		cfg.PortShares, err = NewPortShares(config.PortShare)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.PortInspector != nil {
+		cfg.PortInspector = NewPortInspector(config.PortInspector, config.WorkspacePodConfig.SupervisorPort)
+	}
+	if config.PortRateLimit != nil {
+		cfg.PortRateLimiter = NewPortRateLimiter(config.PortRateLimit)
 	}
 	for _, o := range opts {
 		o(config, cfg)
@@ -99,6 +122,10 @@ func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip common
 		routes.HandleCreateKeyRoute(r.Path("/_supervisor/v1/ssh_keys/create"), sshGatewayServer.HostKeys)
 	}
 
+	if config.PortShares != nil {
+		routes.HandlePortShareRoute(r.PathPrefix("/_ports/share"), config.PortShares)
+	}
+
 	// The favicon warants special handling, because we pull that from the supervisor frontend
 	// rather than the IDE.
 	faviconRouter := r.Path("/favicon.ico").Subrouter()
@@ -533,7 +560,8 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoP
 	}
 
 	r.Use(logHandler)
-	r.Use(config.WorkspaceAuthHandler)
+	r.Use(portShareAuthHandler(config.PortShares, infoProvider, config.WorkspaceAuthHandler))
+	r.Use(portRateLimitHandler(config.PortRateLimiter, infoProvider))
 	// filter all session cookies
 	r.Use(sensitiveCookieHandler(config.Config.GitpodInstallation.HostName))
 
@@ -563,6 +591,7 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoP
 				workspacePodPortResolver,
 				withHTTPErrorHandler(showPortNotFoundPage),
 				withXFrameOptionsFilter(),
+				withPortInspector(config.PortInspector),
 				func(h *proxyPassConfig) {
 					h.Transport = &http.Transport{
 						TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
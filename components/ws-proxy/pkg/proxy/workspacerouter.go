@@ -5,13 +5,17 @@
 package proxy
 
 import (
+	"bufio"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/gorilla/mux"
+	"golang.org/x/xerrors"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/namegen"
@@ -49,15 +53,7 @@ func HostBasedRouter(header, wsHostSuffix string, wsHostSuffixRegex string) Work
 		setupAcmeRouter(r)
 
 		var (
-			getHostHeader = func(req *http.Request) string {
-				host := req.Header.Get(header)
-				// if we don't get host from special header, fallback to use req.Host
-				if header == "Host" || host == "" {
-					parts := strings.Split(req.Host, ":")
-					return parts[0]
-				}
-				return host
-			}
+			getHostHeader = hostHeader(header)
 			foreignRouter = r.MatcherFunc(matchForeignHostHeader(wsHostSuffix, getHostHeader)).Subrouter()
 			portRouter    = r.MatcherFunc(matchWorkspaceHostHeader(wsHostSuffix, getHostHeader, true)).Subrouter()
 			ideRouter     = r.MatcherFunc(matchWorkspaceHostHeader(allClusterWsHostSuffixRegex, getHostHeader, false)).Subrouter()
@@ -72,8 +68,62 @@ func HostBasedRouter(header, wsHostSuffix string, wsHostSuffixRegex string) Work
 	}
 }
 
+// PathBasedRouter is a WorkspaceRouter that serves all workspaces from a single host and routes based on the path,
+// i.e. /ws/<workspaceID>/ for the IDE and /ws/<workspaceID>/port/<port>/ for exposed ports.
+// Unlike the HostBasedRouter it requires neither wildcard DNS nor wildcard certificates.
+//
+// The path prefix is stripped before requests are forwarded and passed on in the X-Forwarded-Prefix header instead.
+// Redirects and cookies of responses are rewritten to stay within the prefix.
+//
+// All IDEs and ports share a single origin, so the browser does not isolate them from each other: a page served
+// from any port, including public ports of other users, can script the IDE of every workspace the visitor is
+// logged into and read its responses. The cookie paths are no security boundary. Use this only for installations
+// whose users trust each other, which is why the config requires GitpodInstallation.InsecureSharedWorkspaceOrigin.
+func PathBasedRouter(header, wsHost string) WorkspaceRouter {
+	return func(r *mux.Router, wsInfoProvider common.WorkspaceInfoProvider) (*mux.Router, *mux.Router, *mux.Router) {
+		// make sure acme router is the first handler setup to make sure it has a chance to catch acme challenge
+		setupAcmeRouter(r)
+
+		var (
+			getHostHeader = hostHeader(header)
+			hostRouter    = r.MatcherFunc(func(req *http.Request, m *mux.RouteMatch) bool {
+				return getHostHeader(req) == wsHost
+			}).Subrouter()
+		)
+		hostRouter.MatcherFunc(matchWorkspacePathWithoutSlash()).HandlerFunc(redirectToWorkspacePathWithSlash)
+		var (
+			portRouter = hostRouter.MatcherFunc(matchWorkspacePathPrefix(true)).Subrouter()
+			ideRouter  = hostRouter.MatcherFunc(matchWorkspacePathPrefix(false)).Subrouter()
+			// all workspaces share a single host, hence there are no foreign hosts to serve
+			foreignRouter = r.MatcherFunc(func(req *http.Request, m *mux.RouteMatch) bool { return false }).Subrouter()
+		)
+		portRouter.Use(workspacePathPrefixHandler(wsHost))
+		ideRouter.Use(workspacePathPrefixHandler(wsHost))
+
+		r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			hostname := getHostHeader(req)
+			log.Debugf("no match for path %s, host: %s", req.URL.Path, hostname)
+			w.WriteHeader(http.StatusNotFound)
+		})
+		return ideRouter, portRouter, foreignRouter
+	}
+}
+
 type hostHeaderProvider func(req *http.Request) string
 
+// hostHeader returns the host of requests from the given header, falling back to the Host of the request
+func hostHeader(header string) hostHeaderProvider {
+	return func(req *http.Request) string {
+		host := req.Header.Get(header)
+		// if we don't get host from special header, fallback to use req.Host
+		if header == "Host" || host == "" {
+			parts := strings.Split(req.Host, ":")
+			return parts[0]
+		}
+		return host
+	}
+}
+
 func matchWorkspaceHostHeader(wsHostSuffix string, headerProvider hostHeaderProvider, matchPort bool) mux.MatcherFunc {
 	var regexPrefix string
 	if matchPort {
@@ -217,6 +267,198 @@ func matchForeignHostHeader(wsHostSuffix string, headerProvider hostHeaderProvid
 	}
 }
 
+var workspacePathPrefixRegex = "/ws/" + debugWorkspaceRegex + workspaceIDRegex + "(?:/port/(?P<" + common.WorkspacePortIdentifier + ">[0-9]+))?"
+
+// matchWorkspacePathPrefix matches requests whose path starts with /ws/<workspaceID>/ or /ws/<workspaceID>/port/<port>/,
+// and strips that prefix from the path.
+func matchWorkspacePathPrefix(matchPort bool) mux.MatcherFunc {
+	r := regexp.MustCompile("^" + workspacePathPrefixRegex + "/")
+	return func(req *http.Request, m *mux.RouteMatch) bool {
+		if _, stripped := m.Vars[common.WorkspacePathPrefixIdentifier]; stripped {
+			// routes of subrouters inherit their matchers, i.e. we're called again once the prefix is stripped
+			return matchPort == (m.Vars[common.WorkspacePortIdentifier] != "")
+		}
+
+		matches := r.FindStringSubmatch(req.URL.Path)
+		if len(matches) < 4 {
+			return false
+		}
+		var (
+			// /ws/debug-coral-dragon-ilr0r6eq/port/3000/index.html
+			// pathPrefix: /ws/debug-coral-dragon-ilr0r6eq/port/3000
+			// debugWorkspace: true
+			// workspaceID: coral-dragon-ilr0r6eq
+			// workspacePort: 3000
+			pathPrefix     = strings.TrimSuffix(matches[0], "/")
+			debugWorkspace = matches[1] != ""
+			workspaceID    = matches[2]
+			workspacePort  = matches[3]
+		)
+		if matchPort != (workspacePort != "") {
+			return false
+		}
+
+		if m.Vars == nil {
+			m.Vars = make(map[string]string)
+		}
+		m.Vars[common.WorkspacePathPrefixIdentifier] = pathPrefix
+		m.Vars[common.WorkspaceIDIdentifier] = workspaceID
+		if workspacePort != "" {
+			m.Vars[common.WorkspacePortIdentifier] = workspacePort
+		}
+		if debugWorkspace {
+			m.Vars[common.DebugWorkspaceIdentifier] = "true"
+		}
+
+		req.URL.Path = strings.TrimPrefix(req.URL.Path, pathPrefix)
+		req.URL.RawPath = strings.TrimPrefix(req.URL.RawPath, pathPrefix)
+		return true
+	}
+}
+
+// matchWorkspacePathWithoutSlash matches requests to /ws/<workspaceID> or /ws/<workspaceID>/port/<port>,
+// which must be redirected to the path with a trailing slash for relative URLs to resolve within the prefix.
+func matchWorkspacePathWithoutSlash() mux.MatcherFunc {
+	r := regexp.MustCompile("^" + workspacePathPrefixRegex + "$")
+	return func(req *http.Request, m *mux.RouteMatch) bool {
+		return r.MatchString(req.URL.Path)
+	}
+}
+
+func redirectToWorkspacePathWithSlash(w http.ResponseWriter, req *http.Request) {
+	u := *req.URL
+	u.Path += "/"
+	if u.RawPath != "" {
+		u.RawPath += "/"
+	}
+	http.Redirect(w, req, u.RequestURI(), http.StatusMovedPermanently)
+}
+
+// workspacePathPrefixHandler passes the workspace path prefix on to the workspace, and rewrites
+// redirects and cookies of responses to stay within the prefix.
+func workspacePathPrefixHandler(wsHost string) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			prefix := mux.Vars(req)[common.WorkspacePathPrefixIdentifier]
+			if prefix == "" {
+				h.ServeHTTP(resp, req)
+				return
+			}
+			req.Header.Set("X-Forwarded-Prefix", prefix)
+			h.ServeHTTP(&pathPrefixResponseWriter{ResponseWriter: resp, host: wsHost, prefix: prefix}, req)
+		})
+	}
+}
+
+// pathPrefixResponseWriter adds the path prefix to the Location and Set-Cookie headers of responses
+type pathPrefixResponseWriter struct {
+	http.ResponseWriter
+	host   string
+	prefix string
+
+	wroteHeader bool
+}
+
+func (w *pathPrefixResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader && code >= http.StatusOK {
+		w.wroteHeader = true
+		w.rewriteHeader()
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *pathPrefixResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *pathPrefixResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *pathPrefixResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, xerrors.Errorf("response writer does not support hijacking")
+	}
+	return h.Hijack()
+}
+
+func (w *pathPrefixResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *pathPrefixResponseWriter) rewriteHeader() {
+	header := w.Header()
+	if location := header.Get("Location"); location != "" {
+		header.Set("Location", w.rewriteLocation(location))
+	}
+	cookies := header.Values("Set-Cookie")
+	for i, cookie := range cookies {
+		cookies[i] = w.rewriteCookie(cookie)
+	}
+}
+
+// rewriteLocation adds the prefix to redirects within the workspace
+func (w *pathPrefixResponseWriter) rewriteLocation(location string) string {
+	u, err := url.Parse(location)
+	if err != nil {
+		return location
+	}
+	if u.Host != "" && u.Hostname() != w.host {
+		// redirect to another site
+		return location
+	}
+	if u.Host == "" && !strings.HasPrefix(u.Path, "/") {
+		// relative redirect, which resolves within the prefix already
+		return location
+	}
+	if !w.prefixPath(&u.Path) {
+		return location
+	}
+	if u.RawPath != "" {
+		w.prefixPath(&u.RawPath)
+	}
+	return u.String()
+}
+
+// rewriteCookie scopes a cookie to the prefix: paths are prefixed and domains are removed,
+// such that cookies are neither shared with other workspaces nor with other hosts.
+func (w *pathPrefixResponseWriter) rewriteCookie(cookie string) string {
+	parts := strings.Split(cookie, ";")
+	res := parts[:1]
+	for _, attr := range parts[1:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(attr), "=")
+		switch strings.ToLower(name) {
+		case "domain":
+			continue
+		case "path":
+			if strings.HasPrefix(value, "/") {
+				w.prefixPath(&value)
+				attr = " Path=" + value
+			}
+		}
+		res = append(res, attr)
+	}
+	return strings.Join(res, ";")
+}
+
+// prefixPath adds the prefix to an absolute path unless it's there already
+func (w *pathPrefixResponseWriter) prefixPath(path *string) bool {
+	if *path == w.prefix || strings.HasPrefix(*path, w.prefix+"/") {
+		return false
+	}
+	*path = w.prefix + *path
+	return true
+}
+
 func getWorkspaceCoords(req *http.Request) common.WorkspaceCoords {
 	vars := mux.Vars(req)
 	return common.WorkspaceCoords{
@@ -95,6 +95,46 @@ func TestWorkspaceRouter(t *testing.T) {
 				URL:            "http://1234-debug-amaranth-smelt-9ba20cc1.ws.gitpod.dev/",
 			},
 		},
+		{
+			Name:   "path-based workspace access",
+			URL:    "http://ws.gitpod.dev/ws/amaranth-smelt-9ba20cc1/",
+			Router: PathBasedRouter(forwardedHostnameHeader, "ws.gitpod.dev"),
+			Expected: Expectation{
+				WorkspaceID: "amaranth-smelt-9ba20cc1",
+				Status:      http.StatusOK,
+				URL:         "http://ws.gitpod.dev/",
+			},
+		},
+		{
+			Name:   "path-based debug port access",
+			URL:    "http://ws.gitpod.dev/ws/debug-amaranth-smelt-9ba20cc1/port/1234/",
+			Router: PathBasedRouter(forwardedHostnameHeader, "ws.gitpod.dev"),
+			Expected: Expectation{
+				DebugWorkspace: "true",
+				WorkspaceID:    "amaranth-smelt-9ba20cc1",
+				WorkspacePort:  "1234",
+				Status:         http.StatusOK,
+				URL:            "http://ws.gitpod.dev/",
+			},
+		},
+		{
+			Name:   "path-based port access without trailing slash",
+			URL:    "http://ws.gitpod.dev/ws/amaranth-smelt-9ba20cc1/port/1234",
+			Router: PathBasedRouter(forwardedHostnameHeader, "ws.gitpod.dev"),
+			Expected: Expectation{
+				Status:             http.StatusMovedPermanently,
+				AdditionalHitCount: -1,
+			},
+		},
+		{
+			Name:   "path-based access to another host",
+			URL:    "http://amaranth-smelt-9ba20cc1.ws.gitpod.dev/ws/amaranth-smelt-9ba20cc1/",
+			Router: PathBasedRouter(forwardedHostnameHeader, "ws.gitpod.dev"),
+			Expected: Expectation{
+				Status:             http.StatusNotFound,
+				AdditionalHitCount: -1,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -154,6 +194,71 @@ func TestWorkspaceRouter(t *testing.T) {
 	}
 }
 
+func TestPathPrefixResponseWriter(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Location string
+		Cookies  []string
+		Expected http.Header
+	}{
+		{
+			Name:     "absolute path redirect",
+			Location: "/login?next=%2F",
+			Expected: http.Header{"Location": []string{"/ws/amaranth-smelt-9ba20cc1/port/3000/login?next=%2F"}},
+		},
+		{
+			Name:     "redirect to workspace host",
+			Location: "https://ws.gitpod.dev/login",
+			Expected: http.Header{"Location": []string{"https://ws.gitpod.dev/ws/amaranth-smelt-9ba20cc1/port/3000/login"}},
+		},
+		{
+			Name:     "prefixed redirect",
+			Location: "/ws/amaranth-smelt-9ba20cc1/port/3000/login",
+			Expected: http.Header{"Location": []string{"/ws/amaranth-smelt-9ba20cc1/port/3000/login"}},
+		},
+		{
+			Name:     "relative redirect",
+			Location: "login",
+			Expected: http.Header{"Location": []string{"login"}},
+		},
+		{
+			Name:     "redirect to other site",
+			Location: "https://github.com/login",
+			Expected: http.Header{"Location": []string{"https://github.com/login"}},
+		},
+		{
+			Name: "cookies",
+			Cookies: []string{
+				"session=abc; Path=/; Domain=ws.gitpod.dev; HttpOnly",
+				"theme=dark; path=/app",
+				"lang=en",
+			},
+			Expected: http.Header{"Set-Cookie": []string{
+				"session=abc; Path=/ws/amaranth-smelt-9ba20cc1/port/3000/; HttpOnly",
+				"theme=dark; Path=/ws/amaranth-smelt-9ba20cc1/port/3000/app",
+				"lang=en",
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			w := &pathPrefixResponseWriter{ResponseWriter: rec, host: "ws.gitpod.dev", prefix: "/ws/amaranth-smelt-9ba20cc1/port/3000"}
+			if test.Location != "" {
+				w.Header().Set("Location", test.Location)
+			}
+			for _, c := range test.Cookies {
+				w.Header().Add("Set-Cookie", c)
+			}
+			w.WriteHeader(http.StatusFound)
+
+			if diff := cmp.Diff(test.Expected, rec.Header()); diff != "" {
+				t.Errorf("unexpected response header (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestMatchWorkspaceHostHeader(t *testing.T) {
 	type matchResult struct {
 		MatchesWorkspace bool
@@ -314,3 +419,20 @@ func TestAcmeHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestPathBasedRoutingRequiresInsecureSharedOrigin(t *testing.T) {
+	cfg := GitpodInstallation{
+		Scheme:           "https",
+		HostName:         "gitpod.dev",
+		WorkspaceRouting: WorkspaceRoutingPath,
+		WorkspaceHost:    "ws.gitpod.dev",
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected path based routing without insecureSharedWorkspaceOrigin to be invalid")
+	}
+
+	cfg.InsecureSharedWorkspaceOrigin = true
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/golang-crypto/ssh"
+	"golang.org/x/xerrors"
+)
+
+// AuditEventType describes what happened in an SSH session
+type AuditEventType string
+
+const (
+	// AuditConnect is emitted once a client is connected to a workspace
+	AuditConnect AuditEventType = "ssh.connect"
+	// AuditDisconnect is emitted once a client connection has ended
+	AuditDisconnect AuditEventType = "ssh.disconnect"
+	// AuditChannelOpen is emitted when a channel is opened, e.g. a session or a port-forward
+	AuditChannelOpen AuditEventType = "ssh.channel.open"
+	// AuditPty is emitted when a client requests a pseudo terminal
+	AuditPty AuditEventType = "ssh.pty"
+	// AuditShell is emitted when a client starts a shell
+	AuditShell AuditEventType = "ssh.shell"
+	// AuditExec is emitted when a client executes a command
+	AuditExec AuditEventType = "ssh.exec"
+	// AuditSubsystem is emitted when a client starts a subsystem, e.g. sftp
+	AuditSubsystem AuditEventType = "ssh.subsystem"
+	// AuditRemoteForward is emitted when a client requests a remote port-forward
+	AuditRemoteForward AuditEventType = "ssh.remote-forward"
+	// AuditAuthFailure is emitted when a client fails to authenticate, e.g. with a wrong owner token or key
+	AuditAuthFailure AuditEventType = "ssh.auth.failure"
+)
+
+// AuditEvent records who did what in an SSH session at the gateway
+type AuditEvent struct {
+	Time time.Time      `json:"time"`
+	Type AuditEventType `json:"type"`
+
+	WorkspaceID    string `json:"workspaceId"`
+	InstanceID     string `json:"instanceId"`
+	OrganizationID string `json:"organizationId,omitempty"`
+	UserID         string `json:"userId"`
+	RemoteAddr     string `json:"remoteAddr,omitempty"`
+	AuthMethod     string `json:"authMethod,omitempty"`
+	CertKeyID      string `json:"certKeyId,omitempty"`
+
+	// ChannelType is the SSH channel type, e.g. session or direct-tcpip
+	ChannelType string `json:"channelType,omitempty"`
+	// Direction is "client" for channels the client opened and "workspace" for channels the workspace opened
+	Direction string `json:"direction,omitempty"`
+	// Command is the command of an exec request
+	Command string `json:"command,omitempty"`
+	// Subsystem is the name of a subsystem request
+	Subsystem string `json:"subsystem,omitempty"`
+	// Term is the terminal type of a pty request
+	Term string `json:"term,omitempty"`
+	// ForwardHost and ForwardPort are the target of a port-forward
+	ForwardHost string `json:"forwardHost,omitempty"`
+	ForwardPort uint32 `json:"forwardPort,omitempty"`
+	// Recording is the asciicast file the session is recorded to
+	Recording string `json:"recording,omitempty"`
+	// Duration is the length of a connection, set on disconnect
+	Duration time.Duration `json:"duration,omitempty"`
+	// Error is the reason an authentication attempt failed
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink receives SSH audit events
+type AuditSink interface {
+	Send(ctx context.Context, ev *AuditEvent) error
+	io.Closer
+}
+
+// AuditSinks sends events to all of its sinks
+type AuditSinks []AuditSink
+
+func (s AuditSinks) Send(ctx context.Context, ev *AuditEvent) error {
+	var errs []error
+	for _, sink := range s {
+		err := sink.Send(ctx, ev)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s AuditSinks) Close() error {
+	var errs []error
+	for _, sink := range s {
+		err := sink.Close()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LogAuditSink writes audit events to the structured log
+type LogAuditSink struct{}
+
+func (LogAuditSink) Send(ctx context.Context, ev *AuditEvent) error {
+	log.WithField("audit", ev).WithFields(log.OWI(ev.UserID, ev.WorkspaceID, ev.InstanceID)).Info(string(ev.Type))
+	return nil
+}
+
+func (LogAuditSink) Close() error { return nil }
+
+// NewFileAuditSink creates a sink which appends events as JSON lines to a file
+func NewFileAuditSink(path string) (*FileAuditSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot open SSH audit log %s: %w", path, err)
+	}
+	return &FileAuditSink{f: f}, nil
+}
+
+// FileAuditSink appends events as JSON lines to a file
+type FileAuditSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func (s *FileAuditSink) Send(ctx context.Context, ev *AuditEvent) error {
+	line, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.f.Write(line)
+	return err
+}
+
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
+
+// audit sends an event about a session to the audit sink, if there is one
+func (s *Server) audit(session *Session, ev *AuditEvent) {
+	if s.Audit == nil {
+		return
+	}
+	ev.WorkspaceID = session.WorkspaceID
+	ev.InstanceID = session.InstanceID
+	ev.OrganizationID = session.OrganizationID
+	ev.UserID = session.OwnerUserId
+	if session.Conn != nil {
+		ev.RemoteAddr = session.Conn.RemoteAddr().String()
+		if perm := session.Conn.Permissions; perm != nil {
+			ev.AuthMethod = perm.Extensions["authMethod"]
+			ev.CertKeyID = perm.Extensions["certKeyId"]
+		}
+	}
+	s.sendAudit(ev)
+}
+
+// auditAuthAttempt sends an event about a failed authentication attempt to the audit sink, if there is one.
+// It ignores successful attempts and clients probing for the available methods.
+func (s *Server) auditAuthAttempt(conn ssh.ConnMetadata, method string, err error) {
+	if s.Audit == nil || err == nil || errors.Is(err, ssh.ErrNoAuth) {
+		return
+	}
+	// the user of "none" authentication is workspaceId#ownerToken, which must never end up in the audit log
+	workspaceID, _, withToken := strings.Cut(conn.User(), "#")
+	if method == "none" && !withToken {
+		return
+	}
+	workspaceID = strings.TrimPrefix(workspaceID, "debug-")
+
+	ev := &AuditEvent{
+		Type:        AuditAuthFailure,
+		WorkspaceID: workspaceID,
+		RemoteAddr:  conn.RemoteAddr().String(),
+		AuthMethod:  method,
+		Error:       err.Error(),
+	}
+	if wsInfo := s.workspaceInfoProvider.WorkspaceInfo(workspaceID); wsInfo != nil {
+		ev.InstanceID = wsInfo.InstanceID
+		ev.OrganizationID = wsInfo.OrganizationID
+		ev.UserID = wsInfo.OwnerUserId
+	}
+	s.sendAudit(ev)
+}
+
+func (s *Server) sendAudit(ev *AuditEvent) {
+	ev.Time = time.Now()
+	err := s.Audit.Send(context.Background(), ev)
+	if err != nil {
+		log.WithFields(log.OWI(ev.UserID, ev.WorkspaceID, ev.InstanceID)).WithError(err).WithField("type", ev.Type).Warn("cannot send SSH audit event")
+	}
+}
+
+// channelAuditEvent describes a newly opened channel
+func channelAuditEvent(channelType string, extraData []byte) *AuditEvent {
+	ev := &AuditEvent{Type: AuditChannelOpen, ChannelType: channelType}
+	switch channelType {
+	case "direct-tcpip", "forwarded-tcpip":
+		var payload struct {
+			Host       string
+			Port       uint32
+			OriginHost string
+			OriginPort uint32
+		}
+		if ssh.Unmarshal(extraData, &payload) == nil {
+			ev.ForwardHost, ev.ForwardPort = payload.Host, payload.Port
+		}
+	}
+	return ev
+}
+
+// requestAuditEvent describes a channel or global request worth auditing. Returns nil for all other requests.
+func requestAuditEvent(req *ssh.Request) *AuditEvent {
+	switch req.Type {
+	case "pty-req":
+		var payload ptyRequest
+		_ = ssh.Unmarshal(req.Payload, &payload)
+		return &AuditEvent{Type: AuditPty, Term: payload.Term}
+	case "shell":
+		return &AuditEvent{Type: AuditShell}
+	case "exec":
+		var payload struct{ Command string }
+		_ = ssh.Unmarshal(req.Payload, &payload)
+		return &AuditEvent{Type: AuditExec, Command: payload.Command}
+	case "subsystem":
+		var payload struct{ Name string }
+		_ = ssh.Unmarshal(req.Payload, &payload)
+		return &AuditEvent{Type: AuditSubsystem, Subsystem: payload.Name}
+	case "tcpip-forward":
+		var payload struct {
+			Host string
+			Port uint32
+		}
+		_ = ssh.Unmarshal(req.Payload, &payload)
+		return &AuditEvent{Type: AuditRemoteForward, ForwardHost: payload.Host, ForwardPort: payload.Port}
+	default:
+		return nil
+	}
+}
+
+type ptyRequest struct {
+	Term    string
+	Columns uint32
+	Rows    uint32
+	Width   uint32
+	Height  uint32
+	Modes   string
+}
+
+type windowChangeRequest struct {
+	Columns uint32
+	Rows    uint32
+	Width   uint32
+	Height  uint32
+}
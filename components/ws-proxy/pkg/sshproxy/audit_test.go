@@ -0,0 +1,356 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+	"github.com/gitpod-io/golang-crypto/ssh"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRequestAuditEvent(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Request     *ssh.Request
+		Expectation *AuditEvent
+	}{
+		{
+			Name:        "exec",
+			Request:     &ssh.Request{Type: "exec", Payload: ssh.Marshal(struct{ Command string }{"ls -la"})},
+			Expectation: &AuditEvent{Type: AuditExec, Command: "ls -la"},
+		},
+		{
+			Name:        "sftp",
+			Request:     &ssh.Request{Type: "subsystem", Payload: ssh.Marshal(struct{ Name string }{"sftp"})},
+			Expectation: &AuditEvent{Type: AuditSubsystem, Subsystem: "sftp"},
+		},
+		{
+			Name:        "pty",
+			Request:     &ssh.Request{Type: "pty-req", Payload: ssh.Marshal(ptyRequest{Term: "xterm-256color", Columns: 80, Rows: 24})},
+			Expectation: &AuditEvent{Type: AuditPty, Term: "xterm-256color"},
+		},
+		{
+			Name:        "shell",
+			Request:     &ssh.Request{Type: "shell"},
+			Expectation: &AuditEvent{Type: AuditShell},
+		},
+		{
+			Name: "remote forward",
+			Request: &ssh.Request{Type: "tcpip-forward", Payload: ssh.Marshal(struct {
+				Host string
+				Port uint32
+			}{"localhost", 8080})},
+			Expectation: &AuditEvent{Type: AuditRemoteForward, ForwardHost: "localhost", ForwardPort: 8080},
+		},
+		{
+			Name:    "window change",
+			Request: &ssh.Request{Type: "window-change", Payload: ssh.Marshal(windowChangeRequest{Columns: 100, Rows: 40})},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := requestAuditEvent(test.Request)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected audit event (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	act := channelAuditEvent("direct-tcpip", ssh.Marshal(struct {
+		Host       string
+		Port       uint32
+		OriginHost string
+		OriginPort uint32
+	}{"localhost", 3000, "127.0.0.1", 54321}))
+	if diff := cmp.Diff(&AuditEvent{Type: AuditChannelOpen, ChannelType: "direct-tcpip", ForwardHost: "localhost", ForwardPort: 3000}, act); diff != "" {
+		t.Errorf("unexpected channel audit event (-want +got):\n%s", diff)
+	}
+}
+
+func TestFileAuditSink(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewFileAuditSink(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := []*AuditEvent{
+		{Type: AuditConnect, WorkspaceID: "ws", UserID: "user"},
+		{Type: AuditExec, WorkspaceID: "ws", UserID: "user", Command: "whoami"},
+	}
+	for _, ev := range events {
+		err = AuditSinks{sink}.Send(context.Background(), ev)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = sink.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var act []*AuditEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev AuditEvent
+		err := json.Unmarshal(scanner.Bytes(), &ev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		act = append(act, &ev)
+	}
+	if diff := cmp.Diff(events, act); diff != "" {
+		t.Errorf("unexpected audit log (-want +got):\n%s", diff)
+	}
+}
+
+func TestAsciicastRecorder(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "ws", "recording.cast")
+	rec := &asciicastRecorder{}
+
+	// output before the terminal was requested is not recorded
+	_, _ = rec.Write([]byte("motd"))
+	err := rec.Start(fn, ptyRequest{Term: "xterm", Columns: 80, Rows: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Path() != fn {
+		t.Errorf("unexpected recording path: %s", rec.Path())
+	}
+	euro := []byte("€")
+	_, _ = rec.Write(append([]byte("price: "), euro[:1]...))
+	_, _ = rec.Write(euro[1:])
+	rec.Resize(100, 40)
+	err = rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		t.Fatal("recording has no header")
+	}
+	var header asciicastHeader
+	err = json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(asciicastHeader{Version: 2, Width: 80, Height: 24, Env: map[string]string{"TERM": "xterm"}}, header, cmpopts.IgnoreFields(asciicastHeader{}, "Timestamp")); diff != "" {
+		t.Errorf("unexpected recording header (-want +got):\n%s", diff)
+	}
+
+	var events [][]interface{}
+	for scanner.Scan() {
+		var ev []interface{}
+		err := json.Unmarshal(scanner.Bytes(), &ev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ev) != 3 {
+			t.Fatalf("unexpected recording event: %s", scanner.Text())
+		}
+		events = append(events, ev[1:])
+	}
+	expectation := [][]interface{}{
+		{"o", "price: "},
+		{"o", "€"},
+		{"r", "100x40"},
+	}
+	if diff := cmp.Diff(expectation, events); diff != "" {
+		t.Errorf("unexpected recording events (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecordingConfig(t *testing.T) {
+	var cfg *RecordingConfig
+	if cfg.enabledFor(&Session{OrganizationID: "org", RecordingRequested: true}) {
+		t.Error("recording is enabled without configuration")
+	}
+	cfg = &RecordingConfig{Location: "/recordings", Organizations: []string{"org"}}
+	if !cfg.enabledFor(&Session{OrganizationID: "org"}) {
+		t.Error("recording is disabled for configured organization")
+	}
+	if !cfg.enabledFor(&Session{OrganizationID: "other-org", RecordingRequested: true}) {
+		t.Error("recording is disabled for an organization which requested it")
+	}
+	if cfg.enabledFor(&Session{OrganizationID: "other-org"}) || cfg.enabledFor(&Session{}) {
+		t.Error("recording is enabled for an organization which isn't configured")
+	}
+}
+
+func TestPruneRecordings(t *testing.T) {
+	loc := t.TempDir()
+	now := time.Now()
+	files := map[string]time.Time{
+		"old-ws/old.cast":       now.Add(-48 * time.Hour),
+		"mixed-ws/old.cast":     now.Add(-48 * time.Hour),
+		"mixed-ws/new.cast":     now.Add(-time.Hour),
+		"mixed-ws/notes.txt":    now.Add(-48 * time.Hour),
+		"recent-ws/recent.cast": now,
+	}
+	for fn, mtime := range files {
+		fn = filepath.Join(loc, fn)
+		err := os.MkdirAll(filepath.Dir(fn), 0700)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, nil, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.Chtimes(fn, mtime, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := &RecordingConfig{Location: loc, Retention: 24 * time.Hour}
+	err := cfg.prune(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var act []string
+	err = filepath.WalkDir(loc, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != loc {
+			rel, _ := filepath.Rel(loc, path)
+			act = append(act, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectation := []string{"mixed-ws", "mixed-ws/new.cast", "mixed-ws/notes.txt", "recent-ws", "recent-ws/recent.cast"}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected recordings after pruning (-want +got):\n%s", diff)
+	}
+
+	err = (&RecordingConfig{Location: filepath.Join(loc, "missing")}).prune(now)
+	if err != nil {
+		t.Errorf("cannot prune missing recording location: %v", err)
+	}
+}
+
+type fakeConnMetadata struct {
+	ssh.ConnMetadata
+	user string
+}
+
+func (c fakeConnMetadata) User() string { return c.user }
+
+func (c fakeConnMetadata) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4242}
+}
+
+type fakeInfoProvider map[string]*common.WorkspaceInfo
+
+func (p fakeInfoProvider) WorkspaceInfo(workspaceID string) *common.WorkspaceInfo {
+	return p[workspaceID]
+}
+
+type memoryAuditSink struct {
+	Events []*AuditEvent
+}
+
+func (s *memoryAuditSink) Send(ctx context.Context, ev *AuditEvent) error {
+	s.Events = append(s.Events, ev)
+	return nil
+}
+
+func (s *memoryAuditSink) Close() error { return nil }
+
+func TestAuditAuthAttempt(t *testing.T) {
+	tests := []struct {
+		Name        string
+		User        string
+		Method      string
+		Err         error
+		Expectation []*AuditEvent
+	}{
+		{
+			Name:   "success",
+			User:   "ws",
+			Method: "publickey",
+		},
+		{
+			Name:   "probing none",
+			User:   "ws",
+			Method: "none",
+			Err:    ssh.ErrNoAuth,
+		},
+		{
+			Name:   "none without token",
+			User:   "ws",
+			Method: "none",
+			Err:    ErrWorkspaceNotFound,
+		},
+		{
+			Name:   "wrong owner token",
+			User:   "debug-ws#secret",
+			Method: "none",
+			Err:    ErrAuthFailedWithReject,
+			Expectation: []*AuditEvent{
+				{Type: AuditAuthFailure, WorkspaceID: "ws", InstanceID: "instance", OrganizationID: "org", UserID: "owner", RemoteAddr: "10.0.0.1:4242", AuthMethod: "none", Error: ErrAuthFailedWithReject.Error()},
+			},
+		},
+		{
+			Name:   "unknown key",
+			User:   "ws",
+			Method: "publickey",
+			Err:    ErrAuthFailed,
+			Expectation: []*AuditEvent{
+				{Type: AuditAuthFailure, WorkspaceID: "ws", InstanceID: "instance", OrganizationID: "org", UserID: "owner", RemoteAddr: "10.0.0.1:4242", AuthMethod: "publickey", Error: ErrAuthFailed.Error()},
+			},
+		},
+		{
+			Name:   "unknown workspace",
+			User:   "other-ws",
+			Method: "password",
+			Err:    ErrWorkspaceNotFound,
+			Expectation: []*AuditEvent{
+				{Type: AuditAuthFailure, WorkspaceID: "other-ws", RemoteAddr: "10.0.0.1:4242", AuthMethod: "password", Error: ErrWorkspaceNotFound.Error()},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			sink := &memoryAuditSink{}
+			srv := &Server{
+				Audit: sink,
+				workspaceInfoProvider: fakeInfoProvider{
+					"ws": {WorkspaceID: "ws", InstanceID: "instance", OrganizationID: "org", OwnerUserId: "owner"},
+				},
+			}
+			srv.auditAuthAttempt(fakeConnMetadata{user: test.User}, test.Method, test.Err)
+			if diff := cmp.Diff(test.Expectation, sink.Events, cmpopts.IgnoreFields(AuditEvent{}, "Time")); diff != "" {
+				t.Errorf("unexpected audit events (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package sshproxy
 import (
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/analytics"
@@ -35,10 +36,50 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 	}
 	defer originChan.Close()
 
+	direction := "client"
+	if targetConn == ssh.Conn(session.Conn) {
+		direction = "workspace"
+	}
+	channelEvent := channelAuditEvent(originChannel.ChannelType(), originChannel.ExtraData())
+	channelEvent.Direction = direction
+	s.audit(session, channelEvent)
+
+	var (
+		recorder      *asciicastRecorder
+		recordingPath string
+	)
+	if direction == "client" && originChannel.ChannelType() == "session" && s.Recording.enabledFor(session) {
+		recorder = &asciicastRecorder{}
+		recordingPath = s.Recording.path(session, time.Now(), atomic.AddUint64(&session.channels, 1))
+		defer recorder.Close()
+	}
+
 	maskedReqs := make(chan *ssh.Request, 1)
 
 	go func() {
 		for req := range originReqs {
+			if ev := requestAuditEvent(req); ev != nil {
+				ev.ChannelType, ev.Direction = originChannel.ChannelType(), direction
+				if recorder != nil {
+					switch req.Type {
+					case "pty-req":
+						var pty ptyRequest
+						_ = ssh.Unmarshal(req.Payload, &pty)
+						err := recorder.Start(recordingPath, pty)
+						if err != nil {
+							log.WithFields(log.OWI("", session.WorkspaceID, session.InstanceID)).WithError(err).Warn("cannot record SSH session")
+						}
+					case "shell", "exec":
+						ev.Recording = recorder.Path()
+					}
+				}
+				s.audit(session, ev)
+			} else if req.Type == "window-change" && recorder != nil {
+				var size windowChangeRequest
+				if ssh.Unmarshal(req.Payload, &size) == nil {
+					recorder.Resize(size.Columns, size.Rows)
+				}
+			}
 			switch req.Type {
 			case "pty-req", "shell":
 				log.WithFields(log.OWI("", session.WorkspaceID, session.InstanceID)).Debugf("forwarding %s request", req.Type)
@@ -72,7 +113,11 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 
 	go func() {
 		defer wg.Done()
-		_, _ = io.Copy(originChan, targetChan)
+		var src io.Reader = targetChan
+		if recorder != nil {
+			src = io.TeeReader(targetChan, recorder)
+		}
+		_, _ = io.Copy(originChan, src)
 		_ = originChan.CloseWrite()
 		originChannelWg.Done()
 		originChannelWg.Wait()
@@ -0,0 +1,270 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"golang.org/x/xerrors"
+)
+
+// DefaultRecordingRetention is how long recordings are kept if the retention isn't configured
+const DefaultRecordingRetention = 30 * 24 * time.Hour
+
+// RecordingConfig configures the recording of terminal sessions
+type RecordingConfig struct {
+	// Location is the directory recordings are written to
+	Location string
+	// Organizations are the IDs of organizations whose sessions we record in addition to those
+	// which enabled the recording in their settings
+	Organizations []string
+	// Retention is how long recordings are kept before they're deleted. Defaults to DefaultRecordingRetention.
+	Retention time.Duration
+}
+
+// enabledFor returns true if the terminal sessions of a session are recorded
+func (c *RecordingConfig) enabledFor(session *Session) bool {
+	if c == nil || c.Location == "" {
+		return false
+	}
+	if session.RecordingRequested {
+		return true
+	}
+	if session.OrganizationID == "" {
+		return false
+	}
+	for _, org := range c.Organizations {
+		if org == session.OrganizationID {
+			return true
+		}
+	}
+	return false
+}
+
+// retention returns how long recordings are kept
+func (c *RecordingConfig) retention() time.Duration {
+	if c.Retention <= 0 {
+		return DefaultRecordingRetention
+	}
+	return c.Retention
+}
+
+// PruneRecordings periodically deletes recordings older than the retention until the context is canceled
+func (c *RecordingConfig) PruneRecordings(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		err := c.prune(time.Now())
+		if err != nil {
+			log.WithError(err).Warn("cannot prune SSH session recordings")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// prune deletes recordings which were last written to before the retention and removes workspace directories which are empty afterwards
+func (c *RecordingConfig) prune(now time.Time) error {
+	entries, err := os.ReadDir(c.Location)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deadline := now.Add(-c.retention())
+	var errs []error
+	for _, dir := range entries {
+		if !dir.IsDir() {
+			continue
+		}
+		dirPath := filepath.Join(c.Location, dir.Name())
+		recordings, err := os.ReadDir(dirPath)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		remaining := len(recordings)
+		for _, rec := range recordings {
+			if rec.IsDir() || filepath.Ext(rec.Name()) != ".cast" {
+				continue
+			}
+			info, err := rec.Info()
+			if err != nil || !info.ModTime().Before(deadline) {
+				continue
+			}
+			err = os.Remove(filepath.Join(dirPath, rec.Name()))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, err)
+				continue
+			}
+			remaining--
+		}
+		if remaining == 0 {
+			// a session might have started a recording in the meantime, in which case removing the directory fails
+			_ = os.Remove(dirPath)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// path returns the file a recording of a session's channel is written to
+func (c *RecordingConfig) path(session *Session, start time.Time, channel uint64) string {
+	return filepath.Join(c.Location, session.WorkspaceID, fmt.Sprintf("%s-%d-%d.cast", session.InstanceID, start.UnixNano(), channel))
+}
+
+// asciicastRecorder records the output of a terminal session in the asciicast v2 format.
+// It only records once a terminal was requested and discards all writes until then.
+// We deliberately don't record input, as that would include passwords typed at prompts.
+type asciicastRecorder struct {
+	mu    sync.Mutex
+	path  string
+	f     *os.File
+	start time.Time
+	// partial holds an incomplete UTF-8 sequence at the end of the last write
+	partial []byte
+}
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     uint32            `json:"width"`
+	Height    uint32            `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Start creates the recording. Calling Start on a started recorder does nothing.
+func (r *asciicastRecorder) Start(path string, pty ptyRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f != nil {
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return xerrors.Errorf("cannot create session recording: %w", err)
+	}
+	r.start = time.Now()
+	header := asciicastHeader{
+		Version:   2,
+		Width:     pty.Columns,
+		Height:    pty.Rows,
+		Timestamp: r.start.Unix(),
+	}
+	if pty.Term != "" {
+		header.Env = map[string]string{"TERM": pty.Term}
+	}
+	err = writeJSONLine(f, header)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f, r.path = f, path
+	return nil
+}
+
+// Write records terminal output
+func (r *asciicastRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return len(p), nil
+	}
+
+	data := append(r.partial, p...)
+	r.partial = nil
+	// don't split multi-byte characters across events
+	if n := incompleteRuneLen(data); n > 0 {
+		r.partial = append([]byte(nil), data[len(data)-n:]...)
+		data = data[:len(data)-n]
+	}
+	if len(data) == 0 {
+		return len(p), nil
+	}
+
+	// failing to record must not break the session
+	_ = r.event("o", string(data))
+	return len(p), nil
+}
+
+// Resize records a change of the terminal size
+func (r *asciicastRecorder) Resize(columns, rows uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return
+	}
+	_ = r.event("r", fmt.Sprintf("%dx%d", columns, rows))
+}
+
+// Path returns the file the session is recorded to, or an empty string if the recording hasn't started
+func (r *asciicastRecorder) Path() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.path
+}
+
+func (r *asciicastRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return nil
+	}
+	if len(r.partial) > 0 {
+		_ = r.event("o", string(r.partial))
+		r.partial = nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+func (r *asciicastRecorder) event(code, data string) error {
+	return writeJSONLine(r.f, []interface{}{time.Since(r.start).Seconds(), code, data})
+}
+
+func writeJSONLine(f *os.File, v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
+
+// incompleteRuneLen returns the length of an incomplete UTF-8 sequence at the end of p
+func incompleteRuneLen(p []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(p); i++ {
+		c := p[len(p)-i]
+		if utf8.RuneStart(c) {
+			if !utf8.FullRune(p[len(p)-i:]) {
+				return i
+			}
+			return 0
+		}
+	}
+	return 0
+}
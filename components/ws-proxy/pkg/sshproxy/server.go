@@ -99,21 +99,32 @@ func NewSSHErrorWithReject(shortName string, description string) SSHError {
 type Session struct {
 	Conn *ssh.ServerConn
 
-	WorkspaceID string
-	InstanceID  string
-	OwnerUserId string
+	WorkspaceID    string
+	InstanceID     string
+	OwnerUserId    string
+	OrganizationID string
+	// RecordingRequested is true if the organization of the workspace asked for its terminal sessions to be recorded
+	RecordingRequested bool
 
 	PublicKey           ssh.PublicKey
 	WorkspacePrivateKey ssh.Signer
+
+	// channels counts the channels opened in this session
+	channels uint64
 }
 
 type Server struct {
 	Heartbeater Heartbeat
+	// Audit receives the audit events of all SSH sessions. There's no audit log if it's nil.
+	Audit AuditSink
+	// Recording configures which terminal sessions are recorded. There are no recordings if it's nil.
+	Recording *RecordingConfig
 
 	HostKeys              []ssh.Signer
 	sshConfig             *ssh.ServerConfig
 	workspaceInfoProvider common.WorkspaceInfoProvider
 	caKey                 ssh.Signer
+	userCA                *UserCertificateAuthority
 }
 
 func init() {
@@ -127,12 +138,13 @@ func init() {
 
 // New creates a new SSH proxy server
 
-func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvider, heartbeat Heartbeat, caKey ssh.Signer) *Server {
+func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvider, heartbeat Heartbeat, caKey ssh.Signer, userCA *UserCertificateAuthority) *Server {
 	server := &Server{
 		workspaceInfoProvider: workspaceInfoProvider,
 		Heartbeater:           &noHeartbeat{},
 		HostKeys:              signers,
 		caKey:                 caKey,
+		userCA:                userCA,
 	}
 	if heartbeat != nil {
 		server.Heartbeater = heartbeat
@@ -157,6 +169,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			Extensions: map[string]string{
 				"workspaceId":    workspaceId,
 				"debugWorkspace": info[common.DebugWorkspaceIdentifier],
+				"authMethod":     "websocket",
 			},
 		}, nil
 	}
@@ -191,6 +204,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 				Extensions: map[string]string{
 					"workspaceId":    workspaceId,
 					"debugWorkspace": debugWorkspace,
+					"authMethod":     "owner-token",
 				},
 			}, nil
 		},
@@ -215,6 +229,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 				Extensions: map[string]string{
 					"workspaceId":    workspaceId,
 					"debugWorkspace": debugWorkspace,
+					"authMethod":     "owner-token",
 				},
 			}, nil
 		},
@@ -235,6 +250,23 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			defer func() {
 				server.TrackSSHConnection(wsInfo, "auth", err)
 			}()
+			if cert, ok := pk.(*ssh.Certificate); ok {
+				err = server.VerifyUserCertificate(wsInfo, cert)
+				if err != nil {
+					log.WithError(err).WithField("workspaceId", workspaceId).WithField("keyId", cert.KeyId).Debug("rejected SSH certificate")
+					return nil, ErrAuthFailed
+				}
+				return &ssh.Permissions{
+					// the SSH server enforces the source-address option on its own
+					CriticalOptions: cert.CriticalOptions,
+					Extensions: map[string]string{
+						"workspaceId":    workspaceId,
+						"debugWorkspace": debugWorkspace,
+						"authMethod":     "certificate",
+						"certKeyId":      cert.KeyId,
+					},
+				}, nil
+			}
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 			ok, _ := server.VerifyPublicKey(ctx, wsInfo, pk)
@@ -245,10 +277,12 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 				Extensions: map[string]string{
 					"workspaceId":    workspaceId,
 					"debugWorkspace": debugWorkspace,
+					"authMethod":     "public-key",
 				},
 			}, nil
 		},
 	}
+	server.sshConfig.AuthLogCallback = server.auditAuthAttempt
 	for _, s := range signers {
 		server.sshConfig.AddHostKey(s)
 	}
@@ -317,10 +351,13 @@ func (s *Server) HandleConn(c net.Conn) {
 	userName := "gitpod"
 
 	session := &Session{
-		Conn:        clientConn,
-		WorkspaceID: workspaceId,
-		InstanceID:  wsInfo.InstanceID,
-		OwnerUserId: wsInfo.OwnerUserId,
+		Conn:           clientConn,
+		WorkspaceID:    workspaceId,
+		InstanceID:     wsInfo.InstanceID,
+		OwnerUserId:    wsInfo.OwnerUserId,
+		OrganizationID: wsInfo.OrganizationID,
+
+		RecordingRequested: wsInfo.SSHRecording,
 	}
 
 	if !wsInfo.IsManagedByMk2 {
@@ -402,9 +439,16 @@ func (s *Server) HandleConn(c net.Conn) {
 	s.TrackSSHConnection(wsInfo, "connect", nil)
 	SSHConnectionCount.Inc()
 	ReportSSHAttemptMetrics(nil)
+	connectedAt := time.Now()
+	s.audit(session, &AuditEvent{Type: AuditConnect})
 
 	forwardRequests := func(reqs <-chan *ssh.Request, targetConn ssh.Conn) {
 		for req := range reqs {
+			if targetConn == workspaceConn {
+				if ev := requestAuditEvent(req); ev != nil && ev.Type == AuditRemoteForward {
+					s.audit(session, ev)
+				}
+			}
 			result, payload, err := targetConn.SendRequest(req.Type, req.WantReply, req.Payload)
 			if err != nil {
 				continue
@@ -438,6 +482,7 @@ func (s *Server) HandleConn(c net.Conn) {
 		cancel()
 	}()
 	<-ctx.Done()
+	s.audit(session, &AuditEvent{Type: AuditDisconnect, Duration: time.Since(connectedAt)})
 	SSHConnectionCount.Dec()
 	workspaceConn.Close()
 	clientConn.Close()
@@ -496,6 +541,14 @@ func (s *Server) VerifyPublicKey(ctx context.Context, wsInfo *common.WorkspaceIn
 	return false, nil
 }
 
+// VerifyUserCertificate checks that cert was signed by a trusted user CA for the owner of the workspace
+func (s *Server) VerifyUserCertificate(wsInfo *common.WorkspaceInfo, cert *ssh.Certificate) error {
+	if s.userCA == nil {
+		return xerrors.Errorf("authentication with SSH certificates is not enabled")
+	}
+	return s.userCA.Verify(cert, wsInfo.OwnerUserId)
+}
+
 func (s *Server) GetWorkspaceSSHKey(ctx context.Context, workspaceIP string, supervisorPort string) (ssh.Signer, string, error) {
 	supervisorConn, err := grpc.Dial(workspaceIP+":"+supervisorPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"time"
+
+	"github.com/gitpod-io/golang-crypto/ssh"
+	"golang.org/x/xerrors"
+)
+
+// UserCertificateAuthority verifies the SSH certificates users authenticate with at the gateway.
+// A certificate grants access to the workspaces of the user whose ID is among its principals.
+type UserCertificateAuthority struct {
+	// Keys are the public keys of the CAs we trust to sign user certificates
+	Keys []ssh.PublicKey
+	// MaxValidity is the longest validity period we accept for a certificate. Zero means there's no limit.
+	MaxValidity time.Duration
+
+	// clock is used for testing
+	clock func() time.Time
+}
+
+// ParseUserCertificateAuthority parses CA public keys in authorized_keys format
+func ParseUserCertificateAuthority(authorizedKeys []byte, maxValidity time.Duration) (*UserCertificateAuthority, error) {
+	res := &UserCertificateAuthority{MaxValidity: maxValidity}
+	for rest := bytes.TrimSpace(authorizedKeys); len(rest) > 0; rest = bytes.TrimSpace(rest) {
+		key, _, _, r, err := ssh.ParseAuthorizedKey(rest)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse CA public key: %w", err)
+		}
+		res.Keys = append(res.Keys, key)
+		rest = r
+	}
+	if len(res.Keys) == 0 {
+		return nil, xerrors.Errorf("no CA public key found")
+	}
+	return res, nil
+}
+
+// Verify checks that cert is a valid user certificate for the user with the given ID
+func (ca *UserCertificateAuthority) Verify(cert *ssh.Certificate, userID string) error {
+	if cert.CertType != ssh.UserCert {
+		return xerrors.Errorf("not a user certificate")
+	}
+	if !ca.isAuthority(cert.SignatureKey) {
+		return xerrors.Errorf("certificate is not signed by a trusted CA")
+	}
+	// certificates without principals are valid for everyone - we don't want any such certificate to grant access to all workspaces
+	if userID == "" || len(cert.ValidPrincipals) == 0 {
+		return xerrors.Errorf("certificate has no principals")
+	}
+	if ca.MaxValidity > 0 {
+		if cert.ValidBefore == ssh.CertTimeInfinity || cert.ValidBefore < cert.ValidAfter ||
+			time.Duration(cert.ValidBefore-cert.ValidAfter)*time.Second > ca.MaxValidity {
+			return xerrors.Errorf("certificate is valid for longer than %s", ca.MaxValidity)
+		}
+	}
+
+	checker := &ssh.CertChecker{Clock: ca.clock}
+	err := checker.CheckCert(userID, cert)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ca *UserCertificateAuthority) isAuthority(key ssh.PublicKey) bool {
+	kd := key.Marshal()
+	for _, k := range ca.Keys {
+		cd := k.Marshal()
+		if len(cd) == len(kd) && subtle.ConstantTimeCompare(cd, kd) == 1 {
+			return true
+		}
+	}
+	return false
+}
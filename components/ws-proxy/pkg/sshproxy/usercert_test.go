@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/golang-crypto/ssh"
+)
+
+func TestUserCertificateAuthority(t *testing.T) {
+	newSigner := func() ssh.Signer {
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signer, err := ssh.NewSignerFromKey(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signer
+	}
+	var (
+		ca        = newSigner()
+		untrusted = newSigner()
+		userKey   = newSigner()
+		now       = time.Now()
+	)
+
+	authority, err := ParseUserCertificateAuthority(append(ssh.MarshalAuthorizedKey(newSigner().PublicKey()), ssh.MarshalAuthorizedKey(ca.PublicKey())...), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(authority.Keys) != 2 {
+		t.Fatalf("expected two CA keys, got %d", len(authority.Keys))
+	}
+
+	type Cert struct {
+		Type        uint32
+		Principals  []string
+		ValidAfter  time.Time
+		ValidBefore time.Time
+		Signer      ssh.Signer
+	}
+	valid := Cert{
+		Type:        ssh.UserCert,
+		Principals:  []string{"user-id"},
+		ValidAfter:  now.Add(-time.Minute),
+		ValidBefore: now.Add(10 * time.Minute),
+		Signer:      ca,
+	}
+	tests := []struct {
+		Name    string
+		Cert    func(c Cert) Cert
+		UserID  string
+		WantErr bool
+	}{
+		{Name: "valid", UserID: "user-id"},
+		{Name: "other user", UserID: "other-user-id", WantErr: true},
+		{Name: "no principals", Cert: func(c Cert) Cert { c.Principals = nil; return c }, UserID: "user-id", WantErr: true},
+		{Name: "untrusted CA", Cert: func(c Cert) Cert { c.Signer = untrusted; return c }, UserID: "user-id", WantErr: true},
+		{Name: "host certificate", Cert: func(c Cert) Cert { c.Type = ssh.HostCert; return c }, UserID: "user-id", WantErr: true},
+		{Name: "expired", Cert: func(c Cert) Cert { c.ValidBefore = now.Add(-time.Second); return c }, UserID: "user-id", WantErr: true},
+		{Name: "too long validity", Cert: func(c Cert) Cert { c.ValidBefore = now.Add(24 * time.Hour); return c }, UserID: "user-id", WantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			c := valid
+			if test.Cert != nil {
+				c = test.Cert(c)
+			}
+			cert := &ssh.Certificate{
+				Key:             userKey.PublicKey(),
+				CertType:        c.Type,
+				KeyId:           "test",
+				ValidPrincipals: c.Principals,
+				ValidAfter:      uint64(c.ValidAfter.Unix()),
+				ValidBefore:     uint64(c.ValidBefore.Unix()),
+			}
+			err := cert.SignCert(rand.Reader, c.Signer)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = authority.Verify(cert, test.UserID)
+			if (err != nil) != test.WantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, test.WantErr)
+			}
+		})
+	}
+}
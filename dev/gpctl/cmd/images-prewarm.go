@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/gpctl/pkg/util"
+	"github.com/gitpod-io/gitpod/registry-facade/api"
+)
+
+// imagesPrewarmCmd represents the prewarm command
+var imagesPrewarmCmd = &cobra.Command{
+	Use:   "prewarm [ref...]",
+	Short: "Pulls images onto the workspace nodes ahead of workspace starts",
+	Long: `Asks registry-facade on each workspace node to pull the images into its caches, and into the node's
+containerd if registry-facade has access to it. Reads the image refs from STDIN if none are given, e.g.
+	mysql -N -B -u gitpod -p -h 127.0.0.1 gitpod -e 'SELECT ws.baseImageNameResolved FROM d_b_workspace_instance wsi LEFT JOIN d_b_workspace ws ON ws.id = workspaceId WHERE wsi.creationTime > (NOW() - INTERVAL 1 DAY)' | \
+	sort | \
+	uniq | \
+	gpctl images prewarm
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		refs := args
+		if len(refs) == 0 {
+			scanner := bufio.NewScanner(os.Stdin)
+			for scanner.Scan() {
+				ref := strings.TrimSpace(scanner.Text())
+				if len(ref) == 0 {
+					continue
+				}
+				refs = append(refs, ref)
+			}
+		}
+		if len(refs) == 0 {
+			log.Fatal("no images to prewarm")
+		}
+
+		cfg, namespace, err := getKubeconfig()
+		if err != nil {
+			log.WithError(err).Fatal("cannot get kubeconfig")
+		}
+		clientSet, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect to Kubernetes")
+		}
+
+		node, _ := cmd.Flags().GetString("node")
+		pods, err := clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: "component=registry-facade",
+		})
+		if err != nil {
+			log.WithError(err).Fatal("cannot list registry-facade pods")
+		}
+
+		port, _ := cmd.Flags().GetInt("port")
+		var prewarmed int
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			if node != "" && pod.Spec.NodeName != node {
+				continue
+			}
+
+			resp, err := prewarmImages(ctx, pod.Name, port, refs)
+			if err != nil {
+				log.WithError(err).WithField("node", pod.Spec.NodeName).Error("cannot prewarm images")
+				continue
+			}
+			prewarmed++
+
+			for _, r := range resp.Results {
+				log := log.WithField("node", pod.Spec.NodeName).WithField("ref", r.Ref)
+				if r.Error != "" {
+					log.WithField("error", r.Error).Warn("cannot prewarm image")
+					continue
+				}
+				log.WithField("layers", r.Layers).WithField("bytesFetched", r.BytesFetched).WithField("containerd", r.Containerd).Info("prewarmed image")
+			}
+		}
+		if prewarmed == 0 {
+			log.Fatal("no registry-facade pod prewarmed the images")
+		}
+	},
+}
+
+func prewarmImages(ctx context.Context, podName string, port int, refs []string) (*api.PrewarmResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cfg, namespace, err := getKubeconfig()
+	if err != nil {
+		return nil, err
+	}
+	freePort, err := GetFreePort()
+	if err != nil {
+		return nil, err
+	}
+	readychan, errchan := util.ForwardPort(ctx, cfg, namespace, podName, fmt.Sprintf("%d:%d", freePort, port))
+	select {
+	case <-readychan:
+	case err := <-errchan:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", freePort), grpc.WithTransportCredentials(insecure.NewCredentials()), util.WithClientUnaryInterceptor())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return api.NewImagePrewarmerClient(conn).Prewarm(ctx, &api.PrewarmRequest{Refs: refs})
+}
+
+func init() {
+	imagesCmd.AddCommand(imagesPrewarmCmd)
+
+	imagesPrewarmCmd.Flags().String("node", "", "only prewarm the images on this node")
+	imagesPrewarmCmd.Flags().Int("port", 8087, "port registry-facade serves image prewarm requests on")
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// workspacesDrainNodeCmd represents the drain-node command
+var workspacesDrainNodeCmd = &cobra.Command{
+	Use:   "drain-node <nodeName>",
+	Short: "stops all workspaces on a node and restarts them on other nodes",
+	Long: `Marks a node for draining. ws-manager cordons the node, notifies the users of the workspaces
+running on it and stops them once the drain grace period has passed. Stopped workspaces are backed up,
+and the server restarts them from that backup on another node.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cfg, _, err := getKubeconfig()
+		if err != nil {
+			log.WithError(err).Fatal("cannot get kubeconfig")
+		}
+		clientSet, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect to Kubernetes")
+		}
+
+		nodeName := args[0]
+		cancelDrain, _ := cmd.Flags().GetBool("cancel")
+
+		var (
+			annotation interface{} = time.Now().UTC().Format(time.RFC3339)
+			msg                    = "draining"
+		)
+		patch := map[string]interface{}{}
+		if cancelDrain {
+			// ws-manager only cordons nodes, hence undoing the drain makes the node schedulable again.
+			annotation = nil
+			msg = "drain cancelled"
+			patch["spec"] = map[string]interface{}{"unschedulable": false}
+		}
+		patch["metadata"] = map[string]interface{}{
+			"annotations": map[string]interface{}{
+				wsk8s.NodeDrainAnnotation: annotation,
+			},
+		}
+		payload, err := json.Marshal(patch)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		_, err = clientSet.CoreV1().Nodes().Patch(ctx, nodeName, types.MergePatchType, payload, metav1.PatchOptions{})
+		if err != nil {
+			log.WithError(err).Fatal("cannot update node")
+		}
+
+		fmt.Printf("node '%s' %s\n", nodeName, msg)
+	},
+}
+
+func init() {
+	workspacesCmd.AddCommand(workspacesDrainNodeCmd)
+	workspacesDrainNodeCmd.Flags().Bool("cancel", false, "cancels the drain and uncordons the node")
+}
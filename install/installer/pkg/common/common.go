@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	imgbuildercfg "github.com/gitpod-io/gitpod/image-builder/api/config"
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 
@@ -730,6 +731,10 @@ var (
 		APIVersion: "v1",
 		Kind:       "Secret",
 	}
+	TypeMetaPersistentVolumeClaim = metav1.TypeMeta{
+		APIVersion: "v1",
+		Kind:       "PersistentVolumeClaim",
+	}
 	TypeMetaResourceQuota = metav1.TypeMeta{
 		APIVersion: "v1",
 		Kind:       "ResourceQuota",
@@ -836,6 +841,23 @@ func ExperimentalWebappConfig(ctx *RenderContext) *experimental.WebAppConfig {
 	return experimentalCfg.WebApp
 }
 
+// ImageBuildCache returns the node directory which keeps the local build cache of image builds, and where
+// image build workspaces mount it. Both are empty if image builds don't use a local build cache.
+func ImageBuildCache(ctx *RenderContext) (hostPath, dir string) {
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil {
+			return nil
+		}
+		cfg := ucfg.Workspace.ImageBuilderMk3
+		if cfg.BuildCache == nil || cfg.BuildCache.Mode != imgbuildercfg.BuildCacheModeLocal {
+			return nil
+		}
+		hostPath, dir = cfg.BuildCacheHostPath, cfg.BuildCache.Dir
+		return nil
+	})
+	return
+}
+
 // WithLocalWsManager returns true if the installed application cluster should connect to a local ws-manager
 func WithLocalWsManager(ctx *RenderContext) bool {
 	return ctx.Config.Kind == config.InstallationFull
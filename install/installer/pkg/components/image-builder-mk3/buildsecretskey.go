@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package image_builder_mk3
+
+import (
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// buildSecretsKeySecret holds the key image-builder encrypts build secrets with. Build workspaces
+// reference it, hence it must live in the namespace the workspaces run in.
+func buildSecretsKeySecret(ctx *common.RenderContext) ([]runtime.Object, error) {
+	// bob expects the key to be exactly 32 bytes long
+	key, err := common.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return []runtime.Object{
+		&corev1.Secret{
+			TypeMeta: common.TypeMetaSecret,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      BuildSecretsKeySecretName,
+				Namespace: ctx.Namespace,
+				Labels:    common.DefaultLabels(Component),
+			},
+			Data: map[string][]byte{
+				"key": []byte(key),
+			},
+		},
+	}, nil
+}
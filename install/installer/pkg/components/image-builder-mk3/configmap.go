@@ -43,9 +43,11 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 	baseImageRepoName := "base-images"
 	workspaceImageRepoName := "workspace-images"
+	var buildCache *config.BuildCacheConfig
 
 	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
 		if cfg.Workspace != nil {
+			buildCache = cfg.Workspace.ImageBuilderMk3.BuildCache
 			if cfg.Workspace.ImageBuilderMk3.BaseImageRepositoryName != "" {
 				baseImageRepoName = cfg.Workspace.ImageBuilderMk3.BaseImageRepositoryName
 			}
@@ -68,10 +70,13 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 		PullSecret:               secretName,
 		PullSecretFile:           "/config/pull-secret/pull-secret.json",
+		BuildSecretsKeySecret:    BuildSecretsKeySecretName,
+		BuildSecretsKeyFile:      "/config/build-secrets-key/key",
 		BaseImageRepository:      fmt.Sprintf("%s/%s", registryName, baseImageRepoName),
 		WorkspaceImageRepository: fmt.Sprintf("%s/%s", registryName, workspaceImageRepoName),
 		BuilderImage:             ctx.ImageName(ctx.Config.Repository, BuilderImage, ctx.VersionManifest.Components.ImageBuilderMk3.BuilderImage.Version),
 		EnableAdditionalECRAuth:  ctx.Config.ContainerRegistry.EnableAdditionalECRAuth,
+		BuildCache:               buildCache,
 	}
 
 	workspaceImage := ctx.Config.Workspace.WorkspaceImage
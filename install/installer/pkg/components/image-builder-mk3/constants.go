@@ -13,4 +13,8 @@ const (
 	RPCPortName    = "service"
 	TLSSecretName  = common.ImageBuilderTLSSecret
 	VolumeTLSCerts = common.ImageBuilderVolumeTLSCerts
+
+	// BuildSecretsKeySecretName names the secret with the key we encrypt build secrets with
+	BuildSecretsKeySecretName = "image-builder-build-secrets-key"
+	VolumeBuildSecretsKey     = "build-secrets-key"
 )
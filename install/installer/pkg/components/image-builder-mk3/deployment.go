@@ -87,6 +87,14 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 				},
 			},
 		},
+		{
+			Name: VolumeBuildSecretsKey,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: BuildSecretsKeySecretName,
+				},
+			},
+		},
 		common.CAVolume(),
 	}
 
@@ -105,6 +113,11 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 			Name:      "pull-secret",
 			MountPath: "/config/pull-secret",
 		},
+		{
+			Name:      VolumeBuildSecretsKey,
+			MountPath: "/config/build-secrets-key",
+			ReadOnly:  true,
+		},
 		common.CAVolumeMount(),
 	}
 
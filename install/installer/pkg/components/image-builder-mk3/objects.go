@@ -21,4 +21,5 @@ var Objects = common.CompositeRenderFunc(
 	}),
 	common.DefaultServiceAccount(Component),
 	tlssecret,
+	buildSecretsKeySecret,
 )
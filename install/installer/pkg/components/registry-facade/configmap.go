@@ -22,6 +22,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 	var (
 		ipfsCache  *regfac.IPFSCacheConfig
 		redisCache *regfac.RedisCacheConfig
+		prewarm    *regfac.PrewarmConfig
 	)
 	remoteSpecProviders := []*regfac.RSProvider{
 		{
@@ -57,6 +58,16 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			}
 		}
 
+		if prewarmCfg := ucfg.Workspace.RegistryFacade.Prewarm; prewarmCfg.Enabled {
+			prewarm = &regfac.PrewarmConfig{
+				Addr:     common.LocalhostAddressFromPort(PrewarmPort),
+				Schedule: prewarmCfg.Schedule,
+			}
+			if prewarmCfg.Containerd {
+				prewarm.ContainerdSocket = "/mnt/containerd/containerd.sock"
+			}
+		}
+
 		return nil
 	})
 
@@ -86,6 +97,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			},
 			IPFSCache:  ipfsCache,
 			RedisCache: redisCache,
+			Prewarm:    prewarm,
 		},
 		AuthCfg:            "/mnt/pull-secret/pull-secret.json",
 		PProfAddr:          common.LocalhostAddressFromPort(baseserver.BuiltinDebugPort),
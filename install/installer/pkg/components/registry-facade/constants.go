@@ -17,4 +17,6 @@ const (
 	SupervisorImage   = workspace.SupervisorImage
 	WorkspacekitImage = workspace.WorkspacekitImage
 	ReadinessPort     = 8086
+	PrewarmPort       = 8087
+	PrewarmPortName   = "prewarm"
 )
@@ -88,7 +88,15 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil, fmt.Errorf("%s: invalid container registry config", Component)
 	}
 
-	var envvars []corev1.EnvVar
+	var (
+		envvars []corev1.EnvVar
+		ports   = []corev1.ContainerPort{{
+			Name:          ContainerPortName,
+			ContainerPort: ServicePort,
+			HostPort:      ServicePort,
+		}}
+		runAsUser = pointer.Int64(1000)
+	)
 	err = ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
 			return nil
@@ -127,6 +135,28 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 			}
 		}
 
+		if prewarm := ucfg.Workspace.RegistryFacade.Prewarm; prewarm.Enabled {
+			// the prewarm service listens on localhost only and is reached through port-forwards
+			ports = append(ports, corev1.ContainerPort{
+				Name:          PrewarmPortName,
+				ContainerPort: PrewarmPort,
+			})
+			if prewarm.Containerd {
+				volumes = append(volumes, corev1.Volume{
+					Name: "containerd-socket",
+					VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+						Path: ctx.Config.Workspace.Runtime.ContainerDSocketDir,
+					}},
+				})
+				volumeMounts = append(volumeMounts, corev1.VolumeMount{
+					Name:      "containerd-socket",
+					MountPath: "/mnt/containerd",
+				})
+				// the containerd socket is accessible to root only
+				runAsUser = pointer.Int64(0)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -205,15 +235,11 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 								"memory": resource.MustParse("32Mi"),
 							},
 						}),
-						Ports: []corev1.ContainerPort{{
-							Name:          ContainerPortName,
-							ContainerPort: ServicePort,
-							HostPort:      ServicePort,
-						}},
+						Ports: ports,
 						SecurityContext: &corev1.SecurityContext{
 							Privileged:               pointer.Bool(false),
 							AllowPrivilegeEscalation: pointer.Bool(false),
-							RunAsUser:                pointer.Int64(1000),
+							RunAsUser:                runAsUser,
 						},
 						Env: common.CustomizeEnvvar(ctx, Component, common.MergeEnv(
 							common.DefaultEnv(&ctx.Config),
@@ -14,6 +14,7 @@ import (
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	wsdapi "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/activity"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	wsdconfig "github.com/gitpod-io/gitpod/ws-daemon/pkg/config"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
@@ -59,6 +60,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		Tier2:   0,
 	}
 
+	machineActivityConfig := activity.Config{
+		Enabled:      false,
+		Interval:     util.Duration(5 * time.Minute),
+		ProcLocation: "/proc",
+	}
+
 	runtimeMapping := make(map[string]string)
 	// default runtime mapping
 	runtimeMapping[ctx.Config.Workspace.Runtime.ContainerDRuntimeDir] = "/mnt/node0"
@@ -92,6 +99,11 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		oomScoreAdjConfig.Tier1 = ucfg.Workspace.OOMScores.Tier1
 		oomScoreAdjConfig.Tier2 = ucfg.Workspace.OOMScores.Tier2
 
+		machineActivityConfig.Enabled = ucfg.Workspace.MachineActivity.Enabled
+		if ucfg.Workspace.MachineActivity.Interval > 0 {
+			machineActivityConfig.Interval = ucfg.Workspace.MachineActivity.Interval
+		}
+
 		if len(ucfg.Workspace.WSDaemon.Runtime.NodeToContainerMapping) > 0 {
 			// reset map
 			runtimeMapping = make(map[string]string)
@@ -156,11 +168,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 					Size:  70000,
 				}},
 			},
-			CPULimit:  cpuLimitConfig,
-			IOLimit:   ioLimitConfig,
-			ProcLimit: procLimit,
-			NetLimit:  networkLimitConfig,
-			OOMScores: oomScoreAdjConfig,
+			CPULimit:        cpuLimitConfig,
+			IOLimit:         ioLimitConfig,
+			ProcLimit:       procLimit,
+			NetLimit:        networkLimitConfig,
+			OOMScores:       oomScoreAdjConfig,
+			MachineActivity: machineActivityConfig,
 			DiskSpaceGuard: diskguard.Config{
 				Enabled:  true,
 				Interval: util.Duration(5 * time.Minute),
@@ -65,6 +65,20 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 	}
 
+	// image build workspaces write the local build cache as the workspace user
+	if hostPath, _ := common.ImageBuildCache(ctx); hostPath != "" {
+		initContainers = append(initContainers, corev1.Container{
+			Name:    "build-cache",
+			Image:   ctx.ImageName(cfg.Repository, "ws-daemon", ctx.VersionManifest.Components.WSDaemon.Version),
+			Command: []string{"chown", "33333:33333", "/mnt/build-cache"},
+			VolumeMounts: []corev1.VolumeMount{{
+				Name:      "build-cache",
+				MountPath: "/mnt/build-cache",
+			}},
+			SecurityContext: &corev1.SecurityContext{RunAsUser: pointer.Int64(0)},
+		})
+	}
+
 	volumes := []corev1.Volume{
 		{
 			Name: "hostfs",
@@ -143,6 +157,15 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 		common.CAVolume(),
 	}
+	if hostPath, _ := common.ImageBuildCache(ctx); hostPath != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "build-cache",
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+				Path: hostPath,
+				Type: func() *corev1.HostPathType { r := corev1.HostPathDirectoryOrCreate; return &r }(),
+			}},
+		})
+	}
 
 	volumeMounts := []corev1.VolumeMount{
 		{
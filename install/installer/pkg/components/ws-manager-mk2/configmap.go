@@ -85,6 +85,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 	hostWorkingArea := wsdaemon.HostWorkingAreaMk2
 
 	rateLimits := map[string]grpc.RateLimit{}
+	var audit *config.AuditConfiguration
 
 	err = ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
@@ -119,7 +120,8 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 						Storage:          c.Resources.Limits.Storage,
 					},
 				},
-				Templates: tplsCfg,
+				Templates:       tplsCfg,
+				MachineActivity: c.MachineActivity,
 			}
 			for tmpl_n, tmpl_v := range ctpls {
 				if _, ok := tpls[tmpl_n]; ok {
@@ -144,6 +146,10 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		if ucfg.Workspace.WorkspaceClusterHost != "" {
 			workspaceClusterHost = ucfg.Workspace.WorkspaceClusterHost
 		}
+		if ucfg.Workspace.WSProxy.WorkspaceRouting == "path" {
+			workspaceURLTemplate = fmt.Sprintf("https://%s/ws/{{ .Prefix }}", workspaceClusterHost)
+			workspacePortURLTemplate = fmt.Sprintf("https://%s/ws/{{ .Prefix }}/port/{{ .WorkspacePort }}", workspaceClusterHost)
+		}
 		if ucfg.Workspace.WorkspaceURLTemplate != "" {
 			workspaceURLTemplate = ucfg.Workspace.WorkspaceURLTemplate
 		}
@@ -152,6 +158,14 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		}
 		rateLimits = ucfg.Workspace.WSManagerRateLimits
 
+		if ucfg.Workspace.Audit != nil {
+			auditCfg := *ucfg.Workspace.Audit
+			if auditCfg.Source == "" {
+				auditCfg.Source = "https://" + workspaceClusterHost
+			}
+			audit = &auditCfg
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -223,6 +237,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			RegistryFacadeHost:               fmt.Sprintf("reg.%s:%d", ctx.Config.Domain, common.RegistryFacadeServicePort),
 			WorkspaceMaxConcurrentReconciles: 25,
 			TimeoutMaxConcurrentReconciles:   15,
+			Audit:                            audit,
 		},
 		Content: struct {
 			Storage storageconfig.StorageConfig `json:"storage"`
@@ -324,7 +339,7 @@ func buildWorkspaceTemplates(ctx *common.RenderContext, cfgTpls *configv1.Worksp
 		Tpl  *corev1.Pod
 	}{
 		{Name: "default", Path: &cfg.DefaultPath, Tpl: cfgTpls.Default},
-		{Name: "imagebuild", Path: &cfg.ImagebuildPath, Tpl: cfgTpls.ImageBuild},
+		{Name: "imagebuild", Path: &cfg.ImagebuildPath, Tpl: imageBuildTemplate(ctx, cfgTpls.ImageBuild)},
 		{Name: "prebuild", Path: &cfg.PrebuildPath, Tpl: cfgTpls.Prebuild},
 		{Name: "regular", Path: &cfg.RegularPath, Tpl: cfgTpls.Regular},
 	}
@@ -346,3 +361,37 @@ func buildWorkspaceTemplates(ctx *common.RenderContext, cfgTpls *configv1.Worksp
 
 	return cfg, tpls, nil
 }
+
+// imageBuildTemplate adds the local build cache, if image builds use one, to the image build workspace template
+func imageBuildTemplate(ctx *common.RenderContext, tpl *corev1.Pod) *corev1.Pod {
+	hostPath, dir := common.ImageBuildCache(ctx)
+	if hostPath == "" || dir == "" {
+		return tpl
+	}
+
+	res := &corev1.Pod{}
+	if tpl != nil {
+		res = tpl.DeepCopy()
+	}
+	hostPathType := corev1.HostPathDirectoryOrCreate
+	res.Spec.Volumes = append(res.Spec.Volumes, corev1.Volume{
+		Name: VolumeBuildCache,
+		VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+			Path: hostPath,
+			Type: &hostPathType,
+		}},
+	})
+
+	mount := corev1.VolumeMount{Name: VolumeBuildCache, MountPath: dir}
+	for i, c := range res.Spec.Containers {
+		if c.Name == "workspace" {
+			res.Spec.Containers[i].VolumeMounts = append(res.Spec.Containers[i].VolumeMounts, mount)
+			return res
+		}
+	}
+	res.Spec.Containers = append(res.Spec.Containers, corev1.Container{
+		Name:         "workspace",
+		VolumeMounts: []corev1.VolumeMount{mount},
+	})
+	return res
+}
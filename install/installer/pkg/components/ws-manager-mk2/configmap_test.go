@@ -13,8 +13,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
+	imgbuildercfg "github.com/gitpod-io/gitpod/image-builder/api/config"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/versions"
 	wsmancfg "github.com/gitpod-io/gitpod/ws-manager/api/config"
 )
@@ -149,6 +151,76 @@ func TestBuildWorkspaceTemplates(t *testing.T) {
 	}
 }
 
+func TestImageBuildTemplate(t *testing.T) {
+	hostPathType := corev1.HostPathDirectoryOrCreate
+	cacheVolume := corev1.Volume{
+		Name: VolumeBuildCache,
+		VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+			Path: "/var/lib/gitpod/build-cache",
+			Type: &hostPathType,
+		}},
+	}
+	cacheMount := corev1.VolumeMount{Name: VolumeBuildCache, MountPath: "/workspace/.build-cache"}
+	otherMount := corev1.VolumeMount{Name: "other", MountPath: "/other"}
+
+	tests := []struct {
+		Name       string
+		BuildCache *imgbuildercfg.BuildCacheConfig
+		Template   *corev1.Pod
+		Expected   *corev1.Pod
+	}{
+		{
+			Name: "no build cache",
+		},
+		{
+			Name:       "registry build cache",
+			BuildCache: &imgbuildercfg.BuildCacheConfig{Mode: imgbuildercfg.BuildCacheModeRegistry},
+		},
+		{
+			Name:       "local build cache",
+			BuildCache: &imgbuildercfg.BuildCacheConfig{Mode: imgbuildercfg.BuildCacheModeLocal, Dir: cacheMount.MountPath},
+			Expected: &corev1.Pod{Spec: corev1.PodSpec{
+				Volumes:    []corev1.Volume{cacheVolume},
+				Containers: []corev1.Container{{Name: "workspace", VolumeMounts: []corev1.VolumeMount{cacheMount}}},
+			}},
+		},
+		{
+			Name:       "local build cache with template",
+			BuildCache: &imgbuildercfg.BuildCacheConfig{Mode: imgbuildercfg.BuildCacheModeLocal, Dir: cacheMount.MountPath},
+			Template: &corev1.Pod{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "workspace", VolumeMounts: []corev1.VolumeMount{otherMount}}},
+			}},
+			Expected: &corev1.Pod{Spec: corev1.PodSpec{
+				Volumes:    []corev1.Volume{cacheVolume},
+				Containers: []corev1.Container{{Name: "workspace", VolumeMounts: []corev1.VolumeMount{otherMount, cacheMount}}},
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			wsCfg := &experimental.WorkspaceConfig{}
+			wsCfg.ImageBuilderMk3.BuildCache = test.BuildCache
+			wsCfg.ImageBuilderMk3.BuildCacheHostPath = cacheVolume.HostPath.Path
+			ctx, err := common.NewRenderContext(config.Config{
+				Experimental: &experimental.Config{Workspace: wsCfg},
+			}, versions.Manifest{}, "test-namespace")
+			require.NoError(t, err)
+
+			var tpl *corev1.Pod
+			if test.Template != nil {
+				tpl = test.Template.DeepCopy()
+			}
+			act := imageBuildTemplate(ctx, tpl)
+			if diff := cmp.Diff(test.Expected, act); test.Expected != nil && diff != "" {
+				t.Errorf("unexpected template (-want +got):\n%s", diff)
+			}
+			if test.Expected == nil && act != tpl {
+				t.Errorf("template was modified")
+			}
+		})
+	}
+}
+
 func TestWorkspaceURLTemplates(t *testing.T) {
 	tests := []struct {
 		Name                             string
@@ -16,6 +16,7 @@ const (
 	VolumeConfig               = "config"
 	VolumeTLSCerts             = "tls-certs"
 	VolumeWorkspaceTemplate    = "workspace-template"
+	VolumeBuildCache           = "build-cache"
 	WorkspaceTemplatePath      = "/workspace-templates"
 	WorkspaceTemplateConfigMap = "workspace-templates"
 )
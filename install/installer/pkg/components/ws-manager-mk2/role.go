@@ -109,6 +109,8 @@ var controllerClusterRules = []rbacv1.PolicyRule{
 		Verbs: []string{
 			"get",
 			"list",
+			"patch",
+			"update",
 			"watch",
 		},
 	},
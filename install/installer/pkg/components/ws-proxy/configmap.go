@@ -6,6 +6,8 @@ package wsproxy
 
 import (
 	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/gitpod-io/gitpod/installer/pkg/components/workspace"
@@ -36,6 +38,9 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 	gitpodInstallationWorkspaceHostSuffix := fmt.Sprintf(".ws%s.%s", installationShortNameSuffix, ctx.Config.Domain)
 	gitpodInstallationWorkspaceHostSuffixRegex := fmt.Sprintf("\\.ws[^\\.]*\\.%s", ctx.Config.Domain)
+	var workspaceRouting proxy.WorkspaceRouting
+	var portRateLimit *proxy.PortRateLimitConfig
+	var portInspector *proxy.PortInspectorConfig
 
 	wsManagerConfig := &config.WorkspaceManagerConn{
 		Addr: fmt.Sprintf("ws-manager-mk2:%d", wsmanagermk2.RPCPort),
@@ -69,6 +74,37 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		if ucfg.Workspace.WSProxy.GitpodInstallationWorkspaceHostSuffixRegex != "" {
 			gitpodInstallationWorkspaceHostSuffixRegex = ucfg.Workspace.WSProxy.GitpodInstallationWorkspaceHostSuffixRegex
 		}
+		workspaceRouting = proxy.WorkspaceRouting(ucfg.Workspace.WSProxy.WorkspaceRouting)
+		if ucfg.Workspace.WSProxy.PortRateLimit != nil {
+			limits := *ucfg.Workspace.WSProxy.PortRateLimit
+			classes := make(map[string]proxy.PortRateLimits, len(limits.Classes))
+			for k, v := range limits.Classes {
+				classes[k] = v
+			}
+			for k, c := range ucfg.Workspace.WorkspaceClasses {
+				if c.PortRateLimits != nil {
+					classes[k] = *c.PortRateLimits
+				}
+			}
+			limits.Classes = classes
+			portRateLimit = &limits
+		}
+		if pi := ucfg.Workspace.WSProxy.PortInspector; pi != nil {
+			portInspector = &proxy.PortInspectorConfig{
+				MaxBodySize: 64 * 1024,
+				QueueSize:   100,
+				Concurrency: 16,
+			}
+			if pi.MaxBodySize > 0 {
+				portInspector.MaxBodySize = pi.MaxBodySize
+			}
+			if pi.QueueSize > 0 {
+				portInspector.QueueSize = pi.QueueSize
+			}
+			if pi.Concurrency > 0 {
+				portInspector.Concurrency = pi.Concurrency
+			}
+		}
 
 		return nil
 	})
@@ -105,6 +141,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				HostName:                 gitpodInstallationHostName,
 				WorkspaceHostSuffix:      gitpodInstallationWorkspaceHostSuffix,
 				WorkspaceHostSuffixRegex: gitpodInstallationWorkspaceHostSuffixRegex,
+				WorkspaceRouting:         workspaceRouting,
 			},
 			WorkspacePodConfig: &proxy.WorkspacePodConfig{
 				TheiaPort:               workspace.ContainerPort,
@@ -117,6 +154,9 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			BuiltinPages: proxy.BuiltinPagesConfig{
 				Location: "/app/public",
 			},
+			PortRateLimit: portRateLimit,
+			PortShare:     portShareConfig(ctx),
+			PortInspector: portInspector,
 		},
 		PProfAddr:          common.LocalhostAddressFromPort(baseserver.BuiltinDebugPort),
 		PrometheusAddr:     common.LocalhostPrometheusAddr(),
@@ -124,10 +164,23 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		WorkspaceManager:   wsManagerConfig,
 	}
 
+	if workspaceRouting == proxy.WorkspaceRoutingPath {
+		wspcfg.Proxy.GitpodInstallation.WorkspaceHost = strings.TrimPrefix(gitpodInstallationWorkspaceHostSuffix, ".")
+		// path based routing serves all workspaces from one origin, see the WorkspaceRouting experimental config
+		wspcfg.Proxy.GitpodInstallation.InsecureSharedWorkspaceOrigin = true
+	}
+
 	if ctx.Config.SSHGatewayCAKey != nil {
 		wspcfg.Proxy.SSHGatewayCAKeyFile = "/mnt/ca-key/ca.key"
 	}
 
+	if ca := sshUserCA(ctx); ca != nil {
+		wspcfg.Proxy.SSHGatewayUserCAKeyFile = path.Join(sshUserCADir, "ca.pub")
+		wspcfg.Proxy.SSHGatewayUserCertMaxValidity = ca.MaxValidity
+	}
+
+	wspcfg.Proxy.SSHGatewayAudit = sshAuditConfig(ctx)
+
 	fc, err := common.ToJSONString(wspcfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal ws-proxy config: %w", err)
@@ -21,4 +21,11 @@ const (
 	SSHTargetPort        = 2200
 	SSHPortName          = "ssh"
 	ReadinessPort        = 8086
+	// PortShareKeySecretName is the secret with the key ws-proxy signs port share links with
+	PortShareKeySecretName = "ws-proxy-port-share-key"
+	portShareKeyDir        = "/secrets/port-share"
+	sshUserCADir           = "/secrets/ssh-user-ca"
+	// SSHAuditClaimName is the persistent volume claim the SSH gateway keeps audit events and recordings on
+	SSHAuditClaimName = "ws-proxy-ssh-audit"
+	sshAuditDir       = "/var/lib/ssh-audit"
 )
@@ -81,6 +81,34 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		})
 	}
 
+	if ca := sshUserCA(ctx); ca != nil {
+		volume, mount := sshUserCAVolume(ca.SecretName)
+		volumes = append(volumes, volume)
+		volumeMounts = append(volumeMounts, mount)
+	}
+
+	if portShareConfig(ctx) != nil {
+		volume, mount := portShareKeyVolume()
+		volumes = append(volumes, volume)
+		volumeMounts = append(volumeMounts, mount)
+	}
+
+	var (
+		env     []corev1.EnvVar
+		fsGroup *int64
+	)
+	if audit := sshAudit(ctx); audit != nil && audit.Storage != nil {
+		volume, mounts := sshAuditVolume()
+		volumes = append(volumes, volume)
+		volumeMounts = append(volumeMounts, mounts...)
+		// the audit log mount is expanded per pod
+		env = append(env, corev1.EnvVar{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+		}})
+		// makes the volume writable for ws-proxy
+		fsGroup = pointer.Int64(31002)
+	}
+
 	podSpec := corev1.PodSpec{
 		PriorityClassName:         common.SystemNodeCritical,
 		Affinity:                  cluster.WithNodeAffinityHostnameAntiAffinity(Component, cluster.AffinityLabelServices),
@@ -89,6 +117,7 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		ServiceAccountName:        Component,
 		SecurityContext: &corev1.PodSecurityContext{
 			RunAsUser: pointer.Int64(31002),
+			FSGroup:   fsGroup,
 		},
 		TerminationGracePeriodSeconds: pointer.Int64(360),
 		Volumes: append([]corev1.Volume{
@@ -142,6 +171,7 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 				common.DefaultEnv(&ctx.Config),
 				common.WorkspaceTracingEnv(ctx, Component),
 				common.AnalyticsEnv(&ctx.Config),
+				env,
 			)),
 			ReadinessProbe: &corev1.Probe{
 				InitialDelaySeconds: int32(2),
@@ -17,6 +17,8 @@ var Objects = common.CompositeRenderFunc(
 	rolebinding,
 	role,
 	pdb,
+	portShareKey,
+	sshAuditClaim,
 	func(cfg *common.RenderContext) ([]runtime.Object, error) {
 		ports := []common.ServicePort{
 			{
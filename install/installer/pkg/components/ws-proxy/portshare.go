@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package wsproxy
+
+import (
+	"math"
+	"path"
+	"time"
+
+	certmanagerv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/proxy"
+)
+
+// portShareConfig returns the port share config of ws-proxy, or nil if sharing ports is disabled
+func portShareConfig(ctx *common.RenderContext) *proxy.PortShareConfig {
+	var res *proxy.PortShareConfig
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSProxy.PortShare == nil {
+			return nil
+		}
+		res = &proxy.PortShareConfig{
+			Signing: proxy.PortShareKey{
+				ID:             "0001",
+				PrivateKeyPath: path.Join(portShareKeyDir, "tls.key"),
+			},
+			MaxTTL: ucfg.Workspace.WSProxy.PortShare.MaxTTL,
+		}
+		return nil
+	})
+	return res
+}
+
+// portShareKey creates the key ws-proxy signs port share links with. We let cert-manager
+// create it, so that it remains the same across deployments.
+func portShareKey(ctx *common.RenderContext) ([]runtime.Object, error) {
+	if portShareConfig(ctx) == nil {
+		return nil, nil
+	}
+
+	return []runtime.Object{
+		&certmanagerv1.Certificate{
+			TypeMeta: common.TypeMetaCertificate,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PortShareKeySecretName,
+				Namespace: ctx.Namespace,
+				Labels:    common.DefaultLabels(Component),
+			},
+			Spec: certmanagerv1.CertificateSpec{
+				Duration: &metav1.Duration{
+					Duration: time.Duration(math.MaxInt64), // never expire automatically
+				},
+				SecretName: PortShareKeySecretName,
+				CommonName: Component,
+				IssuerRef: cmmeta.ObjectReference{
+					Name:  common.CertManagerCAIssuer,
+					Kind:  certmanagerv1.ClusterIssuerKind,
+					Group: "cert-manager.io",
+				},
+				PrivateKey: &certmanagerv1.CertificatePrivateKey{
+					Encoding:  certmanagerv1.PKCS8,
+					Size:      2048,
+					Algorithm: certmanagerv1.RSAKeyAlgorithm,
+				},
+				SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
+					Labels: common.DefaultLabels(Component),
+				},
+			},
+		},
+	}, nil
+}
+
+func portShareKeyVolume() (corev1.Volume, corev1.VolumeMount) {
+	return corev1.Volume{
+		Name: "port-share-key",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: PortShareKeySecretName,
+			},
+		},
+	}, corev1.VolumeMount{
+		Name:      "port-share-key",
+		MountPath: portShareKeyDir,
+		ReadOnly:  true,
+	}
+}
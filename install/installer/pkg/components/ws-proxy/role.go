@@ -33,6 +33,14 @@ func role(ctx *common.RenderContext) ([]runtime.Object, error) {
 			},
 		},
 	}
+	if portShareConfig(ctx) != nil {
+		// revoked port share links are recorded on the workspace resource
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{"workspace.gitpod.io"},
+			Resources: []string{"workspaces"},
+			Verbs:     []string{"update"},
+		})
+	}
 
 	return []runtime.Object{&rbacv1.Role{
 		TypeMeta: common.TypeMetaRole,
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package wsproxy
+
+import (
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/proxy"
+)
+
+// sshUserCA returns the CAs whose user certificates the SSH gateway accepts, or nil if it accepts none
+func sshUserCA(ctx *common.RenderContext) *experimental.WSProxySSHUserCAConfig {
+	var res *experimental.WSProxySSHUserCAConfig
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSProxy.SSHUserCA == nil || ucfg.Workspace.WSProxy.SSHUserCA.SecretName == "" {
+			return nil
+		}
+		res = ucfg.Workspace.WSProxy.SSHUserCA
+		return nil
+	})
+	return res
+}
+
+func sshUserCAVolume(secretName string) (corev1.Volume, corev1.VolumeMount) {
+	return corev1.Volume{
+		Name: "ssh-user-ca",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}, corev1.VolumeMount{
+		Name:      "ssh-user-ca",
+		MountPath: sshUserCADir,
+		ReadOnly:  true,
+	}
+}
+
+// sshAudit returns the audit config of the SSH gateway, or nil if SSH sessions aren't audited
+func sshAudit(ctx *common.RenderContext) *experimental.WSProxySSHAuditConfig {
+	var res *experimental.WSProxySSHAuditConfig
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil {
+			return nil
+		}
+		res = ucfg.Workspace.WSProxy.SSHAudit
+		return nil
+	})
+	return res
+}
+
+// sshAuditConfig returns the ws-proxy config of the SSH gateway audit. Audit events and recordings are kept on
+// the SSH audit volume, so that they survive restarts of ws-proxy.
+func sshAuditConfig(ctx *common.RenderContext) *proxy.SSHGatewayAuditConfig {
+	audit := sshAudit(ctx)
+	if audit == nil {
+		return nil
+	}
+
+	res := &proxy.SSHGatewayAuditConfig{
+		Log: audit.Log,
+	}
+	if audit.Storage != nil {
+		res.LogFile = path.Join(sshAuditDir, "log", "audit.jsonl")
+		res.RecordingLocation = path.Join(sshAuditDir, "recordings")
+		res.RecordedOrganizations = audit.RecordedOrganizations
+		res.RecordingRetention = audit.RecordingRetention
+	}
+	return res
+}
+
+// sshAuditClaim creates the volume claim all ws-proxy replicas keep SSH audit events and recordings on
+func sshAuditClaim(ctx *common.RenderContext) ([]runtime.Object, error) {
+	audit := sshAudit(ctx)
+	if audit == nil || audit.Storage == nil {
+		return nil, nil
+	}
+
+	size := audit.Storage.Size
+	if size.IsZero() {
+		size = resource.MustParse("10Gi")
+	}
+	var storageClass *string
+	if audit.Storage.StorageClass != "" {
+		storageClass = pointer.String(audit.Storage.StorageClass)
+	}
+
+	return []runtime.Object{
+		&corev1.PersistentVolumeClaim{
+			TypeMeta: common.TypeMetaPersistentVolumeClaim,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      SSHAuditClaimName,
+				Namespace: ctx.Namespace,
+				Labels:    common.DefaultLabels(Component),
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				StorageClassName: storageClass,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: size,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// sshAuditVolume mounts the SSH audit claim. Recordings are shared by all replicas, whereas every pod appends
+// audit events to a file of its own.
+func sshAuditVolume() (corev1.Volume, []corev1.VolumeMount) {
+	return corev1.Volume{
+		Name: "ssh-audit",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: SSHAuditClaimName,
+			},
+		},
+	}, []corev1.VolumeMount{
+		{
+			Name:      "ssh-audit",
+			MountPath: path.Join(sshAuditDir, "recordings"),
+			SubPath:   "recordings",
+		},
+		{
+			Name:        "ssh-audit",
+			MountPath:   path.Join(sshAuditDir, "log"),
+			SubPathExpr: "log/$(POD_NAME)",
+		},
+	}
+}
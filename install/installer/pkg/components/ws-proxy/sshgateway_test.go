@@ -0,0 +1,212 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package wsproxy
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	configv1 "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/versions"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/config"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/proxy"
+)
+
+func TestSSHUserCA(t *testing.T) {
+	type Expectation struct {
+		UserCAKeyFile string
+		MaxValidity   util.Duration
+		Volume        *corev1.Volume
+		VolumeMount   *corev1.VolumeMount
+	}
+	tests := []struct {
+		Name        string
+		UserCA      *experimental.WSProxySSHUserCAConfig
+		Expectation Expectation
+	}{
+		{
+			Name: "no user CA",
+		},
+		{
+			Name:   "user CA",
+			UserCA: &experimental.WSProxySSHUserCAConfig{SecretName: "ssh-user-ca", MaxValidity: util.Duration(8 * time.Hour)},
+			Expectation: Expectation{
+				UserCAKeyFile: "/secrets/ssh-user-ca/ca.pub",
+				MaxValidity:   util.Duration(8 * time.Hour),
+				Volume: &corev1.Volume{
+					Name: "ssh-user-ca",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: "ssh-user-ca"},
+					},
+				},
+				VolumeMount: &corev1.VolumeMount{Name: "ssh-user-ca", MountPath: "/secrets/ssh-user-ca", ReadOnly: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := renderContext(t, func(ws *experimental.WorkspaceConfig) {
+				ws.WSProxy.SSHUserCA = test.UserCA
+			})
+			cfg, pod := render(t, ctx)
+
+			act := Expectation{
+				UserCAKeyFile: cfg.Proxy.SSHGatewayUserCAKeyFile,
+				MaxValidity:   cfg.Proxy.SSHGatewayUserCertMaxValidity,
+			}
+			for i, v := range pod.Volumes {
+				if v.Name == "ssh-user-ca" {
+					act.Volume = &pod.Volumes[i]
+				}
+			}
+			for i, m := range pod.Containers[0].VolumeMounts {
+				if m.Name == "ssh-user-ca" {
+					act.VolumeMount = &pod.Containers[0].VolumeMounts[i]
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected rendering (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHAudit(t *testing.T) {
+	type Expectation struct {
+		Audit        *proxy.SSHGatewayAuditConfig
+		Claim        *corev1.PersistentVolumeClaimSpec
+		VolumeMounts []corev1.VolumeMount
+		PodNameEnv   bool
+	}
+	tests := []struct {
+		Name        string
+		Audit       *experimental.WSProxySSHAuditConfig
+		Expectation Expectation
+	}{
+		{
+			Name: "no audit",
+		},
+		{
+			Name:  "log only",
+			Audit: &experimental.WSProxySSHAuditConfig{Log: true},
+			Expectation: Expectation{
+				Audit: &proxy.SSHGatewayAuditConfig{Log: true},
+			},
+		},
+		{
+			Name: "persistent storage",
+			Audit: &experimental.WSProxySSHAuditConfig{
+				Storage:               &experimental.WSProxySSHAuditStorage{StorageClass: "nfs", Size: resource.MustParse("50Gi")},
+				RecordedOrganizations: []string{"org"},
+				RecordingRetention:    util.Duration(7 * 24 * time.Hour),
+			},
+			Expectation: Expectation{
+				Audit: &proxy.SSHGatewayAuditConfig{
+					LogFile:               "/var/lib/ssh-audit/log/audit.jsonl",
+					RecordingLocation:     "/var/lib/ssh-audit/recordings",
+					RecordedOrganizations: []string{"org"},
+					RecordingRetention:    util.Duration(7 * 24 * time.Hour),
+				},
+				Claim: &corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					StorageClassName: pointer.String("nfs"),
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("50Gi")},
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "ssh-audit", MountPath: "/var/lib/ssh-audit/recordings", SubPath: "recordings"},
+					{Name: "ssh-audit", MountPath: "/var/lib/ssh-audit/log", SubPathExpr: "log/$(POD_NAME)"},
+				},
+				PodNameEnv: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := renderContext(t, func(ws *experimental.WorkspaceConfig) {
+				ws.WSProxy.SSHAudit = test.Audit
+			})
+			cfg, pod := render(t, ctx)
+
+			act := Expectation{
+				Audit: cfg.Proxy.SSHGatewayAudit,
+			}
+			objs, err := sshAuditClaim(ctx)
+			require.NoError(t, err)
+			if len(objs) > 0 {
+				act.Claim = &objs[0].(*corev1.PersistentVolumeClaim).Spec
+			}
+			for _, m := range pod.Containers[0].VolumeMounts {
+				if m.Name == "ssh-audit" {
+					act.VolumeMounts = append(act.VolumeMounts, m)
+				}
+			}
+			for _, e := range pod.Containers[0].Env {
+				if e.Name == "POD_NAME" {
+					act.PodNameEnv = true
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); diff != "" {
+				t.Errorf("unexpected rendering (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func renderContext(t *testing.T, mod func(ws *experimental.WorkspaceConfig)) *common.RenderContext {
+	t.Helper()
+
+	var ws experimental.WorkspaceConfig
+	mod(&ws)
+	var manifest versions.Manifest
+	manifest.Components.WSProxy.Version = "test"
+	manifest.Components.Workspace.Supervisor.Version = "test"
+	ctx, err := common.NewRenderContext(configv1.Config{
+		Domain:     "example.com",
+		Repository: "eu.gcr.io/gitpod",
+		ObjectStorage: configv1.ObjectStorage{
+			InCluster: pointer.Bool(true),
+		},
+		Experimental: &experimental.Config{
+			Workspace: &ws,
+		},
+	}, manifest, "test_namespace")
+	require.NoError(t, err)
+	return ctx
+}
+
+// render returns the ws-proxy config and pod spec of a render context
+func render(t *testing.T, ctx *common.RenderContext) (*config.Config, *corev1.PodSpec) {
+	t.Helper()
+
+	objs, err := configmap(ctx)
+	require.NoError(t, err)
+	cfgmap, ok := objs[0].(*corev1.ConfigMap)
+	require.Truef(t, ok, "configmap function did not return a configmap")
+	var cfg config.Config
+	err = json.Unmarshal([]byte(cfgmap.Data["config.json"]), &cfg)
+	require.NoError(t, err)
+
+	objs, err = deployment(ctx)
+	require.NoError(t, err)
+	deploy, ok := objs[0].(*appsv1.Deployment)
+	require.Truef(t, ok, "deployment function did not return a deployment")
+
+	return &cfg, &deploy.Spec.Template.Spec
+}
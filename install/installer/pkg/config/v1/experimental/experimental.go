@@ -15,8 +15,13 @@ import (
 
 	agentSmith "github.com/gitpod-io/gitpod/agent-smith/pkg/config"
 	"github.com/gitpod-io/gitpod/common-go/grpc"
+	"github.com/gitpod-io/gitpod/common-go/util"
 	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	imgbuildercfg "github.com/gitpod-io/gitpod/image-builder/api/config"
+	regfac "github.com/gitpod-io/gitpod/registry-facade/api/config"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
+	wsmancfg "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/proxy"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -84,8 +89,17 @@ type WorkspaceConfig struct {
 
 	ProcLimit int64 `json:"procLimit"`
 
+	MachineActivity struct {
+		Enabled  bool          `json:"enabled"`
+		Interval util.Duration `json:"interval"`
+	} `json:"machineActivity"`
+
 	WSManagerRateLimits map[string]grpc.RateLimit `json:"wsManagerRateLimits,omitempty"`
 
+	// Audit configures the sinks ws-manager-mk2 publishes workspace lifecycle events to.
+	// The source defaults to the workspace cluster host.
+	Audit *wsmancfg.AuditConfiguration `json:"audit,omitempty"`
+
 	RegistryFacade struct {
 		IPFSCache struct {
 			Enabled  bool   `json:"enabled"`
@@ -99,6 +113,14 @@ type WorkspaceConfig struct {
 			UseTLS             bool   `json:"useTLS"`
 			InsecureSkipVerify bool   `json:"insecureSkipVerify"`
 		} `json:"redisCache"`
+		// Prewarm enables the image prewarm service, which listens on localhost and is used by gpctl images prewarm
+		Prewarm struct {
+			Enabled bool `json:"enabled"`
+			// Containerd makes registry-facade pull prewarmed images into the node's containerd, too.
+			// This requires registry-facade to run as root.
+			Containerd bool                    `json:"containerd"`
+			Schedule   *regfac.PrewarmSchedule `json:"schedule,omitempty"`
+		} `json:"prewarm"`
 	} `json:"registryFacade"`
 
 	WSDaemon struct {
@@ -116,6 +138,21 @@ type WorkspaceConfig struct {
 		GitpodInstallationHostName                 string `json:"gitpodInstallationHostName"`
 		GitpodInstallationWorkspaceHostSuffix      string `json:"gitpodInstallationWorkspaceHostSuffix"`
 		GitpodInstallationWorkspaceHostSuffixRegex string `json:"gitpodInstallationWorkspaceHostSuffixRegex"`
+		// WorkspaceRouting is either "host" (default) or "path". With "path", workspaces are served from
+		// ws.<domain>/ws/<id>/ instead of their own subdomains, which requires neither wildcard DNS nor wildcard certificates.
+		// All workspaces then share one origin, so a page served from any workspace port can script the IDEs of every
+		// workspace the visitor is logged into. Choosing "path" accepts that and sets ws-proxy's insecureSharedWorkspaceOrigin.
+		WorkspaceRouting string `json:"workspaceRouting,omitempty"`
+		// PortRateLimit limits the requests to and the bandwidth of public workspace ports and of ports accessed through share links
+		PortRateLimit *proxy.PortRateLimitConfig `json:"portRateLimit,omitempty"`
+		// PortShare enables shareable, expiring links to private workspace ports
+		PortShare *WSProxyPortShareConfig `json:"portShare,omitempty"`
+		// PortInspector captures the requests to workspace ports users enabled inspection for
+		PortInspector *WSProxyPortInspectorConfig `json:"portInspector,omitempty"`
+		// SSHUserCA makes the SSH gateway accept user certificates signed by the CAs in a secret
+		SSHUserCA *WSProxySSHUserCAConfig `json:"sshUserCA,omitempty"`
+		// SSHAudit audits and records the SSH sessions at the gateway
+		SSHAudit *WSProxySSHAuditConfig `json:"sshAudit,omitempty"`
 	} `json:"wsProxy"`
 
 	ContentService struct {
@@ -128,14 +165,66 @@ type WorkspaceConfig struct {
 	ImageBuilderMk3 struct {
 		BaseImageRepositoryName      string `json:"baseImageRepositoryName"`
 		WorkspaceImageRepositoryName string `json:"workspaceImageRepositoryName"`
+		// BuildCache configures the BuildKit cache of image builds
+		BuildCache *imgbuildercfg.BuildCacheConfig `json:"buildCache,omitempty"`
+		// BuildCacheHostPath is the node directory which keeps the local build cache.
+		// It is mounted into image build workspaces at buildCache.dir.
+		BuildCacheHostPath string `json:"buildCacheHostPath,omitempty"`
 	} `json:"imageBuilderMk3"`
 }
 
+type WSProxySSHUserCAConfig struct {
+	// SecretName is the secret which holds the public keys of the CAs under the ca.pub key, in authorized_keys format
+	SecretName string `json:"secretName"`
+	// MaxValidity is the longest validity period of user certificates the SSH gateway accepts
+	MaxValidity util.Duration `json:"maxValidity,omitempty"`
+}
+
+type WSProxySSHAuditConfig struct {
+	// Log writes audit events to the log of ws-proxy
+	Log bool `json:"log,omitempty"`
+	// Storage keeps the audit events and the terminal session recordings on the persistent volume claim ws-proxy-ssh-audit.
+	// Recordings are written to recordings/<workspace-id>/ and audit events to log/<pod-name>/audit.jsonl on that volume.
+	// Terminal sessions are recorded only if the storage is configured.
+	Storage *WSProxySSHAuditStorage `json:"storage,omitempty"`
+	// RecordedOrganizations are the IDs of organizations whose terminal sessions are recorded, in addition
+	// to those which enabled the recording in their organization settings
+	RecordedOrganizations []string `json:"recordedOrganizations,omitempty"`
+	// RecordingRetention is how long recordings are kept before they're deleted. Defaults to 30 days.
+	RecordingRetention util.Duration `json:"recordingRetention,omitempty"`
+}
+
+type WSProxySSHAuditStorage struct {
+	// StorageClass of the volume. All ws-proxy replicas share the volume, hence the storage class must support ReadWriteMany.
+	// Defaults to the default storage class of the cluster.
+	StorageClass string `json:"storageClass,omitempty"`
+	// Size of the volume. Defaults to 10Gi.
+	Size resource.Quantity `json:"size,omitempty"`
+}
+
+type WSProxyPortShareConfig struct {
+	// MaxTTL is the longest a share link may be valid. Defaults to 24 hours.
+	MaxTTL util.Duration `json:"maxTTL,omitempty"`
+}
+
+type WSProxyPortInspectorConfig struct {
+	// MaxBodySize is the number of bytes captured of request and response bodies. Defaults to 64 KiB.
+	MaxBodySize int64 `json:"maxBodySize,omitempty"`
+	// QueueSize is the number of captured requests per workspace waiting to be sent to supervisor. Defaults to 100.
+	QueueSize int `json:"queueSize,omitempty"`
+	// Concurrency is the number of supervisors ws-proxy sends captured requests to at the same time. Defaults to 16.
+	Concurrency int `json:"concurrency,omitempty"`
+}
+
 type WorkspaceClass struct {
 	Name        string             `json:"name" validate:"required"`
 	Description string             `json:"description"`
 	Resources   WorkspaceResources `json:"resources" validate:"required"`
 	Templates   WorkspaceTemplates `json:"templates,omitempty"`
+	// PortRateLimits overrides the default rate limits of public and shared ports for workspaces of this class
+	PortRateLimits *proxy.PortRateLimits `json:"portRateLimits,omitempty"`
+	// MachineActivity configures which workload keeps workspaces of this class from timing out
+	MachineActivity *wsmancfg.MachineActivityConfiguration `json:"machineActivity,omitempty"`
 }
 
 type WorkspaceResources struct {